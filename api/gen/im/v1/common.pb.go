@@ -29,6 +29,7 @@ const (
 	ConversationType_CONVERSATION_TYPE_UNSPECIFIED ConversationType = 0
 	ConversationType_CONVERSATION_TYPE_SINGLE      ConversationType = 1
 	ConversationType_CONVERSATION_TYPE_GROUP       ConversationType = 2
+	ConversationType_CONVERSATION_TYPE_CHANNEL     ConversationType = 3 // 频道：仅群主和管理员可发言，订阅者只读
 )
 
 // Enum value maps for ConversationType.
@@ -37,11 +38,13 @@ var (
 		0: "CONVERSATION_TYPE_UNSPECIFIED",
 		1: "CONVERSATION_TYPE_SINGLE",
 		2: "CONVERSATION_TYPE_GROUP",
+		3: "CONVERSATION_TYPE_CHANNEL",
 	}
 	ConversationType_value = map[string]int32{
 		"CONVERSATION_TYPE_UNSPECIFIED": 0,
 		"CONVERSATION_TYPE_SINGLE":      1,
 		"CONVERSATION_TYPE_GROUP":       2,
+		"CONVERSATION_TYPE_CHANNEL":     3,
 	}
 )
 
@@ -86,21 +89,23 @@ const (
 	MessageContentType_MESSAGE_CONTENT_TYPE_CALL_ACCEPT MessageContentType = 7
 	MessageContentType_MESSAGE_CONTENT_TYPE_CALL_REJECT MessageContentType = 8
 	MessageContentType_MESSAGE_CONTENT_TYPE_CALL_END    MessageContentType = 9
+	MessageContentType_MESSAGE_CONTENT_TYPE_SYSTEM      MessageContentType = 10
 )
 
 // Enum value maps for MessageContentType.
 var (
 	MessageContentType_name = map[int32]string{
-		0: "MESSAGE_CONTENT_TYPE_UNSPECIFIED",
-		1: "MESSAGE_CONTENT_TYPE_TEXT",
-		2: "MESSAGE_CONTENT_TYPE_IMAGE",
-		3: "MESSAGE_CONTENT_TYPE_FILE",
-		4: "MESSAGE_CONTENT_TYPE_AUDIO",
-		5: "MESSAGE_CONTENT_TYPE_VIDEO",
-		6: "MESSAGE_CONTENT_TYPE_CALL_INVITE",
-		7: "MESSAGE_CONTENT_TYPE_CALL_ACCEPT",
-		8: "MESSAGE_CONTENT_TYPE_CALL_REJECT",
-		9: "MESSAGE_CONTENT_TYPE_CALL_END",
+		0:  "MESSAGE_CONTENT_TYPE_UNSPECIFIED",
+		1:  "MESSAGE_CONTENT_TYPE_TEXT",
+		2:  "MESSAGE_CONTENT_TYPE_IMAGE",
+		3:  "MESSAGE_CONTENT_TYPE_FILE",
+		4:  "MESSAGE_CONTENT_TYPE_AUDIO",
+		5:  "MESSAGE_CONTENT_TYPE_VIDEO",
+		6:  "MESSAGE_CONTENT_TYPE_CALL_INVITE",
+		7:  "MESSAGE_CONTENT_TYPE_CALL_ACCEPT",
+		8:  "MESSAGE_CONTENT_TYPE_CALL_REJECT",
+		9:  "MESSAGE_CONTENT_TYPE_CALL_END",
+		10: "MESSAGE_CONTENT_TYPE_SYSTEM",
 	}
 	MessageContentType_value = map[string]int32{
 		"MESSAGE_CONTENT_TYPE_UNSPECIFIED": 0,
@@ -113,6 +118,7 @@ var (
 		"MESSAGE_CONTENT_TYPE_CALL_ACCEPT": 7,
 		"MESSAGE_CONTENT_TYPE_CALL_REJECT": 8,
 		"MESSAGE_CONTENT_TYPE_CALL_END":    9,
+		"MESSAGE_CONTENT_TYPE_SYSTEM":      10,
 	}
 )
 
@@ -356,11 +362,14 @@ func (x *MediaRef) GetThumbnailKey() string {
 	return ""
 }
 
+// mention_user_ids 为被@的成员，mention_all 表示@所有人
 type TextBody struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Text           string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	MentionUserIds []int64                `protobuf:"varint,2,rep,packed,name=mention_user_ids,json=mentionUserIds,proto3" json:"mention_user_ids,omitempty"`
+	MentionAll     bool                   `protobuf:"varint,3,opt,name=mention_all,json=mentionAll,proto3" json:"mention_all,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *TextBody) Reset() {
@@ -400,6 +409,20 @@ func (x *TextBody) GetText() string {
 	return ""
 }
 
+func (x *TextBody) GetMentionUserIds() []int64 {
+	if x != nil {
+		return x.MentionUserIds
+	}
+	return nil
+}
+
+func (x *TextBody) GetMentionAll() bool {
+	if x != nil {
+		return x.MentionAll
+	}
+	return false
+}
+
 type CallBody struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ConvoHint     string                 `protobuf:"bytes,1,opt,name=convo_hint,json=convoHint,proto3" json:"convo_hint,omitempty"`
@@ -444,6 +467,76 @@ func (x *CallBody) GetConvoHint() string {
 	return ""
 }
 
+// 系统消息（入群、移除、禁言、改群名等）
+// text 中的 {{uid:N}} 为用户占位符，由客户端替换为展示名；payload 为原始事件 JSON
+type SystemBody struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Payload       string                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	MentionAll    bool                   `protobuf:"varint,4,opt,name=mention_all,json=mentionAll,proto3" json:"mention_all,omitempty"` // 是否提醒所有成员（如发布群公告）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SystemBody) Reset() {
+	*x = SystemBody{}
+	mi := &file_im_v1_common_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemBody) ProtoMessage() {}
+
+func (x *SystemBody) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_common_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemBody.ProtoReflect.Descriptor instead.
+func (*SystemBody) Descriptor() ([]byte, []int) {
+	return file_im_v1_common_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SystemBody) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SystemBody) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SystemBody) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *SystemBody) GetMentionAll() bool {
+	if x != nil {
+		return x.MentionAll
+	}
+	return false
+}
+
 type MessageBody struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Body:
@@ -454,6 +547,7 @@ type MessageBody struct {
 	//	*MessageBody_Audio
 	//	*MessageBody_Video
 	//	*MessageBody_Call
+	//	*MessageBody_System
 	Body          isMessageBody_Body `protobuf_oneof:"body"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -461,7 +555,7 @@ type MessageBody struct {
 
 func (x *MessageBody) Reset() {
 	*x = MessageBody{}
-	mi := &file_im_v1_common_proto_msgTypes[5]
+	mi := &file_im_v1_common_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -473,7 +567,7 @@ func (x *MessageBody) String() string {
 func (*MessageBody) ProtoMessage() {}
 
 func (x *MessageBody) ProtoReflect() protoreflect.Message {
-	mi := &file_im_v1_common_proto_msgTypes[5]
+	mi := &file_im_v1_common_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -486,7 +580,7 @@ func (x *MessageBody) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageBody.ProtoReflect.Descriptor instead.
 func (*MessageBody) Descriptor() ([]byte, []int) {
-	return file_im_v1_common_proto_rawDescGZIP(), []int{5}
+	return file_im_v1_common_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *MessageBody) GetBody() isMessageBody_Body {
@@ -550,6 +644,15 @@ func (x *MessageBody) GetCall() *CallBody {
 	return nil
 }
 
+func (x *MessageBody) GetSystem() *SystemBody {
+	if x != nil {
+		if x, ok := x.Body.(*MessageBody_System); ok {
+			return x.System
+		}
+	}
+	return nil
+}
+
 type isMessageBody_Body interface {
 	isMessageBody_Body()
 }
@@ -578,6 +681,10 @@ type MessageBody_Call struct {
 	Call *CallBody `protobuf:"bytes,6,opt,name=call,proto3,oneof"`
 }
 
+type MessageBody_System struct {
+	System *SystemBody `protobuf:"bytes,7,opt,name=system,proto3,oneof"`
+}
+
 func (*MessageBody_Text) isMessageBody_Body() {}
 
 func (*MessageBody_Image) isMessageBody_Body() {}
@@ -590,6 +697,8 @@ func (*MessageBody_Video) isMessageBody_Body() {}
 
 func (*MessageBody_Call) isMessageBody_Body() {}
 
+func (*MessageBody_System) isMessageBody_Body() {}
+
 type MessageItem struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -599,13 +708,14 @@ type MessageItem struct {
 	ContentType    MessageContentType     `protobuf:"varint,5,opt,name=content_type,json=contentType,proto3,enum=im.v1.MessageContentType" json:"content_type,omitempty"`
 	Body           *MessageBody           `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
 	CreateTime     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	ExpireTime     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"` // 自动删除时间，未设置表示不会过期
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *MessageItem) Reset() {
 	*x = MessageItem{}
-	mi := &file_im_v1_common_proto_msgTypes[6]
+	mi := &file_im_v1_common_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -617,7 +727,7 @@ func (x *MessageItem) String() string {
 func (*MessageItem) ProtoMessage() {}
 
 func (x *MessageItem) ProtoReflect() protoreflect.Message {
-	mi := &file_im_v1_common_proto_msgTypes[6]
+	mi := &file_im_v1_common_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -630,7 +740,7 @@ func (x *MessageItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageItem.ProtoReflect.Descriptor instead.
 func (*MessageItem) Descriptor() ([]byte, []int) {
-	return file_im_v1_common_proto_rawDescGZIP(), []int{6}
+	return file_im_v1_common_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *MessageItem) GetId() int64 {
@@ -682,6 +792,13 @@ func (x *MessageItem) GetCreateTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *MessageItem) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
 var File_im_v1_common_proto protoreflect.FileDescriptor
 
 const file_im_v1_common_proto_rawDesc = "" +
@@ -705,20 +822,31 @@ const file_im_v1_common_proto_rawDesc = "" +
 	"\n" +
 	"size_bytes\x18\x04 \x01(\x03R\tsizeBytes\x12!\n" +
 	"\fduration_sec\x18\x05 \x01(\x05R\vdurationSec\x12#\n" +
-	"\rthumbnail_key\x18\x06 \x01(\tR\fthumbnailKey\"\x1e\n" +
+	"\rthumbnail_key\x18\x06 \x01(\tR\fthumbnailKey\"i\n" +
 	"\bTextBody\x12\x12\n" +
-	"\x04text\x18\x01 \x01(\tR\x04text\")\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12(\n" +
+	"\x10mention_user_ids\x18\x02 \x03(\x03R\x0ementionUserIds\x12\x1f\n" +
+	"\vmention_all\x18\x03 \x01(\bR\n" +
+	"mentionAll\")\n" +
 	"\bCallBody\x12\x1d\n" +
 	"\n" +
-	"convo_hint\x18\x01 \x01(\tR\tconvoHint\"\x85\x02\n" +
+	"convo_hint\x18\x01 \x01(\tR\tconvoHint\"o\n" +
+	"\n" +
+	"SystemBody\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x18\n" +
+	"\apayload\x18\x03 \x01(\tR\apayload\x12\x1f\n" +
+	"\vmention_all\x18\x04 \x01(\bR\n" +
+	"mentionAll\"\xb2\x02\n" +
 	"\vMessageBody\x12%\n" +
 	"\x04text\x18\x01 \x01(\v2\x0f.im.v1.TextBodyH\x00R\x04text\x12'\n" +
 	"\x05image\x18\x02 \x01(\v2\x0f.im.v1.MediaRefH\x00R\x05image\x12%\n" +
 	"\x04file\x18\x03 \x01(\v2\x0f.im.v1.MediaRefH\x00R\x04file\x12'\n" +
 	"\x05audio\x18\x04 \x01(\v2\x0f.im.v1.MediaRefH\x00R\x05audio\x12'\n" +
 	"\x05video\x18\x05 \x01(\v2\x0f.im.v1.MediaRefH\x00R\x05video\x12%\n" +
-	"\x04call\x18\x06 \x01(\v2\x0f.im.v1.CallBodyH\x00R\x04callB\x06\n" +
-	"\x04body\"\x98\x02\n" +
+	"\x04call\x18\x06 \x01(\v2\x0f.im.v1.CallBodyH\x00R\x04call\x12+\n" +
+	"\x06system\x18\a \x01(\v2\x11.im.v1.SystemBodyH\x00R\x06systemB\x06\n" +
+	"\x04body\"\xd5\x02\n" +
 	"\vMessageItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
 	"\x0fconversation_id\x18\x02 \x01(\x03R\x0econversationId\x12\x1b\n" +
@@ -727,11 +855,14 @@ const file_im_v1_common_proto_rawDesc = "" +
 	"\fcontent_type\x18\x05 \x01(\x0e2\x19.im.v1.MessageContentTypeR\vcontentType\x12&\n" +
 	"\x04body\x18\x06 \x01(\v2\x12.im.v1.MessageBodyR\x04body\x12;\n" +
 	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"createTime*p\n" +
+	"createTime\x12;\n" +
+	"\vexpire_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime*\x8f\x01\n" +
 	"\x10ConversationType\x12!\n" +
 	"\x1dCONVERSATION_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18CONVERSATION_TYPE_SINGLE\x10\x01\x12\x1b\n" +
-	"\x17CONVERSATION_TYPE_GROUP\x10\x02*\xed\x02\n" +
+	"\x17CONVERSATION_TYPE_GROUP\x10\x02\x12\x1d\n" +
+	"\x19CONVERSATION_TYPE_CHANNEL\x10\x03*\x8e\x03\n" +
 	"\x12MessageContentType\x12$\n" +
 	" MESSAGE_CONTENT_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
 	"\x19MESSAGE_CONTENT_TYPE_TEXT\x10\x01\x12\x1e\n" +
@@ -742,7 +873,9 @@ const file_im_v1_common_proto_rawDesc = "" +
 	" MESSAGE_CONTENT_TYPE_CALL_INVITE\x10\x06\x12$\n" +
 	" MESSAGE_CONTENT_TYPE_CALL_ACCEPT\x10\a\x12$\n" +
 	" MESSAGE_CONTENT_TYPE_CALL_REJECT\x10\b\x12!\n" +
-	"\x1dMESSAGE_CONTENT_TYPE_CALL_END\x10\tB*Z(github.com/EthanQC/IM/api/gen/im/v1;imv1b\x06proto3"
+	"\x1dMESSAGE_CONTENT_TYPE_CALL_END\x10\t\x12\x1f\n" +
+	"\x1bMESSAGE_CONTENT_TYPE_SYSTEM\x10\n" +
+	"B*Z(github.com/EthanQC/IM/api/gen/im/v1;imv1b\x06proto3"
 
 var (
 	file_im_v1_common_proto_rawDescOnce sync.Once
@@ -757,7 +890,7 @@ func file_im_v1_common_proto_rawDescGZIP() []byte {
 }
 
 var file_im_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_im_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_im_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_im_v1_common_proto_goTypes = []any{
 	(ConversationType)(0),         // 0: im.v1.ConversationType
 	(MessageContentType)(0),       // 1: im.v1.MessageContentType
@@ -766,9 +899,10 @@ var file_im_v1_common_proto_goTypes = []any{
 	(*MediaRef)(nil),              // 4: im.v1.MediaRef
 	(*TextBody)(nil),              // 5: im.v1.TextBody
 	(*CallBody)(nil),              // 6: im.v1.CallBody
-	(*MessageBody)(nil),           // 7: im.v1.MessageBody
-	(*MessageItem)(nil),           // 8: im.v1.MessageItem
-	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+	(*SystemBody)(nil),            // 7: im.v1.SystemBody
+	(*MessageBody)(nil),           // 8: im.v1.MessageBody
+	(*MessageItem)(nil),           // 9: im.v1.MessageItem
+	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
 }
 var file_im_v1_common_proto_depIdxs = []int32{
 	0,  // 0: im.v1.ConversationBrief.type:type_name -> im.v1.ConversationType
@@ -778,14 +912,16 @@ var file_im_v1_common_proto_depIdxs = []int32{
 	4,  // 4: im.v1.MessageBody.audio:type_name -> im.v1.MediaRef
 	4,  // 5: im.v1.MessageBody.video:type_name -> im.v1.MediaRef
 	6,  // 6: im.v1.MessageBody.call:type_name -> im.v1.CallBody
-	1,  // 7: im.v1.MessageItem.content_type:type_name -> im.v1.MessageContentType
-	7,  // 8: im.v1.MessageItem.body:type_name -> im.v1.MessageBody
-	9,  // 9: im.v1.MessageItem.create_time:type_name -> google.protobuf.Timestamp
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	7,  // 7: im.v1.MessageBody.system:type_name -> im.v1.SystemBody
+	1,  // 8: im.v1.MessageItem.content_type:type_name -> im.v1.MessageContentType
+	8,  // 9: im.v1.MessageItem.body:type_name -> im.v1.MessageBody
+	10, // 10: im.v1.MessageItem.create_time:type_name -> google.protobuf.Timestamp
+	10, // 11: im.v1.MessageItem.expire_time:type_name -> google.protobuf.Timestamp
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_im_v1_common_proto_init() }
@@ -793,13 +929,14 @@ func file_im_v1_common_proto_init() {
 	if File_im_v1_common_proto != nil {
 		return
 	}
-	file_im_v1_common_proto_msgTypes[5].OneofWrappers = []any{
+	file_im_v1_common_proto_msgTypes[6].OneofWrappers = []any{
 		(*MessageBody_Text)(nil),
 		(*MessageBody_Image)(nil),
 		(*MessageBody_File)(nil),
 		(*MessageBody_Audio)(nil),
 		(*MessageBody_Video)(nil),
 		(*MessageBody_Call)(nil),
+		(*MessageBody_System)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -807,7 +944,7 @@ func file_im_v1_common_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_im_v1_common_proto_rawDesc), len(file_im_v1_common_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
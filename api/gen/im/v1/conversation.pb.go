@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,6 +23,168 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 成员角色
+type MemberRole int32
+
+const (
+	MemberRole_MEMBER_ROLE_UNSPECIFIED MemberRole = 0
+	MemberRole_MEMBER_ROLE_MEMBER      MemberRole = 1
+	MemberRole_MEMBER_ROLE_ADMIN       MemberRole = 2
+	MemberRole_MEMBER_ROLE_OWNER       MemberRole = 3
+)
+
+// Enum value maps for MemberRole.
+var (
+	MemberRole_name = map[int32]string{
+		0: "MEMBER_ROLE_UNSPECIFIED",
+		1: "MEMBER_ROLE_MEMBER",
+		2: "MEMBER_ROLE_ADMIN",
+		3: "MEMBER_ROLE_OWNER",
+	}
+	MemberRole_value = map[string]int32{
+		"MEMBER_ROLE_UNSPECIFIED": 0,
+		"MEMBER_ROLE_MEMBER":      1,
+		"MEMBER_ROLE_ADMIN":       2,
+		"MEMBER_ROLE_OWNER":       3,
+	}
+)
+
+func (x MemberRole) Enum() *MemberRole {
+	p := new(MemberRole)
+	*p = x
+	return p
+}
+
+func (x MemberRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemberRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_im_v1_conversation_proto_enumTypes[0].Descriptor()
+}
+
+func (MemberRole) Type() protoreflect.EnumType {
+	return &file_im_v1_conversation_proto_enumTypes[0]
+}
+
+func (x MemberRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemberRole.Descriptor instead.
+func (MemberRole) EnumDescriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{0}
+}
+
+// 入群申请状态
+type JoinRequestStatus int32
+
+const (
+	JoinRequestStatus_JOIN_REQUEST_STATUS_UNSPECIFIED JoinRequestStatus = 0
+	JoinRequestStatus_JOIN_REQUEST_STATUS_PENDING     JoinRequestStatus = 1
+	JoinRequestStatus_JOIN_REQUEST_STATUS_APPROVED    JoinRequestStatus = 2
+	JoinRequestStatus_JOIN_REQUEST_STATUS_REJECTED    JoinRequestStatus = 3
+)
+
+// Enum value maps for JoinRequestStatus.
+var (
+	JoinRequestStatus_name = map[int32]string{
+		0: "JOIN_REQUEST_STATUS_UNSPECIFIED",
+		1: "JOIN_REQUEST_STATUS_PENDING",
+		2: "JOIN_REQUEST_STATUS_APPROVED",
+		3: "JOIN_REQUEST_STATUS_REJECTED",
+	}
+	JoinRequestStatus_value = map[string]int32{
+		"JOIN_REQUEST_STATUS_UNSPECIFIED": 0,
+		"JOIN_REQUEST_STATUS_PENDING":     1,
+		"JOIN_REQUEST_STATUS_APPROVED":    2,
+		"JOIN_REQUEST_STATUS_REJECTED":    3,
+	}
+)
+
+func (x JoinRequestStatus) Enum() *JoinRequestStatus {
+	p := new(JoinRequestStatus)
+	*p = x
+	return p
+}
+
+func (x JoinRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JoinRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_im_v1_conversation_proto_enumTypes[1].Descriptor()
+}
+
+func (JoinRequestStatus) Type() protoreflect.EnumType {
+	return &file_im_v1_conversation_proto_enumTypes[1]
+}
+
+func (x JoinRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JoinRequestStatus.Descriptor instead.
+func (JoinRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{1}
+}
+
+// 智能文件夹，由系统按会话状态自动归类
+type SmartFolder int32
+
+const (
+	SmartFolder_SMART_FOLDER_UNSPECIFIED SmartFolder = 0
+	SmartFolder_SMART_FOLDER_UNREAD      SmartFolder = 1 // 有未读消息
+	SmartFolder_SMART_FOLDER_MENTIONS    SmartFolder = 2 // 未读消息中有@我
+	SmartFolder_SMART_FOLDER_GROUPS      SmartFolder = 3 // 群聊和频道
+	SmartFolder_SMART_FOLDER_DIRECT      SmartFolder = 4 // 单聊
+)
+
+// Enum value maps for SmartFolder.
+var (
+	SmartFolder_name = map[int32]string{
+		0: "SMART_FOLDER_UNSPECIFIED",
+		1: "SMART_FOLDER_UNREAD",
+		2: "SMART_FOLDER_MENTIONS",
+		3: "SMART_FOLDER_GROUPS",
+		4: "SMART_FOLDER_DIRECT",
+	}
+	SmartFolder_value = map[string]int32{
+		"SMART_FOLDER_UNSPECIFIED": 0,
+		"SMART_FOLDER_UNREAD":      1,
+		"SMART_FOLDER_MENTIONS":    2,
+		"SMART_FOLDER_GROUPS":      3,
+		"SMART_FOLDER_DIRECT":      4,
+	}
+)
+
+func (x SmartFolder) Enum() *SmartFolder {
+	p := new(SmartFolder)
+	*p = x
+	return p
+}
+
+func (x SmartFolder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SmartFolder) Descriptor() protoreflect.EnumDescriptor {
+	return file_im_v1_conversation_proto_enumTypes[2].Descriptor()
+}
+
+func (SmartFolder) Type() protoreflect.EnumType {
+	return &file_im_v1_conversation_proto_enumTypes[2]
+}
+
+func (x SmartFolder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SmartFolder.Descriptor instead.
+func (SmartFolder) EnumDescriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{2}
+}
+
 type CreateConversationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Type          ConversationType       `protobuf:"varint,1,opt,name=type,proto3,enum=im.v1.ConversationType" json:"type,omitempty"`
@@ -282,6 +445,7 @@ func (x *GetMembersRequest) GetConversationId() int64 {
 	return 0
 }
 
+// members 的 display_name 为群昵称，未设置群昵称时为空
 type GetMembersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Members       []*UserBrief           `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
@@ -326,28 +490,33 @@ func (x *GetMembersResponse) GetMembers() []*UserBrief {
 	return nil
 }
 
-type ListMyConversationsRequest struct {
+type MemberItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          MemberRole             `protobuf:"varint,2,opt,name=role,proto3,enum=im.v1.MemberRole" json:"role,omitempty"`
+	Nickname      string                 `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Muted         bool                   `protobuf:"varint,4,opt,name=muted,proto3" json:"muted,omitempty"`
+	MutedUntil    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=muted_until,json=mutedUntil,proto3" json:"muted_until,omitempty"` // 未设置且 muted 为 true 表示永久禁言
+	JoinTime      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=join_time,json=joinTime,proto3" json:"join_time,omitempty"`
+	User          *UserBrief             `protobuf:"bytes,7,opt,name=user,proto3" json:"user,omitempty"` // 用户资料，仅 ScrollMembers 返回，display_name 为群内展示名（群昵称优先）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMyConversationsRequest) Reset() {
-	*x = ListMyConversationsRequest{}
+func (x *MemberItem) Reset() {
+	*x = MemberItem{}
 	mi := &file_im_v1_conversation_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMyConversationsRequest) String() string {
+func (x *MemberItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMyConversationsRequest) ProtoMessage() {}
+func (*MemberItem) ProtoMessage() {}
 
-func (x *ListMyConversationsRequest) ProtoReflect() protoreflect.Message {
+func (x *MemberItem) ProtoReflect() protoreflect.Message {
 	mi := &file_im_v1_conversation_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -359,48 +528,126 @@ func (x *ListMyConversationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMyConversationsRequest.ProtoReflect.Descriptor instead.
-func (*ListMyConversationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MemberItem.ProtoReflect.Descriptor instead.
+func (*MemberItem) Descriptor() ([]byte, []int) {
 	return file_im_v1_conversation_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListMyConversationsRequest) GetPage() int32 {
+func (x *MemberItem) GetUserId() int64 {
 	if x != nil {
-		return x.Page
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *ListMyConversationsRequest) GetPageSize() int32 {
+func (x *MemberItem) GetRole() MemberRole {
 	if x != nil {
-		return x.PageSize
+		return x.Role
+	}
+	return MemberRole_MEMBER_ROLE_UNSPECIFIED
+}
+
+func (x *MemberItem) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *MemberItem) GetMuted() bool {
+	if x != nil {
+		return x.Muted
+	}
+	return false
+}
+
+func (x *MemberItem) GetMutedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MutedUntil
+	}
+	return nil
+}
+
+func (x *MemberItem) GetJoinTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinTime
+	}
+	return nil
+}
+
+func (x *MemberItem) GetUser() *UserBrief {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ListMembersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListMembersRequest) Reset() {
+	*x = ListMembersRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMembersRequest) ProtoMessage() {}
+
+func (x *ListMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListMembersRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListMembersRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
 	}
 	return 0
 }
 
-type ListMyConversationsResponse struct {
+type ListMembersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*ConversationBrief   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Members       []*MemberItem          `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMyConversationsResponse) Reset() {
-	*x = ListMyConversationsResponse{}
-	mi := &file_im_v1_conversation_proto_msgTypes[7]
+func (x *ListMembersResponse) Reset() {
+	*x = ListMembersResponse{}
+	mi := &file_im_v1_conversation_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMyConversationsResponse) String() string {
+func (x *ListMembersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMyConversationsResponse) ProtoMessage() {}
+func (*ListMembersResponse) ProtoMessage() {}
 
-func (x *ListMyConversationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_im_v1_conversation_proto_msgTypes[7]
+func (x *ListMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,63 +658,3861 @@ func (x *ListMyConversationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMyConversationsResponse.ProtoReflect.Descriptor instead.
-func (*ListMyConversationsResponse) Descriptor() ([]byte, []int) {
-	return file_im_v1_conversation_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListMembersResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ListMyConversationsResponse) GetItems() []*ConversationBrief {
+func (x *ListMembersResponse) GetMembers() []*MemberItem {
 	if x != nil {
-		return x.Items
+		return x.Members
 	}
 	return nil
 }
 
-func (x *ListMyConversationsResponse) GetTotal() int32 {
+// 成员按群主、管理员、普通成员排序，同角色按入群时间先后
+// role 为 UNSPECIFIED 表示不按角色过滤，keyword 匹配群昵称、展示名或用户名
+// 按 keyword 搜索时单次请求只扫描有限数量的成员，items 可能不足 limit，以 has_more 判断是否继续翻页
+type ScrollMembersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Cursor         string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit          int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Role           MemberRole             `protobuf:"varint,4,opt,name=role,proto3,enum=im.v1.MemberRole" json:"role,omitempty"`
+	Muted          *bool                  `protobuf:"varint,5,opt,name=muted,proto3,oneof" json:"muted,omitempty"`
+	Keyword        string                 `protobuf:"bytes,6,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ScrollMembersRequest) Reset() {
+	*x = ScrollMembersRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScrollMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScrollMembersRequest) ProtoMessage() {}
+
+func (x *ScrollMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScrollMembersRequest.ProtoReflect.Descriptor instead.
+func (*ScrollMembersRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ScrollMembersRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *ScrollMembersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ScrollMembersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ScrollMembersRequest) GetRole() MemberRole {
+	if x != nil {
+		return x.Role
+	}
+	return MemberRole_MEMBER_ROLE_UNSPECIFIED
+}
+
+func (x *ScrollMembersRequest) GetMuted() bool {
+	if x != nil && x.Muted != nil {
+		return *x.Muted
+	}
+	return false
+}
+
+func (x *ScrollMembersRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+// 各角色成员数（不受过滤条件影响）
+type MemberSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	OwnerCount    int32                  `protobuf:"varint,2,opt,name=owner_count,json=ownerCount,proto3" json:"owner_count,omitempty"`
+	AdminCount    int32                  `protobuf:"varint,3,opt,name=admin_count,json=adminCount,proto3" json:"admin_count,omitempty"`
+	MemberCount   int32                  `protobuf:"varint,4,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberSummary) Reset() {
+	*x = MemberSummary{}
+	mi := &file_im_v1_conversation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberSummary) ProtoMessage() {}
+
+func (x *MemberSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberSummary.ProtoReflect.Descriptor instead.
+func (*MemberSummary) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MemberSummary) GetTotal() int32 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-var File_im_v1_conversation_proto protoreflect.FileDescriptor
+func (x *MemberSummary) GetOwnerCount() int32 {
+	if x != nil {
+		return x.OwnerCount
+	}
+	return 0
+}
 
-const file_im_v1_conversation_proto_rawDesc = "" +
-	"\n" +
-	"\x18im/v1/conversation.proto\x12\x05im.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x12im/v1/common.proto\"}\n" +
-	"\x19CreateConversationRequest\x12+\n" +
-	"\x04type\x18\x01 \x01(\x0e2\x17.im.v1.ConversationTypeR\x04type\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1d\n" +
-	"\n" +
-	"member_ids\x18\x03 \x03(\x03R\tmemberIds\"Z\n" +
-	"\x19UpdateConversationRequest\x12'\n" +
-	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\"W\n" +
-	"\x11AddMembersRequest\x12'\n" +
-	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x19\n" +
-	"\buser_ids\x18\x02 \x03(\x03R\auserIds\"Z\n" +
-	"\x14RemoveMembersRequest\x12'\n" +
-	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x19\n" +
-	"\buser_ids\x18\x02 \x03(\x03R\auserIds\"<\n" +
-	"\x11GetMembersRequest\x12'\n" +
+func (x *MemberSummary) GetAdminCount() int32 {
+	if x != nil {
+		return x.AdminCount
+	}
+	return 0
+}
+
+func (x *MemberSummary) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+// summary 仅在首页（cursor 为空）返回
+type ScrollMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*MemberItem          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	Summary       *MemberSummary         `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScrollMembersResponse) Reset() {
+	*x = ScrollMembersResponse{}
+	mi := &file_im_v1_conversation_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScrollMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScrollMembersResponse) ProtoMessage() {}
+
+func (x *ScrollMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScrollMembersResponse.ProtoReflect.Descriptor instead.
+func (*ScrollMembersResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ScrollMembersResponse) GetItems() []*MemberItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ScrollMembersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ScrollMembersResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ScrollMembersResponse) GetSummary() *MemberSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+type GetConversationStateRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetConversationStateRequest) Reset() {
+	*x = GetConversationStateRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationStateRequest) ProtoMessage() {}
+
+func (x *GetConversationStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationStateRequest.ProtoReflect.Descriptor instead.
+func (*GetConversationStateRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetConversationStateRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+type IsMemberRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId         int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *IsMemberRequest) Reset() {
+	*x = IsMemberRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsMemberRequest) ProtoMessage() {}
+
+func (x *IsMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsMemberRequest.ProtoReflect.Descriptor instead.
+func (*IsMemberRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *IsMemberRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *IsMemberRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type IsMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsMember      bool                   `protobuf:"varint,1,opt,name=is_member,json=isMember,proto3" json:"is_member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsMemberResponse) Reset() {
+	*x = IsMemberResponse{}
+	mi := &file_im_v1_conversation_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsMemberResponse) ProtoMessage() {}
+
+func (x *IsMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsMemberResponse.ProtoReflect.Descriptor instead.
+func (*IsMemberResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *IsMemberResponse) GetIsMember() bool {
+	if x != nil {
+		return x.IsMember
+	}
+	return false
+}
+
+type MemberState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          MemberRole             `protobuf:"varint,2,opt,name=role,proto3,enum=im.v1.MemberRole" json:"role,omitempty"`
+	Muted         bool                   `protobuf:"varint,3,opt,name=muted,proto3" json:"muted,omitempty"`
+	MutedUntil    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=muted_until,json=mutedUntil,proto3" json:"muted_until,omitempty"`    // 未设置且 muted 为 true 表示永久禁言
+	Dnd           bool                   `protobuf:"varint,5,opt,name=dnd,proto3" json:"dnd,omitempty"`                                   // 当前是否开启免打扰
+	DisplayName   string                 `protobuf:"bytes,6,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"` // 群昵称，未设置时为空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberState) Reset() {
+	*x = MemberState{}
+	mi := &file_im_v1_conversation_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberState) ProtoMessage() {}
+
+func (x *MemberState) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberState.ProtoReflect.Descriptor instead.
+func (*MemberState) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MemberState) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MemberState) GetRole() MemberRole {
+	if x != nil {
+		return x.Role
+	}
+	return MemberRole_MEMBER_ROLE_UNSPECIFIED
+}
+
+func (x *MemberState) GetMuted() bool {
+	if x != nil {
+		return x.Muted
+	}
+	return false
+}
+
+func (x *MemberState) GetMutedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MutedUntil
+	}
+	return nil
+}
+
+func (x *MemberState) GetDnd() bool {
+	if x != nil {
+		return x.Dnd
+	}
+	return false
+}
+
+func (x *MemberState) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+// 频道的 members 只包含群主和管理员（可发言者），订阅者不在其中
+type ConversationState struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId    int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Dissolved         bool                   `protobuf:"varint,2,opt,name=dissolved,proto3" json:"dissolved,omitempty"`
+	MuteAll           bool                   `protobuf:"varint,3,opt,name=mute_all,json=muteAll,proto3" json:"mute_all,omitempty"`
+	Members           []*MemberState         `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+	Type              ConversationType       `protobuf:"varint,5,opt,name=type,proto3,enum=im.v1.ConversationType" json:"type,omitempty"`
+	MessageTtlSeconds int64                  `protobuf:"varint,6,opt,name=message_ttl_seconds,json=messageTtlSeconds,proto3" json:"message_ttl_seconds,omitempty"` // 消息自动删除时长，0表示关闭
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ConversationState) Reset() {
+	*x = ConversationState{}
+	mi := &file_im_v1_conversation_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationState) ProtoMessage() {}
+
+func (x *ConversationState) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationState.ProtoReflect.Descriptor instead.
+func (*ConversationState) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ConversationState) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *ConversationState) GetDissolved() bool {
+	if x != nil {
+		return x.Dissolved
+	}
+	return false
+}
+
+func (x *ConversationState) GetMuteAll() bool {
+	if x != nil {
+		return x.MuteAll
+	}
+	return false
+}
+
+func (x *ConversationState) GetMembers() []*MemberState {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ConversationState) GetType() ConversationType {
+	if x != nil {
+		return x.Type
+	}
+	return ConversationType_CONVERSATION_TYPE_UNSPECIFIED
+}
+
+func (x *ConversationState) GetMessageTtlSeconds() int64 {
+	if x != nil {
+		return x.MessageTtlSeconds
+	}
+	return 0
+}
+
+type LeaveConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LeaveConversationRequest) Reset() {
+	*x = LeaveConversationRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveConversationRequest) ProtoMessage() {}
+
+func (x *LeaveConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveConversationRequest.ProtoReflect.Descriptor instead.
+func (*LeaveConversationRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LeaveConversationRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+type DissolveConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DissolveConversationRequest) Reset() {
+	*x = DissolveConversationRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DissolveConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DissolveConversationRequest) ProtoMessage() {}
+
+func (x *DissolveConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DissolveConversationRequest.ProtoReflect.Descriptor instead.
+func (*DissolveConversationRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DissolveConversationRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+// role 仅支持 MEMBER/ADMIN，群主通过 TransferOwnership 变更
+type SetMemberRoleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId         int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role           MemberRole             `protobuf:"varint,3,opt,name=role,proto3,enum=im.v1.MemberRole" json:"role,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetMemberRoleRequest) Reset() {
+	*x = SetMemberRoleRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMemberRoleRequest) ProtoMessage() {}
+
+func (x *SetMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*SetMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetMemberRoleRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *SetMemberRoleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetMemberRoleRequest) GetRole() MemberRole {
+	if x != nil {
+		return x.Role
+	}
+	return MemberRole_MEMBER_ROLE_UNSPECIFIED
+}
+
+type TransferOwnershipRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	NewOwnerId     int64                  `protobuf:"varint,2,opt,name=new_owner_id,json=newOwnerId,proto3" json:"new_owner_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipRequest) Reset() {
+	*x = TransferOwnershipRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TransferOwnershipRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *TransferOwnershipRequest) GetNewOwnerId() int64 {
+	if x != nil {
+		return x.NewOwnerId
+	}
+	return 0
+}
+
+// duration_seconds 为0表示永久禁言
+type MuteMemberRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId  int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId          int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MuteMemberRequest) Reset() {
+	*x = MuteMemberRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteMemberRequest) ProtoMessage() {}
+
+func (x *MuteMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteMemberRequest.ProtoReflect.Descriptor instead.
+func (*MuteMemberRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MuteMemberRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *MuteMemberRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MuteMemberRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type UnmuteMemberRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId         int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UnmuteMemberRequest) Reset() {
+	*x = UnmuteMemberRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteMemberRequest) ProtoMessage() {}
+
+func (x *UnmuteMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteMemberRequest.ProtoReflect.Descriptor instead.
+func (*UnmuteMemberRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UnmuteMemberRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *UnmuteMemberRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type SetMuteAllRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Mute           bool                   `protobuf:"varint,2,opt,name=mute,proto3" json:"mute,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetMuteAllRequest) Reset() {
+	*x = SetMuteAllRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMuteAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMuteAllRequest) ProtoMessage() {}
+
+func (x *SetMuteAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMuteAllRequest.ProtoReflect.Descriptor instead.
+func (*SetMuteAllRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetMuteAllRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *SetMuteAllRequest) GetMute() bool {
+	if x != nil {
+		return x.Mute
+	}
+	return false
+}
+
+// nickname 为空表示清除群昵称
+type SetMemberNicknameRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Nickname       string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetMemberNicknameRequest) Reset() {
+	*x = SetMemberNicknameRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMemberNicknameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMemberNicknameRequest) ProtoMessage() {}
+
+func (x *SetMemberNicknameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMemberNicknameRequest.ProtoReflect.Descriptor instead.
+func (*SetMemberNicknameRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetMemberNicknameRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *SetMemberNicknameRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+// ttl_seconds 为0表示关闭，仅支持 1天(86400)、7天(604800)、30天(2592000)
+type SetMessageTTLRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	TtlSeconds     int64                  `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetMessageTTLRequest) Reset() {
+	*x = SetMessageTTLRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMessageTTLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMessageTTLRequest) ProtoMessage() {}
+
+func (x *SetMessageTTLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMessageTTLRequest.ProtoReflect.Descriptor instead.
+func (*SetMessageTTLRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetMessageTTLRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *SetMessageTTLRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+// archived 为 true 时只列出已归档会话
+type ListMyConversationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Archived      bool                   `protobuf:"varint,3,opt,name=archived,proto3" json:"archived,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyConversationsRequest) Reset() {
+	*x = ListMyConversationsRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyConversationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyConversationsRequest) ProtoMessage() {}
+
+func (x *ListMyConversationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyConversationsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyConversationsRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListMyConversationsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMyConversationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMyConversationsRequest) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+// items 与 conversations 顺序一致，conversations 额外携带个人设置
+type ListMyConversationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ConversationBrief   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Conversations []*MyConversationItem  `protobuf:"bytes,3,rep,name=conversations,proto3" json:"conversations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyConversationsResponse) Reset() {
+	*x = ListMyConversationsResponse{}
+	mi := &file_im_v1_conversation_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyConversationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyConversationsResponse) ProtoMessage() {}
+
+func (x *ListMyConversationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyConversationsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyConversationsResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListMyConversationsResponse) GetItems() []*ConversationBrief {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListMyConversationsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListMyConversationsResponse) GetConversations() []*MyConversationItem {
+	if x != nil {
+		return x.Conversations
+	}
+	return nil
+}
+
+// cursor 为空表示从头开始；folder_id 与 smart_folder 至多指定一个，用于只列出文件夹内的会话
+type ScrollMyConversationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Archived      bool                   `protobuf:"varint,3,opt,name=archived,proto3" json:"archived,omitempty"`
+	FolderId      int64                  `protobuf:"varint,4,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	SmartFolder   SmartFolder            `protobuf:"varint,5,opt,name=smart_folder,json=smartFolder,proto3,enum=im.v1.SmartFolder" json:"smart_folder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScrollMyConversationsRequest) Reset() {
+	*x = ScrollMyConversationsRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScrollMyConversationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScrollMyConversationsRequest) ProtoMessage() {}
+
+func (x *ScrollMyConversationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScrollMyConversationsRequest.ProtoReflect.Descriptor instead.
+func (*ScrollMyConversationsRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ScrollMyConversationsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ScrollMyConversationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ScrollMyConversationsRequest) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *ScrollMyConversationsRequest) GetFolderId() int64 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *ScrollMyConversationsRequest) GetSmartFolder() SmartFolder {
+	if x != nil {
+		return x.SmartFolder
+	}
+	return SmartFolder_SMART_FOLDER_UNSPECIFIED
+}
+
+type ScrollMyConversationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*MyConversationItem  `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScrollMyConversationsResponse) Reset() {
+	*x = ScrollMyConversationsResponse{}
+	mi := &file_im_v1_conversation_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScrollMyConversationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScrollMyConversationsResponse) ProtoMessage() {}
+
+func (x *ScrollMyConversationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScrollMyConversationsResponse.ProtoReflect.Descriptor instead.
+func (*ScrollMyConversationsResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ScrollMyConversationsResponse) GetItems() []*MyConversationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ScrollMyConversationsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ScrollMyConversationsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type ConversationSetting struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Pinned         bool                   `protobuf:"varint,2,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	PinnedAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=pinned_at,json=pinnedAt,proto3" json:"pinned_at,omitempty"`
+	Muted          bool                   `protobuf:"varint,4,opt,name=muted,proto3" json:"muted,omitempty"`                         // 免打扰
+	MuteUntil      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=mute_until,json=muteUntil,proto3" json:"mute_until,omitempty"` // 未设置且 muted 为 true 表示永久免打扰
+	Archived       bool                   `protobuf:"varint,6,opt,name=archived,proto3" json:"archived,omitempty"`
+	Remark         string                 `protobuf:"bytes,7,opt,name=remark,proto3" json:"remark,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConversationSetting) Reset() {
+	*x = ConversationSetting{}
+	mi := &file_im_v1_conversation_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationSetting) ProtoMessage() {}
+
+func (x *ConversationSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationSetting.ProtoReflect.Descriptor instead.
+func (*ConversationSetting) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ConversationSetting) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *ConversationSetting) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *ConversationSetting) GetPinnedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PinnedAt
+	}
+	return nil
+}
+
+func (x *ConversationSetting) GetMuted() bool {
+	if x != nil {
+		return x.Muted
+	}
+	return false
+}
+
+func (x *ConversationSetting) GetMuteUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MuteUntil
+	}
+	return nil
+}
+
+func (x *ConversationSetting) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *ConversationSetting) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+type MyConversationItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversation  *ConversationBrief     `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"`
+	Setting       *ConversationSetting   `protobuf:"bytes,2,opt,name=setting,proto3" json:"setting,omitempty"`
+	LastMessageAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_message_at,json=lastMessageAt,proto3" json:"last_message_at,omitempty"`
+	FolderIds     []int64                `protobuf:"varint,4,rep,packed,name=folder_ids,json=folderIds,proto3" json:"folder_ids,omitempty"` // 会话所在的自定义文件夹
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MyConversationItem) Reset() {
+	*x = MyConversationItem{}
+	mi := &file_im_v1_conversation_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MyConversationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MyConversationItem) ProtoMessage() {}
+
+func (x *MyConversationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MyConversationItem.ProtoReflect.Descriptor instead.
+func (*MyConversationItem) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MyConversationItem) GetConversation() *ConversationBrief {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
+}
+
+func (x *MyConversationItem) GetSetting() *ConversationSetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+func (x *MyConversationItem) GetLastMessageAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastMessageAt
+	}
+	return nil
+}
+
+func (x *MyConversationItem) GetFolderIds() []int64 {
+	if x != nil {
+		return x.FolderIds
+	}
+	return nil
+}
+
+type GetConversationSettingRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetConversationSettingRequest) Reset() {
+	*x = GetConversationSettingRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationSettingRequest) ProtoMessage() {}
+
+func (x *GetConversationSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationSettingRequest.ProtoReflect.Descriptor instead.
+func (*GetConversationSettingRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetConversationSettingRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+// 未设置的字段保持不变；开启免打扰时 mute_seconds 为0表示永久，remark 为空串表示清除备注
+type UpdateConversationSettingRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Pinned         *bool                  `protobuf:"varint,2,opt,name=pinned,proto3,oneof" json:"pinned,omitempty"`
+	Muted          *bool                  `protobuf:"varint,3,opt,name=muted,proto3,oneof" json:"muted,omitempty"`
+	MuteSeconds    int64                  `protobuf:"varint,4,opt,name=mute_seconds,json=muteSeconds,proto3" json:"mute_seconds,omitempty"`
+	Archived       *bool                  `protobuf:"varint,5,opt,name=archived,proto3,oneof" json:"archived,omitempty"`
+	Remark         *string                `protobuf:"bytes,6,opt,name=remark,proto3,oneof" json:"remark,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateConversationSettingRequest) Reset() {
+	*x = UpdateConversationSettingRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateConversationSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateConversationSettingRequest) ProtoMessage() {}
+
+func (x *UpdateConversationSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateConversationSettingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateConversationSettingRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateConversationSettingRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *UpdateConversationSettingRequest) GetPinned() bool {
+	if x != nil && x.Pinned != nil {
+		return *x.Pinned
+	}
+	return false
+}
+
+func (x *UpdateConversationSettingRequest) GetMuted() bool {
+	if x != nil && x.Muted != nil {
+		return *x.Muted
+	}
+	return false
+}
+
+func (x *UpdateConversationSettingRequest) GetMuteSeconds() int64 {
+	if x != nil {
+		return x.MuteSeconds
+	}
+	return 0
+}
+
+func (x *UpdateConversationSettingRequest) GetArchived() bool {
+	if x != nil && x.Archived != nil {
+		return *x.Archived
+	}
+	return false
+}
+
+func (x *UpdateConversationSettingRequest) GetRemark() string {
+	if x != nil && x.Remark != nil {
+		return *x.Remark
+	}
+	return ""
+}
+
+type JoinRequestItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ConversationId int64                  `protobuf:"varint,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId         int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Message        string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Status         JoinRequestStatus      `protobuf:"varint,5,opt,name=status,proto3,enum=im.v1.JoinRequestStatus" json:"status,omitempty"`
+	HandlerId      int64                  `protobuf:"varint,6,opt,name=handler_id,json=handlerId,proto3" json:"handler_id,omitempty"`
+	CreateTime     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	InviteId       int64                  `protobuf:"varint,9,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *JoinRequestItem) Reset() {
+	*x = JoinRequestItem{}
+	mi := &file_im_v1_conversation_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRequestItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRequestItem) ProtoMessage() {}
+
+func (x *JoinRequestItem) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRequestItem.ProtoReflect.Descriptor instead.
+func (*JoinRequestItem) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *JoinRequestItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *JoinRequestItem) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *JoinRequestItem) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *JoinRequestItem) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *JoinRequestItem) GetStatus() JoinRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JoinRequestStatus_JOIN_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *JoinRequestItem) GetHandlerId() int64 {
+	if x != nil {
+		return x.HandlerId
+	}
+	return 0
+}
+
+func (x *JoinRequestItem) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *JoinRequestItem) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *JoinRequestItem) GetInviteId() int64 {
+	if x != nil {
+		return x.InviteId
+	}
+	return 0
+}
+
+type RequestJoinRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RequestJoinRequest) Reset() {
+	*x = RequestJoinRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestJoinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestJoinRequest) ProtoMessage() {}
+
+func (x *RequestJoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestJoinRequest.ProtoReflect.Descriptor instead.
+func (*RequestJoinRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RequestJoinRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *RequestJoinRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// status 为空时默认查询待审批申请
+type ListJoinRequestsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Status         JoinRequestStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=im.v1.JoinRequestStatus" json:"status,omitempty"`
+	Page           int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize       int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListJoinRequestsRequest) Reset() {
+	*x = ListJoinRequestsRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJoinRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJoinRequestsRequest) ProtoMessage() {}
+
+func (x *ListJoinRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJoinRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListJoinRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListJoinRequestsRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *ListJoinRequestsRequest) GetStatus() JoinRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JoinRequestStatus_JOIN_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *ListJoinRequestsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListJoinRequestsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListJoinRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*JoinRequestItem     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJoinRequestsResponse) Reset() {
+	*x = ListJoinRequestsResponse{}
+	mi := &file_im_v1_conversation_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJoinRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJoinRequestsResponse) ProtoMessage() {}
+
+func (x *ListJoinRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJoinRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListJoinRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListJoinRequestsResponse) GetItems() []*JoinRequestItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListJoinRequestsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type HandleJoinRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     int64                  `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Approve       bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandleJoinRequestRequest) Reset() {
+	*x = HandleJoinRequestRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandleJoinRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleJoinRequestRequest) ProtoMessage() {}
+
+func (x *HandleJoinRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleJoinRequestRequest.ProtoReflect.Descriptor instead.
+func (*HandleJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *HandleJoinRequestRequest) GetRequestId() int64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *HandleJoinRequestRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+type InviteItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ConversationId int64                  `protobuf:"varint,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Code           string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	CreatorId      int64                  `protobuf:"varint,4,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	ExpireTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"` // 未设置表示永不过期
+	MaxUses        int32                  `protobuf:"varint,6,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`         // 0 表示不限次数
+	UsedCount      int32                  `protobuf:"varint,7,opt,name=used_count,json=usedCount,proto3" json:"used_count,omitempty"`
+	Revoked        bool                   `protobuf:"varint,8,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	CreateTime     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InviteItem) Reset() {
+	*x = InviteItem{}
+	mi := &file_im_v1_conversation_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteItem) ProtoMessage() {}
+
+func (x *InviteItem) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteItem.ProtoReflect.Descriptor instead.
+func (*InviteItem) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *InviteItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InviteItem) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *InviteItem) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *InviteItem) GetCreatorId() int64 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *InviteItem) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *InviteItem) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *InviteItem) GetUsedCount() int32 {
+	if x != nil {
+		return x.UsedCount
+	}
+	return 0
+}
+
+func (x *InviteItem) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+func (x *InviteItem) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+// expires_in_seconds 为0表示永不过期
+type CreateInviteRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId   int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	ExpiresInSeconds int64                  `protobuf:"varint,2,opt,name=expires_in_seconds,json=expiresInSeconds,proto3" json:"expires_in_seconds,omitempty"`
+	MaxUses          int32                  `protobuf:"varint,3,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateInviteRequest) Reset() {
+	*x = CreateInviteRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteRequest) ProtoMessage() {}
+
+func (x *CreateInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteRequest.ProtoReflect.Descriptor instead.
+func (*CreateInviteRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateInviteRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *CreateInviteRequest) GetExpiresInSeconds() int64 {
+	if x != nil {
+		return x.ExpiresInSeconds
+	}
+	return 0
+}
+
+func (x *CreateInviteRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+type ListInvitesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListInvitesRequest) Reset() {
+	*x = ListInvitesRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitesRequest) ProtoMessage() {}
+
+func (x *ListInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitesRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListInvitesRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+type ListInvitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*InviteItem          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitesResponse) Reset() {
+	*x = ListInvitesResponse{}
+	mi := &file_im_v1_conversation_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitesResponse) ProtoMessage() {}
+
+func (x *ListInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitesResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListInvitesResponse) GetItems() []*InviteItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RevokeInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InviteId      int64                  `protobuf:"varint,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteRequest) Reset() {
+	*x = RevokeInviteRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteRequest) ProtoMessage() {}
+
+func (x *RevokeInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInviteRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RevokeInviteRequest) GetInviteId() int64 {
+	if x != nil {
+		return x.InviteId
+	}
+	return 0
+}
+
+type PreviewInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewInviteRequest) Reset() {
+	*x = PreviewInviteRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewInviteRequest) ProtoMessage() {}
+
+func (x *PreviewInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewInviteRequest.ProtoReflect.Descriptor instead.
+func (*PreviewInviteRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PreviewInviteRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type InvitePreview struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	AvatarUrl      string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	MemberCount    int32                  `protobuf:"varint,4,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	MemberLimit    int32                  `protobuf:"varint,5,opt,name=member_limit,json=memberLimit,proto3" json:"member_limit,omitempty"`
+	NeedApproval   bool                   `protobuf:"varint,6,opt,name=need_approval,json=needApproval,proto3" json:"need_approval,omitempty"`
+	ExpireTime     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InvitePreview) Reset() {
+	*x = InvitePreview{}
+	mi := &file_im_v1_conversation_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvitePreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvitePreview) ProtoMessage() {}
+
+func (x *InvitePreview) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvitePreview.ProtoReflect.Descriptor instead.
+func (*InvitePreview) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *InvitePreview) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *InvitePreview) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *InvitePreview) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *InvitePreview) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *InvitePreview) GetMemberLimit() int32 {
+	if x != nil {
+		return x.MemberLimit
+	}
+	return 0
+}
+
+func (x *InvitePreview) GetNeedApproval() bool {
+	if x != nil {
+		return x.NeedApproval
+	}
+	return false
+}
+
+func (x *InvitePreview) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type JoinByInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinByInviteRequest) Reset() {
+	*x = JoinByInviteRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinByInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinByInviteRequest) ProtoMessage() {}
+
+func (x *JoinByInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinByInviteRequest.ProtoReflect.Descriptor instead.
+func (*JoinByInviteRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *JoinByInviteRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type Announcement struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Content        string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	EditorId       int64                  `protobuf:"varint,3,opt,name=editor_id,json=editorId,proto3" json:"editor_id,omitempty"` // 最后编辑者
+	CreateTime     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Announcement) Reset() {
+	*x = Announcement{}
+	mi := &file_im_v1_conversation_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Announcement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Announcement) ProtoMessage() {}
+
+func (x *Announcement) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Announcement) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *Announcement) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Announcement) GetEditorId() int64 {
+	if x != nil {
+		return x.EditorId
+	}
+	return 0
+}
+
+func (x *Announcement) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Announcement) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type GetAnnouncementRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetAnnouncementRequest) Reset() {
+	*x = GetAnnouncementRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnnouncementRequest) ProtoMessage() {}
+
+func (x *GetAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*GetAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetAnnouncementRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+type SetAnnouncementRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Content        string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetAnnouncementRequest) Reset() {
+	*x = SetAnnouncementRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAnnouncementRequest) ProtoMessage() {}
+
+func (x *SetAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*SetAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SetAnnouncementRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *SetAnnouncementRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type DeleteAnnouncementRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteAnnouncementRequest) Reset() {
+	*x = DeleteAnnouncementRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAnnouncementRequest) ProtoMessage() {}
+
+func (x *DeleteAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DeleteAnnouncementRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+type PinnedMessageItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	MessageId      int64                  `protobuf:"varint,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	PinnedBy       int64                  `protobuf:"varint,3,opt,name=pinned_by,json=pinnedBy,proto3" json:"pinned_by,omitempty"`
+	PinTime        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=pin_time,json=pinTime,proto3" json:"pin_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PinnedMessageItem) Reset() {
+	*x = PinnedMessageItem{}
+	mi := &file_im_v1_conversation_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinnedMessageItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinnedMessageItem) ProtoMessage() {}
+
+func (x *PinnedMessageItem) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinnedMessageItem.ProtoReflect.Descriptor instead.
+func (*PinnedMessageItem) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PinnedMessageItem) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *PinnedMessageItem) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *PinnedMessageItem) GetPinnedBy() int64 {
+	if x != nil {
+		return x.PinnedBy
+	}
+	return 0
+}
+
+func (x *PinnedMessageItem) GetPinTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PinTime
+	}
+	return nil
+}
+
+type ListPinnedMessagesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListPinnedMessagesRequest) Reset() {
+	*x = ListPinnedMessagesRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPinnedMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPinnedMessagesRequest) ProtoMessage() {}
+
+func (x *ListPinnedMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPinnedMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListPinnedMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListPinnedMessagesRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+type ListPinnedMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*PinnedMessageItem   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPinnedMessagesResponse) Reset() {
+	*x = ListPinnedMessagesResponse{}
+	mi := &file_im_v1_conversation_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPinnedMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPinnedMessagesResponse) ProtoMessage() {}
+
+func (x *ListPinnedMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPinnedMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListPinnedMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListPinnedMessagesResponse) GetItems() []*PinnedMessageItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type PinMessageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	MessageId      int64                  `protobuf:"varint,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PinMessageRequest) Reset() {
+	*x = PinMessageRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinMessageRequest) ProtoMessage() {}
+
+func (x *PinMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinMessageRequest.ProtoReflect.Descriptor instead.
+func (*PinMessageRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PinMessageRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *PinMessageRequest) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+type UnpinMessageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	MessageId      int64                  `protobuf:"varint,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UnpinMessageRequest) Reset() {
+	*x = UnpinMessageRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinMessageRequest) ProtoMessage() {}
+
+func (x *UnpinMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinMessageRequest.ProtoReflect.Descriptor instead.
+func (*UnpinMessageRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UnpinMessageRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *UnpinMessageRequest) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+type GetConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetConversationRequest) Reset() {
+	*x = GetConversationRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationRequest) ProtoMessage() {}
+
+func (x *GetConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationRequest.ProtoReflect.Descriptor instead.
+func (*GetConversationRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetConversationRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+type ConversationDetail struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Conversation      *ConversationBrief     `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"`
+	AvatarUrl         string                 `protobuf:"bytes,2,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	OwnerId           int64                  `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	MemberCount       int32                  `protobuf:"varint,4,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	MemberLimit       int32                  `protobuf:"varint,5,opt,name=member_limit,json=memberLimit,proto3" json:"member_limit,omitempty"`
+	NeedApproval      bool                   `protobuf:"varint,6,opt,name=need_approval,json=needApproval,proto3" json:"need_approval,omitempty"`
+	MuteAll           bool                   `protobuf:"varint,7,opt,name=mute_all,json=muteAll,proto3" json:"mute_all,omitempty"`
+	Announcement      *Announcement          `protobuf:"bytes,8,opt,name=announcement,proto3" json:"announcement,omitempty"` // 未设置表示暂无公告
+	PinnedMessages    []*PinnedMessageItem   `protobuf:"bytes,9,rep,name=pinned_messages,json=pinnedMessages,proto3" json:"pinned_messages,omitempty"`
+	Setting           *ConversationSetting   `protobuf:"bytes,10,opt,name=setting,proto3" json:"setting,omitempty"`
+	CreateTime        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	MessageTtlSeconds int64                  `protobuf:"varint,12,opt,name=message_ttl_seconds,json=messageTtlSeconds,proto3" json:"message_ttl_seconds,omitempty"` // 消息自动删除时长，0表示关闭
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ConversationDetail) Reset() {
+	*x = ConversationDetail{}
+	mi := &file_im_v1_conversation_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationDetail) ProtoMessage() {}
+
+func (x *ConversationDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationDetail.ProtoReflect.Descriptor instead.
+func (*ConversationDetail) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ConversationDetail) GetConversation() *ConversationBrief {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
+}
+
+func (x *ConversationDetail) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *ConversationDetail) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *ConversationDetail) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *ConversationDetail) GetMemberLimit() int32 {
+	if x != nil {
+		return x.MemberLimit
+	}
+	return 0
+}
+
+func (x *ConversationDetail) GetNeedApproval() bool {
+	if x != nil {
+		return x.NeedApproval
+	}
+	return false
+}
+
+func (x *ConversationDetail) GetMuteAll() bool {
+	if x != nil {
+		return x.MuteAll
+	}
+	return false
+}
+
+func (x *ConversationDetail) GetAnnouncement() *Announcement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+func (x *ConversationDetail) GetPinnedMessages() []*PinnedMessageItem {
+	if x != nil {
+		return x.PinnedMessages
+	}
+	return nil
+}
+
+func (x *ConversationDetail) GetSetting() *ConversationSetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+func (x *ConversationDetail) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *ConversationDetail) GetMessageTtlSeconds() int64 {
+	if x != nil {
+		return x.MessageTtlSeconds
+	}
+	return 0
+}
+
+// 智能文件夹的 id 为0，以 smart 区分；计数只统计未归档会话
+type Folder struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SortOrder         int32                  `protobuf:"varint,3,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	Smart             SmartFolder            `protobuf:"varint,4,opt,name=smart,proto3,enum=im.v1.SmartFolder" json:"smart,omitempty"`
+	ConversationCount int32                  `protobuf:"varint,5,opt,name=conversation_count,json=conversationCount,proto3" json:"conversation_count,omitempty"`
+	UnreadCount       int32                  `protobuf:"varint,6,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Folder) Reset() {
+	*x = Folder{}
+	mi := &file_im_v1_conversation_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Folder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Folder) ProtoMessage() {}
+
+func (x *Folder) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Folder.ProtoReflect.Descriptor instead.
+func (*Folder) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *Folder) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Folder) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Folder) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *Folder) GetSmart() SmartFolder {
+	if x != nil {
+		return x.Smart
+	}
+	return SmartFolder_SMART_FOLDER_UNSPECIFIED
+}
+
+func (x *Folder) GetConversationCount() int32 {
+	if x != nil {
+		return x.ConversationCount
+	}
+	return 0
+}
+
+func (x *Folder) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type ListFoldersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFoldersRequest) Reset() {
+	*x = ListFoldersRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFoldersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFoldersRequest) ProtoMessage() {}
+
+func (x *ListFoldersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFoldersRequest.ProtoReflect.Descriptor instead.
+func (*ListFoldersRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{59}
+}
+
+// 智能文件夹在前，自定义文件夹按 sort_order 排列
+type ListFoldersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Folder              `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFoldersResponse) Reset() {
+	*x = ListFoldersResponse{}
+	mi := &file_im_v1_conversation_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFoldersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFoldersResponse) ProtoMessage() {}
+
+func (x *ListFoldersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFoldersResponse.ProtoReflect.Descriptor instead.
+func (*ListFoldersResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListFoldersResponse) GetItems() []*Folder {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CreateFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFolderRequest) Reset() {
+	*x = CreateFolderRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFolderRequest) ProtoMessage() {}
+
+func (x *CreateFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFolderRequest.ProtoReflect.Descriptor instead.
+func (*CreateFolderRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CreateFolderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderId      int64                  `protobuf:"varint,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameFolderRequest) Reset() {
+	*x = RenameFolderRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameFolderRequest) ProtoMessage() {}
+
+func (x *RenameFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameFolderRequest.ProtoReflect.Descriptor instead.
+func (*RenameFolderRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RenameFolderRequest) GetFolderId() int64 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *RenameFolderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderId      int64                  `protobuf:"varint,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFolderRequest) Reset() {
+	*x = DeleteFolderRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFolderRequest) ProtoMessage() {}
+
+func (x *DeleteFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFolderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFolderRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DeleteFolderRequest) GetFolderId() int64 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+// folder_ids 须包含用户的全部自定义文件夹
+type ReorderFoldersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolderIds     []int64                `protobuf:"varint,1,rep,packed,name=folder_ids,json=folderIds,proto3" json:"folder_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderFoldersRequest) Reset() {
+	*x = ReorderFoldersRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderFoldersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderFoldersRequest) ProtoMessage() {}
+
+func (x *ReorderFoldersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderFoldersRequest.ProtoReflect.Descriptor instead.
+func (*ReorderFoldersRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ReorderFoldersRequest) GetFolderIds() []int64 {
+	if x != nil {
+		return x.FolderIds
+	}
+	return nil
+}
+
+type AddFolderConversationsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FolderId        int64                  `protobuf:"varint,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	ConversationIds []int64                `protobuf:"varint,2,rep,packed,name=conversation_ids,json=conversationIds,proto3" json:"conversation_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AddFolderConversationsRequest) Reset() {
+	*x = AddFolderConversationsRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFolderConversationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFolderConversationsRequest) ProtoMessage() {}
+
+func (x *AddFolderConversationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFolderConversationsRequest.ProtoReflect.Descriptor instead.
+func (*AddFolderConversationsRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *AddFolderConversationsRequest) GetFolderId() int64 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *AddFolderConversationsRequest) GetConversationIds() []int64 {
+	if x != nil {
+		return x.ConversationIds
+	}
+	return nil
+}
+
+type RemoveFolderConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FolderId       int64                  `protobuf:"varint,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	ConversationId int64                  `protobuf:"varint,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RemoveFolderConversationRequest) Reset() {
+	*x = RemoveFolderConversationRequest{}
+	mi := &file_im_v1_conversation_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFolderConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFolderConversationRequest) ProtoMessage() {}
+
+func (x *RemoveFolderConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_conversation_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFolderConversationRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFolderConversationRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_conversation_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RemoveFolderConversationRequest) GetFolderId() int64 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *RemoveFolderConversationRequest) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+var File_im_v1_conversation_proto protoreflect.FileDescriptor
+
+const file_im_v1_conversation_proto_rawDesc = "" +
+	"\n" +
+	"\x18im/v1/conversation.proto\x12\x05im.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x12im/v1/common.proto\"}\n" +
+	"\x19CreateConversationRequest\x12+\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x17.im.v1.ConversationTypeR\x04type\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"member_ids\x18\x03 \x03(\x03R\tmemberIds\"Z\n" +
+	"\x19UpdateConversationRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\"W\n" +
+	"\x11AddMembersRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\x03R\auserIds\"Z\n" +
+	"\x14RemoveMembersRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\x03R\auserIds\"<\n" +
+	"\x11GetMembersRequest\x12'\n" +
 	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\"@\n" +
 	"\x12GetMembersResponse\x12*\n" +
-	"\amembers\x18\x01 \x03(\v2\x10.im.v1.UserBriefR\amembers\"M\n" +
+	"\amembers\x18\x01 \x03(\v2\x10.im.v1.UserBriefR\amembers\"\x9a\x02\n" +
+	"\n" +
+	"MemberItem\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12%\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x11.im.v1.MemberRoleR\x04role\x12\x1a\n" +
+	"\bnickname\x18\x03 \x01(\tR\bnickname\x12\x14\n" +
+	"\x05muted\x18\x04 \x01(\bR\x05muted\x12;\n" +
+	"\vmuted_until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"mutedUntil\x127\n" +
+	"\tjoin_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinTime\x12$\n" +
+	"\x04user\x18\a \x01(\v2\x10.im.v1.UserBriefR\x04user\"=\n" +
+	"\x12ListMembersRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\"B\n" +
+	"\x13ListMembersResponse\x12+\n" +
+	"\amembers\x18\x01 \x03(\v2\x11.im.v1.MemberItemR\amembers\"\xd3\x01\n" +
+	"\x14ScrollMembersRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12%\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x11.im.v1.MemberRoleR\x04role\x12\x19\n" +
+	"\x05muted\x18\x05 \x01(\bH\x00R\x05muted\x88\x01\x01\x12\x18\n" +
+	"\akeyword\x18\x06 \x01(\tR\akeywordB\b\n" +
+	"\x06_muted\"\x8a\x01\n" +
+	"\rMemberSummary\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x1f\n" +
+	"\vowner_count\x18\x02 \x01(\x05R\n" +
+	"ownerCount\x12\x1f\n" +
+	"\vadmin_count\x18\x03 \x01(\x05R\n" +
+	"adminCount\x12!\n" +
+	"\fmember_count\x18\x04 \x01(\x05R\vmemberCount\"\xac\x01\n" +
+	"\x15ScrollMembersResponse\x12'\n" +
+	"\x05items\x18\x01 \x03(\v2\x11.im.v1.MemberItemR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12.\n" +
+	"\asummary\x18\x04 \x01(\v2\x14.im.v1.MemberSummaryR\asummary\"F\n" +
+	"\x1bGetConversationStateRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\"S\n" +
+	"\x0fIsMemberRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"/\n" +
+	"\x10IsMemberResponse\x12\x1b\n" +
+	"\tis_member\x18\x01 \x01(\bR\bisMember\"\xd5\x01\n" +
+	"\vMemberState\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12%\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x11.im.v1.MemberRoleR\x04role\x12\x14\n" +
+	"\x05muted\x18\x03 \x01(\bR\x05muted\x12;\n" +
+	"\vmuted_until\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"mutedUntil\x12\x10\n" +
+	"\x03dnd\x18\x05 \x01(\bR\x03dnd\x12!\n" +
+	"\fdisplay_name\x18\x06 \x01(\tR\vdisplayName\"\x80\x02\n" +
+	"\x11ConversationState\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x1c\n" +
+	"\tdissolved\x18\x02 \x01(\bR\tdissolved\x12\x19\n" +
+	"\bmute_all\x18\x03 \x01(\bR\amuteAll\x12,\n" +
+	"\amembers\x18\x04 \x03(\v2\x12.im.v1.MemberStateR\amembers\x12+\n" +
+	"\x04type\x18\x05 \x01(\x0e2\x17.im.v1.ConversationTypeR\x04type\x12.\n" +
+	"\x13message_ttl_seconds\x18\x06 \x01(\x03R\x11messageTtlSeconds\"C\n" +
+	"\x18LeaveConversationRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\"F\n" +
+	"\x1bDissolveConversationRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\"\x7f\n" +
+	"\x14SetMemberRoleRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12%\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x11.im.v1.MemberRoleR\x04role\"e\n" +
+	"\x18TransferOwnershipRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12 \n" +
+	"\fnew_owner_id\x18\x02 \x01(\x03R\n" +
+	"newOwnerId\"\x80\x01\n" +
+	"\x11MuteMemberRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12)\n" +
+	"\x10duration_seconds\x18\x03 \x01(\x03R\x0fdurationSeconds\"W\n" +
+	"\x13UnmuteMemberRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"P\n" +
+	"\x11SetMuteAllRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x12\n" +
+	"\x04mute\x18\x02 \x01(\bR\x04mute\"_\n" +
+	"\x18SetMemberNicknameRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x1a\n" +
+	"\bnickname\x18\x02 \x01(\tR\bnickname\"`\n" +
+	"\x14SetMessageTTLRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x1f\n" +
+	"\vttl_seconds\x18\x02 \x01(\x03R\n" +
+	"ttlSeconds\"i\n" +
 	"\x1aListMyConversationsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"c\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1a\n" +
+	"\barchived\x18\x03 \x01(\bR\barchived\"\xa4\x01\n" +
 	"\x1bListMyConversationsResponse\x12.\n" +
 	"\x05items\x18\x01 \x03(\v2\x18.im.v1.ConversationBriefR\x05items\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total2\xe0\x03\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12?\n" +
+	"\rconversations\x18\x03 \x03(\v2\x19.im.v1.MyConversationItemR\rconversations\"\xbc\x01\n" +
+	"\x1cScrollMyConversationsRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1a\n" +
+	"\barchived\x18\x03 \x01(\bR\barchived\x12\x1b\n" +
+	"\tfolder_id\x18\x04 \x01(\x03R\bfolderId\x125\n" +
+	"\fsmart_folder\x18\x05 \x01(\x0e2\x12.im.v1.SmartFolderR\vsmartFolder\"\x8c\x01\n" +
+	"\x1dScrollMyConversationsResponse\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.im.v1.MyConversationItemR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"\x94\x02\n" +
+	"\x13ConversationSetting\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x16\n" +
+	"\x06pinned\x18\x02 \x01(\bR\x06pinned\x127\n" +
+	"\tpinned_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bpinnedAt\x12\x14\n" +
+	"\x05muted\x18\x04 \x01(\bR\x05muted\x129\n" +
+	"\n" +
+	"mute_until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tmuteUntil\x12\x1a\n" +
+	"\barchived\x18\x06 \x01(\bR\barchived\x12\x16\n" +
+	"\x06remark\x18\a \x01(\tR\x06remark\"\xeb\x01\n" +
+	"\x12MyConversationItem\x12<\n" +
+	"\fconversation\x18\x01 \x01(\v2\x18.im.v1.ConversationBriefR\fconversation\x124\n" +
+	"\asetting\x18\x02 \x01(\v2\x1a.im.v1.ConversationSettingR\asetting\x12B\n" +
+	"\x0flast_message_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rlastMessageAt\x12\x1d\n" +
+	"\n" +
+	"folder_ids\x18\x04 \x03(\x03R\tfolderIds\"H\n" +
+	"\x1dGetConversationSettingRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\"\x91\x02\n" +
+	" UpdateConversationSettingRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x1b\n" +
+	"\x06pinned\x18\x02 \x01(\bH\x00R\x06pinned\x88\x01\x01\x12\x19\n" +
+	"\x05muted\x18\x03 \x01(\bH\x01R\x05muted\x88\x01\x01\x12!\n" +
+	"\fmute_seconds\x18\x04 \x01(\x03R\vmuteSeconds\x12\x1f\n" +
+	"\barchived\x18\x05 \x01(\bH\x02R\barchived\x88\x01\x01\x12\x1b\n" +
+	"\x06remark\x18\x06 \x01(\tH\x03R\x06remark\x88\x01\x01B\t\n" +
+	"\a_pinnedB\b\n" +
+	"\x06_mutedB\v\n" +
+	"\t_archivedB\t\n" +
+	"\a_remark\"\xe5\x02\n" +
+	"\x0fJoinRequestItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\x03R\x0econversationId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x120\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x18.im.v1.JoinRequestStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"handler_id\x18\x06 \x01(\x03R\thandlerId\x12;\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12\x1b\n" +
+	"\tinvite_id\x18\t \x01(\x03R\binviteId\"W\n" +
+	"\x12RequestJoinRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xa5\x01\n" +
+	"\x17ListJoinRequestsRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x120\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x18.im.v1.JoinRequestStatusR\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"^\n" +
+	"\x18ListJoinRequestsResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.im.v1.JoinRequestItemR\x05items\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"S\n" +
+	"\x18HandleJoinRequestRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\x03R\trequestId\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove\"\xc6\x02\n" +
+	"\n" +
+	"InviteItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\x03R\x0econversationId\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x04 \x01(\x03R\tcreatorId\x12;\n" +
+	"\vexpire_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x12\x19\n" +
+	"\bmax_uses\x18\x06 \x01(\x05R\amaxUses\x12\x1d\n" +
+	"\n" +
+	"used_count\x18\a \x01(\x05R\tusedCount\x12\x18\n" +
+	"\arevoked\x18\b \x01(\bR\arevoked\x12;\n" +
+	"\vcreate_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"\x87\x01\n" +
+	"\x13CreateInviteRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12,\n" +
+	"\x12expires_in_seconds\x18\x02 \x01(\x03R\x10expiresInSeconds\x12\x19\n" +
+	"\bmax_uses\x18\x03 \x01(\x05R\amaxUses\"=\n" +
+	"\x12ListInvitesRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\">\n" +
+	"\x13ListInvitesResponse\x12'\n" +
+	"\x05items\x18\x01 \x03(\v2\x11.im.v1.InviteItemR\x05items\"2\n" +
+	"\x13RevokeInviteRequest\x12\x1b\n" +
+	"\tinvite_id\x18\x01 \x01(\x03R\binviteId\"*\n" +
+	"\x14PreviewInviteRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\x95\x02\n" +
+	"\rInvitePreview\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\x12!\n" +
+	"\fmember_count\x18\x04 \x01(\x05R\vmemberCount\x12!\n" +
+	"\fmember_limit\x18\x05 \x01(\x05R\vmemberLimit\x12#\n" +
+	"\rneed_approval\x18\x06 \x01(\bR\fneedApproval\x12;\n" +
+	"\vexpire_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\")\n" +
+	"\x13JoinByInviteRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\xe8\x01\n" +
+	"\fAnnouncement\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1b\n" +
+	"\teditor_id\x18\x03 \x01(\x03R\beditorId\x12;\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"A\n" +
+	"\x16GetAnnouncementRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\"[\n" +
+	"\x16SetAnnouncementRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"D\n" +
+	"\x19DeleteAnnouncementRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\"\xaf\x01\n" +
+	"\x11PinnedMessageItem\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\x03R\tmessageId\x12\x1b\n" +
+	"\tpinned_by\x18\x03 \x01(\x03R\bpinnedBy\x125\n" +
+	"\bpin_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\apinTime\"D\n" +
+	"\x19ListPinnedMessagesRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\"L\n" +
+	"\x1aListPinnedMessagesResponse\x12.\n" +
+	"\x05items\x18\x01 \x03(\v2\x18.im.v1.PinnedMessageItemR\x05items\"[\n" +
+	"\x11PinMessageRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\x03R\tmessageId\"]\n" +
+	"\x13UnpinMessageRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\x03R\tmessageId\"A\n" +
+	"\x16GetConversationRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\"\xb1\x04\n" +
+	"\x12ConversationDetail\x12<\n" +
+	"\fconversation\x18\x01 \x01(\v2\x18.im.v1.ConversationBriefR\fconversation\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x02 \x01(\tR\tavatarUrl\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\x03R\aownerId\x12!\n" +
+	"\fmember_count\x18\x04 \x01(\x05R\vmemberCount\x12!\n" +
+	"\fmember_limit\x18\x05 \x01(\x05R\vmemberLimit\x12#\n" +
+	"\rneed_approval\x18\x06 \x01(\bR\fneedApproval\x12\x19\n" +
+	"\bmute_all\x18\a \x01(\bR\amuteAll\x127\n" +
+	"\fannouncement\x18\b \x01(\v2\x13.im.v1.AnnouncementR\fannouncement\x12A\n" +
+	"\x0fpinned_messages\x18\t \x03(\v2\x18.im.v1.PinnedMessageItemR\x0epinnedMessages\x124\n" +
+	"\asetting\x18\n" +
+	" \x01(\v2\x1a.im.v1.ConversationSettingR\asetting\x12;\n" +
+	"\vcreate_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12.\n" +
+	"\x13message_ttl_seconds\x18\f \x01(\x03R\x11messageTtlSeconds\"\xc7\x01\n" +
+	"\x06Folder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x03 \x01(\x05R\tsortOrder\x12(\n" +
+	"\x05smart\x18\x04 \x01(\x0e2\x12.im.v1.SmartFolderR\x05smart\x12-\n" +
+	"\x12conversation_count\x18\x05 \x01(\x05R\x11conversationCount\x12!\n" +
+	"\funread_count\x18\x06 \x01(\x05R\vunreadCount\"\x14\n" +
+	"\x12ListFoldersRequest\":\n" +
+	"\x13ListFoldersResponse\x12#\n" +
+	"\x05items\x18\x01 \x03(\v2\r.im.v1.FolderR\x05items\")\n" +
+	"\x13CreateFolderRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"F\n" +
+	"\x13RenameFolderRequest\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\x03R\bfolderId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"2\n" +
+	"\x13DeleteFolderRequest\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\x03R\bfolderId\"6\n" +
+	"\x15ReorderFoldersRequest\x12\x1d\n" +
+	"\n" +
+	"folder_ids\x18\x01 \x03(\x03R\tfolderIds\"g\n" +
+	"\x1dAddFolderConversationsRequest\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\x03R\bfolderId\x12)\n" +
+	"\x10conversation_ids\x18\x02 \x03(\x03R\x0fconversationIds\"g\n" +
+	"\x1fRemoveFolderConversationRequest\x12\x1b\n" +
+	"\tfolder_id\x18\x01 \x01(\x03R\bfolderId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\x03R\x0econversationId*o\n" +
+	"\n" +
+	"MemberRole\x12\x1b\n" +
+	"\x17MEMBER_ROLE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12MEMBER_ROLE_MEMBER\x10\x01\x12\x15\n" +
+	"\x11MEMBER_ROLE_ADMIN\x10\x02\x12\x15\n" +
+	"\x11MEMBER_ROLE_OWNER\x10\x03*\x9d\x01\n" +
+	"\x11JoinRequestStatus\x12#\n" +
+	"\x1fJOIN_REQUEST_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bJOIN_REQUEST_STATUS_PENDING\x10\x01\x12 \n" +
+	"\x1cJOIN_REQUEST_STATUS_APPROVED\x10\x02\x12 \n" +
+	"\x1cJOIN_REQUEST_STATUS_REJECTED\x10\x03*\x91\x01\n" +
+	"\vSmartFolder\x12\x1c\n" +
+	"\x18SMART_FOLDER_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13SMART_FOLDER_UNREAD\x10\x01\x12\x19\n" +
+	"\x15SMART_FOLDER_MENTIONS\x10\x02\x12\x17\n" +
+	"\x13SMART_FOLDER_GROUPS\x10\x03\x12\x17\n" +
+	"\x13SMART_FOLDER_DIRECT\x10\x042\xee\x19\n" +
 	"\x13ConversationService\x12P\n" +
 	"\x12CreateConversation\x12 .im.v1.CreateConversationRequest\x1a\x18.im.v1.ConversationBrief\x12P\n" +
-	"\x12UpdateConversation\x12 .im.v1.UpdateConversationRequest\x1a\x18.im.v1.ConversationBrief\x12>\n" +
+	"\x12UpdateConversation\x12 .im.v1.UpdateConversationRequest\x1a\x18.im.v1.ConversationBrief\x12K\n" +
+	"\x0fGetConversation\x12\x1d.im.v1.GetConversationRequest\x1a\x19.im.v1.ConversationDetail\x12>\n" +
 	"\n" +
 	"AddMembers\x12\x18.im.v1.AddMembersRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
 	"\rRemoveMembers\x12\x1b.im.v1.RemoveMembersRequest\x1a\x16.google.protobuf.Empty\x12A\n" +
 	"\n" +
-	"GetMembers\x12\x18.im.v1.GetMembersRequest\x1a\x19.im.v1.GetMembersResponse\x12\\\n" +
-	"\x13ListMyConversations\x12!.im.v1.ListMyConversationsRequest\x1a\".im.v1.ListMyConversationsResponseB*Z(github.com/EthanQC/IM/api/gen/im/v1;imv1b\x06proto3"
+	"GetMembers\x12\x18.im.v1.GetMembersRequest\x1a\x19.im.v1.GetMembersResponse\x12D\n" +
+	"\vListMembers\x12\x19.im.v1.ListMembersRequest\x1a\x1a.im.v1.ListMembersResponse\x12J\n" +
+	"\rScrollMembers\x12\x1b.im.v1.ScrollMembersRequest\x1a\x1c.im.v1.ScrollMembersResponse\x12T\n" +
+	"\x14GetConversationState\x12\".im.v1.GetConversationStateRequest\x1a\x18.im.v1.ConversationState\x12;\n" +
+	"\bIsMember\x12\x16.im.v1.IsMemberRequest\x1a\x17.im.v1.IsMemberResponse\x12L\n" +
+	"\x11LeaveConversation\x12\x1f.im.v1.LeaveConversationRequest\x1a\x16.google.protobuf.Empty\x12R\n" +
+	"\x14DissolveConversation\x12\".im.v1.DissolveConversationRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
+	"\rSetMemberRole\x12\x1b.im.v1.SetMemberRoleRequest\x1a\x16.google.protobuf.Empty\x12L\n" +
+	"\x11TransferOwnership\x12\x1f.im.v1.TransferOwnershipRequest\x1a\x16.google.protobuf.Empty\x12>\n" +
+	"\n" +
+	"MuteMember\x12\x18.im.v1.MuteMemberRequest\x1a\x16.google.protobuf.Empty\x12B\n" +
+	"\fUnmuteMember\x12\x1a.im.v1.UnmuteMemberRequest\x1a\x16.google.protobuf.Empty\x12>\n" +
+	"\n" +
+	"SetMuteAll\x12\x18.im.v1.SetMuteAllRequest\x1a\x16.google.protobuf.Empty\x12G\n" +
+	"\x11SetMemberNickname\x12\x1f.im.v1.SetMemberNicknameRequest\x1a\x11.im.v1.MemberItem\x12D\n" +
+	"\rSetMessageTTL\x12\x1b.im.v1.SetMessageTTLRequest\x1a\x16.google.protobuf.Empty\x12\\\n" +
+	"\x13ListMyConversations\x12!.im.v1.ListMyConversationsRequest\x1a\".im.v1.ListMyConversationsResponse\x12b\n" +
+	"\x15ScrollMyConversations\x12#.im.v1.ScrollMyConversationsRequest\x1a$.im.v1.ScrollMyConversationsResponse\x12Z\n" +
+	"\x16GetConversationSetting\x12$.im.v1.GetConversationSettingRequest\x1a\x1a.im.v1.ConversationSetting\x12`\n" +
+	"\x19UpdateConversationSetting\x12'.im.v1.UpdateConversationSettingRequest\x1a\x1a.im.v1.ConversationSetting\x12@\n" +
+	"\vRequestJoin\x12\x19.im.v1.RequestJoinRequest\x1a\x16.im.v1.JoinRequestItem\x12S\n" +
+	"\x10ListJoinRequests\x12\x1e.im.v1.ListJoinRequestsRequest\x1a\x1f.im.v1.ListJoinRequestsResponse\x12L\n" +
+	"\x11HandleJoinRequest\x12\x1f.im.v1.HandleJoinRequestRequest\x1a\x16.im.v1.JoinRequestItem\x12=\n" +
+	"\fCreateInvite\x12\x1a.im.v1.CreateInviteRequest\x1a\x11.im.v1.InviteItem\x12D\n" +
+	"\vListInvites\x12\x19.im.v1.ListInvitesRequest\x1a\x1a.im.v1.ListInvitesResponse\x12B\n" +
+	"\fRevokeInvite\x12\x1a.im.v1.RevokeInviteRequest\x1a\x16.google.protobuf.Empty\x12B\n" +
+	"\rPreviewInvite\x12\x1b.im.v1.PreviewInviteRequest\x1a\x14.im.v1.InvitePreview\x12B\n" +
+	"\fJoinByInvite\x12\x1a.im.v1.JoinByInviteRequest\x1a\x16.im.v1.JoinRequestItem\x12E\n" +
+	"\x0fGetAnnouncement\x12\x1d.im.v1.GetAnnouncementRequest\x1a\x13.im.v1.Announcement\x12E\n" +
+	"\x0fSetAnnouncement\x12\x1d.im.v1.SetAnnouncementRequest\x1a\x13.im.v1.Announcement\x12N\n" +
+	"\x12DeleteAnnouncement\x12 .im.v1.DeleteAnnouncementRequest\x1a\x16.google.protobuf.Empty\x12Y\n" +
+	"\x12ListPinnedMessages\x12 .im.v1.ListPinnedMessagesRequest\x1a!.im.v1.ListPinnedMessagesResponse\x12@\n" +
+	"\n" +
+	"PinMessage\x12\x18.im.v1.PinMessageRequest\x1a\x18.im.v1.PinnedMessageItem\x12B\n" +
+	"\fUnpinMessage\x12\x1a.im.v1.UnpinMessageRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
+	"\vListFolders\x12\x19.im.v1.ListFoldersRequest\x1a\x1a.im.v1.ListFoldersResponse\x129\n" +
+	"\fCreateFolder\x12\x1a.im.v1.CreateFolderRequest\x1a\r.im.v1.Folder\x129\n" +
+	"\fRenameFolder\x12\x1a.im.v1.RenameFolderRequest\x1a\r.im.v1.Folder\x12B\n" +
+	"\fDeleteFolder\x12\x1a.im.v1.DeleteFolderRequest\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\x0eReorderFolders\x12\x1c.im.v1.ReorderFoldersRequest\x1a\x16.google.protobuf.Empty\x12V\n" +
+	"\x16AddFolderConversations\x12$.im.v1.AddFolderConversationsRequest\x1a\x16.google.protobuf.Empty\x12Z\n" +
+	"\x18RemoveFolderConversation\x12&.im.v1.RemoveFolderConversationRequest\x1a\x16.google.protobuf.EmptyB*Z(github.com/EthanQC/IM/api/gen/im/v1;imv1b\x06proto3"
 
 var (
 	file_im_v1_conversation_proto_rawDescOnce sync.Once
@@ -481,42 +4526,223 @@ func file_im_v1_conversation_proto_rawDescGZIP() []byte {
 	return file_im_v1_conversation_proto_rawDescData
 }
 
-var file_im_v1_conversation_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_im_v1_conversation_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_im_v1_conversation_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
 var file_im_v1_conversation_proto_goTypes = []any{
-	(*CreateConversationRequest)(nil),   // 0: im.v1.CreateConversationRequest
-	(*UpdateConversationRequest)(nil),   // 1: im.v1.UpdateConversationRequest
-	(*AddMembersRequest)(nil),           // 2: im.v1.AddMembersRequest
-	(*RemoveMembersRequest)(nil),        // 3: im.v1.RemoveMembersRequest
-	(*GetMembersRequest)(nil),           // 4: im.v1.GetMembersRequest
-	(*GetMembersResponse)(nil),          // 5: im.v1.GetMembersResponse
-	(*ListMyConversationsRequest)(nil),  // 6: im.v1.ListMyConversationsRequest
-	(*ListMyConversationsResponse)(nil), // 7: im.v1.ListMyConversationsResponse
-	(ConversationType)(0),               // 8: im.v1.ConversationType
-	(*UserBrief)(nil),                   // 9: im.v1.UserBrief
-	(*ConversationBrief)(nil),           // 10: im.v1.ConversationBrief
-	(*emptypb.Empty)(nil),               // 11: google.protobuf.Empty
+	(MemberRole)(0),                          // 0: im.v1.MemberRole
+	(JoinRequestStatus)(0),                   // 1: im.v1.JoinRequestStatus
+	(SmartFolder)(0),                         // 2: im.v1.SmartFolder
+	(*CreateConversationRequest)(nil),        // 3: im.v1.CreateConversationRequest
+	(*UpdateConversationRequest)(nil),        // 4: im.v1.UpdateConversationRequest
+	(*AddMembersRequest)(nil),                // 5: im.v1.AddMembersRequest
+	(*RemoveMembersRequest)(nil),             // 6: im.v1.RemoveMembersRequest
+	(*GetMembersRequest)(nil),                // 7: im.v1.GetMembersRequest
+	(*GetMembersResponse)(nil),               // 8: im.v1.GetMembersResponse
+	(*MemberItem)(nil),                       // 9: im.v1.MemberItem
+	(*ListMembersRequest)(nil),               // 10: im.v1.ListMembersRequest
+	(*ListMembersResponse)(nil),              // 11: im.v1.ListMembersResponse
+	(*ScrollMembersRequest)(nil),             // 12: im.v1.ScrollMembersRequest
+	(*MemberSummary)(nil),                    // 13: im.v1.MemberSummary
+	(*ScrollMembersResponse)(nil),            // 14: im.v1.ScrollMembersResponse
+	(*GetConversationStateRequest)(nil),      // 15: im.v1.GetConversationStateRequest
+	(*IsMemberRequest)(nil),                  // 16: im.v1.IsMemberRequest
+	(*IsMemberResponse)(nil),                 // 17: im.v1.IsMemberResponse
+	(*MemberState)(nil),                      // 18: im.v1.MemberState
+	(*ConversationState)(nil),                // 19: im.v1.ConversationState
+	(*LeaveConversationRequest)(nil),         // 20: im.v1.LeaveConversationRequest
+	(*DissolveConversationRequest)(nil),      // 21: im.v1.DissolveConversationRequest
+	(*SetMemberRoleRequest)(nil),             // 22: im.v1.SetMemberRoleRequest
+	(*TransferOwnershipRequest)(nil),         // 23: im.v1.TransferOwnershipRequest
+	(*MuteMemberRequest)(nil),                // 24: im.v1.MuteMemberRequest
+	(*UnmuteMemberRequest)(nil),              // 25: im.v1.UnmuteMemberRequest
+	(*SetMuteAllRequest)(nil),                // 26: im.v1.SetMuteAllRequest
+	(*SetMemberNicknameRequest)(nil),         // 27: im.v1.SetMemberNicknameRequest
+	(*SetMessageTTLRequest)(nil),             // 28: im.v1.SetMessageTTLRequest
+	(*ListMyConversationsRequest)(nil),       // 29: im.v1.ListMyConversationsRequest
+	(*ListMyConversationsResponse)(nil),      // 30: im.v1.ListMyConversationsResponse
+	(*ScrollMyConversationsRequest)(nil),     // 31: im.v1.ScrollMyConversationsRequest
+	(*ScrollMyConversationsResponse)(nil),    // 32: im.v1.ScrollMyConversationsResponse
+	(*ConversationSetting)(nil),              // 33: im.v1.ConversationSetting
+	(*MyConversationItem)(nil),               // 34: im.v1.MyConversationItem
+	(*GetConversationSettingRequest)(nil),    // 35: im.v1.GetConversationSettingRequest
+	(*UpdateConversationSettingRequest)(nil), // 36: im.v1.UpdateConversationSettingRequest
+	(*JoinRequestItem)(nil),                  // 37: im.v1.JoinRequestItem
+	(*RequestJoinRequest)(nil),               // 38: im.v1.RequestJoinRequest
+	(*ListJoinRequestsRequest)(nil),          // 39: im.v1.ListJoinRequestsRequest
+	(*ListJoinRequestsResponse)(nil),         // 40: im.v1.ListJoinRequestsResponse
+	(*HandleJoinRequestRequest)(nil),         // 41: im.v1.HandleJoinRequestRequest
+	(*InviteItem)(nil),                       // 42: im.v1.InviteItem
+	(*CreateInviteRequest)(nil),              // 43: im.v1.CreateInviteRequest
+	(*ListInvitesRequest)(nil),               // 44: im.v1.ListInvitesRequest
+	(*ListInvitesResponse)(nil),              // 45: im.v1.ListInvitesResponse
+	(*RevokeInviteRequest)(nil),              // 46: im.v1.RevokeInviteRequest
+	(*PreviewInviteRequest)(nil),             // 47: im.v1.PreviewInviteRequest
+	(*InvitePreview)(nil),                    // 48: im.v1.InvitePreview
+	(*JoinByInviteRequest)(nil),              // 49: im.v1.JoinByInviteRequest
+	(*Announcement)(nil),                     // 50: im.v1.Announcement
+	(*GetAnnouncementRequest)(nil),           // 51: im.v1.GetAnnouncementRequest
+	(*SetAnnouncementRequest)(nil),           // 52: im.v1.SetAnnouncementRequest
+	(*DeleteAnnouncementRequest)(nil),        // 53: im.v1.DeleteAnnouncementRequest
+	(*PinnedMessageItem)(nil),                // 54: im.v1.PinnedMessageItem
+	(*ListPinnedMessagesRequest)(nil),        // 55: im.v1.ListPinnedMessagesRequest
+	(*ListPinnedMessagesResponse)(nil),       // 56: im.v1.ListPinnedMessagesResponse
+	(*PinMessageRequest)(nil),                // 57: im.v1.PinMessageRequest
+	(*UnpinMessageRequest)(nil),              // 58: im.v1.UnpinMessageRequest
+	(*GetConversationRequest)(nil),           // 59: im.v1.GetConversationRequest
+	(*ConversationDetail)(nil),               // 60: im.v1.ConversationDetail
+	(*Folder)(nil),                           // 61: im.v1.Folder
+	(*ListFoldersRequest)(nil),               // 62: im.v1.ListFoldersRequest
+	(*ListFoldersResponse)(nil),              // 63: im.v1.ListFoldersResponse
+	(*CreateFolderRequest)(nil),              // 64: im.v1.CreateFolderRequest
+	(*RenameFolderRequest)(nil),              // 65: im.v1.RenameFolderRequest
+	(*DeleteFolderRequest)(nil),              // 66: im.v1.DeleteFolderRequest
+	(*ReorderFoldersRequest)(nil),            // 67: im.v1.ReorderFoldersRequest
+	(*AddFolderConversationsRequest)(nil),    // 68: im.v1.AddFolderConversationsRequest
+	(*RemoveFolderConversationRequest)(nil),  // 69: im.v1.RemoveFolderConversationRequest
+	(ConversationType)(0),                    // 70: im.v1.ConversationType
+	(*UserBrief)(nil),                        // 71: im.v1.UserBrief
+	(*timestamppb.Timestamp)(nil),            // 72: google.protobuf.Timestamp
+	(*ConversationBrief)(nil),                // 73: im.v1.ConversationBrief
+	(*emptypb.Empty)(nil),                    // 74: google.protobuf.Empty
 }
 var file_im_v1_conversation_proto_depIdxs = []int32{
-	8,  // 0: im.v1.CreateConversationRequest.type:type_name -> im.v1.ConversationType
-	9,  // 1: im.v1.GetMembersResponse.members:type_name -> im.v1.UserBrief
-	10, // 2: im.v1.ListMyConversationsResponse.items:type_name -> im.v1.ConversationBrief
-	0,  // 3: im.v1.ConversationService.CreateConversation:input_type -> im.v1.CreateConversationRequest
-	1,  // 4: im.v1.ConversationService.UpdateConversation:input_type -> im.v1.UpdateConversationRequest
-	2,  // 5: im.v1.ConversationService.AddMembers:input_type -> im.v1.AddMembersRequest
-	3,  // 6: im.v1.ConversationService.RemoveMembers:input_type -> im.v1.RemoveMembersRequest
-	4,  // 7: im.v1.ConversationService.GetMembers:input_type -> im.v1.GetMembersRequest
-	6,  // 8: im.v1.ConversationService.ListMyConversations:input_type -> im.v1.ListMyConversationsRequest
-	10, // 9: im.v1.ConversationService.CreateConversation:output_type -> im.v1.ConversationBrief
-	10, // 10: im.v1.ConversationService.UpdateConversation:output_type -> im.v1.ConversationBrief
-	11, // 11: im.v1.ConversationService.AddMembers:output_type -> google.protobuf.Empty
-	11, // 12: im.v1.ConversationService.RemoveMembers:output_type -> google.protobuf.Empty
-	5,  // 13: im.v1.ConversationService.GetMembers:output_type -> im.v1.GetMembersResponse
-	7,  // 14: im.v1.ConversationService.ListMyConversations:output_type -> im.v1.ListMyConversationsResponse
-	9,  // [9:15] is the sub-list for method output_type
-	3,  // [3:9] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	70, // 0: im.v1.CreateConversationRequest.type:type_name -> im.v1.ConversationType
+	71, // 1: im.v1.GetMembersResponse.members:type_name -> im.v1.UserBrief
+	0,  // 2: im.v1.MemberItem.role:type_name -> im.v1.MemberRole
+	72, // 3: im.v1.MemberItem.muted_until:type_name -> google.protobuf.Timestamp
+	72, // 4: im.v1.MemberItem.join_time:type_name -> google.protobuf.Timestamp
+	71, // 5: im.v1.MemberItem.user:type_name -> im.v1.UserBrief
+	9,  // 6: im.v1.ListMembersResponse.members:type_name -> im.v1.MemberItem
+	0,  // 7: im.v1.ScrollMembersRequest.role:type_name -> im.v1.MemberRole
+	9,  // 8: im.v1.ScrollMembersResponse.items:type_name -> im.v1.MemberItem
+	13, // 9: im.v1.ScrollMembersResponse.summary:type_name -> im.v1.MemberSummary
+	0,  // 10: im.v1.MemberState.role:type_name -> im.v1.MemberRole
+	72, // 11: im.v1.MemberState.muted_until:type_name -> google.protobuf.Timestamp
+	18, // 12: im.v1.ConversationState.members:type_name -> im.v1.MemberState
+	70, // 13: im.v1.ConversationState.type:type_name -> im.v1.ConversationType
+	0,  // 14: im.v1.SetMemberRoleRequest.role:type_name -> im.v1.MemberRole
+	73, // 15: im.v1.ListMyConversationsResponse.items:type_name -> im.v1.ConversationBrief
+	34, // 16: im.v1.ListMyConversationsResponse.conversations:type_name -> im.v1.MyConversationItem
+	2,  // 17: im.v1.ScrollMyConversationsRequest.smart_folder:type_name -> im.v1.SmartFolder
+	34, // 18: im.v1.ScrollMyConversationsResponse.items:type_name -> im.v1.MyConversationItem
+	72, // 19: im.v1.ConversationSetting.pinned_at:type_name -> google.protobuf.Timestamp
+	72, // 20: im.v1.ConversationSetting.mute_until:type_name -> google.protobuf.Timestamp
+	73, // 21: im.v1.MyConversationItem.conversation:type_name -> im.v1.ConversationBrief
+	33, // 22: im.v1.MyConversationItem.setting:type_name -> im.v1.ConversationSetting
+	72, // 23: im.v1.MyConversationItem.last_message_at:type_name -> google.protobuf.Timestamp
+	1,  // 24: im.v1.JoinRequestItem.status:type_name -> im.v1.JoinRequestStatus
+	72, // 25: im.v1.JoinRequestItem.create_time:type_name -> google.protobuf.Timestamp
+	72, // 26: im.v1.JoinRequestItem.update_time:type_name -> google.protobuf.Timestamp
+	1,  // 27: im.v1.ListJoinRequestsRequest.status:type_name -> im.v1.JoinRequestStatus
+	37, // 28: im.v1.ListJoinRequestsResponse.items:type_name -> im.v1.JoinRequestItem
+	72, // 29: im.v1.InviteItem.expire_time:type_name -> google.protobuf.Timestamp
+	72, // 30: im.v1.InviteItem.create_time:type_name -> google.protobuf.Timestamp
+	42, // 31: im.v1.ListInvitesResponse.items:type_name -> im.v1.InviteItem
+	72, // 32: im.v1.InvitePreview.expire_time:type_name -> google.protobuf.Timestamp
+	72, // 33: im.v1.Announcement.create_time:type_name -> google.protobuf.Timestamp
+	72, // 34: im.v1.Announcement.update_time:type_name -> google.protobuf.Timestamp
+	72, // 35: im.v1.PinnedMessageItem.pin_time:type_name -> google.protobuf.Timestamp
+	54, // 36: im.v1.ListPinnedMessagesResponse.items:type_name -> im.v1.PinnedMessageItem
+	73, // 37: im.v1.ConversationDetail.conversation:type_name -> im.v1.ConversationBrief
+	50, // 38: im.v1.ConversationDetail.announcement:type_name -> im.v1.Announcement
+	54, // 39: im.v1.ConversationDetail.pinned_messages:type_name -> im.v1.PinnedMessageItem
+	33, // 40: im.v1.ConversationDetail.setting:type_name -> im.v1.ConversationSetting
+	72, // 41: im.v1.ConversationDetail.create_time:type_name -> google.protobuf.Timestamp
+	2,  // 42: im.v1.Folder.smart:type_name -> im.v1.SmartFolder
+	61, // 43: im.v1.ListFoldersResponse.items:type_name -> im.v1.Folder
+	3,  // 44: im.v1.ConversationService.CreateConversation:input_type -> im.v1.CreateConversationRequest
+	4,  // 45: im.v1.ConversationService.UpdateConversation:input_type -> im.v1.UpdateConversationRequest
+	59, // 46: im.v1.ConversationService.GetConversation:input_type -> im.v1.GetConversationRequest
+	5,  // 47: im.v1.ConversationService.AddMembers:input_type -> im.v1.AddMembersRequest
+	6,  // 48: im.v1.ConversationService.RemoveMembers:input_type -> im.v1.RemoveMembersRequest
+	7,  // 49: im.v1.ConversationService.GetMembers:input_type -> im.v1.GetMembersRequest
+	10, // 50: im.v1.ConversationService.ListMembers:input_type -> im.v1.ListMembersRequest
+	12, // 51: im.v1.ConversationService.ScrollMembers:input_type -> im.v1.ScrollMembersRequest
+	15, // 52: im.v1.ConversationService.GetConversationState:input_type -> im.v1.GetConversationStateRequest
+	16, // 53: im.v1.ConversationService.IsMember:input_type -> im.v1.IsMemberRequest
+	20, // 54: im.v1.ConversationService.LeaveConversation:input_type -> im.v1.LeaveConversationRequest
+	21, // 55: im.v1.ConversationService.DissolveConversation:input_type -> im.v1.DissolveConversationRequest
+	22, // 56: im.v1.ConversationService.SetMemberRole:input_type -> im.v1.SetMemberRoleRequest
+	23, // 57: im.v1.ConversationService.TransferOwnership:input_type -> im.v1.TransferOwnershipRequest
+	24, // 58: im.v1.ConversationService.MuteMember:input_type -> im.v1.MuteMemberRequest
+	25, // 59: im.v1.ConversationService.UnmuteMember:input_type -> im.v1.UnmuteMemberRequest
+	26, // 60: im.v1.ConversationService.SetMuteAll:input_type -> im.v1.SetMuteAllRequest
+	27, // 61: im.v1.ConversationService.SetMemberNickname:input_type -> im.v1.SetMemberNicknameRequest
+	28, // 62: im.v1.ConversationService.SetMessageTTL:input_type -> im.v1.SetMessageTTLRequest
+	29, // 63: im.v1.ConversationService.ListMyConversations:input_type -> im.v1.ListMyConversationsRequest
+	31, // 64: im.v1.ConversationService.ScrollMyConversations:input_type -> im.v1.ScrollMyConversationsRequest
+	35, // 65: im.v1.ConversationService.GetConversationSetting:input_type -> im.v1.GetConversationSettingRequest
+	36, // 66: im.v1.ConversationService.UpdateConversationSetting:input_type -> im.v1.UpdateConversationSettingRequest
+	38, // 67: im.v1.ConversationService.RequestJoin:input_type -> im.v1.RequestJoinRequest
+	39, // 68: im.v1.ConversationService.ListJoinRequests:input_type -> im.v1.ListJoinRequestsRequest
+	41, // 69: im.v1.ConversationService.HandleJoinRequest:input_type -> im.v1.HandleJoinRequestRequest
+	43, // 70: im.v1.ConversationService.CreateInvite:input_type -> im.v1.CreateInviteRequest
+	44, // 71: im.v1.ConversationService.ListInvites:input_type -> im.v1.ListInvitesRequest
+	46, // 72: im.v1.ConversationService.RevokeInvite:input_type -> im.v1.RevokeInviteRequest
+	47, // 73: im.v1.ConversationService.PreviewInvite:input_type -> im.v1.PreviewInviteRequest
+	49, // 74: im.v1.ConversationService.JoinByInvite:input_type -> im.v1.JoinByInviteRequest
+	51, // 75: im.v1.ConversationService.GetAnnouncement:input_type -> im.v1.GetAnnouncementRequest
+	52, // 76: im.v1.ConversationService.SetAnnouncement:input_type -> im.v1.SetAnnouncementRequest
+	53, // 77: im.v1.ConversationService.DeleteAnnouncement:input_type -> im.v1.DeleteAnnouncementRequest
+	55, // 78: im.v1.ConversationService.ListPinnedMessages:input_type -> im.v1.ListPinnedMessagesRequest
+	57, // 79: im.v1.ConversationService.PinMessage:input_type -> im.v1.PinMessageRequest
+	58, // 80: im.v1.ConversationService.UnpinMessage:input_type -> im.v1.UnpinMessageRequest
+	62, // 81: im.v1.ConversationService.ListFolders:input_type -> im.v1.ListFoldersRequest
+	64, // 82: im.v1.ConversationService.CreateFolder:input_type -> im.v1.CreateFolderRequest
+	65, // 83: im.v1.ConversationService.RenameFolder:input_type -> im.v1.RenameFolderRequest
+	66, // 84: im.v1.ConversationService.DeleteFolder:input_type -> im.v1.DeleteFolderRequest
+	67, // 85: im.v1.ConversationService.ReorderFolders:input_type -> im.v1.ReorderFoldersRequest
+	68, // 86: im.v1.ConversationService.AddFolderConversations:input_type -> im.v1.AddFolderConversationsRequest
+	69, // 87: im.v1.ConversationService.RemoveFolderConversation:input_type -> im.v1.RemoveFolderConversationRequest
+	73, // 88: im.v1.ConversationService.CreateConversation:output_type -> im.v1.ConversationBrief
+	73, // 89: im.v1.ConversationService.UpdateConversation:output_type -> im.v1.ConversationBrief
+	60, // 90: im.v1.ConversationService.GetConversation:output_type -> im.v1.ConversationDetail
+	74, // 91: im.v1.ConversationService.AddMembers:output_type -> google.protobuf.Empty
+	74, // 92: im.v1.ConversationService.RemoveMembers:output_type -> google.protobuf.Empty
+	8,  // 93: im.v1.ConversationService.GetMembers:output_type -> im.v1.GetMembersResponse
+	11, // 94: im.v1.ConversationService.ListMembers:output_type -> im.v1.ListMembersResponse
+	14, // 95: im.v1.ConversationService.ScrollMembers:output_type -> im.v1.ScrollMembersResponse
+	19, // 96: im.v1.ConversationService.GetConversationState:output_type -> im.v1.ConversationState
+	17, // 97: im.v1.ConversationService.IsMember:output_type -> im.v1.IsMemberResponse
+	74, // 98: im.v1.ConversationService.LeaveConversation:output_type -> google.protobuf.Empty
+	74, // 99: im.v1.ConversationService.DissolveConversation:output_type -> google.protobuf.Empty
+	74, // 100: im.v1.ConversationService.SetMemberRole:output_type -> google.protobuf.Empty
+	74, // 101: im.v1.ConversationService.TransferOwnership:output_type -> google.protobuf.Empty
+	74, // 102: im.v1.ConversationService.MuteMember:output_type -> google.protobuf.Empty
+	74, // 103: im.v1.ConversationService.UnmuteMember:output_type -> google.protobuf.Empty
+	74, // 104: im.v1.ConversationService.SetMuteAll:output_type -> google.protobuf.Empty
+	9,  // 105: im.v1.ConversationService.SetMemberNickname:output_type -> im.v1.MemberItem
+	74, // 106: im.v1.ConversationService.SetMessageTTL:output_type -> google.protobuf.Empty
+	30, // 107: im.v1.ConversationService.ListMyConversations:output_type -> im.v1.ListMyConversationsResponse
+	32, // 108: im.v1.ConversationService.ScrollMyConversations:output_type -> im.v1.ScrollMyConversationsResponse
+	33, // 109: im.v1.ConversationService.GetConversationSetting:output_type -> im.v1.ConversationSetting
+	33, // 110: im.v1.ConversationService.UpdateConversationSetting:output_type -> im.v1.ConversationSetting
+	37, // 111: im.v1.ConversationService.RequestJoin:output_type -> im.v1.JoinRequestItem
+	40, // 112: im.v1.ConversationService.ListJoinRequests:output_type -> im.v1.ListJoinRequestsResponse
+	37, // 113: im.v1.ConversationService.HandleJoinRequest:output_type -> im.v1.JoinRequestItem
+	42, // 114: im.v1.ConversationService.CreateInvite:output_type -> im.v1.InviteItem
+	45, // 115: im.v1.ConversationService.ListInvites:output_type -> im.v1.ListInvitesResponse
+	74, // 116: im.v1.ConversationService.RevokeInvite:output_type -> google.protobuf.Empty
+	48, // 117: im.v1.ConversationService.PreviewInvite:output_type -> im.v1.InvitePreview
+	37, // 118: im.v1.ConversationService.JoinByInvite:output_type -> im.v1.JoinRequestItem
+	50, // 119: im.v1.ConversationService.GetAnnouncement:output_type -> im.v1.Announcement
+	50, // 120: im.v1.ConversationService.SetAnnouncement:output_type -> im.v1.Announcement
+	74, // 121: im.v1.ConversationService.DeleteAnnouncement:output_type -> google.protobuf.Empty
+	56, // 122: im.v1.ConversationService.ListPinnedMessages:output_type -> im.v1.ListPinnedMessagesResponse
+	54, // 123: im.v1.ConversationService.PinMessage:output_type -> im.v1.PinnedMessageItem
+	74, // 124: im.v1.ConversationService.UnpinMessage:output_type -> google.protobuf.Empty
+	63, // 125: im.v1.ConversationService.ListFolders:output_type -> im.v1.ListFoldersResponse
+	61, // 126: im.v1.ConversationService.CreateFolder:output_type -> im.v1.Folder
+	61, // 127: im.v1.ConversationService.RenameFolder:output_type -> im.v1.Folder
+	74, // 128: im.v1.ConversationService.DeleteFolder:output_type -> google.protobuf.Empty
+	74, // 129: im.v1.ConversationService.ReorderFolders:output_type -> google.protobuf.Empty
+	74, // 130: im.v1.ConversationService.AddFolderConversations:output_type -> google.protobuf.Empty
+	74, // 131: im.v1.ConversationService.RemoveFolderConversation:output_type -> google.protobuf.Empty
+	88, // [88:132] is the sub-list for method output_type
+	44, // [44:88] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_im_v1_conversation_proto_init() }
@@ -525,18 +4751,21 @@ func file_im_v1_conversation_proto_init() {
 		return
 	}
 	file_im_v1_common_proto_init()
+	file_im_v1_conversation_proto_msgTypes[9].OneofWrappers = []any{}
+	file_im_v1_conversation_proto_msgTypes[33].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_im_v1_conversation_proto_rawDesc), len(file_im_v1_conversation_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      3,
+			NumMessages:   67,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_im_v1_conversation_proto_goTypes,
 		DependencyIndexes: file_im_v1_conversation_proto_depIdxs,
+		EnumInfos:         file_im_v1_conversation_proto_enumTypes,
 		MessageInfos:      file_im_v1_conversation_proto_msgTypes,
 	}.Build()
 	File_im_v1_conversation_proto = out.File
@@ -20,12 +20,50 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ConversationService_CreateConversation_FullMethodName  = "/im.v1.ConversationService/CreateConversation"
-	ConversationService_UpdateConversation_FullMethodName  = "/im.v1.ConversationService/UpdateConversation"
-	ConversationService_AddMembers_FullMethodName          = "/im.v1.ConversationService/AddMembers"
-	ConversationService_RemoveMembers_FullMethodName       = "/im.v1.ConversationService/RemoveMembers"
-	ConversationService_GetMembers_FullMethodName          = "/im.v1.ConversationService/GetMembers"
-	ConversationService_ListMyConversations_FullMethodName = "/im.v1.ConversationService/ListMyConversations"
+	ConversationService_CreateConversation_FullMethodName        = "/im.v1.ConversationService/CreateConversation"
+	ConversationService_UpdateConversation_FullMethodName        = "/im.v1.ConversationService/UpdateConversation"
+	ConversationService_GetConversation_FullMethodName           = "/im.v1.ConversationService/GetConversation"
+	ConversationService_AddMembers_FullMethodName                = "/im.v1.ConversationService/AddMembers"
+	ConversationService_RemoveMembers_FullMethodName             = "/im.v1.ConversationService/RemoveMembers"
+	ConversationService_GetMembers_FullMethodName                = "/im.v1.ConversationService/GetMembers"
+	ConversationService_ListMembers_FullMethodName               = "/im.v1.ConversationService/ListMembers"
+	ConversationService_ScrollMembers_FullMethodName             = "/im.v1.ConversationService/ScrollMembers"
+	ConversationService_GetConversationState_FullMethodName      = "/im.v1.ConversationService/GetConversationState"
+	ConversationService_IsMember_FullMethodName                  = "/im.v1.ConversationService/IsMember"
+	ConversationService_LeaveConversation_FullMethodName         = "/im.v1.ConversationService/LeaveConversation"
+	ConversationService_DissolveConversation_FullMethodName      = "/im.v1.ConversationService/DissolveConversation"
+	ConversationService_SetMemberRole_FullMethodName             = "/im.v1.ConversationService/SetMemberRole"
+	ConversationService_TransferOwnership_FullMethodName         = "/im.v1.ConversationService/TransferOwnership"
+	ConversationService_MuteMember_FullMethodName                = "/im.v1.ConversationService/MuteMember"
+	ConversationService_UnmuteMember_FullMethodName              = "/im.v1.ConversationService/UnmuteMember"
+	ConversationService_SetMuteAll_FullMethodName                = "/im.v1.ConversationService/SetMuteAll"
+	ConversationService_SetMemberNickname_FullMethodName         = "/im.v1.ConversationService/SetMemberNickname"
+	ConversationService_SetMessageTTL_FullMethodName             = "/im.v1.ConversationService/SetMessageTTL"
+	ConversationService_ListMyConversations_FullMethodName       = "/im.v1.ConversationService/ListMyConversations"
+	ConversationService_ScrollMyConversations_FullMethodName     = "/im.v1.ConversationService/ScrollMyConversations"
+	ConversationService_GetConversationSetting_FullMethodName    = "/im.v1.ConversationService/GetConversationSetting"
+	ConversationService_UpdateConversationSetting_FullMethodName = "/im.v1.ConversationService/UpdateConversationSetting"
+	ConversationService_RequestJoin_FullMethodName               = "/im.v1.ConversationService/RequestJoin"
+	ConversationService_ListJoinRequests_FullMethodName          = "/im.v1.ConversationService/ListJoinRequests"
+	ConversationService_HandleJoinRequest_FullMethodName         = "/im.v1.ConversationService/HandleJoinRequest"
+	ConversationService_CreateInvite_FullMethodName              = "/im.v1.ConversationService/CreateInvite"
+	ConversationService_ListInvites_FullMethodName               = "/im.v1.ConversationService/ListInvites"
+	ConversationService_RevokeInvite_FullMethodName              = "/im.v1.ConversationService/RevokeInvite"
+	ConversationService_PreviewInvite_FullMethodName             = "/im.v1.ConversationService/PreviewInvite"
+	ConversationService_JoinByInvite_FullMethodName              = "/im.v1.ConversationService/JoinByInvite"
+	ConversationService_GetAnnouncement_FullMethodName           = "/im.v1.ConversationService/GetAnnouncement"
+	ConversationService_SetAnnouncement_FullMethodName           = "/im.v1.ConversationService/SetAnnouncement"
+	ConversationService_DeleteAnnouncement_FullMethodName        = "/im.v1.ConversationService/DeleteAnnouncement"
+	ConversationService_ListPinnedMessages_FullMethodName        = "/im.v1.ConversationService/ListPinnedMessages"
+	ConversationService_PinMessage_FullMethodName                = "/im.v1.ConversationService/PinMessage"
+	ConversationService_UnpinMessage_FullMethodName              = "/im.v1.ConversationService/UnpinMessage"
+	ConversationService_ListFolders_FullMethodName               = "/im.v1.ConversationService/ListFolders"
+	ConversationService_CreateFolder_FullMethodName              = "/im.v1.ConversationService/CreateFolder"
+	ConversationService_RenameFolder_FullMethodName              = "/im.v1.ConversationService/RenameFolder"
+	ConversationService_DeleteFolder_FullMethodName              = "/im.v1.ConversationService/DeleteFolder"
+	ConversationService_ReorderFolders_FullMethodName            = "/im.v1.ConversationService/ReorderFolders"
+	ConversationService_AddFolderConversations_FullMethodName    = "/im.v1.ConversationService/AddFolderConversations"
+	ConversationService_RemoveFolderConversation_FullMethodName  = "/im.v1.ConversationService/RemoveFolderConversation"
 )
 
 // ConversationServiceClient is the client API for ConversationService service.
@@ -34,10 +72,62 @@ const (
 type ConversationServiceClient interface {
 	CreateConversation(ctx context.Context, in *CreateConversationRequest, opts ...grpc.CallOption) (*ConversationBrief, error)
 	UpdateConversation(ctx context.Context, in *UpdateConversationRequest, opts ...grpc.CallOption) (*ConversationBrief, error)
+	// 会话详情（含群公告、置顶消息和个人设置）
+	GetConversation(ctx context.Context, in *GetConversationRequest, opts ...grpc.CallOption) (*ConversationDetail, error)
 	AddMembers(ctx context.Context, in *AddMembersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RemoveMembers(ctx context.Context, in *RemoveMembersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetMembers(ctx context.Context, in *GetMembersRequest, opts ...grpc.CallOption) (*GetMembersResponse, error)
+	ListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*ListMembersResponse, error)
+	// 游标分页的成员列表，支持按角色、禁言状态过滤和按名称搜索
+	ScrollMembers(ctx context.Context, in *ScrollMembersRequest, opts ...grpc.CallOption) (*ScrollMembersResponse, error)
+	// 发送权限校验所需的会话状态（供 message_service 内部调用）
+	GetConversationState(ctx context.Context, in *GetConversationStateRequest, opts ...grpc.CallOption) (*ConversationState, error)
+	// 单个用户是否为会话成员，含频道订阅者（供 message_service 内部调用）
+	IsMember(ctx context.Context, in *IsMemberRequest, opts ...grpc.CallOption) (*IsMemberResponse, error)
+	// 群管理
+	LeaveConversation(ctx context.Context, in *LeaveConversationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DissolveConversation(ctx context.Context, in *DissolveConversationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetMemberRole(ctx context.Context, in *SetMemberRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MuteMember(ctx context.Context, in *MuteMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnmuteMember(ctx context.Context, in *UnmuteMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetMuteAll(ctx context.Context, in *SetMuteAllRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 设置自己的群昵称
+	SetMemberNickname(ctx context.Context, in *SetMemberNicknameRequest, opts ...grpc.CallOption) (*MemberItem, error)
+	// 设置消息自动删除时长（单聊任一成员可设置，群聊和频道仅群主/管理员）
+	SetMessageTTL(ctx context.Context, in *SetMessageTTLRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListMyConversations(ctx context.Context, in *ListMyConversationsRequest, opts ...grpc.CallOption) (*ListMyConversationsResponse, error)
+	// 游标分页的会话列表
+	ScrollMyConversations(ctx context.Context, in *ScrollMyConversationsRequest, opts ...grpc.CallOption) (*ScrollMyConversationsResponse, error)
+	// 个人会话设置（置顶、免打扰、归档、备注）
+	GetConversationSetting(ctx context.Context, in *GetConversationSettingRequest, opts ...grpc.CallOption) (*ConversationSetting, error)
+	UpdateConversationSetting(ctx context.Context, in *UpdateConversationSettingRequest, opts ...grpc.CallOption) (*ConversationSetting, error)
+	// 入群申请
+	RequestJoin(ctx context.Context, in *RequestJoinRequest, opts ...grpc.CallOption) (*JoinRequestItem, error)
+	ListJoinRequests(ctx context.Context, in *ListJoinRequestsRequest, opts ...grpc.CallOption) (*ListJoinRequestsResponse, error)
+	HandleJoinRequest(ctx context.Context, in *HandleJoinRequestRequest, opts ...grpc.CallOption) (*JoinRequestItem, error)
+	// 邀请链接
+	CreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*InviteItem, error)
+	ListInvites(ctx context.Context, in *ListInvitesRequest, opts ...grpc.CallOption) (*ListInvitesResponse, error)
+	RevokeInvite(ctx context.Context, in *RevokeInviteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PreviewInvite(ctx context.Context, in *PreviewInviteRequest, opts ...grpc.CallOption) (*InvitePreview, error)
+	JoinByInvite(ctx context.Context, in *JoinByInviteRequest, opts ...grpc.CallOption) (*JoinRequestItem, error)
+	// 群公告
+	GetAnnouncement(ctx context.Context, in *GetAnnouncementRequest, opts ...grpc.CallOption) (*Announcement, error)
+	SetAnnouncement(ctx context.Context, in *SetAnnouncementRequest, opts ...grpc.CallOption) (*Announcement, error)
+	DeleteAnnouncement(ctx context.Context, in *DeleteAnnouncementRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 置顶消息
+	ListPinnedMessages(ctx context.Context, in *ListPinnedMessagesRequest, opts ...grpc.CallOption) (*ListPinnedMessagesResponse, error)
+	PinMessage(ctx context.Context, in *PinMessageRequest, opts ...grpc.CallOption) (*PinnedMessageItem, error)
+	UnpinMessage(ctx context.Context, in *UnpinMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 会话文件夹（按用户保存），列表含智能文件夹及各文件夹未读数
+	ListFolders(ctx context.Context, in *ListFoldersRequest, opts ...grpc.CallOption) (*ListFoldersResponse, error)
+	CreateFolder(ctx context.Context, in *CreateFolderRequest, opts ...grpc.CallOption) (*Folder, error)
+	RenameFolder(ctx context.Context, in *RenameFolderRequest, opts ...grpc.CallOption) (*Folder, error)
+	DeleteFolder(ctx context.Context, in *DeleteFolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReorderFolders(ctx context.Context, in *ReorderFoldersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddFolderConversations(ctx context.Context, in *AddFolderConversationsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveFolderConversation(ctx context.Context, in *RemoveFolderConversationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type conversationServiceClient struct {
@@ -68,6 +158,16 @@ func (c *conversationServiceClient) UpdateConversation(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *conversationServiceClient) GetConversation(ctx context.Context, in *GetConversationRequest, opts ...grpc.CallOption) (*ConversationDetail, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConversationDetail)
+	err := c.cc.Invoke(ctx, ConversationService_GetConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *conversationServiceClient) AddMembers(ctx context.Context, in *AddMembersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -75,202 +175,1422 @@ func (c *conversationServiceClient) AddMembers(ctx context.Context, in *AddMembe
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *conversationServiceClient) RemoveMembers(ctx context.Context, in *RemoveMembersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConversationService_RemoveMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) GetMembers(ctx context.Context, in *GetMembersRequest, opts ...grpc.CallOption) (*GetMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMembersResponse)
+	err := c.cc.Invoke(ctx, ConversationService_GetMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) ListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*ListMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMembersResponse)
+	err := c.cc.Invoke(ctx, ConversationService_ListMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) ScrollMembers(ctx context.Context, in *ScrollMembersRequest, opts ...grpc.CallOption) (*ScrollMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScrollMembersResponse)
+	err := c.cc.Invoke(ctx, ConversationService_ScrollMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) GetConversationState(ctx context.Context, in *GetConversationStateRequest, opts ...grpc.CallOption) (*ConversationState, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConversationState)
+	err := c.cc.Invoke(ctx, ConversationService_GetConversationState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) IsMember(ctx context.Context, in *IsMemberRequest, opts ...grpc.CallOption) (*IsMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsMemberResponse)
+	err := c.cc.Invoke(ctx, ConversationService_IsMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) LeaveConversation(ctx context.Context, in *LeaveConversationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConversationService_LeaveConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) DissolveConversation(ctx context.Context, in *DissolveConversationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConversationService_DissolveConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) SetMemberRole(ctx context.Context, in *SetMemberRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConversationService_SetMemberRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConversationService_TransferOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) MuteMember(ctx context.Context, in *MuteMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConversationService_MuteMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) UnmuteMember(ctx context.Context, in *UnmuteMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConversationService_UnmuteMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) SetMuteAll(ctx context.Context, in *SetMuteAllRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConversationService_SetMuteAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) SetMemberNickname(ctx context.Context, in *SetMemberNicknameRequest, opts ...grpc.CallOption) (*MemberItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemberItem)
+	err := c.cc.Invoke(ctx, ConversationService_SetMemberNickname_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) SetMessageTTL(ctx context.Context, in *SetMessageTTLRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConversationService_SetMessageTTL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) ListMyConversations(ctx context.Context, in *ListMyConversationsRequest, opts ...grpc.CallOption) (*ListMyConversationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyConversationsResponse)
+	err := c.cc.Invoke(ctx, ConversationService_ListMyConversations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) ScrollMyConversations(ctx context.Context, in *ScrollMyConversationsRequest, opts ...grpc.CallOption) (*ScrollMyConversationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScrollMyConversationsResponse)
+	err := c.cc.Invoke(ctx, ConversationService_ScrollMyConversations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) GetConversationSetting(ctx context.Context, in *GetConversationSettingRequest, opts ...grpc.CallOption) (*ConversationSetting, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConversationSetting)
+	err := c.cc.Invoke(ctx, ConversationService_GetConversationSetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) UpdateConversationSetting(ctx context.Context, in *UpdateConversationSettingRequest, opts ...grpc.CallOption) (*ConversationSetting, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConversationSetting)
+	err := c.cc.Invoke(ctx, ConversationService_UpdateConversationSetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) RequestJoin(ctx context.Context, in *RequestJoinRequest, opts ...grpc.CallOption) (*JoinRequestItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinRequestItem)
+	err := c.cc.Invoke(ctx, ConversationService_RequestJoin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) ListJoinRequests(ctx context.Context, in *ListJoinRequestsRequest, opts ...grpc.CallOption) (*ListJoinRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJoinRequestsResponse)
+	err := c.cc.Invoke(ctx, ConversationService_ListJoinRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) HandleJoinRequest(ctx context.Context, in *HandleJoinRequestRequest, opts ...grpc.CallOption) (*JoinRequestItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinRequestItem)
+	err := c.cc.Invoke(ctx, ConversationService_HandleJoinRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) CreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*InviteItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteItem)
+	err := c.cc.Invoke(ctx, ConversationService_CreateInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) ListInvites(ctx context.Context, in *ListInvitesRequest, opts ...grpc.CallOption) (*ListInvitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInvitesResponse)
+	err := c.cc.Invoke(ctx, ConversationService_ListInvites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) RevokeInvite(ctx context.Context, in *RevokeInviteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConversationService_RevokeInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) PreviewInvite(ctx context.Context, in *PreviewInviteRequest, opts ...grpc.CallOption) (*InvitePreview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvitePreview)
+	err := c.cc.Invoke(ctx, ConversationService_PreviewInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) JoinByInvite(ctx context.Context, in *JoinByInviteRequest, opts ...grpc.CallOption) (*JoinRequestItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinRequestItem)
+	err := c.cc.Invoke(ctx, ConversationService_JoinByInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) GetAnnouncement(ctx context.Context, in *GetAnnouncementRequest, opts ...grpc.CallOption) (*Announcement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Announcement)
+	err := c.cc.Invoke(ctx, ConversationService_GetAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) SetAnnouncement(ctx context.Context, in *SetAnnouncementRequest, opts ...grpc.CallOption) (*Announcement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Announcement)
+	err := c.cc.Invoke(ctx, ConversationService_SetAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) DeleteAnnouncement(ctx context.Context, in *DeleteAnnouncementRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConversationService_DeleteAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) ListPinnedMessages(ctx context.Context, in *ListPinnedMessagesRequest, opts ...grpc.CallOption) (*ListPinnedMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPinnedMessagesResponse)
+	err := c.cc.Invoke(ctx, ConversationService_ListPinnedMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) PinMessage(ctx context.Context, in *PinMessageRequest, opts ...grpc.CallOption) (*PinnedMessageItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PinnedMessageItem)
+	err := c.cc.Invoke(ctx, ConversationService_PinMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) UnpinMessage(ctx context.Context, in *UnpinMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConversationService_UnpinMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) ListFolders(ctx context.Context, in *ListFoldersRequest, opts ...grpc.CallOption) (*ListFoldersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFoldersResponse)
+	err := c.cc.Invoke(ctx, ConversationService_ListFolders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) CreateFolder(ctx context.Context, in *CreateFolderRequest, opts ...grpc.CallOption) (*Folder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Folder)
+	err := c.cc.Invoke(ctx, ConversationService_CreateFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) RenameFolder(ctx context.Context, in *RenameFolderRequest, opts ...grpc.CallOption) (*Folder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Folder)
+	err := c.cc.Invoke(ctx, ConversationService_RenameFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) DeleteFolder(ctx context.Context, in *DeleteFolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConversationService_DeleteFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) ReorderFolders(ctx context.Context, in *ReorderFoldersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConversationService_ReorderFolders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) AddFolderConversations(ctx context.Context, in *AddFolderConversationsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConversationService_AddFolderConversations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) RemoveFolderConversation(ctx context.Context, in *RemoveFolderConversationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConversationService_RemoveFolderConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConversationServiceServer is the server API for ConversationService service.
+// All implementations must embed UnimplementedConversationServiceServer
+// for forward compatibility.
+type ConversationServiceServer interface {
+	CreateConversation(context.Context, *CreateConversationRequest) (*ConversationBrief, error)
+	UpdateConversation(context.Context, *UpdateConversationRequest) (*ConversationBrief, error)
+	// 会话详情（含群公告、置顶消息和个人设置）
+	GetConversation(context.Context, *GetConversationRequest) (*ConversationDetail, error)
+	AddMembers(context.Context, *AddMembersRequest) (*emptypb.Empty, error)
+	RemoveMembers(context.Context, *RemoveMembersRequest) (*emptypb.Empty, error)
+	GetMembers(context.Context, *GetMembersRequest) (*GetMembersResponse, error)
+	ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error)
+	// 游标分页的成员列表，支持按角色、禁言状态过滤和按名称搜索
+	ScrollMembers(context.Context, *ScrollMembersRequest) (*ScrollMembersResponse, error)
+	// 发送权限校验所需的会话状态（供 message_service 内部调用）
+	GetConversationState(context.Context, *GetConversationStateRequest) (*ConversationState, error)
+	// 单个用户是否为会话成员，含频道订阅者（供 message_service 内部调用）
+	IsMember(context.Context, *IsMemberRequest) (*IsMemberResponse, error)
+	// 群管理
+	LeaveConversation(context.Context, *LeaveConversationRequest) (*emptypb.Empty, error)
+	DissolveConversation(context.Context, *DissolveConversationRequest) (*emptypb.Empty, error)
+	SetMemberRole(context.Context, *SetMemberRoleRequest) (*emptypb.Empty, error)
+	TransferOwnership(context.Context, *TransferOwnershipRequest) (*emptypb.Empty, error)
+	MuteMember(context.Context, *MuteMemberRequest) (*emptypb.Empty, error)
+	UnmuteMember(context.Context, *UnmuteMemberRequest) (*emptypb.Empty, error)
+	SetMuteAll(context.Context, *SetMuteAllRequest) (*emptypb.Empty, error)
+	// 设置自己的群昵称
+	SetMemberNickname(context.Context, *SetMemberNicknameRequest) (*MemberItem, error)
+	// 设置消息自动删除时长（单聊任一成员可设置，群聊和频道仅群主/管理员）
+	SetMessageTTL(context.Context, *SetMessageTTLRequest) (*emptypb.Empty, error)
+	ListMyConversations(context.Context, *ListMyConversationsRequest) (*ListMyConversationsResponse, error)
+	// 游标分页的会话列表
+	ScrollMyConversations(context.Context, *ScrollMyConversationsRequest) (*ScrollMyConversationsResponse, error)
+	// 个人会话设置（置顶、免打扰、归档、备注）
+	GetConversationSetting(context.Context, *GetConversationSettingRequest) (*ConversationSetting, error)
+	UpdateConversationSetting(context.Context, *UpdateConversationSettingRequest) (*ConversationSetting, error)
+	// 入群申请
+	RequestJoin(context.Context, *RequestJoinRequest) (*JoinRequestItem, error)
+	ListJoinRequests(context.Context, *ListJoinRequestsRequest) (*ListJoinRequestsResponse, error)
+	HandleJoinRequest(context.Context, *HandleJoinRequestRequest) (*JoinRequestItem, error)
+	// 邀请链接
+	CreateInvite(context.Context, *CreateInviteRequest) (*InviteItem, error)
+	ListInvites(context.Context, *ListInvitesRequest) (*ListInvitesResponse, error)
+	RevokeInvite(context.Context, *RevokeInviteRequest) (*emptypb.Empty, error)
+	PreviewInvite(context.Context, *PreviewInviteRequest) (*InvitePreview, error)
+	JoinByInvite(context.Context, *JoinByInviteRequest) (*JoinRequestItem, error)
+	// 群公告
+	GetAnnouncement(context.Context, *GetAnnouncementRequest) (*Announcement, error)
+	SetAnnouncement(context.Context, *SetAnnouncementRequest) (*Announcement, error)
+	DeleteAnnouncement(context.Context, *DeleteAnnouncementRequest) (*emptypb.Empty, error)
+	// 置顶消息
+	ListPinnedMessages(context.Context, *ListPinnedMessagesRequest) (*ListPinnedMessagesResponse, error)
+	PinMessage(context.Context, *PinMessageRequest) (*PinnedMessageItem, error)
+	UnpinMessage(context.Context, *UnpinMessageRequest) (*emptypb.Empty, error)
+	// 会话文件夹（按用户保存），列表含智能文件夹及各文件夹未读数
+	ListFolders(context.Context, *ListFoldersRequest) (*ListFoldersResponse, error)
+	CreateFolder(context.Context, *CreateFolderRequest) (*Folder, error)
+	RenameFolder(context.Context, *RenameFolderRequest) (*Folder, error)
+	DeleteFolder(context.Context, *DeleteFolderRequest) (*emptypb.Empty, error)
+	ReorderFolders(context.Context, *ReorderFoldersRequest) (*emptypb.Empty, error)
+	AddFolderConversations(context.Context, *AddFolderConversationsRequest) (*emptypb.Empty, error)
+	RemoveFolderConversation(context.Context, *RemoveFolderConversationRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedConversationServiceServer()
+}
+
+// UnimplementedConversationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedConversationServiceServer struct{}
+
+func (UnimplementedConversationServiceServer) CreateConversation(context.Context, *CreateConversationRequest) (*ConversationBrief, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateConversation not implemented")
+}
+func (UnimplementedConversationServiceServer) UpdateConversation(context.Context, *UpdateConversationRequest) (*ConversationBrief, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateConversation not implemented")
+}
+func (UnimplementedConversationServiceServer) GetConversation(context.Context, *GetConversationRequest) (*ConversationDetail, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetConversation not implemented")
+}
+func (UnimplementedConversationServiceServer) AddMembers(context.Context, *AddMembersRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddMembers not implemented")
+}
+func (UnimplementedConversationServiceServer) RemoveMembers(context.Context, *RemoveMembersRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveMembers not implemented")
+}
+func (UnimplementedConversationServiceServer) GetMembers(context.Context, *GetMembersRequest) (*GetMembersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMembers not implemented")
+}
+func (UnimplementedConversationServiceServer) ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMembers not implemented")
+}
+func (UnimplementedConversationServiceServer) ScrollMembers(context.Context, *ScrollMembersRequest) (*ScrollMembersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ScrollMembers not implemented")
+}
+func (UnimplementedConversationServiceServer) GetConversationState(context.Context, *GetConversationStateRequest) (*ConversationState, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetConversationState not implemented")
+}
+func (UnimplementedConversationServiceServer) IsMember(context.Context, *IsMemberRequest) (*IsMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method IsMember not implemented")
+}
+func (UnimplementedConversationServiceServer) LeaveConversation(context.Context, *LeaveConversationRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method LeaveConversation not implemented")
+}
+func (UnimplementedConversationServiceServer) DissolveConversation(context.Context, *DissolveConversationRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DissolveConversation not implemented")
+}
+func (UnimplementedConversationServiceServer) SetMemberRole(context.Context, *SetMemberRoleRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMemberRole not implemented")
+}
+func (UnimplementedConversationServiceServer) TransferOwnership(context.Context, *TransferOwnershipRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method TransferOwnership not implemented")
+}
+func (UnimplementedConversationServiceServer) MuteMember(context.Context, *MuteMemberRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method MuteMember not implemented")
+}
+func (UnimplementedConversationServiceServer) UnmuteMember(context.Context, *UnmuteMemberRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnmuteMember not implemented")
+}
+func (UnimplementedConversationServiceServer) SetMuteAll(context.Context, *SetMuteAllRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMuteAll not implemented")
+}
+func (UnimplementedConversationServiceServer) SetMemberNickname(context.Context, *SetMemberNicknameRequest) (*MemberItem, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMemberNickname not implemented")
+}
+func (UnimplementedConversationServiceServer) SetMessageTTL(context.Context, *SetMessageTTLRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMessageTTL not implemented")
+}
+func (UnimplementedConversationServiceServer) ListMyConversations(context.Context, *ListMyConversationsRequest) (*ListMyConversationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMyConversations not implemented")
+}
+func (UnimplementedConversationServiceServer) ScrollMyConversations(context.Context, *ScrollMyConversationsRequest) (*ScrollMyConversationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ScrollMyConversations not implemented")
+}
+func (UnimplementedConversationServiceServer) GetConversationSetting(context.Context, *GetConversationSettingRequest) (*ConversationSetting, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetConversationSetting not implemented")
+}
+func (UnimplementedConversationServiceServer) UpdateConversationSetting(context.Context, *UpdateConversationSettingRequest) (*ConversationSetting, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateConversationSetting not implemented")
+}
+func (UnimplementedConversationServiceServer) RequestJoin(context.Context, *RequestJoinRequest) (*JoinRequestItem, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestJoin not implemented")
+}
+func (UnimplementedConversationServiceServer) ListJoinRequests(context.Context, *ListJoinRequestsRequest) (*ListJoinRequestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListJoinRequests not implemented")
+}
+func (UnimplementedConversationServiceServer) HandleJoinRequest(context.Context, *HandleJoinRequestRequest) (*JoinRequestItem, error) {
+	return nil, status.Error(codes.Unimplemented, "method HandleJoinRequest not implemented")
+}
+func (UnimplementedConversationServiceServer) CreateInvite(context.Context, *CreateInviteRequest) (*InviteItem, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateInvite not implemented")
+}
+func (UnimplementedConversationServiceServer) ListInvites(context.Context, *ListInvitesRequest) (*ListInvitesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListInvites not implemented")
+}
+func (UnimplementedConversationServiceServer) RevokeInvite(context.Context, *RevokeInviteRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeInvite not implemented")
+}
+func (UnimplementedConversationServiceServer) PreviewInvite(context.Context, *PreviewInviteRequest) (*InvitePreview, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewInvite not implemented")
+}
+func (UnimplementedConversationServiceServer) JoinByInvite(context.Context, *JoinByInviteRequest) (*JoinRequestItem, error) {
+	return nil, status.Error(codes.Unimplemented, "method JoinByInvite not implemented")
+}
+func (UnimplementedConversationServiceServer) GetAnnouncement(context.Context, *GetAnnouncementRequest) (*Announcement, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAnnouncement not implemented")
+}
+func (UnimplementedConversationServiceServer) SetAnnouncement(context.Context, *SetAnnouncementRequest) (*Announcement, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetAnnouncement not implemented")
+}
+func (UnimplementedConversationServiceServer) DeleteAnnouncement(context.Context, *DeleteAnnouncementRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAnnouncement not implemented")
+}
+func (UnimplementedConversationServiceServer) ListPinnedMessages(context.Context, *ListPinnedMessagesRequest) (*ListPinnedMessagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPinnedMessages not implemented")
+}
+func (UnimplementedConversationServiceServer) PinMessage(context.Context, *PinMessageRequest) (*PinnedMessageItem, error) {
+	return nil, status.Error(codes.Unimplemented, "method PinMessage not implemented")
+}
+func (UnimplementedConversationServiceServer) UnpinMessage(context.Context, *UnpinMessageRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnpinMessage not implemented")
+}
+func (UnimplementedConversationServiceServer) ListFolders(context.Context, *ListFoldersRequest) (*ListFoldersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFolders not implemented")
+}
+func (UnimplementedConversationServiceServer) CreateFolder(context.Context, *CreateFolderRequest) (*Folder, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateFolder not implemented")
+}
+func (UnimplementedConversationServiceServer) RenameFolder(context.Context, *RenameFolderRequest) (*Folder, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenameFolder not implemented")
+}
+func (UnimplementedConversationServiceServer) DeleteFolder(context.Context, *DeleteFolderRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteFolder not implemented")
+}
+func (UnimplementedConversationServiceServer) ReorderFolders(context.Context, *ReorderFoldersRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReorderFolders not implemented")
+}
+func (UnimplementedConversationServiceServer) AddFolderConversations(context.Context, *AddFolderConversationsRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddFolderConversations not implemented")
+}
+func (UnimplementedConversationServiceServer) RemoveFolderConversation(context.Context, *RemoveFolderConversationRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveFolderConversation not implemented")
+}
+func (UnimplementedConversationServiceServer) mustEmbedUnimplementedConversationServiceServer() {}
+func (UnimplementedConversationServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeConversationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConversationServiceServer will
+// result in compilation errors.
+type UnsafeConversationServiceServer interface {
+	mustEmbedUnimplementedConversationServiceServer()
+}
+
+func RegisterConversationServiceServer(s grpc.ServiceRegistrar, srv ConversationServiceServer) {
+	// If the following call panics, it indicates UnimplementedConversationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ConversationService_ServiceDesc, srv)
+}
+
+func _ConversationService_CreateConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).CreateConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_CreateConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).CreateConversation(ctx, req.(*CreateConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_UpdateConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).UpdateConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_UpdateConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).UpdateConversation(ctx, req.(*UpdateConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_GetConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).GetConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_GetConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).GetConversation(ctx, req.(*GetConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_AddMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).AddMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_AddMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).AddMembers(ctx, req.(*AddMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_RemoveMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).RemoveMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_RemoveMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).RemoveMembers(ctx, req.(*RemoveMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_GetMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).GetMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_GetMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).GetMembers(ctx, req.(*GetMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_ListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).ListMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_ListMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).ListMembers(ctx, req.(*ListMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_ScrollMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScrollMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).ScrollMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_ScrollMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).ScrollMembers(ctx, req.(*ScrollMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_GetConversationState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).GetConversationState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_GetConversationState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).GetConversationState(ctx, req.(*GetConversationStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_IsMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).IsMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_IsMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).IsMember(ctx, req.(*IsMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_LeaveConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).LeaveConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_LeaveConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).LeaveConversation(ctx, req.(*LeaveConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_DissolveConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DissolveConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).DissolveConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_DissolveConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).DissolveConversation(ctx, req.(*DissolveConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_SetMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).SetMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_SetMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).SetMemberRole(ctx, req.(*SetMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).TransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_TransferOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).TransferOwnership(ctx, req.(*TransferOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_MuteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).MuteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_MuteMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).MuteMember(ctx, req.(*MuteMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_UnmuteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmuteMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).UnmuteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_UnmuteMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).UnmuteMember(ctx, req.(*UnmuteMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_SetMuteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMuteAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).SetMuteAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_SetMuteAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).SetMuteAll(ctx, req.(*SetMuteAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_SetMemberNickname_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMemberNicknameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).SetMemberNickname(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_SetMemberNickname_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).SetMemberNickname(ctx, req.(*SetMemberNicknameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_SetMessageTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMessageTTLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).SetMessageTTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_SetMessageTTL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).SetMessageTTL(ctx, req.(*SetMessageTTLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_ListMyConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyConversationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).ListMyConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_ListMyConversations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).ListMyConversations(ctx, req.(*ListMyConversationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_ScrollMyConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScrollMyConversationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).ScrollMyConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_ScrollMyConversations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).ScrollMyConversations(ctx, req.(*ScrollMyConversationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_GetConversationSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).GetConversationSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_GetConversationSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).GetConversationSetting(ctx, req.(*GetConversationSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_UpdateConversationSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConversationSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).UpdateConversationSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_UpdateConversationSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).UpdateConversationSetting(ctx, req.(*UpdateConversationSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_RequestJoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestJoinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).RequestJoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_RequestJoin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).RequestJoin(ctx, req.(*RequestJoinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_ListJoinRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJoinRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).ListJoinRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_ListJoinRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).ListJoinRequests(ctx, req.(*ListJoinRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_HandleJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandleJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).HandleJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_HandleJoinRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).HandleJoinRequest(ctx, req.(*HandleJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_CreateInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).CreateInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_CreateInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).CreateInvite(ctx, req.(*CreateInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_ListInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).ListInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_ListInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).ListInvites(ctx, req.(*ListInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_RevokeInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).RevokeInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_RevokeInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).RevokeInvite(ctx, req.(*RevokeInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_PreviewInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).PreviewInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_PreviewInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).PreviewInvite(ctx, req.(*PreviewInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_JoinByInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinByInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).JoinByInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_JoinByInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).JoinByInvite(ctx, req.(*JoinByInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *conversationServiceClient) RemoveMembers(ctx context.Context, in *RemoveMembersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, ConversationService_RemoveMembers_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ConversationService_GetAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnnouncementRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).GetAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_GetAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).GetAnnouncement(ctx, req.(*GetAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *conversationServiceClient) GetMembers(ctx context.Context, in *GetMembersRequest, opts ...grpc.CallOption) (*GetMembersResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetMembersResponse)
-	err := c.cc.Invoke(ctx, ConversationService_GetMembers_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ConversationService_SetAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAnnouncementRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).SetAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_SetAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).SetAnnouncement(ctx, req.(*SetAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *conversationServiceClient) ListMyConversations(ctx context.Context, in *ListMyConversationsRequest, opts ...grpc.CallOption) (*ListMyConversationsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListMyConversationsResponse)
-	err := c.cc.Invoke(ctx, ConversationService_ListMyConversations_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ConversationService_DeleteAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAnnouncementRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).DeleteAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_DeleteAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).DeleteAnnouncement(ctx, req.(*DeleteAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// ConversationServiceServer is the server API for ConversationService service.
-// All implementations must embed UnimplementedConversationServiceServer
-// for forward compatibility.
-type ConversationServiceServer interface {
-	CreateConversation(context.Context, *CreateConversationRequest) (*ConversationBrief, error)
-	UpdateConversation(context.Context, *UpdateConversationRequest) (*ConversationBrief, error)
-	AddMembers(context.Context, *AddMembersRequest) (*emptypb.Empty, error)
-	RemoveMembers(context.Context, *RemoveMembersRequest) (*emptypb.Empty, error)
-	GetMembers(context.Context, *GetMembersRequest) (*GetMembersResponse, error)
-	ListMyConversations(context.Context, *ListMyConversationsRequest) (*ListMyConversationsResponse, error)
-	mustEmbedUnimplementedConversationServiceServer()
+func _ConversationService_ListPinnedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPinnedMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).ListPinnedMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_ListPinnedMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).ListPinnedMessages(ctx, req.(*ListPinnedMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedConversationServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedConversationServiceServer struct{}
-
-func (UnimplementedConversationServiceServer) CreateConversation(context.Context, *CreateConversationRequest) (*ConversationBrief, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateConversation not implemented")
-}
-func (UnimplementedConversationServiceServer) UpdateConversation(context.Context, *UpdateConversationRequest) (*ConversationBrief, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateConversation not implemented")
-}
-func (UnimplementedConversationServiceServer) AddMembers(context.Context, *AddMembersRequest) (*emptypb.Empty, error) {
-	return nil, status.Error(codes.Unimplemented, "method AddMembers not implemented")
-}
-func (UnimplementedConversationServiceServer) RemoveMembers(context.Context, *RemoveMembersRequest) (*emptypb.Empty, error) {
-	return nil, status.Error(codes.Unimplemented, "method RemoveMembers not implemented")
-}
-func (UnimplementedConversationServiceServer) GetMembers(context.Context, *GetMembersRequest) (*GetMembersResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetMembers not implemented")
-}
-func (UnimplementedConversationServiceServer) ListMyConversations(context.Context, *ListMyConversationsRequest) (*ListMyConversationsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListMyConversations not implemented")
+func _ConversationService_PinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).PinMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_PinMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).PinMessage(ctx, req.(*PinMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConversationServiceServer) mustEmbedUnimplementedConversationServiceServer() {}
-func (UnimplementedConversationServiceServer) testEmbeddedByValue()                             {}
 
-// UnsafeConversationServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ConversationServiceServer will
-// result in compilation errors.
-type UnsafeConversationServiceServer interface {
-	mustEmbedUnimplementedConversationServiceServer()
+func _ConversationService_UnpinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).UnpinMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_UnpinMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).UnpinMessage(ctx, req.(*UnpinMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterConversationServiceServer(s grpc.ServiceRegistrar, srv ConversationServiceServer) {
-	// If the following call panics, it indicates UnimplementedConversationServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _ConversationService_ListFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ConversationService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).ListFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_ListFolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).ListFolders(ctx, req.(*ListFoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ConversationService_CreateConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateConversationRequest)
+func _ConversationService_CreateFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFolderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConversationServiceServer).CreateConversation(ctx, in)
+		return srv.(ConversationServiceServer).CreateFolder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ConversationService_CreateConversation_FullMethodName,
+		FullMethod: ConversationService_CreateFolder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConversationServiceServer).CreateConversation(ctx, req.(*CreateConversationRequest))
+		return srv.(ConversationServiceServer).CreateFolder(ctx, req.(*CreateFolderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ConversationService_UpdateConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateConversationRequest)
+func _ConversationService_RenameFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameFolderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConversationServiceServer).UpdateConversation(ctx, in)
+		return srv.(ConversationServiceServer).RenameFolder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ConversationService_UpdateConversation_FullMethodName,
+		FullMethod: ConversationService_RenameFolder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConversationServiceServer).UpdateConversation(ctx, req.(*UpdateConversationRequest))
+		return srv.(ConversationServiceServer).RenameFolder(ctx, req.(*RenameFolderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ConversationService_AddMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddMembersRequest)
+func _ConversationService_DeleteFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFolderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConversationServiceServer).AddMembers(ctx, in)
+		return srv.(ConversationServiceServer).DeleteFolder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ConversationService_AddMembers_FullMethodName,
+		FullMethod: ConversationService_DeleteFolder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConversationServiceServer).AddMembers(ctx, req.(*AddMembersRequest))
+		return srv.(ConversationServiceServer).DeleteFolder(ctx, req.(*DeleteFolderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ConversationService_RemoveMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveMembersRequest)
+func _ConversationService_ReorderFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderFoldersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConversationServiceServer).RemoveMembers(ctx, in)
+		return srv.(ConversationServiceServer).ReorderFolders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ConversationService_RemoveMembers_FullMethodName,
+		FullMethod: ConversationService_ReorderFolders_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConversationServiceServer).RemoveMembers(ctx, req.(*RemoveMembersRequest))
+		return srv.(ConversationServiceServer).ReorderFolders(ctx, req.(*ReorderFoldersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ConversationService_GetMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMembersRequest)
+func _ConversationService_AddFolderConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFolderConversationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConversationServiceServer).GetMembers(ctx, in)
+		return srv.(ConversationServiceServer).AddFolderConversations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ConversationService_GetMembers_FullMethodName,
+		FullMethod: ConversationService_AddFolderConversations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConversationServiceServer).GetMembers(ctx, req.(*GetMembersRequest))
+		return srv.(ConversationServiceServer).AddFolderConversations(ctx, req.(*AddFolderConversationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ConversationService_ListMyConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMyConversationsRequest)
+func _ConversationService_RemoveFolderConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFolderConversationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConversationServiceServer).ListMyConversations(ctx, in)
+		return srv.(ConversationServiceServer).RemoveFolderConversation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ConversationService_ListMyConversations_FullMethodName,
+		FullMethod: ConversationService_RemoveFolderConversation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConversationServiceServer).ListMyConversations(ctx, req.(*ListMyConversationsRequest))
+		return srv.(ConversationServiceServer).RemoveFolderConversation(ctx, req.(*RemoveFolderConversationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -290,6 +1610,10 @@ var ConversationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateConversation",
 			Handler:    _ConversationService_UpdateConversation_Handler,
 		},
+		{
+			MethodName: "GetConversation",
+			Handler:    _ConversationService_GetConversation_Handler,
+		},
 		{
 			MethodName: "AddMembers",
 			Handler:    _ConversationService_AddMembers_Handler,
@@ -302,10 +1626,158 @@ var ConversationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMembers",
 			Handler:    _ConversationService_GetMembers_Handler,
 		},
+		{
+			MethodName: "ListMembers",
+			Handler:    _ConversationService_ListMembers_Handler,
+		},
+		{
+			MethodName: "ScrollMembers",
+			Handler:    _ConversationService_ScrollMembers_Handler,
+		},
+		{
+			MethodName: "GetConversationState",
+			Handler:    _ConversationService_GetConversationState_Handler,
+		},
+		{
+			MethodName: "IsMember",
+			Handler:    _ConversationService_IsMember_Handler,
+		},
+		{
+			MethodName: "LeaveConversation",
+			Handler:    _ConversationService_LeaveConversation_Handler,
+		},
+		{
+			MethodName: "DissolveConversation",
+			Handler:    _ConversationService_DissolveConversation_Handler,
+		},
+		{
+			MethodName: "SetMemberRole",
+			Handler:    _ConversationService_SetMemberRole_Handler,
+		},
+		{
+			MethodName: "TransferOwnership",
+			Handler:    _ConversationService_TransferOwnership_Handler,
+		},
+		{
+			MethodName: "MuteMember",
+			Handler:    _ConversationService_MuteMember_Handler,
+		},
+		{
+			MethodName: "UnmuteMember",
+			Handler:    _ConversationService_UnmuteMember_Handler,
+		},
+		{
+			MethodName: "SetMuteAll",
+			Handler:    _ConversationService_SetMuteAll_Handler,
+		},
+		{
+			MethodName: "SetMemberNickname",
+			Handler:    _ConversationService_SetMemberNickname_Handler,
+		},
+		{
+			MethodName: "SetMessageTTL",
+			Handler:    _ConversationService_SetMessageTTL_Handler,
+		},
 		{
 			MethodName: "ListMyConversations",
 			Handler:    _ConversationService_ListMyConversations_Handler,
 		},
+		{
+			MethodName: "ScrollMyConversations",
+			Handler:    _ConversationService_ScrollMyConversations_Handler,
+		},
+		{
+			MethodName: "GetConversationSetting",
+			Handler:    _ConversationService_GetConversationSetting_Handler,
+		},
+		{
+			MethodName: "UpdateConversationSetting",
+			Handler:    _ConversationService_UpdateConversationSetting_Handler,
+		},
+		{
+			MethodName: "RequestJoin",
+			Handler:    _ConversationService_RequestJoin_Handler,
+		},
+		{
+			MethodName: "ListJoinRequests",
+			Handler:    _ConversationService_ListJoinRequests_Handler,
+		},
+		{
+			MethodName: "HandleJoinRequest",
+			Handler:    _ConversationService_HandleJoinRequest_Handler,
+		},
+		{
+			MethodName: "CreateInvite",
+			Handler:    _ConversationService_CreateInvite_Handler,
+		},
+		{
+			MethodName: "ListInvites",
+			Handler:    _ConversationService_ListInvites_Handler,
+		},
+		{
+			MethodName: "RevokeInvite",
+			Handler:    _ConversationService_RevokeInvite_Handler,
+		},
+		{
+			MethodName: "PreviewInvite",
+			Handler:    _ConversationService_PreviewInvite_Handler,
+		},
+		{
+			MethodName: "JoinByInvite",
+			Handler:    _ConversationService_JoinByInvite_Handler,
+		},
+		{
+			MethodName: "GetAnnouncement",
+			Handler:    _ConversationService_GetAnnouncement_Handler,
+		},
+		{
+			MethodName: "SetAnnouncement",
+			Handler:    _ConversationService_SetAnnouncement_Handler,
+		},
+		{
+			MethodName: "DeleteAnnouncement",
+			Handler:    _ConversationService_DeleteAnnouncement_Handler,
+		},
+		{
+			MethodName: "ListPinnedMessages",
+			Handler:    _ConversationService_ListPinnedMessages_Handler,
+		},
+		{
+			MethodName: "PinMessage",
+			Handler:    _ConversationService_PinMessage_Handler,
+		},
+		{
+			MethodName: "UnpinMessage",
+			Handler:    _ConversationService_UnpinMessage_Handler,
+		},
+		{
+			MethodName: "ListFolders",
+			Handler:    _ConversationService_ListFolders_Handler,
+		},
+		{
+			MethodName: "CreateFolder",
+			Handler:    _ConversationService_CreateFolder_Handler,
+		},
+		{
+			MethodName: "RenameFolder",
+			Handler:    _ConversationService_RenameFolder_Handler,
+		},
+		{
+			MethodName: "DeleteFolder",
+			Handler:    _ConversationService_DeleteFolder_Handler,
+		},
+		{
+			MethodName: "ReorderFolders",
+			Handler:    _ConversationService_ReorderFolders_Handler,
+		},
+		{
+			MethodName: "AddFolderConversations",
+			Handler:    _ConversationService_AddFolderConversations_Handler,
+		},
+		{
+			MethodName: "RemoveFolderConversation",
+			Handler:    _ConversationService_RemoveFolderConversation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "im/v1/conversation.proto",
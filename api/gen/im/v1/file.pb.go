@@ -9,6 +9,7 @@ package imv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -253,11 +254,63 @@ func (x *CompleteUploadResponse) GetMessage() *MessageItem {
 	return nil
 }
 
+type DeleteObjectsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ObjectKeys    []string               `protobuf:"bytes,1,rep,name=object_keys,json=objectKeys,proto3" json:"object_keys,omitempty"`
+	OwnerId       int64                  `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"` // 上传者（消息发送者），必填
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteObjectsRequest) Reset() {
+	*x = DeleteObjectsRequest{}
+	mi := &file_im_v1_file_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteObjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteObjectsRequest) ProtoMessage() {}
+
+func (x *DeleteObjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_file_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteObjectsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteObjectsRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_file_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteObjectsRequest) GetObjectKeys() []string {
+	if x != nil {
+		return x.ObjectKeys
+	}
+	return nil
+}
+
+func (x *DeleteObjectsRequest) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
 var File_im_v1_file_proto protoreflect.FileDescriptor
 
 const file_im_v1_file_proto_rawDesc = "" +
 	"\n" +
-	"\x10im/v1/file.proto\x12\x05im.v1\x1a\x12im/v1/common.proto\"\x87\x01\n" +
+	"\x10im/v1/file.proto\x12\x05im.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x12im/v1/common.proto\"\x87\x01\n" +
 	"\x13CreateUploadRequest\x12\x1a\n" +
 	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
 	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1d\n" +
@@ -275,10 +328,15 @@ const file_im_v1_file_proto_rawDesc = "" +
 	"\rclient_msg_id\x18\x02 \x01(\tR\vclientMsgId\x12%\n" +
 	"\x05media\x18\x03 \x01(\v2\x0f.im.v1.MediaRefR\x05media\"F\n" +
 	"\x16CompleteUploadResponse\x12,\n" +
-	"\amessage\x18\x01 \x01(\v2\x12.im.v1.MessageItemR\amessage2\xa5\x01\n" +
+	"\amessage\x18\x01 \x01(\v2\x12.im.v1.MessageItemR\amessage\"R\n" +
+	"\x14DeleteObjectsRequest\x12\x1f\n" +
+	"\vobject_keys\x18\x01 \x03(\tR\n" +
+	"objectKeys\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\x03R\aownerId2\xeb\x01\n" +
 	"\vFileService\x12G\n" +
 	"\fCreateUpload\x12\x1a.im.v1.CreateUploadRequest\x1a\x1b.im.v1.CreateUploadResponse\x12M\n" +
-	"\x0eCompleteUpload\x12\x1c.im.v1.CompleteUploadRequest\x1a\x1d.im.v1.CompleteUploadResponseB*Z(github.com/EthanQC/IM/api/gen/im/v1;imv1b\x06proto3"
+	"\x0eCompleteUpload\x12\x1c.im.v1.CompleteUploadRequest\x1a\x1d.im.v1.CompleteUploadResponse\x12D\n" +
+	"\rDeleteObjects\x12\x1b.im.v1.DeleteObjectsRequest\x1a\x16.google.protobuf.EmptyB*Z(github.com/EthanQC/IM/api/gen/im/v1;imv1b\x06proto3"
 
 var (
 	file_im_v1_file_proto_rawDescOnce sync.Once
@@ -292,24 +350,28 @@ func file_im_v1_file_proto_rawDescGZIP() []byte {
 	return file_im_v1_file_proto_rawDescData
 }
 
-var file_im_v1_file_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_im_v1_file_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_im_v1_file_proto_goTypes = []any{
 	(*CreateUploadRequest)(nil),    // 0: im.v1.CreateUploadRequest
 	(*CreateUploadResponse)(nil),   // 1: im.v1.CreateUploadResponse
 	(*CompleteUploadRequest)(nil),  // 2: im.v1.CompleteUploadRequest
 	(*CompleteUploadResponse)(nil), // 3: im.v1.CompleteUploadResponse
-	(*MediaRef)(nil),               // 4: im.v1.MediaRef
-	(*MessageItem)(nil),            // 5: im.v1.MessageItem
+	(*DeleteObjectsRequest)(nil),   // 4: im.v1.DeleteObjectsRequest
+	(*MediaRef)(nil),               // 5: im.v1.MediaRef
+	(*MessageItem)(nil),            // 6: im.v1.MessageItem
+	(*emptypb.Empty)(nil),          // 7: google.protobuf.Empty
 }
 var file_im_v1_file_proto_depIdxs = []int32{
-	4, // 0: im.v1.CompleteUploadRequest.media:type_name -> im.v1.MediaRef
-	5, // 1: im.v1.CompleteUploadResponse.message:type_name -> im.v1.MessageItem
+	5, // 0: im.v1.CompleteUploadRequest.media:type_name -> im.v1.MediaRef
+	6, // 1: im.v1.CompleteUploadResponse.message:type_name -> im.v1.MessageItem
 	0, // 2: im.v1.FileService.CreateUpload:input_type -> im.v1.CreateUploadRequest
 	2, // 3: im.v1.FileService.CompleteUpload:input_type -> im.v1.CompleteUploadRequest
-	1, // 4: im.v1.FileService.CreateUpload:output_type -> im.v1.CreateUploadResponse
-	3, // 5: im.v1.FileService.CompleteUpload:output_type -> im.v1.CompleteUploadResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
+	4, // 4: im.v1.FileService.DeleteObjects:input_type -> im.v1.DeleteObjectsRequest
+	1, // 5: im.v1.FileService.CreateUpload:output_type -> im.v1.CreateUploadResponse
+	3, // 6: im.v1.FileService.CompleteUpload:output_type -> im.v1.CompleteUploadResponse
+	7, // 7: im.v1.FileService.DeleteObjects:output_type -> google.protobuf.Empty
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
 	2, // [2:2] is the sub-list for extension type_name
 	2, // [2:2] is the sub-list for extension extendee
 	0, // [0:2] is the sub-list for field type_name
@@ -327,7 +389,7 @@ func file_im_v1_file_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_im_v1_file_proto_rawDesc), len(file_im_v1_file_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -21,6 +22,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	FileService_CreateUpload_FullMethodName   = "/im.v1.FileService/CreateUpload"
 	FileService_CompleteUpload_FullMethodName = "/im.v1.FileService/CompleteUpload"
+	FileService_DeleteObjects_FullMethodName  = "/im.v1.FileService/DeleteObjects"
 )
 
 // FileServiceClient is the client API for FileService service.
@@ -31,6 +33,9 @@ type FileServiceClient interface {
 	CreateUpload(ctx context.Context, in *CreateUploadRequest, opts ...grpc.CallOption) (*CreateUploadResponse, error)
 	// MinIO/S3 回调或客户端确认完成，转成一条文件消息由 message 服务分发
 	CompleteUpload(ctx context.Context, in *CompleteUploadRequest, opts ...grpc.CallOption) (*CompleteUploadResponse, error)
+	// 删除消息引用的文件对象（内部接口，供 message 服务清理过期消息）
+	// 只删除上传者为 owner_id 的对象，无上传记录或属于他人的对象忽略
+	DeleteObjects(ctx context.Context, in *DeleteObjectsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type fileServiceClient struct {
@@ -61,6 +66,16 @@ func (c *fileServiceClient) CompleteUpload(ctx context.Context, in *CompleteUplo
 	return out, nil
 }
 
+func (c *fileServiceClient) DeleteObjects(ctx context.Context, in *DeleteObjectsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FileService_DeleteObjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FileServiceServer is the server API for FileService service.
 // All implementations must embed UnimplementedFileServiceServer
 // for forward compatibility.
@@ -69,6 +84,9 @@ type FileServiceServer interface {
 	CreateUpload(context.Context, *CreateUploadRequest) (*CreateUploadResponse, error)
 	// MinIO/S3 回调或客户端确认完成，转成一条文件消息由 message 服务分发
 	CompleteUpload(context.Context, *CompleteUploadRequest) (*CompleteUploadResponse, error)
+	// 删除消息引用的文件对象（内部接口，供 message 服务清理过期消息）
+	// 只删除上传者为 owner_id 的对象，无上传记录或属于他人的对象忽略
+	DeleteObjects(context.Context, *DeleteObjectsRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedFileServiceServer()
 }
 
@@ -85,6 +103,9 @@ func (UnimplementedFileServiceServer) CreateUpload(context.Context, *CreateUploa
 func (UnimplementedFileServiceServer) CompleteUpload(context.Context, *CompleteUploadRequest) (*CompleteUploadResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CompleteUpload not implemented")
 }
+func (UnimplementedFileServiceServer) DeleteObjects(context.Context, *DeleteObjectsRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteObjects not implemented")
+}
 func (UnimplementedFileServiceServer) mustEmbedUnimplementedFileServiceServer() {}
 func (UnimplementedFileServiceServer) testEmbeddedByValue()                     {}
 
@@ -142,6 +163,24 @@ func _FileService_CompleteUpload_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FileService_DeleteObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServiceServer).DeleteObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileService_DeleteObjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServiceServer).DeleteObjects(ctx, req.(*DeleteObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FileService_ServiceDesc is the grpc.ServiceDesc for FileService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -157,6 +196,10 @@ var FileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CompleteUpload",
 			Handler:    _FileService_CompleteUpload_Handler,
 		},
+		{
+			MethodName: "DeleteObjects",
+			Handler:    _FileService_DeleteObjects_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "im/v1/file.proto",
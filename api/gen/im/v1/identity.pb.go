@@ -22,18 +22,88 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 登录设备信息，ip / user_agent 由网关填写
+type DeviceInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+	Ip            string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceInfo) Reset() {
+	*x = DeviceInfo{}
+	mi := &file_im_v1_identity_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceInfo) ProtoMessage() {}
+
+func (x *DeviceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceInfo.ProtoReflect.Descriptor instead.
+func (*DeviceInfo) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeviceInfo) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *DeviceInfo) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *DeviceInfo) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *DeviceInfo) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
 type RegisterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Device        *DeviceInfo            `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RegisterRequest) Reset() {
 	*x = RegisterRequest{}
-	mi := &file_im_v1_identity_proto_msgTypes[0]
+	mi := &file_im_v1_identity_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -45,7 +115,7 @@ func (x *RegisterRequest) String() string {
 func (*RegisterRequest) ProtoMessage() {}
 
 func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_im_v1_identity_proto_msgTypes[0]
+	mi := &file_im_v1_identity_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -58,7 +128,7 @@ func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
 func (*RegisterRequest) Descriptor() ([]byte, []int) {
-	return file_im_v1_identity_proto_rawDescGZIP(), []int{0}
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *RegisterRequest) GetUsername() string {
@@ -82,17 +152,27 @@ func (x *RegisterRequest) GetDisplayName() string {
 	return ""
 }
 
+func (x *RegisterRequest) GetDevice() *DeviceInfo {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+// captcha_token 连续登录失败后需携带人机验证凭证（Login 返回 FailedPrecondition 时）
 type LoginRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Device        *DeviceInfo            `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
+	CaptchaToken  string                 `protobuf:"bytes,4,opt,name=captcha_token,json=captchaToken,proto3" json:"captcha_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LoginRequest) Reset() {
 	*x = LoginRequest{}
-	mi := &file_im_v1_identity_proto_msgTypes[1]
+	mi := &file_im_v1_identity_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -104,7 +184,7 @@ func (x *LoginRequest) String() string {
 func (*LoginRequest) ProtoMessage() {}
 
 func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_im_v1_identity_proto_msgTypes[1]
+	mi := &file_im_v1_identity_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -117,7 +197,7 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
 func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_im_v1_identity_proto_rawDescGZIP(), []int{1}
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *LoginRequest) GetUsername() string {
@@ -134,16 +214,31 @@ func (x *LoginRequest) GetPassword() string {
 	return ""
 }
 
+func (x *LoginRequest) GetDevice() *DeviceInfo {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+func (x *LoginRequest) GetCaptchaToken() string {
+	if x != nil {
+		return x.CaptchaToken
+	}
+	return ""
+}
+
 type RefreshRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	Device        *DeviceInfo            `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RefreshRequest) Reset() {
 	*x = RefreshRequest{}
-	mi := &file_im_v1_identity_proto_msgTypes[2]
+	mi := &file_im_v1_identity_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -155,7 +250,7 @@ func (x *RefreshRequest) String() string {
 func (*RefreshRequest) ProtoMessage() {}
 
 func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_im_v1_identity_proto_msgTypes[2]
+	mi := &file_im_v1_identity_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -168,7 +263,7 @@ func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshRequest.ProtoReflect.Descriptor instead.
 func (*RefreshRequest) Descriptor() ([]byte, []int) {
-	return file_im_v1_identity_proto_rawDescGZIP(), []int{2}
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *RefreshRequest) GetRefreshToken() string {
@@ -178,19 +273,92 @@ func (x *RefreshRequest) GetRefreshToken() string {
 	return ""
 }
 
+func (x *RefreshRequest) GetDevice() *DeviceInfo {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+// 撤销当前 access token（按 jti），refresh_token 非空时一并撤销
+type LogoutRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccessJti       string                 `protobuf:"bytes,1,opt,name=access_jti,json=accessJti,proto3" json:"access_jti,omitempty"`
+	RefreshToken    string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	AccessExpiresAt int64                  `protobuf:"varint,3,opt,name=access_expires_at,json=accessExpiresAt,proto3" json:"access_expires_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_im_v1_identity_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LogoutRequest) GetAccessJti() string {
+	if x != nil {
+		return x.AccessJti
+	}
+	return ""
+}
+
+func (x *LogoutRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *LogoutRequest) GetAccessExpiresAt() int64 {
+	if x != nil {
+		return x.AccessExpiresAt
+	}
+	return 0
+}
+
 type AuthResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	ExpiresIn     int64                  `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
-	RefreshToken  string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
-	Profile       *UserProfile           `protobuf:"bytes,4,opt,name=profile,proto3" json:"profile,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken  string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	ExpiresIn    int64                  `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	RefreshToken string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	Profile      *UserProfile           `protobuf:"bytes,4,opt,name=profile,proto3" json:"profile,omitempty"`
+	SessionId    string                 `protobuf:"bytes,5,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// mfa_required=true 时不签发令牌，客户端需用 mfa_token 完成二次验证
+	MfaRequired   bool   `protobuf:"varint,6,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	MfaToken      string `protobuf:"bytes,7,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	MfaExpiresIn  int64  `protobuf:"varint,8,opt,name=mfa_expires_in,json=mfaExpiresIn,proto3" json:"mfa_expires_in,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AuthResponse) Reset() {
 	*x = AuthResponse{}
-	mi := &file_im_v1_identity_proto_msgTypes[3]
+	mi := &file_im_v1_identity_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -202,7 +370,7 @@ func (x *AuthResponse) String() string {
 func (*AuthResponse) ProtoMessage() {}
 
 func (x *AuthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_im_v1_identity_proto_msgTypes[3]
+	mi := &file_im_v1_identity_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -215,7 +383,7 @@ func (x *AuthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthResponse.ProtoReflect.Descriptor instead.
 func (*AuthResponse) Descriptor() ([]byte, []int) {
-	return file_im_v1_identity_proto_rawDescGZIP(), []int{3}
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AuthResponse) GetAccessToken() string {
@@ -246,6 +414,34 @@ func (x *AuthResponse) GetProfile() *UserProfile {
 	return nil
 }
 
+func (x *AuthResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *AuthResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *AuthResponse) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *AuthResponse) GetMfaExpiresIn() int64 {
+	if x != nil {
+		return x.MfaExpiresIn
+	}
+	return 0
+}
+
 type GetProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -255,7 +451,7 @@ type GetProfileRequest struct {
 
 func (x *GetProfileRequest) Reset() {
 	*x = GetProfileRequest{}
-	mi := &file_im_v1_identity_proto_msgTypes[4]
+	mi := &file_im_v1_identity_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -267,7 +463,7 @@ func (x *GetProfileRequest) String() string {
 func (*GetProfileRequest) ProtoMessage() {}
 
 func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_im_v1_identity_proto_msgTypes[4]
+	mi := &file_im_v1_identity_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -280,7 +476,7 @@ func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
 func (*GetProfileRequest) Descriptor() ([]byte, []int) {
-	return file_im_v1_identity_proto_rawDescGZIP(), []int{4}
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetProfileRequest) GetUserId() int64 {
@@ -300,7 +496,7 @@ type UpdateProfileRequest struct {
 
 func (x *UpdateProfileRequest) Reset() {
 	*x = UpdateProfileRequest{}
-	mi := &file_im_v1_identity_proto_msgTypes[5]
+	mi := &file_im_v1_identity_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -312,7 +508,7 @@ func (x *UpdateProfileRequest) String() string {
 func (*UpdateProfileRequest) ProtoMessage() {}
 
 func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_im_v1_identity_proto_msgTypes[5]
+	mi := &file_im_v1_identity_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -325,7 +521,7 @@ func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
-	return file_im_v1_identity_proto_rawDescGZIP(), []int{5}
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateProfileRequest) GetDisplayName() string {
@@ -352,7 +548,7 @@ type UserProfile struct {
 
 func (x *UserProfile) Reset() {
 	*x = UserProfile{}
-	mi := &file_im_v1_identity_proto_msgTypes[6]
+	mi := &file_im_v1_identity_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -364,7 +560,7 @@ func (x *UserProfile) String() string {
 func (*UserProfile) ProtoMessage() {}
 
 func (x *UserProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_im_v1_identity_proto_msgTypes[6]
+	mi := &file_im_v1_identity_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -377,7 +573,7 @@ func (x *UserProfile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
 func (*UserProfile) Descriptor() ([]byte, []int) {
-	return file_im_v1_identity_proto_rawDescGZIP(), []int{6}
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UserProfile) GetUser() *UserBrief {
@@ -404,7 +600,7 @@ type ApplyContactRequest struct {
 
 func (x *ApplyContactRequest) Reset() {
 	*x = ApplyContactRequest{}
-	mi := &file_im_v1_identity_proto_msgTypes[7]
+	mi := &file_im_v1_identity_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -416,7 +612,7 @@ func (x *ApplyContactRequest) String() string {
 func (*ApplyContactRequest) ProtoMessage() {}
 
 func (x *ApplyContactRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_im_v1_identity_proto_msgTypes[7]
+	mi := &file_im_v1_identity_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -429,7 +625,7 @@ func (x *ApplyContactRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyContactRequest.ProtoReflect.Descriptor instead.
 func (*ApplyContactRequest) Descriptor() ([]byte, []int) {
-	return file_im_v1_identity_proto_rawDescGZIP(), []int{7}
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ApplyContactRequest) GetTargetUserId() int64 {
@@ -456,7 +652,7 @@ type RespondContactRequest struct {
 
 func (x *RespondContactRequest) Reset() {
 	*x = RespondContactRequest{}
-	mi := &file_im_v1_identity_proto_msgTypes[8]
+	mi := &file_im_v1_identity_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -468,7 +664,7 @@ func (x *RespondContactRequest) String() string {
 func (*RespondContactRequest) ProtoMessage() {}
 
 func (x *RespondContactRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_im_v1_identity_proto_msgTypes[8]
+	mi := &file_im_v1_identity_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -481,7 +677,7 @@ func (x *RespondContactRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RespondContactRequest.ProtoReflect.Descriptor instead.
 func (*RespondContactRequest) Descriptor() ([]byte, []int) {
-	return file_im_v1_identity_proto_rawDescGZIP(), []int{8}
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *RespondContactRequest) GetTargetUserId() int64 {
@@ -507,7 +703,7 @@ type RemoveContactRequest struct {
 
 func (x *RemoveContactRequest) Reset() {
 	*x = RemoveContactRequest{}
-	mi := &file_im_v1_identity_proto_msgTypes[9]
+	mi := &file_im_v1_identity_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -519,7 +715,7 @@ func (x *RemoveContactRequest) String() string {
 func (*RemoveContactRequest) ProtoMessage() {}
 
 func (x *RemoveContactRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_im_v1_identity_proto_msgTypes[9]
+	mi := &file_im_v1_identity_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -532,7 +728,7 @@ func (x *RemoveContactRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveContactRequest.ProtoReflect.Descriptor instead.
 func (*RemoveContactRequest) Descriptor() ([]byte, []int) {
-	return file_im_v1_identity_proto_rawDescGZIP(), []int{9}
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *RemoveContactRequest) GetTargetUserId() int64 {
@@ -551,7 +747,7 @@ type BlacklistRequest struct {
 
 func (x *BlacklistRequest) Reset() {
 	*x = BlacklistRequest{}
-	mi := &file_im_v1_identity_proto_msgTypes[10]
+	mi := &file_im_v1_identity_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -563,7 +759,7 @@ func (x *BlacklistRequest) String() string {
 func (*BlacklistRequest) ProtoMessage() {}
 
 func (x *BlacklistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_im_v1_identity_proto_msgTypes[10]
+	mi := &file_im_v1_identity_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -576,7 +772,7 @@ func (x *BlacklistRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlacklistRequest.ProtoReflect.Descriptor instead.
 func (*BlacklistRequest) Descriptor() ([]byte, []int) {
-	return file_im_v1_identity_proto_rawDescGZIP(), []int{10}
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *BlacklistRequest) GetUserId() int64 {
@@ -596,7 +792,7 @@ type ListContactsRequest struct {
 
 func (x *ListContactsRequest) Reset() {
 	*x = ListContactsRequest{}
-	mi := &file_im_v1_identity_proto_msgTypes[11]
+	mi := &file_im_v1_identity_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -608,7 +804,7 @@ func (x *ListContactsRequest) String() string {
 func (*ListContactsRequest) ProtoMessage() {}
 
 func (x *ListContactsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_im_v1_identity_proto_msgTypes[11]
+	mi := &file_im_v1_identity_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -621,7 +817,7 @@ func (x *ListContactsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListContactsRequest.ProtoReflect.Descriptor instead.
 func (*ListContactsRequest) Descriptor() ([]byte, []int) {
-	return file_im_v1_identity_proto_rawDescGZIP(), []int{11}
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListContactsRequest) GetPage() int32 {
@@ -648,7 +844,7 @@ type ListContactsResponse struct {
 
 func (x *ListContactsResponse) Reset() {
 	*x = ListContactsResponse{}
-	mi := &file_im_v1_identity_proto_msgTypes[12]
+	mi := &file_im_v1_identity_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -660,7 +856,7 @@ func (x *ListContactsResponse) String() string {
 func (*ListContactsResponse) ProtoMessage() {}
 
 func (x *ListContactsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_im_v1_identity_proto_msgTypes[12]
+	mi := &file_im_v1_identity_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -673,7 +869,7 @@ func (x *ListContactsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListContactsResponse.ProtoReflect.Descriptor instead.
 func (*ListContactsResponse) Descriptor() ([]byte, []int) {
-	return file_im_v1_identity_proto_rawDescGZIP(), []int{12}
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListContactsResponse) GetContacts() []*UserBrief {
@@ -690,64 +886,2434 @@ func (x *ListContactsResponse) GetTotal() int32 {
 	return 0
 }
 
-var File_im_v1_identity_proto protoreflect.FileDescriptor
+// 不存在或已注销的用户不在 users 中
+type BatchGetProfilesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []int64                `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_im_v1_identity_proto_rawDesc = "" +
-	"\n" +
-	"\x14im/v1/identity.proto\x12\x05im.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x12im/v1/common.proto\"l\n" +
-	"\x0fRegisterRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\x12!\n" +
-	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\"F\n" +
-	"\fLoginRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"5\n" +
-	"\x0eRefreshRequest\x12#\n" +
-	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"\xa3\x01\n" +
-	"\fAuthResponse\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12\x1d\n" +
-	"\n" +
-	"expires_in\x18\x02 \x01(\x03R\texpiresIn\x12#\n" +
-	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x12,\n" +
-	"\aprofile\x18\x04 \x01(\v2\x12.im.v1.UserProfileR\aprofile\",\n" +
-	"\x11GetProfileRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"X\n" +
-	"\x14UpdateProfileRequest\x12!\n" +
-	"\fdisplay_name\x18\x01 \x01(\tR\vdisplayName\x12\x1d\n" +
-	"\n" +
-	"avatar_url\x18\x02 \x01(\tR\tavatarUrl\"K\n" +
-	"\vUserProfile\x12$\n" +
-	"\x04user\x18\x01 \x01(\v2\x10.im.v1.UserBriefR\x04user\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\"S\n" +
-	"\x13ApplyContactRequest\x12$\n" +
-	"\x0etarget_user_id\x18\x01 \x01(\x03R\ftargetUserId\x12\x16\n" +
-	"\x06remark\x18\x02 \x01(\tR\x06remark\"U\n" +
-	"\x15RespondContactRequest\x12$\n" +
-	"\x0etarget_user_id\x18\x01 \x01(\x03R\ftargetUserId\x12\x16\n" +
-	"\x06accept\x18\x02 \x01(\bR\x06accept\"<\n" +
-	"\x14RemoveContactRequest\x12$\n" +
-	"\x0etarget_user_id\x18\x01 \x01(\x03R\ftargetUserId\"+\n" +
-	"\x10BlacklistRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"F\n" +
-	"\x13ListContactsRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"Z\n" +
-	"\x14ListContactsResponse\x12,\n" +
-	"\bcontacts\x18\x01 \x03(\v2\x10.im.v1.UserBriefR\bcontacts\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total2\xd8\x05\n" +
-	"\x0fIdentityService\x127\n" +
-	"\bRegister\x12\x16.im.v1.RegisterRequest\x1a\x13.im.v1.AuthResponse\x121\n" +
-	"\x05Login\x12\x13.im.v1.LoginRequest\x1a\x13.im.v1.AuthResponse\x125\n" +
-	"\aRefresh\x12\x15.im.v1.RefreshRequest\x1a\x13.im.v1.AuthResponse\x12:\n" +
-	"\n" +
-	"GetProfile\x12\x18.im.v1.GetProfileRequest\x1a\x12.im.v1.UserProfile\x12@\n" +
-	"\rUpdateProfile\x12\x1b.im.v1.UpdateProfileRequest\x1a\x12.im.v1.UserProfile\x12B\n" +
-	"\fApplyContact\x12\x1a.im.v1.ApplyContactRequest\x1a\x16.google.protobuf.Empty\x12F\n" +
-	"\x0eRespondContact\x12\x1c.im.v1.RespondContactRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
-	"\rRemoveContact\x12\x1b.im.v1.RemoveContactRequest\x1a\x16.google.protobuf.Empty\x12A\n" +
-	"\x0eAddToBlacklist\x12\x17.im.v1.BlacklistRequest\x1a\x16.google.protobuf.Empty\x12F\n" +
-	"\x13RemoveFromBlacklist\x12\x17.im.v1.BlacklistRequest\x1a\x16.google.protobuf.Empty\x12G\n" +
-	"\fListContacts\x12\x1a.im.v1.ListContactsRequest\x1a\x1b.im.v1.ListContactsResponseB*Z(github.com/EthanQC/IM/api/gen/im/v1;imv1b\x06proto3"
+func (x *BatchGetProfilesRequest) Reset() {
+	*x = BatchGetProfilesRequest{}
+	mi := &file_im_v1_identity_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetProfilesRequest) ProtoMessage() {}
+
+func (x *BatchGetProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetProfilesRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BatchGetProfilesRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type BatchGetProfilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*UserBrief           `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetProfilesResponse) Reset() {
+	*x = BatchGetProfilesResponse{}
+	mi := &file_im_v1_identity_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetProfilesResponse) ProtoMessage() {}
+
+func (x *BatchGetProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetProfilesResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BatchGetProfilesResponse) GetUsers() []*UserBrief {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// 匹配展示名或用户名包含 keyword 的用户，最多返回 limit 个
+// candidate_ids 非空时只在这些用户中匹配（单次最多 500 个），此时忽略 limit
+type MatchUsersByNameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	CandidateIds  []int64                `protobuf:"varint,3,rep,packed,name=candidate_ids,json=candidateIds,proto3" json:"candidate_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchUsersByNameRequest) Reset() {
+	*x = MatchUsersByNameRequest{}
+	mi := &file_im_v1_identity_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchUsersByNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchUsersByNameRequest) ProtoMessage() {}
+
+func (x *MatchUsersByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchUsersByNameRequest.ProtoReflect.Descriptor instead.
+func (*MatchUsersByNameRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MatchUsersByNameRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *MatchUsersByNameRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *MatchUsersByNameRequest) GetCandidateIds() []int64 {
+	if x != nil {
+		return x.CandidateIds
+	}
+	return nil
+}
+
+type MatchUsersByNameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []int64                `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchUsersByNameResponse) Reset() {
+	*x = MatchUsersByNameResponse{}
+	mi := &file_im_v1_identity_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchUsersByNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchUsersByNameResponse) ProtoMessage() {}
+
+func (x *MatchUsersByNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchUsersByNameResponse.ProtoReflect.Descriptor instead.
+func (*MatchUsersByNameResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MatchUsersByNameResponse) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+// active=false 时 reason 给出封禁/禁用原因
+type CheckUserStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckUserStatusRequest) Reset() {
+	*x = CheckUserStatusRequest{}
+	mi := &file_im_v1_identity_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckUserStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckUserStatusRequest) ProtoMessage() {}
+
+func (x *CheckUserStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckUserStatusRequest.ProtoReflect.Descriptor instead.
+func (*CheckUserStatusRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CheckUserStatusRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type CheckUserStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Active        bool                   `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckUserStatusResponse) Reset() {
+	*x = CheckUserStatusResponse{}
+	mi := &file_im_v1_identity_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckUserStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckUserStatusResponse) ProtoMessage() {}
+
+func (x *CheckUserStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckUserStatusResponse.ProtoReflect.Descriptor instead.
+func (*CheckUserStatusResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CheckUserStatusResponse) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *CheckUserStatusResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// permissions 形如 "Group:Manage"（资源:操作）；系统角色不可修改或删除
+type Role struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Permissions   []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	IsSystem      bool                   `protobuf:"varint,4,opt,name=is_system,json=isSystem,proto3" json:"is_system,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Role) Reset() {
+	*x = Role{}
+	mi := &file_im_v1_identity_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role) ProtoMessage() {}
+
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Role) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Role) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Role) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *Role) GetIsSystem() bool {
+	if x != nil {
+		return x.IsSystem
+	}
+	return false
+}
+
+type ListRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []*Role                `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolesResponse) Reset() {
+	*x = ListRolesResponse{}
+	mi := &file_im_v1_identity_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesResponse) ProtoMessage() {}
+
+func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListRolesResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListRolesResponse) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type RoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Permissions   []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleRequest) Reset() {
+	*x = RoleRequest{}
+	mi := &file_im_v1_identity_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleRequest) ProtoMessage() {}
+
+func (x *RoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleRequest.ProtoReflect.Descriptor instead.
+func (*RoleRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RoleRequest) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type DeleteRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleRequest) Reset() {
+	*x = DeleteRoleRequest{}
+	mi := &file_im_v1_identity_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleRequest) ProtoMessage() {}
+
+func (x *DeleteRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoleRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListUserRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserRolesRequest) Reset() {
+	*x = ListUserRolesRequest{}
+	mi := &file_im_v1_identity_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserRolesRequest) ProtoMessage() {}
+
+func (x *ListUserRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListUserRolesRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListUserRolesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type UserRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserRoleRequest) Reset() {
+	*x = UserRoleRequest{}
+	mi := &file_im_v1_identity_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRoleRequest) ProtoMessage() {}
+
+func (x *UserRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRoleRequest.ProtoReflect.Descriptor instead.
+func (*UserRoleRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UserRoleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// duration_seconds<=0 表示长期封禁
+type BlockUserRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason          string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
+	mi := &file_im_v1_identity_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserRequest) ProtoMessage() {}
+
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BlockUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BlockUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BlockUserRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type UnblockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockUserRequest) Reset() {
+	*x = UnblockUserRequest{}
+	mi := &file_im_v1_identity_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserRequest) ProtoMessage() {}
+
+func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnblockUserRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UnblockUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// current 标记发起请求的会话；last_seen_at 为最近一次登录或刷新令牌的时间（秒）
+type Session struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Platform      string                 `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`
+	Ip            string                 `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,5,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastSeenAt    int64                  `protobuf:"varint,7,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	Current       bool                   `protobuf:"varint,8,opt,name=current,proto3" json:"current,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_im_v1_identity_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Session) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Session) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *Session) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *Session) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Session) GetLastSeenAt() int64 {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return 0
+}
+
+func (x *Session) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type ListSessionsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CurrentSessionId string                 `protobuf:"bytes,1,opt,name=current_session_id,json=currentSessionId,proto3" json:"current_session_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_im_v1_identity_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListSessionsRequest) GetCurrentSessionId() string {
+	if x != nil {
+		return x.CurrentSessionId
+	}
+	return ""
+}
+
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_im_v1_identity_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_im_v1_identity_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RevokeSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type RevokeOtherSessionsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CurrentSessionId string                 `protobuf:"bytes,1,opt,name=current_session_id,json=currentSessionId,proto3" json:"current_session_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RevokeOtherSessionsRequest) Reset() {
+	*x = RevokeOtherSessionsRequest{}
+	mi := &file_im_v1_identity_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeOtherSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeOtherSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeOtherSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeOtherSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeOtherSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RevokeOtherSessionsRequest) GetCurrentSessionId() string {
+	if x != nil {
+		return x.CurrentSessionId
+	}
+	return ""
+}
+
+type RevokeOtherSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revoked       int32                  `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeOtherSessionsResponse) Reset() {
+	*x = RevokeOtherSessionsResponse{}
+	mi := &file_im_v1_identity_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeOtherSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeOtherSessionsResponse) ProtoMessage() {}
+
+func (x *RevokeOtherSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeOtherSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeOtherSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RevokeOtherSessionsResponse) GetRevoked() int32 {
+	if x != nil {
+		return x.Revoked
+	}
+	return 0
+}
+
+type VerifyMFALoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MfaToken      string                 `protobuf:"bytes,1,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyMFALoginRequest) Reset() {
+	*x = VerifyMFALoginRequest{}
+	mi := &file_im_v1_identity_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMFALoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMFALoginRequest) ProtoMessage() {}
+
+func (x *VerifyMFALoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMFALoginRequest.ProtoReflect.Descriptor instead.
+func (*VerifyMFALoginRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *VerifyMFALoginRequest) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *VerifyMFALoginRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type MFAStatus struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Enabled           bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	RecoveryCodesLeft int32                  `protobuf:"varint,2,opt,name=recovery_codes_left,json=recoveryCodesLeft,proto3" json:"recovery_codes_left,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MFAStatus) Reset() {
+	*x = MFAStatus{}
+	mi := &file_im_v1_identity_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MFAStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MFAStatus) ProtoMessage() {}
+
+func (x *MFAStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MFAStatus.ProtoReflect.Descriptor instead.
+func (*MFAStatus) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MFAStatus) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *MFAStatus) GetRecoveryCodesLeft() int32 {
+	if x != nil {
+		return x.RecoveryCodesLeft
+	}
+	return 0
+}
+
+type EnrollTOTPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	OtpauthUri    string                 `protobuf:"bytes,2,opt,name=otpauth_uri,json=otpauthUri,proto3" json:"otpauth_uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollTOTPResponse) Reset() {
+	*x = EnrollTOTPResponse{}
+	mi := &file_im_v1_identity_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTOTPResponse) ProtoMessage() {}
+
+func (x *EnrollTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTOTPResponse.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *EnrollTOTPResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollTOTPResponse) GetOtpauthUri() string {
+	if x != nil {
+		return x.OtpauthUri
+	}
+	return ""
+}
+
+// code 为验证器生成的 6 位验证码，部分接口也接受恢复码
+type MFACodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MFACodeRequest) Reset() {
+	*x = MFACodeRequest{}
+	mi := &file_im_v1_identity_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MFACodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MFACodeRequest) ProtoMessage() {}
+
+func (x *MFACodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MFACodeRequest.ProtoReflect.Descriptor instead.
+func (*MFACodeRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MFACodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RecoveryCodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryCodes []string               `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecoveryCodesResponse) Reset() {
+	*x = RecoveryCodesResponse{}
+	mi := &file_im_v1_identity_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecoveryCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoveryCodesResponse) ProtoMessage() {}
+
+func (x *RecoveryCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoveryCodesResponse.ProtoReflect.Descriptor instead.
+func (*RecoveryCodesResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RecoveryCodesResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type StepUpMFAResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpiresIn     int64                  `protobuf:"varint,1,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StepUpMFAResponse) Reset() {
+	*x = StepUpMFAResponse{}
+	mi := &file_im_v1_identity_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StepUpMFAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepUpMFAResponse) ProtoMessage() {}
+
+func (x *StepUpMFAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepUpMFAResponse.ProtoReflect.Descriptor instead.
+func (*StepUpMFAResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *StepUpMFAResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// 修改成功后当前会话以外的设备全部下线
+type ChangePasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OldPassword   string                 `protobuf:"bytes,1,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_im_v1_identity_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ChangePasswordRequest) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangePasswordResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RevokedSessions int32                  `protobuf:"varint,1,opt,name=revoked_sessions,json=revokedSessions,proto3" json:"revoked_sessions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_im_v1_identity_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResponse) ProtoMessage() {}
+
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ChangePasswordResponse) GetRevokedSessions() int32 {
+	if x != nil {
+		return x.RevokedSessions
+	}
+	return 0
+}
+
+// channel 为 sms 或 email，target 为手机号或邮箱；ip 由网关填写，用于限流
+type RequestPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Ip            string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_im_v1_identity_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RequestPasswordResetRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *RequestPasswordResetRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *RequestPasswordResetRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+type RequestPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpiresIn     int64                  `protobuf:"varint,1,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetResponse) Reset() {
+	*x = RequestPasswordResetResponse{}
+	mi := &file_im_v1_identity_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetResponse) ProtoMessage() {}
+
+func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RequestPasswordResetResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+type VerifyPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Ip            string                 `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPasswordResetRequest) Reset() {
+	*x = VerifyPasswordResetRequest{}
+	mi := &file_im_v1_identity_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPasswordResetRequest) ProtoMessage() {}
+
+func (x *VerifyPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*VerifyPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *VerifyPasswordResetRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *VerifyPasswordResetRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *VerifyPasswordResetRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *VerifyPasswordResetRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+type VerifyPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResetToken    string                 `protobuf:"bytes,1,opt,name=reset_token,json=resetToken,proto3" json:"reset_token,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPasswordResetResponse) Reset() {
+	*x = VerifyPasswordResetResponse{}
+	mi := &file_im_v1_identity_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPasswordResetResponse) ProtoMessage() {}
+
+func (x *VerifyPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*VerifyPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *VerifyPasswordResetResponse) GetResetToken() string {
+	if x != nil {
+		return x.ResetToken
+	}
+	return ""
+}
+
+func (x *VerifyPasswordResetResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// 重置成功后全部设备下线
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResetToken    string                 `protobuf:"bytes,1,opt,name=reset_token,json=resetToken,proto3" json:"reset_token,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_im_v1_identity_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ResetPasswordRequest) GetResetToken() string {
+	if x != nil {
+		return x.ResetToken
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type OIDCProvider struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OIDCProvider) Reset() {
+	*x = OIDCProvider{}
+	mi := &file_im_v1_identity_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OIDCProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCProvider) ProtoMessage() {}
+
+func (x *OIDCProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCProvider.ProtoReflect.Descriptor instead.
+func (*OIDCProvider) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *OIDCProvider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OIDCProvider) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+type ListOIDCProvidersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Providers     []*OIDCProvider        `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOIDCProvidersResponse) Reset() {
+	*x = ListOIDCProvidersResponse{}
+	mi := &file_im_v1_identity_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOIDCProvidersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOIDCProvidersResponse) ProtoMessage() {}
+
+func (x *ListOIDCProvidersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOIDCProvidersResponse.ProtoReflect.Descriptor instead.
+func (*ListOIDCProvidersResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListOIDCProvidersResponse) GetProviders() []*OIDCProvider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+// 客户端跳转到 authorization_url，提供方回调时带回 code 与 state
+// browser_binding 由网关写入发起方浏览器的 HttpOnly Cookie，回调时原样传回，state 只能由发起登录的浏览器完成
+type BeginOIDCLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Device        *DeviceInfo            `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginOIDCLoginRequest) Reset() {
+	*x = BeginOIDCLoginRequest{}
+	mi := &file_im_v1_identity_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginOIDCLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginOIDCLoginRequest) ProtoMessage() {}
+
+func (x *BeginOIDCLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginOIDCLoginRequest.ProtoReflect.Descriptor instead.
+func (*BeginOIDCLoginRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *BeginOIDCLoginRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *BeginOIDCLoginRequest) GetDevice() *DeviceInfo {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+type BeginOIDCLoginResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AuthorizationUrl string                 `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"`
+	State            string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	BrowserBinding   string                 `protobuf:"bytes,3,opt,name=browser_binding,json=browserBinding,proto3" json:"browser_binding,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BeginOIDCLoginResponse) Reset() {
+	*x = BeginOIDCLoginResponse{}
+	mi := &file_im_v1_identity_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginOIDCLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginOIDCLoginResponse) ProtoMessage() {}
+
+func (x *BeginOIDCLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginOIDCLoginResponse.ProtoReflect.Descriptor instead.
+func (*BeginOIDCLoginResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *BeginOIDCLoginResponse) GetAuthorizationUrl() string {
+	if x != nil {
+		return x.AuthorizationUrl
+	}
+	return ""
+}
+
+func (x *BeginOIDCLoginResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *BeginOIDCLoginResponse) GetBrowserBinding() string {
+	if x != nil {
+		return x.BrowserBinding
+	}
+	return ""
+}
+
+type CompleteOIDCLoginRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Provider       string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Code           string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	State          string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	BrowserBinding string                 `protobuf:"bytes,4,opt,name=browser_binding,json=browserBinding,proto3" json:"browser_binding,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CompleteOIDCLoginRequest) Reset() {
+	*x = CompleteOIDCLoginRequest{}
+	mi := &file_im_v1_identity_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteOIDCLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteOIDCLoginRequest) ProtoMessage() {}
+
+func (x *CompleteOIDCLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteOIDCLoginRequest.ProtoReflect.Descriptor instead.
+func (*CompleteOIDCLoginRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CompleteOIDCLoginRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *CompleteOIDCLoginRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CompleteOIDCLoginRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CompleteOIDCLoginRequest) GetBrowserBinding() string {
+	if x != nil {
+		return x.BrowserBinding
+	}
+	return ""
+}
+
+// query 含 @ 按邮箱、合法手机号按手机号、其余按用户名精确匹配
+type SearchUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUserRequest) Reset() {
+	*x = SearchUserRequest{}
+	mi := &file_im_v1_identity_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUserRequest) ProtoMessage() {}
+
+func (x *SearchUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUserRequest.ProtoReflect.Descriptor instead.
+func (*SearchUserRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SearchUserRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type ResolveShareCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveShareCodeRequest) Reset() {
+	*x = ResolveShareCodeRequest{}
+	mi := &file_im_v1_identity_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveShareCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveShareCodeRequest) ProtoMessage() {}
+
+func (x *ResolveShareCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveShareCodeRequest.ProtoReflect.Descriptor instead.
+func (*ResolveShareCodeRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ResolveShareCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// share_url 为空时客户端用 code 自行生成二维码内容
+type ShareCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	ShareUrl      string                 `protobuf:"bytes,2,opt,name=share_url,json=shareUrl,proto3" json:"share_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareCodeResponse) Reset() {
+	*x = ShareCodeResponse{}
+	mi := &file_im_v1_identity_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareCodeResponse) ProtoMessage() {}
+
+func (x *ShareCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareCodeResponse.ProtoReflect.Descriptor instead.
+func (*ShareCodeResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ShareCodeResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ShareCodeResponse) GetShareUrl() string {
+	if x != nil {
+		return x.ShareUrl
+	}
+	return ""
+}
+
+type PrivacySettings struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	AllowFindByUsername bool                   `protobuf:"varint,1,opt,name=allow_find_by_username,json=allowFindByUsername,proto3" json:"allow_find_by_username,omitempty"`
+	AllowFindByPhone    bool                   `protobuf:"varint,2,opt,name=allow_find_by_phone,json=allowFindByPhone,proto3" json:"allow_find_by_phone,omitempty"`
+	AllowFindByEmail    bool                   `protobuf:"varint,3,opt,name=allow_find_by_email,json=allowFindByEmail,proto3" json:"allow_find_by_email,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PrivacySettings) Reset() {
+	*x = PrivacySettings{}
+	mi := &file_im_v1_identity_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivacySettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivacySettings) ProtoMessage() {}
+
+func (x *PrivacySettings) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_identity_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivacySettings.ProtoReflect.Descriptor instead.
+func (*PrivacySettings) Descriptor() ([]byte, []int) {
+	return file_im_v1_identity_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PrivacySettings) GetAllowFindByUsername() bool {
+	if x != nil {
+		return x.AllowFindByUsername
+	}
+	return false
+}
+
+func (x *PrivacySettings) GetAllowFindByPhone() bool {
+	if x != nil {
+		return x.AllowFindByPhone
+	}
+	return false
+}
+
+func (x *PrivacySettings) GetAllowFindByEmail() bool {
+	if x != nil {
+		return x.AllowFindByEmail
+	}
+	return false
+}
+
+var File_im_v1_identity_proto protoreflect.FileDescriptor
+
+const file_im_v1_identity_proto_rawDesc = "" +
+	"\n" +
+	"\x14im/v1/identity.proto\x12\x05im.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x12im/v1/common.proto\"t\n" +
+	"\n" +
+	"DeviceInfo\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\x12\x1a\n" +
+	"\bplatform\x18\x02 \x01(\tR\bplatform\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\"\x97\x01\n" +
+	"\x0fRegisterRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12)\n" +
+	"\x06device\x18\x04 \x01(\v2\x11.im.v1.DeviceInfoR\x06device\"\x96\x01\n" +
+	"\fLoginRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12)\n" +
+	"\x06device\x18\x03 \x01(\v2\x11.im.v1.DeviceInfoR\x06device\x12#\n" +
+	"\rcaptcha_token\x18\x04 \x01(\tR\fcaptchaToken\"`\n" +
+	"\x0eRefreshRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\x12)\n" +
+	"\x06device\x18\x02 \x01(\v2\x11.im.v1.DeviceInfoR\x06device\"\x7f\n" +
+	"\rLogoutRequest\x12\x1d\n" +
+	"\n" +
+	"access_jti\x18\x01 \x01(\tR\taccessJti\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12*\n" +
+	"\x11access_expires_at\x18\x03 \x01(\x03R\x0faccessExpiresAt\"\xa8\x02\n" +
+	"\fAuthResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\x03R\texpiresIn\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x12,\n" +
+	"\aprofile\x18\x04 \x01(\v2\x12.im.v1.UserProfileR\aprofile\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x05 \x01(\tR\tsessionId\x12!\n" +
+	"\fmfa_required\x18\x06 \x01(\bR\vmfaRequired\x12\x1b\n" +
+	"\tmfa_token\x18\a \x01(\tR\bmfaToken\x12$\n" +
+	"\x0emfa_expires_in\x18\b \x01(\x03R\fmfaExpiresIn\",\n" +
+	"\x11GetProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"X\n" +
+	"\x14UpdateProfileRequest\x12!\n" +
+	"\fdisplay_name\x18\x01 \x01(\tR\vdisplayName\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x02 \x01(\tR\tavatarUrl\"K\n" +
+	"\vUserProfile\x12$\n" +
+	"\x04user\x18\x01 \x01(\v2\x10.im.v1.UserBriefR\x04user\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"S\n" +
+	"\x13ApplyContactRequest\x12$\n" +
+	"\x0etarget_user_id\x18\x01 \x01(\x03R\ftargetUserId\x12\x16\n" +
+	"\x06remark\x18\x02 \x01(\tR\x06remark\"U\n" +
+	"\x15RespondContactRequest\x12$\n" +
+	"\x0etarget_user_id\x18\x01 \x01(\x03R\ftargetUserId\x12\x16\n" +
+	"\x06accept\x18\x02 \x01(\bR\x06accept\"<\n" +
+	"\x14RemoveContactRequest\x12$\n" +
+	"\x0etarget_user_id\x18\x01 \x01(\x03R\ftargetUserId\"+\n" +
+	"\x10BlacklistRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"F\n" +
+	"\x13ListContactsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"Z\n" +
+	"\x14ListContactsResponse\x12,\n" +
+	"\bcontacts\x18\x01 \x03(\v2\x10.im.v1.UserBriefR\bcontacts\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"4\n" +
+	"\x17BatchGetProfilesRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x03R\auserIds\"B\n" +
+	"\x18BatchGetProfilesResponse\x12&\n" +
+	"\x05users\x18\x01 \x03(\v2\x10.im.v1.UserBriefR\x05users\"n\n" +
+	"\x17MatchUsersByNameRequest\x12\x18\n" +
+	"\akeyword\x18\x01 \x01(\tR\akeyword\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12#\n" +
+	"\rcandidate_ids\x18\x03 \x03(\x03R\fcandidateIds\"5\n" +
+	"\x18MatchUsersByNameResponse\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x03R\auserIds\"1\n" +
+	"\x16CheckUserStatusRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"I\n" +
+	"\x17CheckUserStatusResponse\x12\x16\n" +
+	"\x06active\x18\x01 \x01(\bR\x06active\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"{\n" +
+	"\x04Role\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12 \n" +
+	"\vpermissions\x18\x03 \x03(\tR\vpermissions\x12\x1b\n" +
+	"\tis_system\x18\x04 \x01(\bR\bisSystem\"6\n" +
+	"\x11ListRolesResponse\x12!\n" +
+	"\x05roles\x18\x01 \x03(\v2\v.im.v1.RoleR\x05roles\"e\n" +
+	"\vRoleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12 \n" +
+	"\vpermissions\x18\x03 \x03(\tR\vpermissions\"'\n" +
+	"\x11DeleteRoleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"/\n" +
+	"\x14ListUserRolesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\">\n" +
+	"\x0fUserRoleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"n\n" +
+	"\x10BlockUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12)\n" +
+	"\x10duration_seconds\x18\x03 \x01(\x03R\x0fdurationSeconds\"-\n" +
+	"\x12UnblockUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\xdc\x01\n" +
+	"\aSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\x12\x1a\n" +
+	"\bplatform\x18\x03 \x01(\tR\bplatform\x12\x0e\n" +
+	"\x02ip\x18\x04 \x01(\tR\x02ip\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x05 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12 \n" +
+	"\flast_seen_at\x18\a \x01(\x03R\n" +
+	"lastSeenAt\x12\x18\n" +
+	"\acurrent\x18\b \x01(\bR\acurrent\"C\n" +
+	"\x13ListSessionsRequest\x12,\n" +
+	"\x12current_session_id\x18\x01 \x01(\tR\x10currentSessionId\"B\n" +
+	"\x14ListSessionsResponse\x12*\n" +
+	"\bsessions\x18\x01 \x03(\v2\x0e.im.v1.SessionR\bsessions\"5\n" +
+	"\x14RevokeSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"J\n" +
+	"\x1aRevokeOtherSessionsRequest\x12,\n" +
+	"\x12current_session_id\x18\x01 \x01(\tR\x10currentSessionId\"7\n" +
+	"\x1bRevokeOtherSessionsResponse\x12\x18\n" +
+	"\arevoked\x18\x01 \x01(\x05R\arevoked\"H\n" +
+	"\x15VerifyMFALoginRequest\x12\x1b\n" +
+	"\tmfa_token\x18\x01 \x01(\tR\bmfaToken\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"U\n" +
+	"\tMFAStatus\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12.\n" +
+	"\x13recovery_codes_left\x18\x02 \x01(\x05R\x11recoveryCodesLeft\"M\n" +
+	"\x12EnrollTOTPResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x1f\n" +
+	"\votpauth_uri\x18\x02 \x01(\tR\n" +
+	"otpauthUri\"$\n" +
+	"\x0eMFACodeRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\">\n" +
+	"\x15RecoveryCodesResponse\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\"2\n" +
+	"\x11StepUpMFAResponse\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x01 \x01(\x03R\texpiresIn\"]\n" +
+	"\x15ChangePasswordRequest\x12!\n" +
+	"\fold_password\x18\x01 \x01(\tR\voldPassword\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"C\n" +
+	"\x16ChangePasswordResponse\x12)\n" +
+	"\x10revoked_sessions\x18\x01 \x01(\x05R\x0frevokedSessions\"_\n" +
+	"\x1bRequestPasswordResetRequest\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\"=\n" +
+	"\x1cRequestPasswordResetResponse\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x01 \x01(\x03R\texpiresIn\"r\n" +
+	"\x1aVerifyPasswordResetRequest\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x0e\n" +
+	"\x02ip\x18\x04 \x01(\tR\x02ip\"]\n" +
+	"\x1bVerifyPasswordResetResponse\x12\x1f\n" +
+	"\vreset_token\x18\x01 \x01(\tR\n" +
+	"resetToken\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\x03R\texpiresIn\"Z\n" +
+	"\x14ResetPasswordRequest\x12\x1f\n" +
+	"\vreset_token\x18\x01 \x01(\tR\n" +
+	"resetToken\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"E\n" +
+	"\fOIDCProvider\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\"N\n" +
+	"\x19ListOIDCProvidersResponse\x121\n" +
+	"\tproviders\x18\x01 \x03(\v2\x13.im.v1.OIDCProviderR\tproviders\"^\n" +
+	"\x15BeginOIDCLoginRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12)\n" +
+	"\x06device\x18\x02 \x01(\v2\x11.im.v1.DeviceInfoR\x06device\"\x84\x01\n" +
+	"\x16BeginOIDCLoginResponse\x12+\n" +
+	"\x11authorization_url\x18\x01 \x01(\tR\x10authorizationUrl\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12'\n" +
+	"\x0fbrowser_binding\x18\x03 \x01(\tR\x0ebrowserBinding\"\x89\x01\n" +
+	"\x18CompleteOIDCLoginRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12'\n" +
+	"\x0fbrowser_binding\x18\x04 \x01(\tR\x0ebrowserBinding\")\n" +
+	"\x11SearchUserRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\"-\n" +
+	"\x17ResolveShareCodeRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"D\n" +
+	"\x11ShareCodeResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1b\n" +
+	"\tshare_url\x18\x02 \x01(\tR\bshareUrl\"\xa4\x01\n" +
+	"\x0fPrivacySettings\x123\n" +
+	"\x16allow_find_by_username\x18\x01 \x01(\bR\x13allowFindByUsername\x12-\n" +
+	"\x13allow_find_by_phone\x18\x02 \x01(\bR\x10allowFindByPhone\x12-\n" +
+	"\x13allow_find_by_email\x18\x03 \x01(\bR\x10allowFindByEmail2\xc4\x19\n" +
+	"\x0fIdentityService\x127\n" +
+	"\bRegister\x12\x16.im.v1.RegisterRequest\x1a\x13.im.v1.AuthResponse\x121\n" +
+	"\x05Login\x12\x13.im.v1.LoginRequest\x1a\x13.im.v1.AuthResponse\x125\n" +
+	"\aRefresh\x12\x15.im.v1.RefreshRequest\x1a\x13.im.v1.AuthResponse\x126\n" +
+	"\x06Logout\x12\x14.im.v1.LogoutRequest\x1a\x16.google.protobuf.Empty\x12:\n" +
+	"\n" +
+	"GetProfile\x12\x18.im.v1.GetProfileRequest\x1a\x12.im.v1.UserProfile\x12@\n" +
+	"\rUpdateProfile\x12\x1b.im.v1.UpdateProfileRequest\x1a\x12.im.v1.UserProfile\x12B\n" +
+	"\fApplyContact\x12\x1a.im.v1.ApplyContactRequest\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\x0eRespondContact\x12\x1c.im.v1.RespondContactRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
+	"\rRemoveContact\x12\x1b.im.v1.RemoveContactRequest\x1a\x16.google.protobuf.Empty\x12A\n" +
+	"\x0eAddToBlacklist\x12\x17.im.v1.BlacklistRequest\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\x13RemoveFromBlacklist\x12\x17.im.v1.BlacklistRequest\x1a\x16.google.protobuf.Empty\x12G\n" +
+	"\fListContacts\x12\x1a.im.v1.ListContactsRequest\x1a\x1b.im.v1.ListContactsResponse\x12S\n" +
+	"\x10BatchGetProfiles\x12\x1e.im.v1.BatchGetProfilesRequest\x1a\x1f.im.v1.BatchGetProfilesResponse\x12S\n" +
+	"\x10MatchUsersByName\x12\x1e.im.v1.MatchUsersByNameRequest\x1a\x1f.im.v1.MatchUsersByNameResponse\x12P\n" +
+	"\x0fCheckUserStatus\x12\x1d.im.v1.CheckUserStatusRequest\x1a\x1e.im.v1.CheckUserStatusResponse\x12=\n" +
+	"\tListRoles\x12\x16.google.protobuf.Empty\x1a\x18.im.v1.ListRolesResponse\x12-\n" +
+	"\n" +
+	"CreateRole\x12\x12.im.v1.RoleRequest\x1a\v.im.v1.Role\x12-\n" +
+	"\n" +
+	"UpdateRole\x12\x12.im.v1.RoleRequest\x1a\v.im.v1.Role\x12>\n" +
+	"\n" +
+	"DeleteRole\x12\x18.im.v1.DeleteRoleRequest\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\rListUserRoles\x12\x1b.im.v1.ListUserRolesRequest\x1a\x18.im.v1.ListRolesResponse\x12@\n" +
+	"\x0eAssignUserRole\x12\x16.im.v1.UserRoleRequest\x1a\x16.google.protobuf.Empty\x12@\n" +
+	"\x0eRevokeUserRole\x12\x16.im.v1.UserRoleRequest\x1a\x16.google.protobuf.Empty\x12<\n" +
+	"\tBlockUser\x12\x17.im.v1.BlockUserRequest\x1a\x16.google.protobuf.Empty\x12@\n" +
+	"\vUnblockUser\x12\x19.im.v1.UnblockUserRequest\x1a\x16.google.protobuf.Empty\x12G\n" +
+	"\fListSessions\x12\x1a.im.v1.ListSessionsRequest\x1a\x1b.im.v1.ListSessionsResponse\x12D\n" +
+	"\rRevokeSession\x12\x1b.im.v1.RevokeSessionRequest\x1a\x16.google.protobuf.Empty\x12\\\n" +
+	"\x13RevokeOtherSessions\x12!.im.v1.RevokeOtherSessionsRequest\x1a\".im.v1.RevokeOtherSessionsResponse\x12C\n" +
+	"\x0eVerifyMFALogin\x12\x1c.im.v1.VerifyMFALoginRequest\x1a\x13.im.v1.AuthResponse\x128\n" +
+	"\fGetMFAStatus\x12\x16.google.protobuf.Empty\x1a\x10.im.v1.MFAStatus\x12?\n" +
+	"\n" +
+	"EnrollTOTP\x12\x16.google.protobuf.Empty\x1a\x19.im.v1.EnrollTOTPResponse\x12B\n" +
+	"\vConfirmTOTP\x12\x15.im.v1.MFACodeRequest\x1a\x1c.im.v1.RecoveryCodesResponse\x12<\n" +
+	"\vDisableTOTP\x12\x15.im.v1.MFACodeRequest\x1a\x16.google.protobuf.Empty\x12N\n" +
+	"\x17RegenerateRecoveryCodes\x12\x15.im.v1.MFACodeRequest\x1a\x1c.im.v1.RecoveryCodesResponse\x12<\n" +
+	"\tStepUpMFA\x12\x15.im.v1.MFACodeRequest\x1a\x18.im.v1.StepUpMFAResponse\x12M\n" +
+	"\x0eChangePassword\x12\x1c.im.v1.ChangePasswordRequest\x1a\x1d.im.v1.ChangePasswordResponse\x12_\n" +
+	"\x14RequestPasswordReset\x12\".im.v1.RequestPasswordResetRequest\x1a#.im.v1.RequestPasswordResetResponse\x12\\\n" +
+	"\x13VerifyPasswordReset\x12!.im.v1.VerifyPasswordResetRequest\x1a\".im.v1.VerifyPasswordResetResponse\x12D\n" +
+	"\rResetPassword\x12\x1b.im.v1.ResetPasswordRequest\x1a\x16.google.protobuf.Empty\x12M\n" +
+	"\x11ListOIDCProviders\x12\x16.google.protobuf.Empty\x1a .im.v1.ListOIDCProvidersResponse\x12M\n" +
+	"\x0eBeginOIDCLogin\x12\x1c.im.v1.BeginOIDCLoginRequest\x1a\x1d.im.v1.BeginOIDCLoginResponse\x12I\n" +
+	"\x11CompleteOIDCLogin\x12\x1f.im.v1.CompleteOIDCLoginRequest\x1a\x13.im.v1.AuthResponse\x128\n" +
+	"\n" +
+	"SearchUser\x12\x18.im.v1.SearchUserRequest\x1a\x10.im.v1.UserBrief\x12D\n" +
+	"\x10ResolveShareCode\x12\x1e.im.v1.ResolveShareCodeRequest\x1a\x10.im.v1.UserBrief\x12@\n" +
+	"\fGetShareCode\x12\x16.google.protobuf.Empty\x1a\x18.im.v1.ShareCodeResponse\x12B\n" +
+	"\x0eResetShareCode\x12\x16.google.protobuf.Empty\x1a\x18.im.v1.ShareCodeResponse\x12D\n" +
+	"\x12GetPrivacySettings\x12\x16.google.protobuf.Empty\x1a\x16.im.v1.PrivacySettings\x12G\n" +
+	"\x15UpdatePrivacySettings\x12\x16.im.v1.PrivacySettings\x1a\x16.im.v1.PrivacySettingsB*Z(github.com/EthanQC/IM/api/gen/im/v1;imv1b\x06proto3"
 
 var (
 	file_im_v1_identity_proto_rawDescOnce sync.Once
@@ -761,55 +3327,179 @@ func file_im_v1_identity_proto_rawDescGZIP() []byte {
 	return file_im_v1_identity_proto_rawDescData
 }
 
-var file_im_v1_identity_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_im_v1_identity_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
 var file_im_v1_identity_proto_goTypes = []any{
-	(*RegisterRequest)(nil),       // 0: im.v1.RegisterRequest
-	(*LoginRequest)(nil),          // 1: im.v1.LoginRequest
-	(*RefreshRequest)(nil),        // 2: im.v1.RefreshRequest
-	(*AuthResponse)(nil),          // 3: im.v1.AuthResponse
-	(*GetProfileRequest)(nil),     // 4: im.v1.GetProfileRequest
-	(*UpdateProfileRequest)(nil),  // 5: im.v1.UpdateProfileRequest
-	(*UserProfile)(nil),           // 6: im.v1.UserProfile
-	(*ApplyContactRequest)(nil),   // 7: im.v1.ApplyContactRequest
-	(*RespondContactRequest)(nil), // 8: im.v1.RespondContactRequest
-	(*RemoveContactRequest)(nil),  // 9: im.v1.RemoveContactRequest
-	(*BlacklistRequest)(nil),      // 10: im.v1.BlacklistRequest
-	(*ListContactsRequest)(nil),   // 11: im.v1.ListContactsRequest
-	(*ListContactsResponse)(nil),  // 12: im.v1.ListContactsResponse
-	(*UserBrief)(nil),             // 13: im.v1.UserBrief
-	(*emptypb.Empty)(nil),         // 14: google.protobuf.Empty
+	(*DeviceInfo)(nil),                   // 0: im.v1.DeviceInfo
+	(*RegisterRequest)(nil),              // 1: im.v1.RegisterRequest
+	(*LoginRequest)(nil),                 // 2: im.v1.LoginRequest
+	(*RefreshRequest)(nil),               // 3: im.v1.RefreshRequest
+	(*LogoutRequest)(nil),                // 4: im.v1.LogoutRequest
+	(*AuthResponse)(nil),                 // 5: im.v1.AuthResponse
+	(*GetProfileRequest)(nil),            // 6: im.v1.GetProfileRequest
+	(*UpdateProfileRequest)(nil),         // 7: im.v1.UpdateProfileRequest
+	(*UserProfile)(nil),                  // 8: im.v1.UserProfile
+	(*ApplyContactRequest)(nil),          // 9: im.v1.ApplyContactRequest
+	(*RespondContactRequest)(nil),        // 10: im.v1.RespondContactRequest
+	(*RemoveContactRequest)(nil),         // 11: im.v1.RemoveContactRequest
+	(*BlacklistRequest)(nil),             // 12: im.v1.BlacklistRequest
+	(*ListContactsRequest)(nil),          // 13: im.v1.ListContactsRequest
+	(*ListContactsResponse)(nil),         // 14: im.v1.ListContactsResponse
+	(*BatchGetProfilesRequest)(nil),      // 15: im.v1.BatchGetProfilesRequest
+	(*BatchGetProfilesResponse)(nil),     // 16: im.v1.BatchGetProfilesResponse
+	(*MatchUsersByNameRequest)(nil),      // 17: im.v1.MatchUsersByNameRequest
+	(*MatchUsersByNameResponse)(nil),     // 18: im.v1.MatchUsersByNameResponse
+	(*CheckUserStatusRequest)(nil),       // 19: im.v1.CheckUserStatusRequest
+	(*CheckUserStatusResponse)(nil),      // 20: im.v1.CheckUserStatusResponse
+	(*Role)(nil),                         // 21: im.v1.Role
+	(*ListRolesResponse)(nil),            // 22: im.v1.ListRolesResponse
+	(*RoleRequest)(nil),                  // 23: im.v1.RoleRequest
+	(*DeleteRoleRequest)(nil),            // 24: im.v1.DeleteRoleRequest
+	(*ListUserRolesRequest)(nil),         // 25: im.v1.ListUserRolesRequest
+	(*UserRoleRequest)(nil),              // 26: im.v1.UserRoleRequest
+	(*BlockUserRequest)(nil),             // 27: im.v1.BlockUserRequest
+	(*UnblockUserRequest)(nil),           // 28: im.v1.UnblockUserRequest
+	(*Session)(nil),                      // 29: im.v1.Session
+	(*ListSessionsRequest)(nil),          // 30: im.v1.ListSessionsRequest
+	(*ListSessionsResponse)(nil),         // 31: im.v1.ListSessionsResponse
+	(*RevokeSessionRequest)(nil),         // 32: im.v1.RevokeSessionRequest
+	(*RevokeOtherSessionsRequest)(nil),   // 33: im.v1.RevokeOtherSessionsRequest
+	(*RevokeOtherSessionsResponse)(nil),  // 34: im.v1.RevokeOtherSessionsResponse
+	(*VerifyMFALoginRequest)(nil),        // 35: im.v1.VerifyMFALoginRequest
+	(*MFAStatus)(nil),                    // 36: im.v1.MFAStatus
+	(*EnrollTOTPResponse)(nil),           // 37: im.v1.EnrollTOTPResponse
+	(*MFACodeRequest)(nil),               // 38: im.v1.MFACodeRequest
+	(*RecoveryCodesResponse)(nil),        // 39: im.v1.RecoveryCodesResponse
+	(*StepUpMFAResponse)(nil),            // 40: im.v1.StepUpMFAResponse
+	(*ChangePasswordRequest)(nil),        // 41: im.v1.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),       // 42: im.v1.ChangePasswordResponse
+	(*RequestPasswordResetRequest)(nil),  // 43: im.v1.RequestPasswordResetRequest
+	(*RequestPasswordResetResponse)(nil), // 44: im.v1.RequestPasswordResetResponse
+	(*VerifyPasswordResetRequest)(nil),   // 45: im.v1.VerifyPasswordResetRequest
+	(*VerifyPasswordResetResponse)(nil),  // 46: im.v1.VerifyPasswordResetResponse
+	(*ResetPasswordRequest)(nil),         // 47: im.v1.ResetPasswordRequest
+	(*OIDCProvider)(nil),                 // 48: im.v1.OIDCProvider
+	(*ListOIDCProvidersResponse)(nil),    // 49: im.v1.ListOIDCProvidersResponse
+	(*BeginOIDCLoginRequest)(nil),        // 50: im.v1.BeginOIDCLoginRequest
+	(*BeginOIDCLoginResponse)(nil),       // 51: im.v1.BeginOIDCLoginResponse
+	(*CompleteOIDCLoginRequest)(nil),     // 52: im.v1.CompleteOIDCLoginRequest
+	(*SearchUserRequest)(nil),            // 53: im.v1.SearchUserRequest
+	(*ResolveShareCodeRequest)(nil),      // 54: im.v1.ResolveShareCodeRequest
+	(*ShareCodeResponse)(nil),            // 55: im.v1.ShareCodeResponse
+	(*PrivacySettings)(nil),              // 56: im.v1.PrivacySettings
+	(*UserBrief)(nil),                    // 57: im.v1.UserBrief
+	(*emptypb.Empty)(nil),                // 58: google.protobuf.Empty
 }
 var file_im_v1_identity_proto_depIdxs = []int32{
-	6,  // 0: im.v1.AuthResponse.profile:type_name -> im.v1.UserProfile
-	13, // 1: im.v1.UserProfile.user:type_name -> im.v1.UserBrief
-	13, // 2: im.v1.ListContactsResponse.contacts:type_name -> im.v1.UserBrief
-	0,  // 3: im.v1.IdentityService.Register:input_type -> im.v1.RegisterRequest
-	1,  // 4: im.v1.IdentityService.Login:input_type -> im.v1.LoginRequest
-	2,  // 5: im.v1.IdentityService.Refresh:input_type -> im.v1.RefreshRequest
-	4,  // 6: im.v1.IdentityService.GetProfile:input_type -> im.v1.GetProfileRequest
-	5,  // 7: im.v1.IdentityService.UpdateProfile:input_type -> im.v1.UpdateProfileRequest
-	7,  // 8: im.v1.IdentityService.ApplyContact:input_type -> im.v1.ApplyContactRequest
-	8,  // 9: im.v1.IdentityService.RespondContact:input_type -> im.v1.RespondContactRequest
-	9,  // 10: im.v1.IdentityService.RemoveContact:input_type -> im.v1.RemoveContactRequest
-	10, // 11: im.v1.IdentityService.AddToBlacklist:input_type -> im.v1.BlacklistRequest
-	10, // 12: im.v1.IdentityService.RemoveFromBlacklist:input_type -> im.v1.BlacklistRequest
-	11, // 13: im.v1.IdentityService.ListContacts:input_type -> im.v1.ListContactsRequest
-	3,  // 14: im.v1.IdentityService.Register:output_type -> im.v1.AuthResponse
-	3,  // 15: im.v1.IdentityService.Login:output_type -> im.v1.AuthResponse
-	3,  // 16: im.v1.IdentityService.Refresh:output_type -> im.v1.AuthResponse
-	6,  // 17: im.v1.IdentityService.GetProfile:output_type -> im.v1.UserProfile
-	6,  // 18: im.v1.IdentityService.UpdateProfile:output_type -> im.v1.UserProfile
-	14, // 19: im.v1.IdentityService.ApplyContact:output_type -> google.protobuf.Empty
-	14, // 20: im.v1.IdentityService.RespondContact:output_type -> google.protobuf.Empty
-	14, // 21: im.v1.IdentityService.RemoveContact:output_type -> google.protobuf.Empty
-	14, // 22: im.v1.IdentityService.AddToBlacklist:output_type -> google.protobuf.Empty
-	14, // 23: im.v1.IdentityService.RemoveFromBlacklist:output_type -> google.protobuf.Empty
-	12, // 24: im.v1.IdentityService.ListContacts:output_type -> im.v1.ListContactsResponse
-	14, // [14:25] is the sub-list for method output_type
-	3,  // [3:14] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	0,  // 0: im.v1.RegisterRequest.device:type_name -> im.v1.DeviceInfo
+	0,  // 1: im.v1.LoginRequest.device:type_name -> im.v1.DeviceInfo
+	0,  // 2: im.v1.RefreshRequest.device:type_name -> im.v1.DeviceInfo
+	8,  // 3: im.v1.AuthResponse.profile:type_name -> im.v1.UserProfile
+	57, // 4: im.v1.UserProfile.user:type_name -> im.v1.UserBrief
+	57, // 5: im.v1.ListContactsResponse.contacts:type_name -> im.v1.UserBrief
+	57, // 6: im.v1.BatchGetProfilesResponse.users:type_name -> im.v1.UserBrief
+	21, // 7: im.v1.ListRolesResponse.roles:type_name -> im.v1.Role
+	29, // 8: im.v1.ListSessionsResponse.sessions:type_name -> im.v1.Session
+	48, // 9: im.v1.ListOIDCProvidersResponse.providers:type_name -> im.v1.OIDCProvider
+	0,  // 10: im.v1.BeginOIDCLoginRequest.device:type_name -> im.v1.DeviceInfo
+	1,  // 11: im.v1.IdentityService.Register:input_type -> im.v1.RegisterRequest
+	2,  // 12: im.v1.IdentityService.Login:input_type -> im.v1.LoginRequest
+	3,  // 13: im.v1.IdentityService.Refresh:input_type -> im.v1.RefreshRequest
+	4,  // 14: im.v1.IdentityService.Logout:input_type -> im.v1.LogoutRequest
+	6,  // 15: im.v1.IdentityService.GetProfile:input_type -> im.v1.GetProfileRequest
+	7,  // 16: im.v1.IdentityService.UpdateProfile:input_type -> im.v1.UpdateProfileRequest
+	9,  // 17: im.v1.IdentityService.ApplyContact:input_type -> im.v1.ApplyContactRequest
+	10, // 18: im.v1.IdentityService.RespondContact:input_type -> im.v1.RespondContactRequest
+	11, // 19: im.v1.IdentityService.RemoveContact:input_type -> im.v1.RemoveContactRequest
+	12, // 20: im.v1.IdentityService.AddToBlacklist:input_type -> im.v1.BlacklistRequest
+	12, // 21: im.v1.IdentityService.RemoveFromBlacklist:input_type -> im.v1.BlacklistRequest
+	13, // 22: im.v1.IdentityService.ListContacts:input_type -> im.v1.ListContactsRequest
+	15, // 23: im.v1.IdentityService.BatchGetProfiles:input_type -> im.v1.BatchGetProfilesRequest
+	17, // 24: im.v1.IdentityService.MatchUsersByName:input_type -> im.v1.MatchUsersByNameRequest
+	19, // 25: im.v1.IdentityService.CheckUserStatus:input_type -> im.v1.CheckUserStatusRequest
+	58, // 26: im.v1.IdentityService.ListRoles:input_type -> google.protobuf.Empty
+	23, // 27: im.v1.IdentityService.CreateRole:input_type -> im.v1.RoleRequest
+	23, // 28: im.v1.IdentityService.UpdateRole:input_type -> im.v1.RoleRequest
+	24, // 29: im.v1.IdentityService.DeleteRole:input_type -> im.v1.DeleteRoleRequest
+	25, // 30: im.v1.IdentityService.ListUserRoles:input_type -> im.v1.ListUserRolesRequest
+	26, // 31: im.v1.IdentityService.AssignUserRole:input_type -> im.v1.UserRoleRequest
+	26, // 32: im.v1.IdentityService.RevokeUserRole:input_type -> im.v1.UserRoleRequest
+	27, // 33: im.v1.IdentityService.BlockUser:input_type -> im.v1.BlockUserRequest
+	28, // 34: im.v1.IdentityService.UnblockUser:input_type -> im.v1.UnblockUserRequest
+	30, // 35: im.v1.IdentityService.ListSessions:input_type -> im.v1.ListSessionsRequest
+	32, // 36: im.v1.IdentityService.RevokeSession:input_type -> im.v1.RevokeSessionRequest
+	33, // 37: im.v1.IdentityService.RevokeOtherSessions:input_type -> im.v1.RevokeOtherSessionsRequest
+	35, // 38: im.v1.IdentityService.VerifyMFALogin:input_type -> im.v1.VerifyMFALoginRequest
+	58, // 39: im.v1.IdentityService.GetMFAStatus:input_type -> google.protobuf.Empty
+	58, // 40: im.v1.IdentityService.EnrollTOTP:input_type -> google.protobuf.Empty
+	38, // 41: im.v1.IdentityService.ConfirmTOTP:input_type -> im.v1.MFACodeRequest
+	38, // 42: im.v1.IdentityService.DisableTOTP:input_type -> im.v1.MFACodeRequest
+	38, // 43: im.v1.IdentityService.RegenerateRecoveryCodes:input_type -> im.v1.MFACodeRequest
+	38, // 44: im.v1.IdentityService.StepUpMFA:input_type -> im.v1.MFACodeRequest
+	41, // 45: im.v1.IdentityService.ChangePassword:input_type -> im.v1.ChangePasswordRequest
+	43, // 46: im.v1.IdentityService.RequestPasswordReset:input_type -> im.v1.RequestPasswordResetRequest
+	45, // 47: im.v1.IdentityService.VerifyPasswordReset:input_type -> im.v1.VerifyPasswordResetRequest
+	47, // 48: im.v1.IdentityService.ResetPassword:input_type -> im.v1.ResetPasswordRequest
+	58, // 49: im.v1.IdentityService.ListOIDCProviders:input_type -> google.protobuf.Empty
+	50, // 50: im.v1.IdentityService.BeginOIDCLogin:input_type -> im.v1.BeginOIDCLoginRequest
+	52, // 51: im.v1.IdentityService.CompleteOIDCLogin:input_type -> im.v1.CompleteOIDCLoginRequest
+	53, // 52: im.v1.IdentityService.SearchUser:input_type -> im.v1.SearchUserRequest
+	54, // 53: im.v1.IdentityService.ResolveShareCode:input_type -> im.v1.ResolveShareCodeRequest
+	58, // 54: im.v1.IdentityService.GetShareCode:input_type -> google.protobuf.Empty
+	58, // 55: im.v1.IdentityService.ResetShareCode:input_type -> google.protobuf.Empty
+	58, // 56: im.v1.IdentityService.GetPrivacySettings:input_type -> google.protobuf.Empty
+	56, // 57: im.v1.IdentityService.UpdatePrivacySettings:input_type -> im.v1.PrivacySettings
+	5,  // 58: im.v1.IdentityService.Register:output_type -> im.v1.AuthResponse
+	5,  // 59: im.v1.IdentityService.Login:output_type -> im.v1.AuthResponse
+	5,  // 60: im.v1.IdentityService.Refresh:output_type -> im.v1.AuthResponse
+	58, // 61: im.v1.IdentityService.Logout:output_type -> google.protobuf.Empty
+	8,  // 62: im.v1.IdentityService.GetProfile:output_type -> im.v1.UserProfile
+	8,  // 63: im.v1.IdentityService.UpdateProfile:output_type -> im.v1.UserProfile
+	58, // 64: im.v1.IdentityService.ApplyContact:output_type -> google.protobuf.Empty
+	58, // 65: im.v1.IdentityService.RespondContact:output_type -> google.protobuf.Empty
+	58, // 66: im.v1.IdentityService.RemoveContact:output_type -> google.protobuf.Empty
+	58, // 67: im.v1.IdentityService.AddToBlacklist:output_type -> google.protobuf.Empty
+	58, // 68: im.v1.IdentityService.RemoveFromBlacklist:output_type -> google.protobuf.Empty
+	14, // 69: im.v1.IdentityService.ListContacts:output_type -> im.v1.ListContactsResponse
+	16, // 70: im.v1.IdentityService.BatchGetProfiles:output_type -> im.v1.BatchGetProfilesResponse
+	18, // 71: im.v1.IdentityService.MatchUsersByName:output_type -> im.v1.MatchUsersByNameResponse
+	20, // 72: im.v1.IdentityService.CheckUserStatus:output_type -> im.v1.CheckUserStatusResponse
+	22, // 73: im.v1.IdentityService.ListRoles:output_type -> im.v1.ListRolesResponse
+	21, // 74: im.v1.IdentityService.CreateRole:output_type -> im.v1.Role
+	21, // 75: im.v1.IdentityService.UpdateRole:output_type -> im.v1.Role
+	58, // 76: im.v1.IdentityService.DeleteRole:output_type -> google.protobuf.Empty
+	22, // 77: im.v1.IdentityService.ListUserRoles:output_type -> im.v1.ListRolesResponse
+	58, // 78: im.v1.IdentityService.AssignUserRole:output_type -> google.protobuf.Empty
+	58, // 79: im.v1.IdentityService.RevokeUserRole:output_type -> google.protobuf.Empty
+	58, // 80: im.v1.IdentityService.BlockUser:output_type -> google.protobuf.Empty
+	58, // 81: im.v1.IdentityService.UnblockUser:output_type -> google.protobuf.Empty
+	31, // 82: im.v1.IdentityService.ListSessions:output_type -> im.v1.ListSessionsResponse
+	58, // 83: im.v1.IdentityService.RevokeSession:output_type -> google.protobuf.Empty
+	34, // 84: im.v1.IdentityService.RevokeOtherSessions:output_type -> im.v1.RevokeOtherSessionsResponse
+	5,  // 85: im.v1.IdentityService.VerifyMFALogin:output_type -> im.v1.AuthResponse
+	36, // 86: im.v1.IdentityService.GetMFAStatus:output_type -> im.v1.MFAStatus
+	37, // 87: im.v1.IdentityService.EnrollTOTP:output_type -> im.v1.EnrollTOTPResponse
+	39, // 88: im.v1.IdentityService.ConfirmTOTP:output_type -> im.v1.RecoveryCodesResponse
+	58, // 89: im.v1.IdentityService.DisableTOTP:output_type -> google.protobuf.Empty
+	39, // 90: im.v1.IdentityService.RegenerateRecoveryCodes:output_type -> im.v1.RecoveryCodesResponse
+	40, // 91: im.v1.IdentityService.StepUpMFA:output_type -> im.v1.StepUpMFAResponse
+	42, // 92: im.v1.IdentityService.ChangePassword:output_type -> im.v1.ChangePasswordResponse
+	44, // 93: im.v1.IdentityService.RequestPasswordReset:output_type -> im.v1.RequestPasswordResetResponse
+	46, // 94: im.v1.IdentityService.VerifyPasswordReset:output_type -> im.v1.VerifyPasswordResetResponse
+	58, // 95: im.v1.IdentityService.ResetPassword:output_type -> google.protobuf.Empty
+	49, // 96: im.v1.IdentityService.ListOIDCProviders:output_type -> im.v1.ListOIDCProvidersResponse
+	51, // 97: im.v1.IdentityService.BeginOIDCLogin:output_type -> im.v1.BeginOIDCLoginResponse
+	5,  // 98: im.v1.IdentityService.CompleteOIDCLogin:output_type -> im.v1.AuthResponse
+	57, // 99: im.v1.IdentityService.SearchUser:output_type -> im.v1.UserBrief
+	57, // 100: im.v1.IdentityService.ResolveShareCode:output_type -> im.v1.UserBrief
+	55, // 101: im.v1.IdentityService.GetShareCode:output_type -> im.v1.ShareCodeResponse
+	55, // 102: im.v1.IdentityService.ResetShareCode:output_type -> im.v1.ShareCodeResponse
+	56, // 103: im.v1.IdentityService.GetPrivacySettings:output_type -> im.v1.PrivacySettings
+	56, // 104: im.v1.IdentityService.UpdatePrivacySettings:output_type -> im.v1.PrivacySettings
+	58, // [58:105] is the sub-list for method output_type
+	11, // [11:58] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_im_v1_identity_proto_init() }
@@ -824,7 +3514,7 @@ func file_im_v1_identity_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_im_v1_identity_proto_rawDesc), len(file_im_v1_identity_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   57,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -238,6 +238,50 @@ func (x *GetHistoryResponse) GetItems() []*MessageItem {
 	return nil
 }
 
+type GetMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     int64                  `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessageRequest) Reset() {
+	*x = GetMessageRequest{}
+	mi := &file_im_v1_message_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageRequest) ProtoMessage() {}
+
+func (x *GetMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_message_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageRequest.ProtoReflect.Descriptor instead.
+func (*GetMessageRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_message_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetMessageRequest) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
 type UpdateReadRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
@@ -248,7 +292,7 @@ type UpdateReadRequest struct {
 
 func (x *UpdateReadRequest) Reset() {
 	*x = UpdateReadRequest{}
-	mi := &file_im_v1_message_proto_msgTypes[4]
+	mi := &file_im_v1_message_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -260,7 +304,7 @@ func (x *UpdateReadRequest) String() string {
 func (*UpdateReadRequest) ProtoMessage() {}
 
 func (x *UpdateReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_im_v1_message_proto_msgTypes[4]
+	mi := &file_im_v1_message_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -273,7 +317,7 @@ func (x *UpdateReadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateReadRequest.ProtoReflect.Descriptor instead.
 func (*UpdateReadRequest) Descriptor() ([]byte, []int) {
-	return file_im_v1_message_proto_rawDescGZIP(), []int{4}
+	return file_im_v1_message_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UpdateReadRequest) GetConversationId() int64 {
@@ -290,6 +334,345 @@ func (x *UpdateReadRequest) GetReadSeq() int64 {
 	return 0
 }
 
+// channel_ids 为其中的频道会话，频道不写收件箱，未读数按最新序号与已读位置计算
+type BatchGetConversationSummariesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ConversationIds []int64                `protobuf:"varint,1,rep,packed,name=conversation_ids,json=conversationIds,proto3" json:"conversation_ids,omitempty"`
+	ChannelIds      []int64                `protobuf:"varint,2,rep,packed,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BatchGetConversationSummariesRequest) Reset() {
+	*x = BatchGetConversationSummariesRequest{}
+	mi := &file_im_v1_message_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetConversationSummariesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetConversationSummariesRequest) ProtoMessage() {}
+
+func (x *BatchGetConversationSummariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_message_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetConversationSummariesRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetConversationSummariesRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_message_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BatchGetConversationSummariesRequest) GetConversationIds() []int64 {
+	if x != nil {
+		return x.ConversationIds
+	}
+	return nil
+}
+
+func (x *BatchGetConversationSummariesRequest) GetChannelIds() []int64 {
+	if x != nil {
+		return x.ChannelIds
+	}
+	return nil
+}
+
+type ConversationSummary struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	LastMessage    *MessageItem           `protobuf:"bytes,2,opt,name=last_message,json=lastMessage,proto3" json:"last_message,omitempty"` // 会话暂无消息时为空
+	Preview        string                 `protobuf:"bytes,3,opt,name=preview,proto3" json:"preview,omitempty"`                            // 最后一条消息的文本预览，如 "[图片]"
+	UnreadCount    int32                  `protobuf:"varint,4,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	HasMention     bool                   `protobuf:"varint,5,opt,name=has_mention,json=hasMention,proto3" json:"has_mention,omitempty"` // 未读消息中是否有@我
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConversationSummary) Reset() {
+	*x = ConversationSummary{}
+	mi := &file_im_v1_message_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationSummary) ProtoMessage() {}
+
+func (x *ConversationSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_message_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationSummary.ProtoReflect.Descriptor instead.
+func (*ConversationSummary) Descriptor() ([]byte, []int) {
+	return file_im_v1_message_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ConversationSummary) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *ConversationSummary) GetLastMessage() *MessageItem {
+	if x != nil {
+		return x.LastMessage
+	}
+	return nil
+}
+
+func (x *ConversationSummary) GetPreview() string {
+	if x != nil {
+		return x.Preview
+	}
+	return ""
+}
+
+func (x *ConversationSummary) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *ConversationSummary) GetHasMention() bool {
+	if x != nil {
+		return x.HasMention
+	}
+	return false
+}
+
+// items 与请求中的 conversation_ids 顺序一致
+type BatchGetConversationSummariesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ConversationSummary `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetConversationSummariesResponse) Reset() {
+	*x = BatchGetConversationSummariesResponse{}
+	mi := &file_im_v1_message_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetConversationSummariesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetConversationSummariesResponse) ProtoMessage() {}
+
+func (x *BatchGetConversationSummariesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_message_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetConversationSummariesResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetConversationSummariesResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_message_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BatchGetConversationSummariesResponse) GetItems() []*ConversationSummary {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// channel_ids 含义同 BatchGetConversationSummariesRequest
+type BatchGetUnreadCountsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ConversationIds []int64                `protobuf:"varint,2,rep,packed,name=conversation_ids,json=conversationIds,proto3" json:"conversation_ids,omitempty"`
+	ChannelIds      []int64                `protobuf:"varint,3,rep,packed,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BatchGetUnreadCountsRequest) Reset() {
+	*x = BatchGetUnreadCountsRequest{}
+	mi := &file_im_v1_message_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetUnreadCountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetUnreadCountsRequest) ProtoMessage() {}
+
+func (x *BatchGetUnreadCountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_message_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetUnreadCountsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetUnreadCountsRequest) Descriptor() ([]byte, []int) {
+	return file_im_v1_message_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchGetUnreadCountsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BatchGetUnreadCountsRequest) GetConversationIds() []int64 {
+	if x != nil {
+		return x.ConversationIds
+	}
+	return nil
+}
+
+func (x *BatchGetUnreadCountsRequest) GetChannelIds() []int64 {
+	if x != nil {
+		return x.ChannelIds
+	}
+	return nil
+}
+
+type UnreadCount struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId int64                  `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UnreadCount    int32                  `protobuf:"varint,2,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	HasMention     bool                   `protobuf:"varint,3,opt,name=has_mention,json=hasMention,proto3" json:"has_mention,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UnreadCount) Reset() {
+	*x = UnreadCount{}
+	mi := &file_im_v1_message_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnreadCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreadCount) ProtoMessage() {}
+
+func (x *UnreadCount) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_message_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreadCount.ProtoReflect.Descriptor instead.
+func (*UnreadCount) Descriptor() ([]byte, []int) {
+	return file_im_v1_message_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UnreadCount) GetConversationId() int64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *UnreadCount) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *UnreadCount) GetHasMention() bool {
+	if x != nil {
+		return x.HasMention
+	}
+	return false
+}
+
+type BatchGetUnreadCountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*UnreadCount         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetUnreadCountsResponse) Reset() {
+	*x = BatchGetUnreadCountsResponse{}
+	mi := &file_im_v1_message_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetUnreadCountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetUnreadCountsResponse) ProtoMessage() {}
+
+func (x *BatchGetUnreadCountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_im_v1_message_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetUnreadCountsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetUnreadCountsResponse) Descriptor() ([]byte, []int) {
+	return file_im_v1_message_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchGetUnreadCountsResponse) GetItems() []*UnreadCount {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
 var File_im_v1_message_proto protoreflect.FileDescriptor
 
 const file_im_v1_message_proto_rawDesc = "" +
@@ -307,16 +690,48 @@ const file_im_v1_message_proto_rawDesc = "" +
 	"\tafter_seq\x18\x02 \x01(\x03R\bafterSeq\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\">\n" +
 	"\x12GetHistoryResponse\x12(\n" +
-	"\x05items\x18\x01 \x03(\v2\x12.im.v1.MessageItemR\x05items\"W\n" +
+	"\x05items\x18\x01 \x03(\v2\x12.im.v1.MessageItemR\x05items\"2\n" +
+	"\x11GetMessageRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\x03R\tmessageId\"W\n" +
 	"\x11UpdateReadRequest\x12'\n" +
 	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12\x19\n" +
-	"\bread_seq\x18\x02 \x01(\x03R\areadSeq2\xd9\x01\n" +
+	"\bread_seq\x18\x02 \x01(\x03R\areadSeq\"r\n" +
+	"$BatchGetConversationSummariesRequest\x12)\n" +
+	"\x10conversation_ids\x18\x01 \x03(\x03R\x0fconversationIds\x12\x1f\n" +
+	"\vchannel_ids\x18\x02 \x03(\x03R\n" +
+	"channelIds\"\xd3\x01\n" +
+	"\x13ConversationSummary\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x125\n" +
+	"\flast_message\x18\x02 \x01(\v2\x12.im.v1.MessageItemR\vlastMessage\x12\x18\n" +
+	"\apreview\x18\x03 \x01(\tR\apreview\x12!\n" +
+	"\funread_count\x18\x04 \x01(\x05R\vunreadCount\x12\x1f\n" +
+	"\vhas_mention\x18\x05 \x01(\bR\n" +
+	"hasMention\"Y\n" +
+	"%BatchGetConversationSummariesResponse\x120\n" +
+	"\x05items\x18\x01 \x03(\v2\x1a.im.v1.ConversationSummaryR\x05items\"\x82\x01\n" +
+	"\x1bBatchGetUnreadCountsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12)\n" +
+	"\x10conversation_ids\x18\x02 \x03(\x03R\x0fconversationIds\x12\x1f\n" +
+	"\vchannel_ids\x18\x03 \x03(\x03R\n" +
+	"channelIds\"z\n" +
+	"\vUnreadCount\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x03R\x0econversationId\x12!\n" +
+	"\funread_count\x18\x02 \x01(\x05R\vunreadCount\x12\x1f\n" +
+	"\vhas_mention\x18\x03 \x01(\bR\n" +
+	"hasMention\"H\n" +
+	"\x1cBatchGetUnreadCountsResponse\x12(\n" +
+	"\x05items\x18\x01 \x03(\v2\x12.im.v1.UnreadCountR\x05items2\xf2\x03\n" +
 	"\x0eMessageService\x12D\n" +
 	"\vSendMessage\x12\x19.im.v1.SendMessageRequest\x1a\x1a.im.v1.SendMessageResponse\x12A\n" +
 	"\n" +
 	"GetHistory\x12\x18.im.v1.GetHistoryRequest\x1a\x19.im.v1.GetHistoryResponse\x12>\n" +
 	"\n" +
-	"UpdateRead\x12\x18.im.v1.UpdateReadRequest\x1a\x16.google.protobuf.EmptyB*Z(github.com/EthanQC/IM/api/gen/im/v1;imv1b\x06proto3"
+	"UpdateRead\x12\x18.im.v1.UpdateReadRequest\x1a\x16.google.protobuf.Empty\x12z\n" +
+	"\x1dBatchGetConversationSummaries\x12+.im.v1.BatchGetConversationSummariesRequest\x1a,.im.v1.BatchGetConversationSummariesResponse\x12:\n" +
+	"\n" +
+	"GetMessage\x12\x18.im.v1.GetMessageRequest\x1a\x12.im.v1.MessageItem\x12_\n" +
+	"\x14BatchGetUnreadCounts\x12\".im.v1.BatchGetUnreadCountsRequest\x1a#.im.v1.BatchGetUnreadCountsResponseB*Z(github.com/EthanQC/IM/api/gen/im/v1;imv1b\x06proto3"
 
 var (
 	file_im_v1_message_proto_rawDescOnce sync.Once
@@ -330,34 +745,50 @@ func file_im_v1_message_proto_rawDescGZIP() []byte {
 	return file_im_v1_message_proto_rawDescData
 }
 
-var file_im_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_im_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_im_v1_message_proto_goTypes = []any{
-	(*SendMessageRequest)(nil),  // 0: im.v1.SendMessageRequest
-	(*SendMessageResponse)(nil), // 1: im.v1.SendMessageResponse
-	(*GetHistoryRequest)(nil),   // 2: im.v1.GetHistoryRequest
-	(*GetHistoryResponse)(nil),  // 3: im.v1.GetHistoryResponse
-	(*UpdateReadRequest)(nil),   // 4: im.v1.UpdateReadRequest
-	(MessageContentType)(0),     // 5: im.v1.MessageContentType
-	(*MessageBody)(nil),         // 6: im.v1.MessageBody
-	(*MessageItem)(nil),         // 7: im.v1.MessageItem
-	(*emptypb.Empty)(nil),       // 8: google.protobuf.Empty
+	(*SendMessageRequest)(nil),                    // 0: im.v1.SendMessageRequest
+	(*SendMessageResponse)(nil),                   // 1: im.v1.SendMessageResponse
+	(*GetHistoryRequest)(nil),                     // 2: im.v1.GetHistoryRequest
+	(*GetHistoryResponse)(nil),                    // 3: im.v1.GetHistoryResponse
+	(*GetMessageRequest)(nil),                     // 4: im.v1.GetMessageRequest
+	(*UpdateReadRequest)(nil),                     // 5: im.v1.UpdateReadRequest
+	(*BatchGetConversationSummariesRequest)(nil),  // 6: im.v1.BatchGetConversationSummariesRequest
+	(*ConversationSummary)(nil),                   // 7: im.v1.ConversationSummary
+	(*BatchGetConversationSummariesResponse)(nil), // 8: im.v1.BatchGetConversationSummariesResponse
+	(*BatchGetUnreadCountsRequest)(nil),           // 9: im.v1.BatchGetUnreadCountsRequest
+	(*UnreadCount)(nil),                           // 10: im.v1.UnreadCount
+	(*BatchGetUnreadCountsResponse)(nil),          // 11: im.v1.BatchGetUnreadCountsResponse
+	(MessageContentType)(0),                       // 12: im.v1.MessageContentType
+	(*MessageBody)(nil),                           // 13: im.v1.MessageBody
+	(*MessageItem)(nil),                           // 14: im.v1.MessageItem
+	(*emptypb.Empty)(nil),                         // 15: google.protobuf.Empty
 }
 var file_im_v1_message_proto_depIdxs = []int32{
-	5, // 0: im.v1.SendMessageRequest.content_type:type_name -> im.v1.MessageContentType
-	6, // 1: im.v1.SendMessageRequest.body:type_name -> im.v1.MessageBody
-	7, // 2: im.v1.SendMessageResponse.message:type_name -> im.v1.MessageItem
-	7, // 3: im.v1.GetHistoryResponse.items:type_name -> im.v1.MessageItem
-	0, // 4: im.v1.MessageService.SendMessage:input_type -> im.v1.SendMessageRequest
-	2, // 5: im.v1.MessageService.GetHistory:input_type -> im.v1.GetHistoryRequest
-	4, // 6: im.v1.MessageService.UpdateRead:input_type -> im.v1.UpdateReadRequest
-	1, // 7: im.v1.MessageService.SendMessage:output_type -> im.v1.SendMessageResponse
-	3, // 8: im.v1.MessageService.GetHistory:output_type -> im.v1.GetHistoryResponse
-	8, // 9: im.v1.MessageService.UpdateRead:output_type -> google.protobuf.Empty
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	12, // 0: im.v1.SendMessageRequest.content_type:type_name -> im.v1.MessageContentType
+	13, // 1: im.v1.SendMessageRequest.body:type_name -> im.v1.MessageBody
+	14, // 2: im.v1.SendMessageResponse.message:type_name -> im.v1.MessageItem
+	14, // 3: im.v1.GetHistoryResponse.items:type_name -> im.v1.MessageItem
+	14, // 4: im.v1.ConversationSummary.last_message:type_name -> im.v1.MessageItem
+	7,  // 5: im.v1.BatchGetConversationSummariesResponse.items:type_name -> im.v1.ConversationSummary
+	10, // 6: im.v1.BatchGetUnreadCountsResponse.items:type_name -> im.v1.UnreadCount
+	0,  // 7: im.v1.MessageService.SendMessage:input_type -> im.v1.SendMessageRequest
+	2,  // 8: im.v1.MessageService.GetHistory:input_type -> im.v1.GetHistoryRequest
+	5,  // 9: im.v1.MessageService.UpdateRead:input_type -> im.v1.UpdateReadRequest
+	6,  // 10: im.v1.MessageService.BatchGetConversationSummaries:input_type -> im.v1.BatchGetConversationSummariesRequest
+	4,  // 11: im.v1.MessageService.GetMessage:input_type -> im.v1.GetMessageRequest
+	9,  // 12: im.v1.MessageService.BatchGetUnreadCounts:input_type -> im.v1.BatchGetUnreadCountsRequest
+	1,  // 13: im.v1.MessageService.SendMessage:output_type -> im.v1.SendMessageResponse
+	3,  // 14: im.v1.MessageService.GetHistory:output_type -> im.v1.GetHistoryResponse
+	15, // 15: im.v1.MessageService.UpdateRead:output_type -> google.protobuf.Empty
+	8,  // 16: im.v1.MessageService.BatchGetConversationSummaries:output_type -> im.v1.BatchGetConversationSummariesResponse
+	14, // 17: im.v1.MessageService.GetMessage:output_type -> im.v1.MessageItem
+	11, // 18: im.v1.MessageService.BatchGetUnreadCounts:output_type -> im.v1.BatchGetUnreadCountsResponse
+	13, // [13:19] is the sub-list for method output_type
+	7,  // [7:13] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_im_v1_message_proto_init() }
@@ -372,7 +803,7 @@ func file_im_v1_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_im_v1_message_proto_rawDesc), len(file_im_v1_message_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
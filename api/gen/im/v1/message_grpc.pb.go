@@ -20,9 +20,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MessageService_SendMessage_FullMethodName = "/im.v1.MessageService/SendMessage"
-	MessageService_GetHistory_FullMethodName  = "/im.v1.MessageService/GetHistory"
-	MessageService_UpdateRead_FullMethodName  = "/im.v1.MessageService/UpdateRead"
+	MessageService_SendMessage_FullMethodName                   = "/im.v1.MessageService/SendMessage"
+	MessageService_GetHistory_FullMethodName                    = "/im.v1.MessageService/GetHistory"
+	MessageService_UpdateRead_FullMethodName                    = "/im.v1.MessageService/UpdateRead"
+	MessageService_BatchGetConversationSummaries_FullMethodName = "/im.v1.MessageService/BatchGetConversationSummaries"
+	MessageService_GetMessage_FullMethodName                    = "/im.v1.MessageService/GetMessage"
+	MessageService_BatchGetUnreadCounts_FullMethodName          = "/im.v1.MessageService/BatchGetUnreadCounts"
 )
 
 // MessageServiceClient is the client API for MessageService service.
@@ -32,6 +35,13 @@ type MessageServiceClient interface {
 	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error)
 	GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error)
 	UpdateRead(ctx context.Context, in *UpdateReadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 批量获取当前用户的会话摘要（最后一条消息、未读数、@提醒）
+	BatchGetConversationSummaries(ctx context.Context, in *BatchGetConversationSummariesRequest, opts ...grpc.CallOption) (*BatchGetConversationSummariesResponse, error)
+	// 获取单条消息（供其他服务内部校验），需在 metadata 中携带 user_id
+	// 调用者不是会话成员，或消息已撤回、删除、过期时返回 NOT_FOUND
+	GetMessage(ctx context.Context, in *GetMessageRequest, opts ...grpc.CallOption) (*MessageItem, error)
+	// 批量获取用户的未读数（供 conversation_service 统计文件夹未读），只返回有未读的会话
+	BatchGetUnreadCounts(ctx context.Context, in *BatchGetUnreadCountsRequest, opts ...grpc.CallOption) (*BatchGetUnreadCountsResponse, error)
 }
 
 type messageServiceClient struct {
@@ -72,6 +82,36 @@ func (c *messageServiceClient) UpdateRead(ctx context.Context, in *UpdateReadReq
 	return out, nil
 }
 
+func (c *messageServiceClient) BatchGetConversationSummaries(ctx context.Context, in *BatchGetConversationSummariesRequest, opts ...grpc.CallOption) (*BatchGetConversationSummariesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetConversationSummariesResponse)
+	err := c.cc.Invoke(ctx, MessageService_BatchGetConversationSummaries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) GetMessage(ctx context.Context, in *GetMessageRequest, opts ...grpc.CallOption) (*MessageItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageItem)
+	err := c.cc.Invoke(ctx, MessageService_GetMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) BatchGetUnreadCounts(ctx context.Context, in *BatchGetUnreadCountsRequest, opts ...grpc.CallOption) (*BatchGetUnreadCountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetUnreadCountsResponse)
+	err := c.cc.Invoke(ctx, MessageService_BatchGetUnreadCounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MessageServiceServer is the server API for MessageService service.
 // All implementations must embed UnimplementedMessageServiceServer
 // for forward compatibility.
@@ -79,6 +119,13 @@ type MessageServiceServer interface {
 	SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error)
 	GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error)
 	UpdateRead(context.Context, *UpdateReadRequest) (*emptypb.Empty, error)
+	// 批量获取当前用户的会话摘要（最后一条消息、未读数、@提醒）
+	BatchGetConversationSummaries(context.Context, *BatchGetConversationSummariesRequest) (*BatchGetConversationSummariesResponse, error)
+	// 获取单条消息（供其他服务内部校验），需在 metadata 中携带 user_id
+	// 调用者不是会话成员，或消息已撤回、删除、过期时返回 NOT_FOUND
+	GetMessage(context.Context, *GetMessageRequest) (*MessageItem, error)
+	// 批量获取用户的未读数（供 conversation_service 统计文件夹未读），只返回有未读的会话
+	BatchGetUnreadCounts(context.Context, *BatchGetUnreadCountsRequest) (*BatchGetUnreadCountsResponse, error)
 	mustEmbedUnimplementedMessageServiceServer()
 }
 
@@ -98,6 +145,15 @@ func (UnimplementedMessageServiceServer) GetHistory(context.Context, *GetHistory
 func (UnimplementedMessageServiceServer) UpdateRead(context.Context, *UpdateReadRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateRead not implemented")
 }
+func (UnimplementedMessageServiceServer) BatchGetConversationSummaries(context.Context, *BatchGetConversationSummariesRequest) (*BatchGetConversationSummariesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchGetConversationSummaries not implemented")
+}
+func (UnimplementedMessageServiceServer) GetMessage(context.Context, *GetMessageRequest) (*MessageItem, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMessage not implemented")
+}
+func (UnimplementedMessageServiceServer) BatchGetUnreadCounts(context.Context, *BatchGetUnreadCountsRequest) (*BatchGetUnreadCountsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchGetUnreadCounts not implemented")
+}
 func (UnimplementedMessageServiceServer) mustEmbedUnimplementedMessageServiceServer() {}
 func (UnimplementedMessageServiceServer) testEmbeddedByValue()                        {}
 
@@ -173,6 +229,60 @@ func _MessageService_UpdateRead_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MessageService_BatchGetConversationSummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetConversationSummariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).BatchGetConversationSummaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_BatchGetConversationSummaries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).BatchGetConversationSummaries(ctx, req.(*BatchGetConversationSummariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_GetMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).GetMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_GetMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).GetMessage(ctx, req.(*GetMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_BatchGetUnreadCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetUnreadCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).BatchGetUnreadCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_BatchGetUnreadCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).BatchGetUnreadCounts(ctx, req.(*BatchGetUnreadCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MessageService_ServiceDesc is the grpc.ServiceDesc for MessageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -192,6 +302,18 @@ var MessageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateRead",
 			Handler:    _MessageService_UpdateRead_Handler,
 		},
+		{
+			MethodName: "BatchGetConversationSummaries",
+			Handler:    _MessageService_BatchGetConversationSummaries_Handler,
+		},
+		{
+			MethodName: "GetMessage",
+			Handler:    _MessageService_GetMessage_Handler,
+		},
+		{
+			MethodName: "BatchGetUnreadCounts",
+			Handler:    _MessageService_BatchGetUnreadCounts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "im/v1/message.proto",
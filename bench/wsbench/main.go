@@ -10,6 +10,7 @@ import (
 	"os"
 	"os/signal"
 	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"syscall"
@@ -32,6 +33,7 @@ type Config struct {
 	AuthMode     string        // none, token, user-file
 	TokenFile    string        // Token 文件路径
 	UserFile     string        // 用户文件路径
+	Tokens       []string      // 从 TokenFile 读取的访问令牌，按连接序号轮流使用
 	Output       string        // 输出格式：text, json
 	Verbose      bool          // 详细输出
 
@@ -137,6 +139,14 @@ type Conn struct {
 
 func main() {
 	cfg := parseFlags()
+	if cfg.AuthMode == "token" {
+		tokens, err := loadTokens(cfg.TokenFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "加载 token 文件失败: %v\n", err)
+			os.Exit(1)
+		}
+		cfg.Tokens = tokens
+	}
 
 	fmt.Println("=== wsbench - WebSocket 压测工具 ===")
 	fmt.Printf("模式: %s\n", cfg.Mode)
@@ -218,6 +228,24 @@ func parseFlags() Config {
 	return cfg
 }
 
+// loadTokens 读取 token 文件，每行一个访问令牌
+func loadTokens(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var tokens []string
+	for _, line := range strings.Split(string(data), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			tokens = append(tokens, line)
+		}
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("no token in %s", path)
+	}
+	return tokens, nil
+}
+
 func runBench(ctx context.Context, cfg Config, stats *Stats) {
 	var wg sync.WaitGroup
 	connCh := make(chan *Conn, cfg.Conns)
@@ -418,8 +446,14 @@ func createConnection(ctx context.Context, id int, cfg Config, stats *Stats, isR
 
 	start := time.Now()
 
-	// 构建 URL
-	url := fmt.Sprintf("%s?user_id=%d&device_id=bench_%d&platform=bench", cfg.Target, 100000+id, id)
+	// 构建 URL；token 模式走 Authorization 头，none 模式依赖服务端开启 auth.allow_query_user_id
+	url := fmt.Sprintf("%s?device_id=bench_%d&platform=bench", cfg.Target, id)
+	header := http.Header{}
+	if len(cfg.Tokens) > 0 {
+		header.Set("Authorization", "Bearer "+cfg.Tokens[id%len(cfg.Tokens)])
+	} else {
+		url += fmt.Sprintf("&user_id=%d", 100000+id)
+	}
 
 	// 创建 dialer - 优化缓冲区和超时
 	dialer := websocket.Dialer{
@@ -430,7 +464,6 @@ func createConnection(ctx context.Context, id int, cfg Config, stats *Stats, isR
 	}
 
 	// 连接
-	header := http.Header{}
 	ws, resp, err := dialer.DialContext(ctx, url, header)
 	if err != nil {
 		if !isRetry {
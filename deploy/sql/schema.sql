@@ -87,6 +87,9 @@ CREATE TABLE IF NOT EXISTS user_block_statuses (
 CREATE TABLE IF NOT EXISTS refresh_tokens (
     id VARCHAR(64) PRIMARY KEY COMMENT 'token id/jti',
     user_id VARCHAR(64) NOT NULL COMMENT '用户ID',
+    session_id VARCHAR(64) NOT NULL DEFAULT '' COMMENT '所属设备会话',
+    family_id VARCHAR(64) NOT NULL DEFAULT '' COMMENT '令牌族ID，轮换产生的令牌共享，取首个令牌的 id',
+    replaced_by VARCHAR(64) NOT NULL DEFAULT '' COMMENT '轮换后替代它的令牌 id，非空表示已轮换',
     access_token TEXT DEFAULT NULL COMMENT '最新 access token',
     refresh_token TEXT NOT NULL COMMENT '刷新令牌',
     refresh_expires_at TIMESTAMP NOT NULL COMMENT '刷新令牌过期时间',
@@ -94,9 +97,99 @@ CREATE TABLE IF NOT EXISTS refresh_tokens (
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     KEY idx_user (user_id),
+    KEY idx_session (session_id, created_at),
+    KEY idx_family (family_id),
     KEY idx_refresh_exp (refresh_expires_at)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='刷新令牌表';
 
+-- 令牌签名密钥（RS256/EdDSA，按 kid 轮换，公钥经 JWKS 发布）
+CREATE TABLE IF NOT EXISTS signing_keys (
+    kid VARCHAR(64) PRIMARY KEY COMMENT '密钥ID，写入令牌 header',
+    algorithm VARCHAR(16) NOT NULL COMMENT 'RS256/EdDSA',
+    private_key TEXT NOT NULL COMMENT 'KEK 加密的 PKCS#8 PEM 私钥(kek:<kek_id>:<base64>)',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '发布时间，激活延迟后开始签发',
+    retired_at TIMESTAMP NULL DEFAULT NULL COMMENT '退役时间，之后仅用于验签',
+    expires_at TIMESTAMP NULL DEFAULT NULL COMMENT '退役密钥删除时间',
+    KEY idx_expires (expires_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='令牌签名密钥表';
+
+-- 角色表（permissions 为 JSON 数组，形如 ["Group:Manage"]；admin/user 为系统角色，启动时写入）
+CREATE TABLE IF NOT EXISTS roles (
+    name VARCHAR(32) PRIMARY KEY COMMENT '角色名',
+    description VARCHAR(255) NOT NULL DEFAULT '' COMMENT '角色说明',
+    permissions TEXT NOT NULL COMMENT '权限列表(JSON)',
+    is_system TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否系统角色: 0=否,1=是',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='角色表';
+
+-- 用户角色分配表（默认 user 角色不落库）
+CREATE TABLE IF NOT EXISTS user_roles (
+    user_id BIGINT UNSIGNED NOT NULL COMMENT '用户ID',
+    role_name VARCHAR(32) NOT NULL COMMENT '角色名',
+    granted_by BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '授权人ID，0=系统初始化',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, role_name),
+    KEY idx_role (role_name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='用户角色分配表';
+
+-- 设备会话（每次登录一条，刷新令牌沿用同一会话）
+CREATE TABLE IF NOT EXISTS user_sessions (
+    id VARCHAR(64) PRIMARY KEY COMMENT '会话ID',
+    user_id VARCHAR(64) NOT NULL COMMENT '用户ID',
+    device_id VARCHAR(128) NOT NULL COMMENT '设备标识，客户端未上报时由平台与 UA 生成',
+    platform VARCHAR(32) NOT NULL DEFAULT '' COMMENT '平台: web/ios/android/...',
+    ip VARCHAR(64) NOT NULL DEFAULT '' COMMENT '最近一次登录/刷新的 IP',
+    user_agent VARCHAR(512) NOT NULL DEFAULT '' COMMENT 'User-Agent',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_seen_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '最近活跃时间',
+    expires_at TIMESTAMP NOT NULL COMMENT '刷新令牌过期时间',
+    revoked_at TIMESTAMP NULL DEFAULT NULL COMMENT '撤销时间',
+    KEY idx_user_device (user_id, device_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='设备会话表';
+
+-- 两步验证（TOTP 密钥与一次性恢复码）
+CREATE TABLE IF NOT EXISTS user_mfa (
+    user_id BIGINT UNSIGNED PRIMARY KEY COMMENT '用户ID',
+    secret VARCHAR(64) NOT NULL COMMENT 'TOTP 密钥(Base32)',
+    enabled TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否已确认启用',
+    last_used_step BIGINT NOT NULL DEFAULT 0 COMMENT '最近一次通过校验的时间步，防止验证码重放',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    enabled_at TIMESTAMP NULL DEFAULT NULL COMMENT '启用时间',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='两步验证表';
+
+-- 两步验证恢复码，每条一行，使用时按摘要删除
+CREATE TABLE IF NOT EXISTS user_mfa_recovery_codes (
+    user_id BIGINT UNSIGNED NOT NULL COMMENT '用户ID',
+    code_hash CHAR(64) NOT NULL COMMENT '恢复码 SHA-256 摘要',
+    PRIMARY KEY (user_id, code_hash)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='两步验证恢复码表';
+
+-- 第三方 / 企业 OIDC 身份绑定
+CREATE TABLE IF NOT EXISTS user_identities (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    provider VARCHAR(64) NOT NULL COMMENT '提供方名称，对应配置中的 name',
+    subject VARCHAR(255) NOT NULL COMMENT '提供方用户标识(ID Token sub)',
+    user_id BIGINT UNSIGNED NOT NULL COMMENT '绑定的本地用户ID',
+    email VARCHAR(255) NOT NULL DEFAULT '' COMMENT '首次绑定时提供方已验证的邮箱',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_login_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '最近一次通过该身份登录的时间',
+    UNIQUE KEY uk_provider_subject (provider, subject),
+    KEY idx_user (user_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='用户第三方身份表';
+
+-- 用户隐私设置与名片码（未保存设置的用户使用默认值）
+CREATE TABLE IF NOT EXISTS user_privacy (
+    user_id BIGINT UNSIGNED PRIMARY KEY COMMENT '用户ID',
+    allow_find_by_username TINYINT(1) NOT NULL DEFAULT 1 COMMENT '允许按用户名搜索',
+    allow_find_by_phone TINYINT(1) NOT NULL DEFAULT 1 COMMENT '允许按手机号搜索',
+    allow_find_by_email TINYINT(1) NOT NULL DEFAULT 0 COMMENT '允许按邮箱搜索',
+    share_code VARCHAR(16) NULL DEFAULT NULL COMMENT '名片码，重置后旧码失效',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_share_code (share_code)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='用户隐私设置表';
+
 -- ============================================
 -- 会话域 (Conversation Service)
 -- ============================================
@@ -104,14 +197,16 @@ CREATE TABLE IF NOT EXISTS refresh_tokens (
 -- 会话表
 CREATE TABLE IF NOT EXISTS conversations (
     id BIGINT UNSIGNED PRIMARY KEY AUTO_INCREMENT,
-    type TINYINT NOT NULL COMMENT '会话类型: 1=单聊,2=群聊',
+    type TINYINT NOT NULL COMMENT '会话类型: 1=单聊,2=群聊,3=频道',
     title VARCHAR(128) DEFAULT NULL COMMENT '会话标题(群聊名称)',
     avatar_url VARCHAR(512) DEFAULT NULL COMMENT '会话头像(群头像)',
     owner_id BIGINT UNSIGNED DEFAULT NULL COMMENT '群主ID(群聊时有效)',
     member_limit INT DEFAULT 500 COMMENT '成员上限',
     join_mode TINYINT NOT NULL DEFAULT 0 COMMENT '加入方式: 0=需要审批,1=自由加入',
     mute_all TINYINT NOT NULL DEFAULT 0 COMMENT '是否全员禁言: 0=否,1=是',
+    message_ttl INT NOT NULL DEFAULT 0 COMMENT '消息自动删除时长(秒),0=关闭',
     status TINYINT NOT NULL DEFAULT 1 COMMENT '会话状态: 0=已解散,1=正常',
+    last_message_at TIMESTAMP NULL DEFAULT NULL COMMENT '最后消息时间(会话列表排序)',
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     KEY idx_owner (owner_id),
@@ -127,6 +222,7 @@ CREATE TABLE IF NOT EXISTS participants (
     nickname VARCHAR(64) DEFAULT NULL COMMENT '群内昵称',
     muted TINYINT NOT NULL DEFAULT 0 COMMENT '是否禁言: 0=否,1=是',
     muted_until TIMESTAMP NULL DEFAULT NULL COMMENT '禁言截止时间',
+    invite_id BIGINT UNSIGNED DEFAULT NULL COMMENT '加入时使用的邀请ID',
     joined_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     last_read_seq BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '最后已读消息序号',
     UNIQUE KEY uk_conv_user (conversation_id, user_id),
@@ -142,8 +238,10 @@ CREATE TABLE IF NOT EXISTS group_join_requests (
     conversation_id BIGINT UNSIGNED NOT NULL,
     user_id BIGINT UNSIGNED NOT NULL COMMENT '申请人ID',
     inviter_id BIGINT UNSIGNED DEFAULT NULL COMMENT '邀请人ID(如果是邀请)',
+    invite_id BIGINT UNSIGNED DEFAULT NULL COMMENT '邀请链接ID(如果是邀请)',
     message VARCHAR(255) DEFAULT NULL COMMENT '申请理由',
     status TINYINT NOT NULL DEFAULT 0 COMMENT '状态: 0=待审批,1=已同意,2=已拒绝',
+    handler_id BIGINT UNSIGNED DEFAULT NULL COMMENT '处理人ID',
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     KEY idx_conv (conversation_id),
@@ -153,6 +251,86 @@ CREATE TABLE IF NOT EXISTS group_join_requests (
     CONSTRAINT fk_join_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='群组加入申请表';
 
+-- 群邀请链接表
+CREATE TABLE IF NOT EXISTS group_invites (
+    id BIGINT UNSIGNED PRIMARY KEY AUTO_INCREMENT,
+    conversation_id BIGINT UNSIGNED NOT NULL,
+    code VARCHAR(32) NOT NULL COMMENT '邀请码',
+    creator_id BIGINT UNSIGNED NOT NULL COMMENT '创建人ID',
+    expires_at TIMESTAMP NULL DEFAULT NULL COMMENT '过期时间,NULL=永不过期',
+    max_uses INT NOT NULL DEFAULT 0 COMMENT '最大使用次数,0=不限',
+    used_count INT NOT NULL DEFAULT 0 COMMENT '已使用次数',
+    revoked TINYINT NOT NULL DEFAULT 0 COMMENT '是否已撤销: 0=否,1=是',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_code (code),
+    KEY idx_conv (conversation_id),
+    CONSTRAINT fk_invite_conv FOREIGN KEY (conversation_id) REFERENCES conversations(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='群邀请链接表';
+
+-- 用户会话设置表(置顶、免打扰、归档、备注)
+-- 免打扰和置顶状态通过 conversation.setting_updated 事件同步到消息域的 inbox 表
+CREATE TABLE IF NOT EXISTS conversation_settings (
+    user_id BIGINT UNSIGNED NOT NULL,
+    conversation_id BIGINT UNSIGNED NOT NULL,
+    pinned_at TIMESTAMP NULL DEFAULT NULL COMMENT '置顶时间,NULL=未置顶(置顶会话按此倒序)',
+    is_muted TINYINT NOT NULL DEFAULT 0 COMMENT '是否免打扰: 0=否,1=是',
+    mute_until TIMESTAMP NULL DEFAULT NULL COMMENT '免打扰截止时间(为空表示永久)',
+    is_archived TINYINT NOT NULL DEFAULT 0 COMMENT '是否归档: 0=否,1=是',
+    remark VARCHAR(64) DEFAULT NULL COMMENT '会话备注名',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, conversation_id),
+    KEY idx_conv_user (conversation_id, user_id),
+    CONSTRAINT fk_setting_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_setting_conv FOREIGN KEY (conversation_id) REFERENCES conversations(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='用户会话设置表';
+
+-- 群公告表(每个会话一条)
+CREATE TABLE IF NOT EXISTS conversation_announcements (
+    conversation_id BIGINT UNSIGNED PRIMARY KEY,
+    content TEXT NOT NULL COMMENT '公告内容',
+    editor_id BIGINT UNSIGNED NOT NULL COMMENT '最后编辑人ID',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    CONSTRAINT fk_announcement_conv FOREIGN KEY (conversation_id) REFERENCES conversations(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='群公告表';
+
+-- 会话置顶消息表
+CREATE TABLE IF NOT EXISTS conversation_pinned_messages (
+    conversation_id BIGINT UNSIGNED NOT NULL,
+    message_id BIGINT UNSIGNED NOT NULL,
+    pinned_by BIGINT UNSIGNED NOT NULL COMMENT '置顶操作人ID',
+    pinned_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (conversation_id, message_id),
+    KEY idx_conv_pinned (conversation_id, pinned_at),
+    CONSTRAINT fk_pinned_conv FOREIGN KEY (conversation_id) REFERENCES conversations(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='会话置顶消息表';
+
+-- 会话文件夹表(按用户保存)
+CREATE TABLE IF NOT EXISTS conversation_folders (
+    id BIGINT UNSIGNED PRIMARY KEY AUTO_INCREMENT,
+    user_id BIGINT UNSIGNED NOT NULL,
+    name VARCHAR(64) NOT NULL COMMENT '文件夹名称',
+    sort_order INT NOT NULL DEFAULT 0 COMMENT '排序值,越小越靠前',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_user_name (user_id, name),
+    KEY idx_user_sort (user_id, sort_order),
+    CONSTRAINT fk_folder_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='会话文件夹表';
+
+-- 文件夹会话归属表
+CREATE TABLE IF NOT EXISTS conversation_folder_items (
+    folder_id BIGINT UNSIGNED NOT NULL,
+    conversation_id BIGINT UNSIGNED NOT NULL,
+    user_id BIGINT UNSIGNED NOT NULL COMMENT '文件夹所属用户',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (folder_id, conversation_id),
+    KEY idx_user_conv (user_id, conversation_id),
+    CONSTRAINT fk_folder_item_folder FOREIGN KEY (folder_id) REFERENCES conversation_folders(id) ON DELETE CASCADE,
+    CONSTRAINT fk_folder_item_conv FOREIGN KEY (conversation_id) REFERENCES conversations(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='文件夹会话归属表';
+
 -- ============================================
 -- 消息域 (Message Service)
 -- ============================================
@@ -176,6 +354,7 @@ CREATE TABLE IF NOT EXISTS messages (
     content JSON NOT NULL COMMENT '消息内容JSON',
     status TINYINT NOT NULL DEFAULT 1 COMMENT '消息状态: 0=已撤回,1=正常,2=已删除',
     reply_to_msg_id BIGINT UNSIGNED DEFAULT NULL COMMENT '回复的消息ID',
+    expires_at TIMESTAMP NULL DEFAULT NULL COMMENT '自动删除时间,NULL=不过期',
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     UNIQUE KEY uk_conv_seq (conversation_id, seq),
@@ -183,10 +362,23 @@ CREATE TABLE IF NOT EXISTS messages (
     KEY idx_conv_time (conversation_id, created_at),
     KEY idx_sender (sender_id),
     KEY idx_reply (reply_to_msg_id),
+    KEY idx_expires (expires_at),
     CONSTRAINT fk_msg_conv FOREIGN KEY (conversation_id) REFERENCES conversations(id) ON DELETE CASCADE,
     CONSTRAINT fk_msg_sender FOREIGN KEY (sender_id) REFERENCES users(id) ON DELETE CASCADE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='消息表';
 
+-- 消息文件引用表(发送时记录,过期清理据此判断对象是否仍被引用)
+CREATE TABLE IF NOT EXISTS message_media_refs (
+    message_id BIGINT UNSIGNED NOT NULL,
+    object_key VARCHAR(255) NOT NULL,
+    conversation_id BIGINT UNSIGNED NOT NULL,
+    owner_id BIGINT UNSIGNED NOT NULL COMMENT '消息发送者,清理时只删除其本人上传的对象',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (message_id, object_key),
+    KEY idx_object_key (object_key),
+    CONSTRAINT fk_media_ref_msg FOREIGN KEY (message_id) REFERENCES messages(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='消息文件引用表';
+
 -- 消息已读回执表
 CREATE TABLE IF NOT EXISTS message_receipts (
     id BIGINT UNSIGNED PRIMARY KEY AUTO_INCREMENT,
@@ -211,7 +403,9 @@ CREATE TABLE IF NOT EXISTS inbox (
     last_read_seq BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '最后已读消息序号',
     last_delivered_seq BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '最后投递消息序号',
     unread_count INT NOT NULL DEFAULT 0 COMMENT '未读消息数',
+    last_mention_seq BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '最近一次被@的消息序号',
     is_muted TINYINT NOT NULL DEFAULT 0 COMMENT '是否免打扰: 0=否,1=是',
+    mute_until TIMESTAMP NULL DEFAULT NULL COMMENT '免打扰截止时间(为空表示永久)',
     is_pinned TINYINT NOT NULL DEFAULT 0 COMMENT '是否置顶: 0=否,1=是',
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     PRIMARY KEY (user_id, conversation_id),
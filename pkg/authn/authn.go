@@ -0,0 +1,88 @@
+// Package authn 提供网关与投递服务共用的访问令牌校验：
+// 验签之后依次检查本地撤销缓存、Redis 黑名单与账号状态。
+package authn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultLegacyHS256Sunset HS256 旧令牌兼容的移除日期，之后无论开关如何都不再接受
+const DefaultLegacyHS256Sunset = "2026-12-31"
+
+// TokenTypeAccess 访问令牌的 typ 声明；刷新令牌与访问令牌共用签名密钥，只有访问令牌能用于访问接口
+const TokenTypeAccess = "access"
+
+var (
+	ErrMissingToken = errors.New("missing token")
+	ErrInvalidToken = errors.New("invalid token")
+	ErrTokenRevoked = errors.New("token revoked")
+	ErrUserInactive = errors.New("user inactive")
+	// ErrAuthUnavailable Redis 黑名单或账号状态查询失败，无法确认令牌有效（默认拒绝）
+	ErrAuthUnavailable = errors.New("auth backend unavailable")
+)
+
+// Claims 校验通过后的令牌信息；SessionID 为签发令牌的设备会话，Roles/Permissions 为签发时写入的授权快照
+type Claims struct {
+	UserID      uint64
+	JTI         string
+	SessionID   string
+	ExpiresAt   time.Time
+	Roles       []string
+	Permissions []string
+}
+
+// HasPermission 是否拥有指定权限（形如 "Group:Manage"）
+func (c *Claims) HasPermission(permission string) bool {
+	for _, p := range c.Permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// HasRole 是否拥有指定角色
+func (c *Claims) HasRole(role string) bool {
+	for _, r := range c.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// RevocationStore 已撤销 jti 的权威存储（identity_service 写入的 Redis 黑名单）
+type RevocationStore interface {
+	IsRevoked(ctx context.Context, jti string) (bool, error)
+}
+
+// StatusChecker 查询账号是否可用；active=false 时 reason 为不可用原因
+type StatusChecker interface {
+	CheckUserStatus(ctx context.Context, userID uint64) (active bool, reason string, err error)
+}
+
+// BearerToken 从 Authorization 头中取出 Bearer 令牌
+func BearerToken(header string) (string, bool) {
+	parts := strings.SplitN(header, " ", 2)
+	if len(parts) != 2 || parts[0] != "Bearer" || parts[1] == "" {
+		return "", false
+	}
+	return parts[1], true
+}
+
+// AcceptLegacyHS256 accept_legacy_hs256 开关在 sunset（YYYY-MM-DD，UTC 当日零点）之前才生效
+// sunset 为空时使用 DefaultLegacyHS256Sunset
+func AcceptLegacyHS256(accept bool, sunset string, now time.Time) (bool, error) {
+	if sunset == "" {
+		sunset = DefaultLegacyHS256Sunset
+	}
+	deadline, err := time.Parse(time.DateOnly, sunset)
+	if err != nil {
+		return false, fmt.Errorf("invalid legacy hs256 sunset %q: %w", sunset, err)
+	}
+	return accept && now.Before(deadline), nil
+}
@@ -0,0 +1,104 @@
+package authn
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+var ErrPermissionDenied = errors.New("permission denied")
+
+// 权限字符串形如 "<Resource>:<Action>"，与 identity_service 的 Permission 值对象一致
+const (
+	PermUserManage    = "User:Manage"
+	PermGroupManage   = "Group:Manage"
+	PermMessageManage = "Message:Manage"
+)
+
+// AccessRule 访问规则：Path 精确匹配或 Pattern 正则匹配，Methods 为空时匹配任意方法。
+// Public 规则不要求令牌；Permission 为空时只要求已认证。
+// 网关 HTTP 路由与 identity_service 管理接口共用这一套规则与判定，不在各服务中另行实现
+type AccessRule struct {
+	Path       string
+	Pattern    string
+	Methods    []string
+	Permission string
+	Public     bool
+}
+
+type compiledRule struct {
+	AccessRule
+	re *regexp.Regexp
+}
+
+func (r *compiledRule) matches(path, method string) bool {
+	if r.re != nil {
+		if !r.re.MatchString(path) {
+			return false
+		}
+	} else if r.Path != path {
+		return false
+	}
+	if len(r.Methods) == 0 {
+		return true
+	}
+	for _, m := range r.Methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// Authorizer 按规则表判定访问权限，规则按顺序匹配、先匹配者生效；
+// 未命中任何规则的路径不做限制（是否要求认证由上游认证中间件决定）
+type Authorizer struct {
+	rules []compiledRule
+}
+
+// NewAuthorizer 编译规则表，Pattern 非法时返回错误
+func NewAuthorizer(rules []AccessRule) (*Authorizer, error) {
+	a := &Authorizer{rules: make([]compiledRule, 0, len(rules))}
+	for _, rule := range rules {
+		cr := compiledRule{AccessRule: rule}
+		if rule.Pattern != "" {
+			re, err := regexp.Compile(rule.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("compile access rule %q: %w", rule.Pattern, err)
+			}
+			cr.re = re
+		} else if rule.Path == "" {
+			return nil, errors.New("access rule requires path or pattern")
+		}
+		a.rules = append(a.rules, cr)
+	}
+	return a, nil
+}
+
+// Match 返回第一条匹配的规则
+func (a *Authorizer) Match(path, method string) (*AccessRule, bool) {
+	for i := range a.rules {
+		if a.rules[i].matches(path, method) {
+			return &a.rules[i].AccessRule, true
+		}
+	}
+	return nil, false
+}
+
+// Authorize 判定 claims 能否访问 path/method；claims 为 nil 表示未认证
+func (a *Authorizer) Authorize(claims *Claims, path, method string) error {
+	rule, ok := a.Match(path, method)
+	if !ok || rule.Public {
+		return nil
+	}
+	if claims == nil {
+		return ErrMissingToken
+	}
+	if rule.Permission == "" {
+		return nil
+	}
+	if !claims.HasPermission(rule.Permission) {
+		return fmt.Errorf("%w: requires %s", ErrPermissionDenied, rule.Permission)
+	}
+	return nil
+}
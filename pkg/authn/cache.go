@@ -0,0 +1,94 @@
+package authn
+
+import (
+	"sync"
+	"time"
+)
+
+const sweepInterval = time.Minute
+
+type statusEntry struct {
+	active   bool
+	reason   string
+	expireAt time.Time
+}
+
+// RevocationCache 进程内缓存：已撤销的 jti 保留到令牌过期，账号状态短暂缓存
+type RevocationCache struct {
+	mu        sync.RWMutex
+	revoked   map[string]time.Time
+	status    map[uint64]statusEntry
+	lastSweep time.Time
+}
+
+func NewRevocationCache() *RevocationCache {
+	return &RevocationCache{
+		revoked:   make(map[string]time.Time),
+		status:    make(map[uint64]statusEntry),
+		lastSweep: time.Now(),
+	}
+}
+
+// Revoke 记录 jti 已撤销，until 之后自动淘汰
+func (c *RevocationCache) Revoke(jti string, until time.Time) {
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if exp, ok := c.revoked[jti]; !ok || until.After(exp) {
+		c.revoked[jti] = until
+	}
+	c.maybeSweepLocked(now)
+}
+
+// IsRevoked 判断 jti 是否在本地撤销缓存中
+func (c *RevocationCache) IsRevoked(jti string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	exp, ok := c.revoked[jti]
+	return ok && time.Now().Before(exp)
+}
+
+// Status 返回未过期的账号状态缓存
+func (c *RevocationCache) Status(userID uint64) (active bool, reason string, ok bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	e, found := c.status[userID]
+	if !found || time.Now().After(e.expireAt) {
+		return false, "", false
+	}
+	return e.active, e.reason, true
+}
+
+// SetStatus 缓存账号状态 ttl 时长
+func (c *RevocationCache) SetStatus(userID uint64, active bool, reason string, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	c.status[userID] = statusEntry{active: active, reason: reason, expireAt: now.Add(ttl)}
+	c.maybeSweepLocked(now)
+}
+
+// InvalidateStatus 丢弃账号状态缓存，下次校验回源
+func (c *RevocationCache) InvalidateStatus(userID uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.status, userID)
+}
+
+// maybeSweepLocked 每隔 sweepInterval 清理一次过期条目
+func (c *RevocationCache) maybeSweepLocked(now time.Time) {
+	if now.Sub(c.lastSweep) < sweepInterval {
+		return
+	}
+	for jti, exp := range c.revoked {
+		if now.After(exp) {
+			delete(c.revoked, jti)
+		}
+	}
+	for uid, e := range c.status {
+		if now.After(e.expireAt) {
+			delete(c.status, uid)
+		}
+	}
+	c.lastSweep = now
+}
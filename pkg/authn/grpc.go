@@ -0,0 +1,77 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// 网关向下游转发令牌信息使用的 metadata 键，与既有的 user_id 键同一信任边界
+const (
+	MetadataUserID      = "user_id"
+	MetadataRoles       = "x-roles"
+	MetadataPermissions = "x-permissions"
+	MetadataSessionID   = "x-session-id"
+)
+
+// AppendToOutgoingContext 把令牌中的用户、会话、角色与权限写入下游 gRPC metadata
+func AppendToOutgoingContext(ctx context.Context, claims *Claims) context.Context {
+	if claims == nil {
+		return ctx
+	}
+	kv := []string{MetadataUserID, strconv.FormatUint(claims.UserID, 10)}
+	if claims.SessionID != "" {
+		kv = append(kv, MetadataSessionID, claims.SessionID)
+	}
+	for _, r := range claims.Roles {
+		kv = append(kv, MetadataRoles, r)
+	}
+	for _, p := range claims.Permissions {
+		kv = append(kv, MetadataPermissions, p)
+	}
+	return metadata.AppendToOutgoingContext(ctx, kv...)
+}
+
+// ClaimsFromIncoming 从上游转发的 metadata 还原令牌信息，缺少 user_id 时返回 nil
+func ClaimsFromIncoming(ctx context.Context) *Claims {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+	ids := md.Get(MetadataUserID)
+	if len(ids) == 0 {
+		return nil
+	}
+	userID, err := strconv.ParseUint(ids[0], 10, 64)
+	if err != nil || userID == 0 {
+		return nil
+	}
+	claims := &Claims{
+		UserID:      userID,
+		Roles:       md.Get(MetadataRoles),
+		Permissions: md.Get(MetadataPermissions),
+	}
+	if sids := md.Get(MetadataSessionID); len(sids) > 0 {
+		claims.SessionID = sids[0]
+	}
+	return claims
+}
+
+// UnaryServerInterceptor 按规则表校验 gRPC 调用，规则 Path 为完整方法名
+// （如 /im.v1.IdentityService/CreateRole），Methods 留空
+func UnaryServerInterceptor(a *Authorizer) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := a.Authorize(ClaimsFromIncoming(ctx), info.FullMethod, ""); err != nil {
+			if errors.Is(err, ErrMissingToken) {
+				return nil, status.Error(codes.Unauthenticated, err.Error())
+			}
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return handler(ctx, req)
+	}
+}
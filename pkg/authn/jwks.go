@@ -0,0 +1,209 @@
+package authn
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultJWKSRefreshInterval JWKS 常规刷新周期，应小于 identity_service 的密钥激活延迟
+	DefaultJWKSRefreshInterval = 5 * time.Minute
+	// jwksMinRefetchInterval 遇到未知 kid 时两次回源的最小间隔，防止伪造 kid 打爆 identity
+	jwksMinRefetchInterval = 10 * time.Second
+)
+
+// KeySource 按 kid 提供验签公钥
+type KeySource interface {
+	Key(ctx context.Context, kid string) (alg string, key crypto.PublicKey, err error)
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type publicKey struct {
+	alg string
+	key crypto.PublicKey
+}
+
+// JWKSClient 拉取并缓存 JWKS（identity_service 或外部 OIDC 提供方发布）
+// 缓存超过 refreshInterval 或遇到未知 kid 时回源；回源失败继续使用旧缓存
+type JWKSClient struct {
+	url             string
+	httpClient      *http.Client
+	refreshInterval time.Duration
+
+	mu          sync.RWMutex
+	keys        map[string]publicKey
+	fetchedAt   time.Time
+	lastAttempt time.Time
+	fetchMu     sync.Mutex
+}
+
+func NewJWKSClient(url string, refreshInterval time.Duration) *JWKSClient {
+	if refreshInterval <= 0 {
+		refreshInterval = DefaultJWKSRefreshInterval
+	}
+	return &JWKSClient{
+		url:             url,
+		httpClient:      &http.Client{Timeout: 5 * time.Second},
+		refreshInterval: refreshInterval,
+		keys:            make(map[string]publicKey),
+	}
+}
+
+func (c *JWKSClient) Key(ctx context.Context, kid string) (string, crypto.PublicKey, error) {
+	c.mu.RLock()
+	k, ok := c.keys[kid]
+	stale := time.Since(c.fetchedAt) >= c.refreshInterval
+	c.mu.RUnlock()
+	if ok && !stale {
+		return k.alg, k.key, nil
+	}
+
+	if err := c.refresh(ctx); err != nil {
+		zap.L().Warn("refresh jwks failed", zap.String("url", c.url), zap.Error(err))
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if k, ok := c.keys[kid]; ok {
+		return k.alg, k.key, nil
+	}
+	return "", nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// refresh 回源拉取 JWKS，并发请求只回源一次，且受最小回源间隔限制
+func (c *JWKSClient) refresh(ctx context.Context) error {
+	c.fetchMu.Lock()
+	defer c.fetchMu.Unlock()
+	if time.Since(c.lastAttempt) < jwksMinRefetchInterval {
+		return nil
+	}
+	c.lastAttempt = time.Now()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return fmt.Errorf("decode jwks: %w", err)
+	}
+	keys := make(map[string]publicKey, len(set.Keys))
+	for _, j := range set.Keys {
+		if j.Use == "enc" {
+			continue
+		}
+		key, err := j.publicKey()
+		if err != nil {
+			zap.L().Warn("skip invalid jwk", zap.String("kid", j.Kid), zap.Error(err))
+			continue
+		}
+		keys[j.Kid] = publicKey{alg: j.Alg, key: key}
+	}
+
+	c.mu.Lock()
+	c.keys = keys
+	c.fetchedAt = time.Now()
+	c.mu.Unlock()
+	return nil
+}
+
+func (j jwk) publicKey() (crypto.PublicKey, error) {
+	dec := base64.RawURLEncoding
+	switch j.Kty {
+	case "RSA":
+		n, err := dec.DecodeString(j.N)
+		if err != nil {
+			return nil, fmt.Errorf("decode n: %w", err)
+		}
+		e, err := dec.DecodeString(j.E)
+		if err != nil {
+			return nil, fmt.Errorf("decode e: %w", err)
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "OKP":
+		if j.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", j.Crv)
+		}
+		x, err := dec.DecodeString(j.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid ed25519 key")
+		}
+		return ed25519.PublicKey(x), nil
+	case "EC":
+		return j.ecdsaKey()
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", j.Kty)
+	}
+}
+
+// ecdsaKey 外部 OIDC 提供方常用 ES256 等签名，先校验点在曲线上
+func (j jwk) ecdsaKey() (crypto.PublicKey, error) {
+	var (
+		curve elliptic.Curve
+		ec    ecdh.Curve
+	)
+	switch j.Crv {
+	case "P-256":
+		curve, ec = elliptic.P256(), ecdh.P256()
+	case "P-384":
+		curve, ec = elliptic.P384(), ecdh.P384()
+	case "P-521":
+		curve, ec = elliptic.P521(), ecdh.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve %q", j.Crv)
+	}
+	dec := base64.RawURLEncoding
+	x, err := dec.DecodeString(j.X)
+	if err != nil {
+		return nil, fmt.Errorf("decode x: %w", err)
+	}
+	y, err := dec.DecodeString(j.Y)
+	if err != nil {
+		return nil, fmt.Errorf("decode y: %w", err)
+	}
+	size := (curve.Params().BitSize + 7) / 8
+	if len(x) != size || len(y) != size {
+		return nil, fmt.Errorf("invalid ec key size")
+	}
+	point := append(append([]byte{4}, x...), y...)
+	if _, err := ec.NewPublicKey(point); err != nil {
+		return nil, fmt.Errorf("invalid ec key: %w", err)
+	}
+	return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+}
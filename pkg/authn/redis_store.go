@@ -0,0 +1,27 @@
+package authn
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// blockedTokenPrefix 与 identity_service AccessTokenRepoRedis 的黑名单 key 保持一致
+const blockedTokenPrefix = "blocked_token:"
+
+// RedisRevocationStore 读取 identity_service 写入的 Redis 黑名单
+type RedisRevocationStore struct {
+	client redis.UniversalClient
+}
+
+func NewRedisRevocationStore(client redis.UniversalClient) *RedisRevocationStore {
+	return &RedisRevocationStore{client: client}
+}
+
+func (s *RedisRevocationStore) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	n, err := s.client.Exists(ctx, blockedTokenPrefix+jti).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
@@ -0,0 +1,31 @@
+package authn
+
+import (
+	"context"
+	"time"
+
+	imv1 "github.com/EthanQC/IM/api/gen/im/v1"
+)
+
+// IdentityStatusChecker 通过 identity_service 的 CheckUserStatus 查询账号状态
+type IdentityStatusChecker struct {
+	client  imv1.IdentityServiceClient
+	timeout time.Duration
+}
+
+func NewIdentityStatusChecker(client imv1.IdentityServiceClient, timeout time.Duration) *IdentityStatusChecker {
+	return &IdentityStatusChecker{client: client, timeout: timeout}
+}
+
+func (c *IdentityStatusChecker) CheckUserStatus(ctx context.Context, userID uint64) (bool, string, error) {
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+	resp, err := c.client.CheckUserStatus(ctx, &imv1.CheckUserStatusRequest{UserId: int64(userID)})
+	if err != nil {
+		return false, "", err
+	}
+	return resp.Active, resp.Reason, nil
+}
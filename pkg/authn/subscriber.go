@@ -0,0 +1,163 @@
+package authn
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+)
+
+// DefaultRevocationTopic identity_service 发布令牌撤销 / 账号状态变更事件的 Topic
+const DefaultRevocationTopic = "im.auth.events"
+
+const (
+	eventTokenRevoked      = "token_revoked"
+	eventUserStatusChanged = "user_status_changed"
+
+	// EventSessionRevoked 设备会话被撤销
+	EventSessionRevoked = "session_revoked"
+	// EventNewDeviceLogin 用户在未登录过的设备上登录
+	EventNewDeviceLogin = "new_device_login"
+)
+
+// AuthEvent 与 identity_service out.AuthEvent 字段一致
+type AuthEvent struct {
+	Type       string `json:"type"`
+	JTI        string `json:"jti"`
+	UserID     string `json:"user_id"`
+	ExpiresAt  int64  `json:"expires_at"`
+	SessionID  string `json:"session_id"`
+	DeviceID   string `json:"device_id"`
+	Platform   string `json:"platform"`
+	IP         string `json:"ip"`
+	Reason     string `json:"reason"`
+	Count      int    `json:"count"`
+	OccurredAt int64  `json:"occurred_at"`
+}
+
+// EventHandler 处理撤销缓存之外的事件（会话撤销、新设备登录），由投递服务注册
+type EventHandler func(ctx context.Context, event AuthEvent)
+
+// RevocationSubscriber 订阅撤销事件写入本地缓存
+// 不使用消费组：每个实例都需要看到全部事件，因此直接消费所有分区，从最新位点开始
+type RevocationSubscriber struct {
+	consumer sarama.Consumer
+	topic    string
+	cache    *RevocationCache
+	// fallbackTTL 事件未带过期时间时 jti 的保留时长，应不小于 access token 有效期
+	fallbackTTL time.Duration
+	handler     EventHandler
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewRevocationSubscriber(brokers []string, topic string, cache *RevocationCache, fallbackTTL time.Duration) (*RevocationSubscriber, error) {
+	if topic == "" {
+		topic = DefaultRevocationTopic
+	}
+	config := sarama.NewConfig()
+	config.Version = sarama.V2_8_0_0
+	config.Consumer.Return.Errors = true
+
+	consumer, err := sarama.NewConsumer(brokers, config)
+	if err != nil {
+		return nil, fmt.Errorf("create revocation consumer failed: %w", err)
+	}
+	return &RevocationSubscriber{
+		consumer:    consumer,
+		topic:       topic,
+		cache:       cache,
+		fallbackTTL: fallbackTTL,
+	}, nil
+}
+
+// SetEventHandler 设置事件回调（可选），需在 Start 之前调用
+func (s *RevocationSubscriber) SetEventHandler(handler EventHandler) {
+	s.handler = handler
+}
+
+// Start 为每个分区启动一个消费协程
+func (s *RevocationSubscriber) Start(ctx context.Context) error {
+	partitions, err := s.consumer.Partitions(s.topic)
+	if err != nil {
+		return fmt.Errorf("list partitions of %s failed: %w", s.topic, err)
+	}
+	ctx, s.cancel = context.WithCancel(ctx)
+	for _, p := range partitions {
+		pc, err := s.consumer.ConsumePartition(s.topic, p, sarama.OffsetNewest)
+		if err != nil {
+			s.cancel()
+			return fmt.Errorf("consume partition %d failed: %w", p, err)
+		}
+		s.wg.Add(1)
+		go s.consume(ctx, pc)
+	}
+	return nil
+}
+
+// Stop 停止消费
+func (s *RevocationSubscriber) Stop() error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	return s.consumer.Close()
+}
+
+func (s *RevocationSubscriber) consume(ctx context.Context, pc sarama.PartitionConsumer) {
+	defer s.wg.Done()
+	defer pc.Close()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-pc.Messages():
+			if !ok {
+				return
+			}
+			if err := s.handle(ctx, msg.Value); err != nil {
+				zap.L().Warn("Handle revocation event failed", zap.String("key", string(msg.Key)), zap.Error(err))
+			}
+		case err, ok := <-pc.Errors():
+			if !ok {
+				return
+			}
+			zap.L().Warn("Error from revocation consumer", zap.Error(err))
+		}
+	}
+}
+
+func (s *RevocationSubscriber) handle(ctx context.Context, data []byte) error {
+	var event AuthEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		return fmt.Errorf("unmarshal revocation event failed: %w", err)
+	}
+	switch event.Type {
+	case eventTokenRevoked:
+		if event.JTI == "" {
+			return nil
+		}
+		until := time.Now().Add(s.fallbackTTL)
+		if event.ExpiresAt > 0 {
+			until = time.Unix(event.ExpiresAt, 0)
+		}
+		s.cache.Revoke(event.JTI, until)
+	case eventUserStatusChanged:
+		userID, err := strconv.ParseUint(event.UserID, 10, 64)
+		if err != nil {
+			return nil
+		}
+		s.cache.InvalidateStatus(userID)
+	default:
+		if s.handler != nil {
+			s.handler(ctx, event)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+)
+
+// DefaultStatusCacheTTL 账号状态缓存时长；封禁生效的最大延迟
+const DefaultStatusCacheTTL = 30 * time.Second
+
+// Verifier 校验访问令牌：验签 → 本地撤销缓存 → Redis 黑名单 → 账号状态
+//
+// 验签优先按 kid 使用 JWKS 中的 RS256/EdDSA 公钥；legacySecret 非空时仍接受旧的 HS256 令牌，
+// 过渡期结束后置空即可。Redis 与 identity_service 不可用时默认拒绝（ErrAuthUnavailable），
+// 显式 SetFailOpen(true) 后改为放行并记录告警，此时只依赖撤销事件流填充的本地缓存。
+type Verifier struct {
+	secret         []byte
+	keys           KeySource
+	cache          *RevocationCache
+	store          RevocationStore
+	status         StatusChecker
+	statusCacheTTL time.Duration
+	failOpen       bool
+}
+
+// NewVerifier 创建校验器，legacySecret 为空时不再接受 HS256 令牌
+func NewVerifier(legacySecret string, cache *RevocationCache) *Verifier {
+	if cache == nil {
+		cache = NewRevocationCache()
+	}
+	v := &Verifier{
+		cache:          cache,
+		statusCacheTTL: DefaultStatusCacheTTL,
+	}
+	if legacySecret != "" {
+		v.secret = []byte(legacySecret)
+	}
+	return v
+}
+
+// SetKeySource 设置非对称验签公钥来源（JWKS）
+func (v *Verifier) SetKeySource(keys KeySource) {
+	v.keys = keys
+}
+
+// SetRevocationStore 设置 Redis 黑名单（可选）
+func (v *Verifier) SetRevocationStore(store RevocationStore) {
+	v.store = store
+}
+
+// SetStatusChecker 设置账号状态查询（可选），ttl<=0 时使用 DefaultStatusCacheTTL
+func (v *Verifier) SetStatusChecker(checker StatusChecker, ttl time.Duration) {
+	v.status = checker
+	if ttl > 0 {
+		v.statusCacheTTL = ttl
+	}
+}
+
+// SetFailOpen 设置黑名单与账号状态查询失败时是否放行，默认 false（拒绝）
+func (v *Verifier) SetFailOpen(failOpen bool) {
+	v.failOpen = failOpen
+}
+
+// Cache 返回本地撤销缓存，供撤销事件订阅者写入
+func (v *Verifier) Cache() *RevocationCache {
+	return v.cache
+}
+
+// Verify 校验令牌并返回其中的用户信息
+func (v *Verifier) Verify(ctx context.Context, tokenString string) (*Claims, error) {
+	if tokenString == "" {
+		return nil, ErrMissingToken
+	}
+	claims, err := v.parse(ctx, tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if err := v.checkRevoked(ctx, claims); err != nil {
+		return nil, err
+	}
+	if err := v.checkStatus(ctx, claims.UserID); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (v *Verifier) parse(ctx context.Context, tokenString string) (*Claims, error) {
+	mc := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, mc, func(t *jwt.Token) (interface{}, error) {
+		return v.verificationKey(ctx, t)
+	}, jwt.WithValidMethods([]string{"RS256", "EdDSA", "HS256"}))
+	if err != nil || !token.Valid {
+		return nil, ErrInvalidToken
+	}
+	// 刷新令牌与未标注类型的旧令牌一律拒绝
+	if typ, _ := mc["typ"].(string); typ != TokenTypeAccess {
+		return nil, ErrInvalidToken
+	}
+
+	claims := &Claims{}
+	claims.JTI, _ = mc["jti"].(string)
+	if sub, ok := mc["sub"].(string); ok {
+		claims.UserID, _ = strconv.ParseUint(sub, 10, 64)
+	} else if claims.JTI != "" {
+		// 兼容旧 token: jti 里存 user_id
+		claims.UserID, _ = strconv.ParseUint(claims.JTI, 10, 64)
+	}
+	if claims.UserID == 0 {
+		return nil, ErrInvalidToken
+	}
+	if exp, err := mc.GetExpirationTime(); err == nil && exp != nil {
+		claims.ExpiresAt = exp.Time
+	}
+	claims.SessionID, _ = mc["sid"].(string)
+	claims.Roles = stringList(mc["roles"])
+	claims.Permissions = stringList(mc["perms"])
+	return claims, nil
+}
+
+// stringList 取出 JSON 字符串数组声明，旧令牌没有该声明时返回 nil
+func stringList(v interface{}) []string {
+	items, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func (v *Verifier) verificationKey(ctx context.Context, t *jwt.Token) (interface{}, error) {
+	if _, ok := t.Method.(*jwt.SigningMethodHMAC); ok {
+		if v.secret == nil {
+			return nil, errors.New("legacy HS256 token no longer accepted")
+		}
+		return v.secret, nil
+	}
+	if v.keys == nil {
+		return nil, errors.New("no key source configured")
+	}
+	kid, _ := t.Header["kid"].(string)
+	if kid == "" {
+		return nil, errors.New("missing kid")
+	}
+	alg, key, err := v.keys.Key(ctx, kid)
+	if err != nil {
+		return nil, err
+	}
+	if alg != t.Method.Alg() {
+		return nil, fmt.Errorf("signing method %s does not match key %s", t.Method.Alg(), alg)
+	}
+	return key, nil
+}
+
+func (v *Verifier) checkRevoked(ctx context.Context, claims *Claims) error {
+	if claims.JTI == "" {
+		return nil
+	}
+	if v.cache.IsRevoked(claims.JTI) {
+		return ErrTokenRevoked
+	}
+	if v.store == nil {
+		return nil
+	}
+	revoked, err := v.store.IsRevoked(ctx, claims.JTI)
+	if err != nil {
+		return v.lookupFailed("revocation lookup failed", err, zap.String("jti", claims.JTI))
+	}
+	if revoked {
+		v.cache.Revoke(claims.JTI, v.revokeUntil(claims.ExpiresAt))
+		return ErrTokenRevoked
+	}
+	return nil
+}
+
+func (v *Verifier) checkStatus(ctx context.Context, userID uint64) error {
+	if v.status == nil {
+		return nil
+	}
+	active, reason, ok := v.cache.Status(userID)
+	if !ok {
+		var err error
+		active, reason, err = v.status.CheckUserStatus(ctx, userID)
+		if err != nil {
+			return v.lookupFailed("user status lookup failed", err, zap.Uint64("user_id", userID))
+		}
+		v.cache.SetStatus(userID, active, reason, v.statusCacheTTL)
+	}
+	if !active {
+		if reason == "" {
+			return ErrUserInactive
+		}
+		return fmt.Errorf("%w: %s", ErrUserInactive, reason)
+	}
+	return nil
+}
+
+// lookupFailed 查询失败时按 failOpen 决定放行或拒绝
+func (v *Verifier) lookupFailed(msg string, err error, field zap.Field) error {
+	zap.L().Warn(msg, field, zap.Bool("fail_open", v.failOpen), zap.Error(err))
+	if v.failOpen {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrAuthUnavailable, msg)
+}
+
+// revokeUntil 令牌无过期时间时按状态缓存时长保留，之后仍会回源 Redis
+func (v *Verifier) revokeUntil(expiresAt time.Time) time.Time {
+	if expiresAt.IsZero() {
+		return time.Now().Add(v.statusCacheTTL)
+	}
+	return expiresAt
+}
@@ -2,19 +2,24 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/pprof"
 	"strconv"
-	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/golang-jwt/jwt/v5"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	imv1 "github.com/EthanQC/IM/api/gen/im/v1"
+	"github.com/EthanQC/IM/pkg/authn"
 )
 
 func (g *Gateway) registerRoutes() {
@@ -50,15 +55,50 @@ func (g *Gateway) registerRoutes() {
 	// 公开接口（不需要认证）
 	g.router.POST("/api/auth/register", g.handleRegister)
 	g.router.POST("/api/auth/login", g.handleLogin)
+	g.router.POST("/api/auth/login/mfa", g.handleVerifyMFALogin)
+	g.router.POST("/api/auth/password/forgot", g.handleRequestPasswordReset)
+	g.router.POST("/api/auth/password/verify", g.handleVerifyPasswordReset)
+	g.router.POST("/api/auth/password/reset", g.handleResetPassword)
 	g.router.POST("/api/auth/refresh", g.handleRefresh)
+	// 第三方 / 企业 OIDC 登录：授权码 + PKCE，回调由提供方重定向到网关
+	g.router.GET("/api/auth/oidc/providers", g.handleListOIDCProviders)
+	g.router.GET("/api/auth/oidc/:provider/authorize", g.handleBeginOIDCLogin)
+	g.router.GET("/api/auth/oidc/:provider/callback", g.handleCompleteOIDCLogin)
 
-	// 需要认证的接口
+	// 需要认证的接口：先认证，再按访问规则校验权限
 	authorized := g.router.Group("/api")
-	authorized.Use(g.authMiddleware())
+	authorized.Use(g.authMiddleware(), authn.GinAuthorize(g.authorizer))
 	{
+		// 登出：撤销当前 access token
+		authorized.POST("/auth/logout", g.handleLogout)
+
+		// 登录设备：查看活跃会话、下线指定设备或其他全部设备
+		authorized.GET("/auth/sessions", g.handleListSessions)
+		authorized.DELETE("/auth/sessions", g.handleRevokeOtherSessions)
+		authorized.DELETE("/auth/sessions/:id", g.handleRevokeSession)
+
+		// 两步验证：绑定/解绑 TOTP、恢复码，以及敏感操作前的再次验证
+		authorized.GET("/auth/mfa", g.handleGetMFAStatus)
+		authorized.POST("/auth/mfa/totp", g.handleEnrollTOTP)
+		authorized.POST("/auth/mfa/totp/confirm", g.handleConfirmTOTP)
+		authorized.POST("/auth/mfa/totp/disable", g.handleDisableTOTP)
+		authorized.POST("/auth/mfa/recovery-codes", g.handleRegenerateRecoveryCodes)
+		authorized.POST("/auth/mfa/step-up", g.handleStepUpMFA)
+
+		// 修改密码：成功后其他设备下线
+		authorized.PUT("/auth/password", g.handleChangePassword)
+
 		// 用户相关
 		authorized.GET("/users/me", g.handleGetProfile)
 		authorized.PUT("/users/me", g.handleUpdateProfile)
+		authorized.GET("/users/me/permissions", g.handleGetMyPermissions)
+		// 查找用户：精确搜索与名片码，只返回公开资料
+		authorized.GET("/users/search", g.handleSearchUser)
+		authorized.GET("/users/share/:code", g.handleResolveShareCode)
+		authorized.GET("/users/me/share-code", g.handleGetShareCode)
+		authorized.POST("/users/me/share-code/reset", g.handleResetShareCode)
+		authorized.GET("/users/me/privacy", g.handleGetPrivacySettings)
+		authorized.PUT("/users/me/privacy", g.handleUpdatePrivacySettings)
 
 		// 联系人相关
 		authorized.GET("/contacts", g.handleGetContacts)
@@ -68,9 +108,47 @@ func (g *Gateway) registerRoutes() {
 
 		// 会话相关
 		authorized.GET("/conversations", g.handleGetConversations)
+		authorized.GET("/conversations/overview", g.handleGetConversationOverview)
 		authorized.POST("/conversations", g.handleCreateConversation)
 		authorized.GET("/conversations/:id", g.handleGetConversation)
 		authorized.PUT("/conversations/:id", g.handleUpdateConversation)
+		authorized.DELETE("/conversations/:id", g.handleDissolveConversation)
+		authorized.GET("/conversations/:id/settings", g.handleGetConversationSetting)
+		authorized.PUT("/conversations/:id/settings", g.handleUpdateConversationSetting)
+		authorized.POST("/conversations/:id/leave", g.handleLeaveConversation)
+		authorized.POST("/conversations/:id/transfer", g.handleTransferOwnership)
+		authorized.PUT("/conversations/:id/mute-all", g.handleSetMuteAll)
+		authorized.PUT("/conversations/:id/message-ttl", g.handleSetMessageTTL)
+		authorized.GET("/conversations/:id/members", g.handleListMembers)
+		authorized.PUT("/conversations/:id/nickname", g.handleSetMemberNickname)
+		authorized.POST("/conversations/:id/members", g.handleAddMembers)
+		authorized.DELETE("/conversations/:id/members/:user_id", g.handleRemoveMember)
+		authorized.PUT("/conversations/:id/members/:user_id/role", g.handleSetMemberRole)
+		authorized.POST("/conversations/:id/members/:user_id/mute", g.handleMuteMember)
+		authorized.DELETE("/conversations/:id/members/:user_id/mute", g.handleUnmuteMember)
+		authorized.POST("/conversations/:id/join-requests", g.handleRequestJoin)
+		authorized.GET("/conversations/:id/join-requests", g.handleListJoinRequests)
+		authorized.POST("/conversations/:id/join-requests/:request_id/handle", g.handleJoinRequest)
+		authorized.POST("/conversations/:id/invites", g.handleCreateInvite)
+		authorized.GET("/conversations/:id/invites", g.handleListInvites)
+		authorized.DELETE("/conversations/:id/invites/:invite_id", g.handleRevokeInvite)
+		authorized.GET("/conversations/:id/announcement", g.handleGetAnnouncement)
+		authorized.PUT("/conversations/:id/announcement", g.handleSetAnnouncement)
+		authorized.DELETE("/conversations/:id/announcement", g.handleDeleteAnnouncement)
+		authorized.GET("/conversations/:id/pins", g.handleListPinnedMessages)
+		authorized.POST("/conversations/:id/pins", g.handlePinMessage)
+		authorized.DELETE("/conversations/:id/pins/:message_id", g.handleUnpinMessage)
+		authorized.GET("/invites/:code", g.handlePreviewInvite)
+		authorized.POST("/invites/:code/join", g.handleJoinByInvite)
+
+		// 会话文件夹
+		authorized.GET("/folders", g.handleListFolders)
+		authorized.POST("/folders", g.handleCreateFolder)
+		authorized.PUT("/folders", g.handleReorderFolders)
+		authorized.PUT("/folders/:id", g.handleRenameFolder)
+		authorized.DELETE("/folders/:id", g.handleDeleteFolder)
+		authorized.POST("/folders/:id/conversations", g.handleAddFolderConversations)
+		authorized.DELETE("/folders/:id/conversations/:conversation_id", g.handleRemoveFolderConversation)
 
 		// 消息相关
 		authorized.POST("/messages", g.handleSendMessage)
@@ -84,10 +162,28 @@ func (g *Gateway) registerRoutes() {
 		// 文件相关
 		authorized.POST("/files/upload", g.handleCreateUpload)
 		authorized.POST("/files/complete", g.handleCompleteUpload)
+
+		// 管理接口：角色管理、用户授权与账号封禁，需要 User:Manage 权限
+		authorized.GET("/admin/roles", g.handleListRoles)
+		authorized.POST("/admin/roles", g.handleCreateRole)
+		authorized.PUT("/admin/roles/:name", g.handleUpdateRole)
+		authorized.DELETE("/admin/roles/:name", g.handleDeleteRole)
+		authorized.GET("/admin/users/:id/roles", g.handleListUserRoles)
+		authorized.POST("/admin/users/:id/roles", g.handleAssignUserRole)
+		authorized.DELETE("/admin/users/:id/roles/:role", g.handleRevokeUserRole)
+		authorized.POST("/admin/users/:id/block", g.handleBlockUser)
+		authorized.DELETE("/admin/users/:id/block", g.handleUnblockUser)
+	}
+}
+
+// accessRules 网关访问规则表，按路由模板匹配；未列出的接口只要求已登录
+func accessRules() []authn.AccessRule {
+	return []authn.AccessRule{
+		{Pattern: `^/api/admin/`, Permission: authn.PermUserManage},
 	}
 }
 
-// JWT认证中间件
+// JWT认证中间件：验签并检查令牌撤销与账号状态
 func (g *Gateway) authMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
@@ -96,43 +192,46 @@ func (g *Gateway) authMiddleware() gin.HandlerFunc {
 			return
 		}
 
-		parts := strings.SplitN(authHeader, " ", 2)
-		if len(parts) != 2 || parts[0] != "Bearer" {
+		tokenString, ok := authn.BearerToken(authHeader)
+		if !ok {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid authorization format"})
 			return
 		}
 
-		tokenString := parts[1]
-		claims := jwt.MapClaims{}
-		token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-			return []byte(g.cfg.JWT.Secret), nil
-		})
-
-		if err != nil || !token.Valid {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid token"})
+		claims, err := g.verifier.Verify(c.Request.Context(), tokenString)
+		if err != nil {
+			writeAuthError(c, err)
 			return
 		}
 
-		// 从claims中提取user_id（存在 jti 字段中）
-		if sub, ok := claims["sub"].(string); ok {
-			userID, err := strconv.ParseUint(sub, 10, 64)
-			if err == nil {
-				c.Set("user_id", userID)
-			}
-		} else if jti, ok := claims["jti"].(string); ok {
-			// 兼容旧 token: jti 里存 user_id
-			var userID uint64
-			fmt.Sscanf(jti, "%d", &userID)
-			c.Set("user_id", userID)
-		}
-
+		c.Set(authn.ClaimsKey, claims)
+		c.Set("user_id", claims.UserID)
+		c.Set("token_jti", claims.JTI)
+		c.Set("token_expires_at", claims.ExpiresAt)
 		c.Next()
 	}
 }
 
-// ctxWithUserID 创建带有 user_id metadata 的 gRPC 上下文
+// writeAuthError 撤销或无效令牌返回 401，账号不可用返回 403，无法确认令牌状态返回 503
+func writeAuthError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, authn.ErrAuthUnavailable):
+		c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"error": "auth service unavailable"})
+	case errors.Is(err, authn.ErrUserInactive):
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, authn.ErrTokenRevoked):
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "token revoked"})
+	default:
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid token"})
+	}
+}
+
+// ctxWithUserID 创建带有 user_id metadata 的 gRPC 上下文，同时转发令牌中的角色与权限
 func (g *Gateway) ctxWithUserID(c *gin.Context) (context.Context, context.CancelFunc) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), g.timeout)
+	if claims := authn.ClaimsFromGin(c); claims != nil {
+		return authn.AppendToOutgoingContext(ctx, claims), cancel
+	}
 	userID, exists := c.Get("user_id")
 	if exists {
 		ctx = metadata.AppendToOutgoingContext(ctx, "user_id", strconv.FormatUint(userID.(uint64), 10))
@@ -140,28 +239,111 @@ func (g *Gateway) ctxWithUserID(c *gin.Context) (context.Context, context.Cancel
 	return ctx, cancel
 }
 
+// writeGRPCError 按 gRPC 状态码返回对应的 HTTP 错误
+func writeGRPCError(c *gin.Context, err error) {
+	httpStatus := http.StatusInternalServerError
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		httpStatus = http.StatusBadRequest
+	case codes.Unauthenticated:
+		httpStatus = http.StatusUnauthorized
+	case codes.PermissionDenied:
+		httpStatus = http.StatusForbidden
+	case codes.NotFound:
+		httpStatus = http.StatusNotFound
+	case codes.AlreadyExists:
+		httpStatus = http.StatusConflict
+	case codes.FailedPrecondition:
+		httpStatus = http.StatusUnprocessableEntity
+	case codes.ResourceExhausted:
+		httpStatus = http.StatusTooManyRequests
+	}
+	setRetryAfter(c, err)
+	c.JSON(httpStatus, gin.H{"error": err.Error()})
+}
+
+// setRetryAfter 下游在状态详情中给出 RetryInfo 时转为 Retry-After（秒，向上取整）
+func setRetryAfter(c *gin.Context, err error) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return
+	}
+	for _, d := range st.Details() {
+		info, ok := d.(*errdetails.RetryInfo)
+		if !ok || info.RetryDelay == nil {
+			continue
+		}
+		delay := info.RetryDelay.AsDuration()
+		if delay <= 0 {
+			continue
+		}
+		c.Header("Retry-After", strconv.FormatInt(int64((delay+time.Second-1)/time.Second), 10))
+		return
+	}
+}
+
 // ==================== 请求/响应结构体 ====================
 
 type loginRequest struct {
 	Username string `json:"username" binding:"required"`
 	Password string `json:"password" binding:"required"`
+	// CaptchaToken 连续登录失败后返回 captcha_required 时需携带
+	CaptchaToken string `json:"captcha_token"`
+	DeviceID     string `json:"device_id"`
+	Platform     string `json:"platform"`
 }
 
 type registerRequest struct {
 	Username    string `json:"username" binding:"required"`
 	Password    string `json:"password" binding:"required"`
 	DisplayName string `json:"display_name"`
+	DeviceID    string `json:"device_id"`
+	Platform    string `json:"platform"`
 }
 
 type refreshRequest struct {
 	RefreshToken string `json:"refresh_token" binding:"required"`
 }
 
+type logoutRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type mfaLoginRequest struct {
+	MFAToken string `json:"mfa_token" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+}
+
+type mfaCodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+type changePasswordRequest struct {
+	OldPassword string `json:"old_password" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required"`
+}
+
+// channel 为 sms 或 email，target 为对应的手机号或邮箱
+type passwordResetTargetRequest struct {
+	Channel string `json:"channel" binding:"required,oneof=sms email"`
+	Target  string `json:"target" binding:"required"`
+	Code    string `json:"code"`
+}
+
+type resetPasswordRequest struct {
+	ResetToken  string `json:"reset_token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required"`
+}
+
 type authResponse struct {
-	AccessToken  string      `json:"access_token"`
-	RefreshToken string      `json:"refresh_token"`
+	AccessToken  string      `json:"access_token,omitempty"`
+	RefreshToken string      `json:"refresh_token,omitempty"`
 	ExpiresIn    int64       `json:"expires_in"`
+	SessionID    string      `json:"session_id,omitempty"`
 	Profile      interface{} `json:"profile,omitempty"`
+	// 开启两步验证的账号登录时只返回挑战令牌，expires_in 为挑战有效期
+	MFARequired bool   `json:"mfa_required,omitempty"`
+	MFAToken    string `json:"mfa_token,omitempty"`
 }
 
 // ==================== 认证相关 Handler ====================
@@ -179,6 +361,7 @@ func (g *Gateway) handleRegister(c *gin.Context) {
 		Username:    req.Username,
 		Password:    req.Password,
 		DisplayName: req.DisplayName,
+		Device:      deviceInfo(c, req.DeviceID, req.Platform),
 	})
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -191,6 +374,7 @@ func (g *Gateway) handleRegister(c *gin.Context) {
 			AccessToken:  resp.AccessToken,
 			RefreshToken: resp.RefreshToken,
 			ExpiresIn:    resp.ExpiresIn,
+			SessionID:    resp.SessionId,
 			Profile:      resp.Profile,
 		},
 	})
@@ -204,15 +388,63 @@ func (g *Gateway) handleLogin(c *gin.Context) {
 	}
 	ctx, cancel := context.WithTimeout(c.Request.Context(), g.timeout)
 	defer cancel()
-	resp, err := g.identityClient.Login(ctx, &imv1.LoginRequest{Username: req.Username, Password: req.Password})
+	resp, err := g.identityClient.Login(ctx, &imv1.LoginRequest{
+		Username:     req.Username,
+		Password:     req.Password,
+		CaptchaToken: req.CaptchaToken,
+		Device:       deviceInfo(c, req.DeviceID, req.Platform),
+	})
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		switch status.Code(err) {
+		case codes.FailedPrecondition:
+			// 失败次数过多，需完成人机验证后携带 captcha_token 重试
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error(), "captcha_required": true})
+		case codes.ResourceExhausted:
+			setRetryAfter(c, err)
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		}
+		return
+	}
+	if resp.MfaRequired {
+		c.JSON(http.StatusOK, authResponse{
+			MFARequired: true,
+			MFAToken:    resp.MfaToken,
+			ExpiresIn:   resp.MfaExpiresIn,
+		})
+		return
+	}
+	c.JSON(http.StatusOK, authResponse{
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+		ExpiresIn:    resp.ExpiresIn,
+		SessionID:    resp.SessionId,
+		Profile:      resp.Profile,
+	})
+}
+
+func (g *Gateway) handleVerifyMFALogin(c *gin.Context) {
+	var req mfaLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), g.timeout)
+	defer cancel()
+	resp, err := g.identityClient.VerifyMFALogin(ctx, &imv1.VerifyMFALoginRequest{
+		MfaToken: req.MFAToken,
+		Code:     req.Code,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, authResponse{
 		AccessToken:  resp.AccessToken,
 		RefreshToken: resp.RefreshToken,
 		ExpiresIn:    resp.ExpiresIn,
+		SessionID:    resp.SessionId,
 		Profile:      resp.Profile,
 	})
 }
@@ -225,7 +457,10 @@ func (g *Gateway) handleRefresh(c *gin.Context) {
 	}
 	ctx, cancel := context.WithTimeout(c.Request.Context(), g.timeout)
 	defer cancel()
-	resp, err := g.identityClient.Refresh(ctx, &imv1.RefreshRequest{RefreshToken: req.RefreshToken})
+	resp, err := g.identityClient.Refresh(ctx, &imv1.RefreshRequest{
+		RefreshToken: req.RefreshToken,
+		Device:       deviceInfo(c, "", ""),
+	})
 	if err != nil {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 		return
@@ -234,79 +469,1506 @@ func (g *Gateway) handleRefresh(c *gin.Context) {
 		AccessToken:  resp.AccessToken,
 		RefreshToken: resp.RefreshToken,
 		ExpiresIn:    resp.ExpiresIn,
+		SessionID:    resp.SessionId,
 		Profile:      resp.Profile,
 	})
 }
 
-// ==================== 用户相关 Handler ====================
+func (g *Gateway) handleLogout(c *gin.Context) {
+	var req logoutRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+			return
+		}
+	}
+	jti := c.GetString("token_jti")
+	if jti == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "token has no jti"})
+		return
+	}
+	expiresAt := c.GetTime("token_expires_at")
 
-func (g *Gateway) handleGetProfile(c *gin.Context) {
-	userID := c.GetUint64("user_id")
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+	logoutReq := &imv1.LogoutRequest{AccessJti: jti, RefreshToken: req.RefreshToken}
+	if !expiresAt.IsZero() {
+		logoutReq.AccessExpiresAt = expiresAt.Unix()
+	}
+	if _, err := g.identityClient.Logout(ctx, logoutReq); err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	// 本实例立即生效，其他实例由撤销事件同步
+	if expiresAt.IsZero() {
+		expiresAt = time.Now().Add(g.cfg.Auth.RevocationTTL)
+	}
+	g.verifier.Cache().Revoke(jti, expiresAt)
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
+
+// deviceInfo 登录设备信息：device_id / platform 由客户端上报，IP 与 UA 取自请求
+func deviceInfo(c *gin.Context, deviceID, platform string) *imv1.DeviceInfo {
+	return &imv1.DeviceInfo{
+		DeviceId:  deviceID,
+		Platform:  platform,
+		Ip:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	}
+}
+
+// ==================== 第三方登录 Handler ====================
+
+const (
+	// oidcBindingCookiePrefix 浏览器绑定 Cookie 名前缀，按 state 区分，同一浏览器可并行发起多次登录
+	oidcBindingCookiePrefix = "im_oidc_"
+	oidcBindingCookiePath   = "/api/auth/oidc/"
+	// oidcBindingCookieTTL 不短于 identity 的 oidc.state_ttl
+	oidcBindingCookieTTL = 10 * time.Minute
+)
+
+// setOIDCBindingCookie HttpOnly + SameSite=Lax：提供方重定向回 callback 的顶级导航会带上，脚本读不到
+func setOIDCBindingCookie(c *gin.Context, state, value string, maxAge int) {
+	secure := c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https"
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oidcBindingCookiePrefix+state, value, maxAge, oidcBindingCookiePath, "", secure, true)
+}
+
+func (g *Gateway) handleListOIDCProviders(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), g.timeout)
 	defer cancel()
+	resp, err := g.identityClient.ListOIDCProviders(ctx, &emptypb.Empty{})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	providers := make([]gin.H, 0, len(resp.Providers))
+	for _, p := range resp.Providers {
+		providers = append(providers, gin.H{"name": p.Name, "display_name": p.DisplayName})
+	}
+	c.JSON(http.StatusOK, gin.H{"providers": providers})
+}
 
-	resp, err := g.identityClient.GetProfile(ctx, &imv1.GetProfileRequest{UserId: int64(userID)})
+// handleBeginOIDCLogin 返回提供方授权地址，客户端跳转后由提供方回调 callback
+func (g *Gateway) handleBeginOIDCLogin(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), g.timeout)
+	defer cancel()
+	resp, err := g.identityClient.BeginOIDCLogin(ctx, &imv1.BeginOIDCLoginRequest{
+		Provider: c.Param("provider"),
+		Device:   deviceInfo(c, c.Query("device_id"), c.Query("platform")),
+	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeGRPCError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp})
+	setOIDCBindingCookie(c, resp.State, resp.BrowserBinding, int(oidcBindingCookieTTL/time.Second))
+	c.JSON(http.StatusOK, gin.H{
+		"authorization_url": resp.AuthorizationUrl,
+		"state":             resp.State,
+	})
 }
 
-func (g *Gateway) handleUpdateProfile(c *gin.Context) {
-	var req struct {
-		DisplayName string `json:"display_name"`
-		AvatarURL   string `json:"avatar_url"`
+func (g *Gateway) handleCompleteOIDCLogin(c *gin.Context) {
+	// 用户拒绝授权等情况下提供方只回传 error
+	if errCode := c.Query("error"); errCode != "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errCode, "error_description": c.Query("error_description")})
+		return
+	}
+	state := c.Query("state")
+	// 没有绑定 Cookie 时交给 identity 拒绝，state 同样会被作废
+	binding, _ := c.Cookie(oidcBindingCookiePrefix + state)
+	setOIDCBindingCookie(c, state, "", -1)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), g.timeout)
+	defer cancel()
+	resp, err := g.identityClient.CompleteOIDCLogin(ctx, &imv1.CompleteOIDCLoginRequest{
+		Provider:       c.Param("provider"),
+		Code:           c.Query("code"),
+		State:          state,
+		BrowserBinding: binding,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	if resp.MfaRequired {
+		c.JSON(http.StatusOK, authResponse{
+			MFARequired: true,
+			MFAToken:    resp.MfaToken,
+			ExpiresIn:   resp.MfaExpiresIn,
+		})
+		return
+	}
+	c.JSON(http.StatusOK, authResponse{
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+		ExpiresIn:    resp.ExpiresIn,
+		SessionID:    resp.SessionId,
+		Profile:      resp.Profile,
+	})
+}
+
+// ==================== 登录设备 Handler ====================
+
+func (g *Gateway) handleListSessions(c *gin.Context) {
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.identityClient.ListSessions(ctx, &imv1.ListSessionsRequest{
+		CurrentSessionId: currentSessionID(c),
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	sessions := resp.Sessions
+	if sessions == nil {
+		sessions = []*imv1.Session{}
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": gin.H{"sessions": sessions}})
+}
+
+func (g *Gateway) handleRevokeSession(c *gin.Context) {
+	sessionID := c.Param("id")
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	if _, err := g.identityClient.RevokeSession(ctx, &imv1.RevokeSessionRequest{SessionId: sessionID}); err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	// 下线当前会话时本实例立即拒绝当前令牌，其他令牌由撤销事件同步
+	if sessionID == currentSessionID(c) {
+		expiresAt := c.GetTime("token_expires_at")
+		if expiresAt.IsZero() {
+			expiresAt = time.Now().Add(g.cfg.Auth.RevocationTTL)
+		}
+		g.verifier.Cache().Revoke(c.GetString("token_jti"), expiresAt)
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
+
+func (g *Gateway) handleRevokeOtherSessions(c *gin.Context) {
+	current := currentSessionID(c)
+	if current == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "token has no session"})
+		return
+	}
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.identityClient.RevokeOtherSessions(ctx, &imv1.RevokeOtherSessionsRequest{CurrentSessionId: current})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": gin.H{"revoked": resp.Revoked}})
+}
+
+// ==================== 两步验证 Handler ====================
+
+func (g *Gateway) handleGetMFAStatus(c *gin.Context) {
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.identityClient.GetMFAStatus(ctx, &emptypb.Empty{})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": gin.H{
+		"enabled":             resp.Enabled,
+		"recovery_codes_left": resp.RecoveryCodesLeft,
+	}})
+}
+
+func (g *Gateway) handleEnrollTOTP(c *gin.Context) {
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.identityClient.EnrollTOTP(ctx, &emptypb.Empty{})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
 	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": gin.H{
+		"secret":      resp.Secret,
+		"otpauth_uri": resp.OtpauthUri,
+	}})
+}
+
+func (g *Gateway) handleConfirmTOTP(c *gin.Context) {
+	var req mfaCodeRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
 		return
 	}
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
 
-	// 从上下文获取 user_id
-	userID, exists := c.Get("user_id")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "user_id not found"})
+	resp, err := g.identityClient.ConfirmTOTP(ctx, &imv1.MFACodeRequest{Code: req.Code})
+	if err != nil {
+		writeGRPCError(c, err)
 		return
 	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": gin.H{"recovery_codes": resp.RecoveryCodes}})
+}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), g.timeout)
+func (g *Gateway) handleDisableTOTP(c *gin.Context) {
+	var req mfaCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+	ctx, cancel := g.ctxWithUserID(c)
 	defer cancel()
 
-	// 通过 gRPC metadata 传递 user_id
-	ctx = metadata.AppendToOutgoingContext(ctx, "user_id", strconv.FormatUint(userID.(uint64), 10))
+	if _, err := g.identityClient.DisableTOTP(ctx, &imv1.MFACodeRequest{Code: req.Code}); err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
 
-	resp, err := g.identityClient.UpdateProfile(ctx, &imv1.UpdateProfileRequest{
-		DisplayName: req.DisplayName,
-		AvatarUrl:   req.AvatarURL,
+func (g *Gateway) handleRegenerateRecoveryCodes(c *gin.Context) {
+	var req mfaCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.identityClient.RegenerateRecoveryCodes(ctx, &imv1.MFACodeRequest{Code: req.Code})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": gin.H{"recovery_codes": resp.RecoveryCodes}})
+}
+
+func (g *Gateway) handleStepUpMFA(c *gin.Context) {
+	var req mfaCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.identityClient.StepUpMFA(ctx, &imv1.MFACodeRequest{Code: req.Code})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": gin.H{"expires_in": resp.ExpiresIn}})
+}
+
+// ==================== 密码 Handler ====================
+
+func (g *Gateway) handleChangePassword(c *gin.Context) {
+	var req changePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.identityClient.ChangePassword(ctx, &imv1.ChangePasswordRequest{
+		OldPassword: req.OldPassword,
+		NewPassword: req.NewPassword,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeGRPCError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success", "data": resp})
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": gin.H{"revoked_sessions": resp.RevokedSessions}})
 }
 
-// ==================== 联系人相关 Handler ====================
+func (g *Gateway) handleRequestPasswordReset(c *gin.Context) {
+	var req passwordResetTargetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), g.timeout)
+	defer cancel()
+
+	resp, err := g.identityClient.RequestPasswordReset(ctx, &imv1.RequestPasswordResetRequest{
+		Channel: req.Channel,
+		Target:  req.Target,
+		Ip:      c.ClientIP(),
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": gin.H{"expires_in": resp.ExpiresIn}})
+}
+
+func (g *Gateway) handleVerifyPasswordReset(c *gin.Context) {
+	var req passwordResetTargetRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Code == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), g.timeout)
+	defer cancel()
+
+	resp, err := g.identityClient.VerifyPasswordReset(ctx, &imv1.VerifyPasswordResetRequest{
+		Channel: req.Channel,
+		Target:  req.Target,
+		Code:    req.Code,
+		Ip:      c.ClientIP(),
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": gin.H{
+		"reset_token": resp.ResetToken,
+		"expires_in":  resp.ExpiresIn,
+	}})
+}
+
+func (g *Gateway) handleResetPassword(c *gin.Context) {
+	var req resetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), g.timeout)
+	defer cancel()
+
+	if _, err := g.identityClient.ResetPassword(ctx, &imv1.ResetPasswordRequest{
+		ResetToken:  req.ResetToken,
+		NewPassword: req.NewPassword,
+	}); err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
+
+// currentSessionID 当前访问令牌所属的会话，会话上线前签发的令牌为空
+func currentSessionID(c *gin.Context) string {
+	if claims := authn.ClaimsFromGin(c); claims != nil {
+		return claims.SessionID
+	}
+	return ""
+}
+
+// ==================== 用户相关 Handler ====================
+
+func (g *Gateway) handleGetProfile(c *gin.Context) {
+	userID := c.GetUint64("user_id")
+	ctx, cancel := context.WithTimeout(c.Request.Context(), g.timeout)
+	defer cancel()
+
+	resp, err := g.identityClient.GetProfile(ctx, &imv1.GetProfileRequest{UserId: int64(userID)})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp})
+}
+
+func (g *Gateway) handleUpdateProfile(c *gin.Context) {
+	var req struct {
+		DisplayName string `json:"display_name"`
+		AvatarURL   string `json:"avatar_url"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	// 从上下文获取 user_id
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "user_id not found"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), g.timeout)
+	defer cancel()
+
+	// 通过 gRPC metadata 传递 user_id
+	ctx = metadata.AppendToOutgoingContext(ctx, "user_id", strconv.FormatUint(userID.(uint64), 10))
+
+	resp, err := g.identityClient.UpdateProfile(ctx, &imv1.UpdateProfileRequest{
+		DisplayName: req.DisplayName,
+		AvatarUrl:   req.AvatarURL,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success", "data": resp})
+}
+
+// handleSearchUser 按用户名 / 手机号 / 邮箱精确查找，受对方隐私设置约束
+func (g *Gateway) handleSearchUser(c *gin.Context) {
+	query := c.Query("q")
+	if query == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "q required"})
+		return
+	}
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.identityClient.SearchUser(ctx, &imv1.SearchUserRequest{Query: query})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": userBriefJSON(resp)})
+}
+
+func (g *Gateway) handleResolveShareCode(c *gin.Context) {
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.identityClient.ResolveShareCode(ctx, &imv1.ResolveShareCodeRequest{Code: c.Param("code")})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": userBriefJSON(resp)})
+}
+
+func (g *Gateway) handleGetShareCode(c *gin.Context) {
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.identityClient.GetShareCode(ctx, &emptypb.Empty{})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": gin.H{"share_code": resp.Code, "share_url": resp.ShareUrl}})
+}
+
+// handleResetShareCode 重新生成名片码，旧码与旧二维码立即失效
+func (g *Gateway) handleResetShareCode(c *gin.Context) {
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.identityClient.ResetShareCode(ctx, &emptypb.Empty{})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": gin.H{"share_code": resp.Code, "share_url": resp.ShareUrl}})
+}
+
+func (g *Gateway) handleGetPrivacySettings(c *gin.Context) {
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.identityClient.GetPrivacySettings(ctx, &emptypb.Empty{})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": privacySettingsJSON(resp)})
+}
+
+func (g *Gateway) handleUpdatePrivacySettings(c *gin.Context) {
+	var req struct {
+		AllowFindByUsername *bool `json:"allow_find_by_username" binding:"required"`
+		AllowFindByPhone    *bool `json:"allow_find_by_phone" binding:"required"`
+		AllowFindByEmail    *bool `json:"allow_find_by_email" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.identityClient.UpdatePrivacySettings(ctx, &imv1.PrivacySettings{
+		AllowFindByUsername: *req.AllowFindByUsername,
+		AllowFindByPhone:    *req.AllowFindByPhone,
+		AllowFindByEmail:    *req.AllowFindByEmail,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success", "data": privacySettingsJSON(resp)})
+}
+
+func userBriefJSON(u *imv1.UserBrief) gin.H {
+	return gin.H{
+		"id":           u.Id,
+		"username":     u.Username,
+		"display_name": u.DisplayName,
+		"avatar_url":   u.AvatarUrl,
+	}
+}
+
+func privacySettingsJSON(p *imv1.PrivacySettings) gin.H {
+	return gin.H{
+		"allow_find_by_username": p.AllowFindByUsername,
+		"allow_find_by_phone":    p.AllowFindByPhone,
+		"allow_find_by_email":    p.AllowFindByEmail,
+	}
+}
+
+// ==================== 联系人相关 Handler ====================
+
+func (g *Gateway) handleGetContacts(c *gin.Context) {
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.identityClient.ListContacts(ctx, &imv1.ListContactsRequest{Page: 1, PageSize: 100})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp.Contacts, "total": resp.Total})
+}
+
+func (g *Gateway) handleApplyContact(c *gin.Context) {
+	var req struct {
+		TargetUserID uint64 `json:"target_user_id" binding:"required"`
+		Remark       string `json:"remark"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	_, err := g.identityClient.ApplyContact(ctx, &imv1.ApplyContactRequest{
+		TargetUserId: int64(req.TargetUserID),
+		Remark:       req.Remark,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
+
+func (g *Gateway) handleContactApply(c *gin.Context) {
+	var req struct {
+		TargetUserID uint64 `json:"target_user_id" binding:"required"`
+		Accept       bool   `json:"accept"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	_, err := g.identityClient.RespondContact(ctx, &imv1.RespondContactRequest{
+		TargetUserId: int64(req.TargetUserID),
+		Accept:       req.Accept,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
+
+func (g *Gateway) handleDeleteContact(c *gin.Context) {
+	targetID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid contact id"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	_, err = g.identityClient.RemoveContact(ctx, &imv1.RemoveContactRequest{TargetUserId: int64(targetID)})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
+
+// ==================== 会话相关 Handler ====================
+
+func (g *Gateway) handleGetConversations(c *gin.Context) {
+	var req struct {
+		Page     int32 `form:"page"`
+		PageSize int32 `form:"page_size"`
+		Archived bool  `form:"archived"` // true: 只列出已归档会话
+	}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+	if req.PageSize == 0 {
+		req.PageSize = 50
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.conversationClient.ListMyConversations(ctx, &imv1.ListMyConversationsRequest{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Archived: req.Archived,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+
+	items := make([]gin.H, 0, len(resp.Conversations))
+	for _, item := range resp.Conversations {
+		items = append(items, conversationListItem(item))
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": items, "total": resp.Total})
+}
+
+// handleGetConversationOverview 游标分页的会话列表，附带最后一条消息预览、未读数和@提醒
+func (g *Gateway) handleGetConversationOverview(c *gin.Context) {
+	var req struct {
+		Cursor   string `form:"cursor"`
+		Limit    int32  `form:"limit"`
+		Archived bool   `form:"archived"`
+		FolderID int64  `form:"folder_id"` // 自定义文件夹
+		Folder   string `form:"folder"`    // 智能文件夹：unread, mentions, groups, direct
+	}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+	if req.Limit == 0 {
+		req.Limit = 20
+	}
+	smart, ok := smartFolders[req.Folder]
+	if req.Folder != "" && !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid folder"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.conversationClient.ScrollMyConversations(ctx, &imv1.ScrollMyConversationsRequest{
+		Cursor:      req.Cursor,
+		Limit:       req.Limit,
+		Archived:    req.Archived,
+		FolderId:    req.FolderID,
+		SmartFolder: smart,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+
+	convIDs := make([]int64, 0, len(resp.Items))
+	var channelIDs []int64
+	for _, item := range resp.Items {
+		convIDs = append(convIDs, item.Conversation.GetId())
+		if item.Conversation.GetType() == imv1.ConversationType_CONVERSATION_TYPE_CHANNEL {
+			channelIDs = append(channelIDs, item.Conversation.GetId())
+		}
+	}
+	summaries := make(map[int64]*imv1.ConversationSummary, len(convIDs))
+	if len(convIDs) > 0 {
+		summaryResp, err := g.messageClient.BatchGetConversationSummaries(ctx, &imv1.BatchGetConversationSummariesRequest{
+			ConversationIds: convIDs,
+			ChannelIds:      channelIDs,
+		})
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		for _, summary := range summaryResp.Items {
+			summaries[summary.ConversationId] = summary
+		}
+	}
+
+	items := make([]gin.H, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		h := conversationListItem(item)
+		h["unread_count"] = 0
+		h["has_mention"] = false
+		if summary, ok := summaries[item.Conversation.GetId()]; ok {
+			h["unread_count"] = summary.UnreadCount
+			h["has_mention"] = summary.HasMention
+			if msg := summary.LastMessage; msg != nil {
+				h["last_message"] = gin.H{
+					"id":           msg.Id,
+					"sender_id":    msg.SenderId,
+					"seq":          msg.Seq,
+					"content_type": msg.ContentType,
+					"preview":      summary.Preview,
+					"created_at":   msg.CreateTime.AsTime().Unix(),
+				}
+			}
+		}
+		items = append(items, h)
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": items, "next_cursor": resp.NextCursor, "has_more": resp.HasMore})
+}
+
+// conversationListItem 会话列表项，在会话基本信息上平铺个人设置
+func conversationListItem(item *imv1.MyConversationItem) gin.H {
+	h := gin.H{
+		"id":    item.Conversation.GetId(),
+		"type":  item.Conversation.GetType(),
+		"title": item.Conversation.GetTitle(),
+	}
+	if st := item.Setting; st != nil {
+		h["pinned"] = st.Pinned
+		h["muted"] = st.Muted
+		h["archived"] = st.Archived
+		h["remark"] = st.Remark
+		if st.PinnedAt != nil {
+			h["pinned_at"] = st.PinnedAt.AsTime().Unix()
+		}
+		if st.MuteUntil != nil {
+			h["mute_until"] = st.MuteUntil.AsTime().Unix()
+		}
+	}
+	if item.LastMessageAt != nil {
+		h["last_message_at"] = item.LastMessageAt.AsTime().Unix()
+	}
+	h["folder_ids"] = item.FolderIds
+	return h
+}
+
+func (g *Gateway) handleGetConversationSetting(c *gin.Context) {
+	convID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || convID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.conversationClient.GetConversationSetting(ctx, &imv1.GetConversationSettingRequest{ConversationId: convID})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp})
+}
+
+func (g *Gateway) handleUpdateConversationSetting(c *gin.Context) {
+	convID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || convID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+		return
+	}
+
+	// 未传的字段保持不变
+	var req struct {
+		Pinned      *bool   `json:"pinned"`
+		Muted       *bool   `json:"muted"`
+		MuteSeconds int64   `json:"mute_seconds"` // 开启免打扰时的时长，0: 永久
+		Archived    *bool   `json:"archived"`
+		Remark      *string `json:"remark"` // 空串: 清除备注
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.conversationClient.UpdateConversationSetting(ctx, &imv1.UpdateConversationSettingRequest{
+		ConversationId: convID,
+		Pinned:         req.Pinned,
+		Muted:          req.Muted,
+		MuteSeconds:    req.MuteSeconds,
+		Archived:       req.Archived,
+		Remark:         req.Remark,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp})
+}
+
+func (g *Gateway) handleCreateConversation(c *gin.Context) {
+	var req struct {
+		Type      int8     `json:"type" binding:"required"` // 1: 单聊, 2: 群聊, 3: 频道
+		Title     string   `json:"title"`
+		MemberIDs []uint64 `json:"member_ids" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	memberIDs := make([]int64, len(req.MemberIDs))
+	for i, id := range req.MemberIDs {
+		memberIDs[i] = int64(id)
+	}
+
+	resp, err := g.conversationClient.CreateConversation(ctx, &imv1.CreateConversationRequest{
+		Type:      imv1.ConversationType(req.Type),
+		Title:     req.Title,
+		MemberIds: memberIDs,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp})
+}
+
+func (g *Gateway) handleGetConversation(c *gin.Context) {
+	convID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || convID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.conversationClient.GetConversation(ctx, &imv1.GetConversationRequest{ConversationId: convID})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp})
+}
+
+func (g *Gateway) handleUpdateConversation(c *gin.Context) {
+	convID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || convID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+		return
+	}
+
+	var req struct {
+		Title string `json:"title"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.conversationClient.UpdateConversation(ctx, &imv1.UpdateConversationRequest{
+		ConversationId: convID,
+		Title:          req.Title,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp})
+}
+
+// parseConvAndUserID 解析路径中的会话ID和成员用户ID
+func parseConvAndUserID(c *gin.Context) (int64, int64, bool) {
+	convID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || convID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+		return 0, 0, false
+	}
+	userID, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	if err != nil || userID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user id"})
+		return 0, 0, false
+	}
+	return convID, userID, true
+}
+
+func (g *Gateway) handleDissolveConversation(c *gin.Context) {
+	convID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || convID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	if _, err := g.conversationClient.DissolveConversation(ctx, &imv1.DissolveConversationRequest{ConversationId: convID}); err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
+
+func (g *Gateway) handleLeaveConversation(c *gin.Context) {
+	convID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || convID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	if _, err := g.conversationClient.LeaveConversation(ctx, &imv1.LeaveConversationRequest{ConversationId: convID}); err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
+
+func (g *Gateway) handleTransferOwnership(c *gin.Context) {
+	convID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || convID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+		return
+	}
+
+	var req struct {
+		NewOwnerID int64 `json:"new_owner_id" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	_, err = g.conversationClient.TransferOwnership(ctx, &imv1.TransferOwnershipRequest{
+		ConversationId: convID,
+		NewOwnerId:     req.NewOwnerID,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
+
+func (g *Gateway) handleSetMuteAll(c *gin.Context) {
+	convID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || convID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+		return
+	}
+
+	var req struct {
+		Mute bool `json:"mute"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	if _, err := g.conversationClient.SetMuteAll(ctx, &imv1.SetMuteAllRequest{ConversationId: convID, Mute: req.Mute}); err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
+
+// handleSetMessageTTL 设置消息自动删除时长，ttl_seconds 为0表示关闭
+func (g *Gateway) handleSetMessageTTL(c *gin.Context) {
+	convID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || convID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+		return
+	}
+
+	var req struct {
+		TTLSeconds int64 `json:"ttl_seconds"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	if _, err := g.conversationClient.SetMessageTTL(ctx, &imv1.SetMessageTTLRequest{ConversationId: convID, TtlSeconds: req.TTLSeconds}); err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
+
+// handleSetMemberNickname 设置自己的群昵称，nickname 为空表示清除
+func (g *Gateway) handleSetMemberNickname(c *gin.Context) {
+	convID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || convID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+		return
+	}
+
+	var req struct {
+		Nickname string `json:"nickname"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.conversationClient.SetMemberNickname(ctx, &imv1.SetMemberNicknameRequest{ConversationId: convID, Nickname: req.Nickname})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp})
+}
+
+// handleListMembers 游标分页的成员列表，支持按角色、禁言状态过滤和按名称搜索，首页附带各角色成员数
+func (g *Gateway) handleListMembers(c *gin.Context) {
+	convID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || convID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+		return
+	}
+
+	var req struct {
+		Cursor  string `form:"cursor"`
+		Limit   int32  `form:"limit"`
+		Role    int32  `form:"role"` // 1: 普通成员, 2: 管理员, 3: 群主，不传表示全部
+		Muted   *bool  `form:"muted"`
+		Keyword string `form:"keyword"`
+	}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+	if req.Limit == 0 {
+		req.Limit = 50
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.conversationClient.ScrollMembers(ctx, &imv1.ScrollMembersRequest{
+		ConversationId: convID,
+		Cursor:         req.Cursor,
+		Limit:          req.Limit,
+		Role:           imv1.MemberRole(req.Role),
+		Muted:          req.Muted,
+		Keyword:        req.Keyword,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+
+	body := gin.H{"code": 0, "data": resp.Items, "next_cursor": resp.NextCursor, "has_more": resp.HasMore}
+	if resp.Summary != nil {
+		body["summary"] = resp.Summary
+	}
+	c.JSON(http.StatusOK, body)
+}
+
+func (g *Gateway) handleAddMembers(c *gin.Context) {
+	convID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || convID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+		return
+	}
+
+	var req struct {
+		UserIDs []int64 `json:"user_ids" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	if _, err := g.conversationClient.AddMembers(ctx, &imv1.AddMembersRequest{ConversationId: convID, UserIds: req.UserIDs}); err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
+
+func (g *Gateway) handleRemoveMember(c *gin.Context) {
+	convID, userID, ok := parseConvAndUserID(c)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	if _, err := g.conversationClient.RemoveMembers(ctx, &imv1.RemoveMembersRequest{ConversationId: convID, UserIds: []int64{userID}}); err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
+
+func (g *Gateway) handleSetMemberRole(c *gin.Context) {
+	convID, userID, ok := parseConvAndUserID(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Role int32 `json:"role" binding:"required"` // 1: 普通成员, 2: 管理员
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	_, err := g.conversationClient.SetMemberRole(ctx, &imv1.SetMemberRoleRequest{
+		ConversationId: convID,
+		UserId:         userID,
+		Role:           imv1.MemberRole(req.Role),
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
+
+func (g *Gateway) handleMuteMember(c *gin.Context) {
+	convID, userID, ok := parseConvAndUserID(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		DurationSeconds int64 `json:"duration_seconds"` // 0: 永久禁言
+	}
+	if err := c.ShouldBindJSON(&req); err != nil && err != io.EOF {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	_, err := g.conversationClient.MuteMember(ctx, &imv1.MuteMemberRequest{
+		ConversationId:  convID,
+		UserId:          userID,
+		DurationSeconds: req.DurationSeconds,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
+
+func (g *Gateway) handleUnmuteMember(c *gin.Context) {
+	convID, userID, ok := parseConvAndUserID(c)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	if _, err := g.conversationClient.UnmuteMember(ctx, &imv1.UnmuteMemberRequest{ConversationId: convID, UserId: userID}); err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
+
+func (g *Gateway) handleRequestJoin(c *gin.Context) {
+	convID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || convID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+		return
+	}
+
+	var req struct {
+		Message string `json:"message"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil && err != io.EOF {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.conversationClient.RequestJoin(ctx, &imv1.RequestJoinRequest{
+		ConversationId: convID,
+		Message:        req.Message,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp})
+}
+
+func (g *Gateway) handleListJoinRequests(c *gin.Context) {
+	convID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || convID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+		return
+	}
+
+	var req struct {
+		Status   int32 `form:"status"` // 1: 待审批, 2: 已同意, 3: 已拒绝
+		Page     int32 `form:"page"`
+		PageSize int32 `form:"page_size"`
+	}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.conversationClient.ListJoinRequests(ctx, &imv1.ListJoinRequestsRequest{
+		ConversationId: convID,
+		Status:         imv1.JoinRequestStatus(req.Status),
+		Page:           req.Page,
+		PageSize:       req.PageSize,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp.Items, "total": resp.Total})
+}
+
+func (g *Gateway) handleJoinRequest(c *gin.Context) {
+	requestID, err := strconv.ParseInt(c.Param("request_id"), 10, 64)
+	if err != nil || requestID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request id"})
+		return
+	}
+
+	var req struct {
+		Approve bool `json:"approve"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.conversationClient.HandleJoinRequest(ctx, &imv1.HandleJoinRequestRequest{
+		RequestId: requestID,
+		Approve:   req.Approve,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp})
+}
+
+func (g *Gateway) handleCreateInvite(c *gin.Context) {
+	convID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || convID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+		return
+	}
+
+	var req struct {
+		ExpiresInSeconds int64 `json:"expires_in_seconds"` // 0: 永不过期
+		MaxUses          int32 `json:"max_uses"`           // 0: 不限次数
+	}
+	if err := c.ShouldBindJSON(&req); err != nil && err != io.EOF {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.conversationClient.CreateInvite(ctx, &imv1.CreateInviteRequest{
+		ConversationId:   convID,
+		ExpiresInSeconds: req.ExpiresInSeconds,
+		MaxUses:          req.MaxUses,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp})
+}
+
+func (g *Gateway) handleListInvites(c *gin.Context) {
+	convID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || convID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.conversationClient.ListInvites(ctx, &imv1.ListInvitesRequest{ConversationId: convID})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp.Items})
+}
+
+func (g *Gateway) handleRevokeInvite(c *gin.Context) {
+	inviteID, err := strconv.ParseInt(c.Param("invite_id"), 10, 64)
+	if err != nil || inviteID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid invite id"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	if _, err := g.conversationClient.RevokeInvite(ctx, &imv1.RevokeInviteRequest{InviteId: inviteID}); err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
+
+func (g *Gateway) handlePreviewInvite(c *gin.Context) {
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.conversationClient.PreviewInvite(ctx, &imv1.PreviewInviteRequest{Code: c.Param("code")})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp})
+}
+
+func (g *Gateway) handleJoinByInvite(c *gin.Context) {
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.conversationClient.JoinByInvite(ctx, &imv1.JoinByInviteRequest{Code: c.Param("code")})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp})
+}
+
+// ==================== 消息相关 Handler ====================
+
+func (g *Gateway) handleGetAnnouncement(c *gin.Context) {
+	convID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || convID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.conversationClient.GetAnnouncement(ctx, &imv1.GetAnnouncementRequest{ConversationId: convID})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp})
+}
+
+func (g *Gateway) handleSetAnnouncement(c *gin.Context) {
+	convID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || convID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+		return
+	}
+
+	var req struct {
+		Content string `json:"content" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.conversationClient.SetAnnouncement(ctx, &imv1.SetAnnouncementRequest{
+		ConversationId: convID,
+		Content:        req.Content,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp})
+}
+
+func (g *Gateway) handleDeleteAnnouncement(c *gin.Context) {
+	convID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || convID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	if _, err := g.conversationClient.DeleteAnnouncement(ctx, &imv1.DeleteAnnouncementRequest{ConversationId: convID}); err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
+
+func (g *Gateway) handleListPinnedMessages(c *gin.Context) {
+	convID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || convID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+		return
+	}
 
-func (g *Gateway) handleGetContacts(c *gin.Context) {
 	ctx, cancel := g.ctxWithUserID(c)
 	defer cancel()
 
-	resp, err := g.identityClient.ListContacts(ctx, &imv1.ListContactsRequest{Page: 1, PageSize: 100})
+	resp, err := g.conversationClient.ListPinnedMessages(ctx, &imv1.ListPinnedMessagesRequest{ConversationId: convID})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeGRPCError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp.Contacts, "total": resp.Total})
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp.Items})
 }
 
-func (g *Gateway) handleApplyContact(c *gin.Context) {
+func (g *Gateway) handlePinMessage(c *gin.Context) {
+	convID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || convID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+		return
+	}
+
 	var req struct {
-		TargetUserID uint64 `json:"target_user_id" binding:"required"`
-		Remark       string `json:"remark"`
+		MessageID int64 `json:"message_id" binding:"required"`
 	}
-	if err := c.ShouldBindJSON(&req); err != nil {
+	if err := c.ShouldBindJSON(&req); err != nil || req.MessageID <= 0 {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
 		return
 	}
@@ -314,78 +1976,108 @@ func (g *Gateway) handleApplyContact(c *gin.Context) {
 	ctx, cancel := g.ctxWithUserID(c)
 	defer cancel()
 
-	_, err := g.identityClient.ApplyContact(ctx, &imv1.ApplyContactRequest{
-		TargetUserId: int64(req.TargetUserID),
-		Remark:       req.Remark,
+	resp, err := g.conversationClient.PinMessage(ctx, &imv1.PinMessageRequest{
+		ConversationId: convID,
+		MessageId:      req.MessageID,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeGRPCError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp})
 }
 
-func (g *Gateway) handleContactApply(c *gin.Context) {
-	var req struct {
-		TargetUserID uint64 `json:"target_user_id" binding:"required"`
-		Accept       bool   `json:"accept"`
+func (g *Gateway) handleUnpinMessage(c *gin.Context) {
+	convID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || convID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+		return
 	}
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+	msgID, err := strconv.ParseInt(c.Param("message_id"), 10, 64)
+	if err != nil || msgID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid message id"})
 		return
 	}
 
 	ctx, cancel := g.ctxWithUserID(c)
 	defer cancel()
 
-	_, err := g.identityClient.RespondContact(ctx, &imv1.RespondContactRequest{
-		TargetUserId: int64(req.TargetUserID),
-		Accept:       req.Accept,
-	})
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	if _, err := g.conversationClient.UnpinMessage(ctx, &imv1.UnpinMessageRequest{
+		ConversationId: convID,
+		MessageId:      msgID,
+	}); err != nil {
+		writeGRPCError(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
 }
 
-func (g *Gateway) handleDeleteContact(c *gin.Context) {
-	targetID, err := strconv.ParseUint(c.Param("id"), 10, 64)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid contact id"})
-		return
+// smartFolders 智能文件夹的查询参数名
+var smartFolders = map[string]imv1.SmartFolder{
+	"unread":   imv1.SmartFolder_SMART_FOLDER_UNREAD,
+	"mentions": imv1.SmartFolder_SMART_FOLDER_MENTIONS,
+	"groups":   imv1.SmartFolder_SMART_FOLDER_GROUPS,
+	"direct":   imv1.SmartFolder_SMART_FOLDER_DIRECT,
+}
+
+func folderItem(f *imv1.Folder) gin.H {
+	h := gin.H{
+		"id":                 f.Id,
+		"name":               f.Name,
+		"sort_order":         f.SortOrder,
+		"conversation_count": f.ConversationCount,
+		"unread_count":       f.UnreadCount,
+	}
+	for name, smart := range smartFolders {
+		if smart == f.Smart {
+			h["smart"] = name
+		}
 	}
+	return h
+}
 
+// handleListFolders 文件夹列表，智能文件夹在前，附带会话数和未读数
+func (g *Gateway) handleListFolders(c *gin.Context) {
 	ctx, cancel := g.ctxWithUserID(c)
 	defer cancel()
 
-	_, err = g.identityClient.RemoveContact(ctx, &imv1.RemoveContactRequest{TargetUserId: int64(targetID)})
+	resp, err := g.conversationClient.ListFolders(ctx, &imv1.ListFoldersRequest{})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeGRPCError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+
+	items := make([]gin.H, 0, len(resp.Items))
+	for _, f := range resp.Items {
+		items = append(items, folderItem(f))
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": items})
 }
 
-// ==================== 会话相关 Handler ====================
+func (g *Gateway) handleCreateFolder(c *gin.Context) {
+	var req struct {
+		Name string `json:"name" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
 
-func (g *Gateway) handleGetConversations(c *gin.Context) {
 	ctx, cancel := g.ctxWithUserID(c)
 	defer cancel()
 
-	resp, err := g.conversationClient.ListMyConversations(ctx, &imv1.ListMyConversationsRequest{Page: 1, PageSize: 50})
+	resp, err := g.conversationClient.CreateFolder(ctx, &imv1.CreateFolderRequest{Name: req.Name})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeGRPCError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp.Items, "total": resp.Total})
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": folderItem(resp)})
 }
 
-func (g *Gateway) handleCreateConversation(c *gin.Context) {
+// handleReorderFolders 调整文件夹顺序，folder_ids 须包含全部自定义文件夹
+func (g *Gateway) handleReorderFolders(c *gin.Context) {
 	var req struct {
-		Type      int8     `json:"type" binding:"required"` // 1: 单聊, 2: 群聊
-		Title     string   `json:"title"`
-		MemberIDs []uint64 `json:"member_ids" binding:"required"`
+		FolderIDs []int64 `json:"folder_ids"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
@@ -395,57 +2087,65 @@ func (g *Gateway) handleCreateConversation(c *gin.Context) {
 	ctx, cancel := g.ctxWithUserID(c)
 	defer cancel()
 
-	memberIDs := make([]int64, len(req.MemberIDs))
-	for i, id := range req.MemberIDs {
-		memberIDs[i] = int64(id)
+	if _, err := g.conversationClient.ReorderFolders(ctx, &imv1.ReorderFoldersRequest{FolderIds: req.FolderIDs}); err != nil {
+		writeGRPCError(c, err)
+		return
 	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
 
-	resp, err := g.conversationClient.CreateConversation(ctx, &imv1.CreateConversationRequest{
-		Type:      imv1.ConversationType(req.Type),
-		Title:     req.Title,
-		MemberIds: memberIDs,
-	})
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+func (g *Gateway) handleRenameFolder(c *gin.Context) {
+	folderID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || folderID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid folder id"})
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp})
-}
 
-func (g *Gateway) handleGetConversation(c *gin.Context) {
-	convID, err := strconv.ParseInt(c.Param("id"), 10, 64)
-	if err != nil || convID <= 0 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+	var req struct {
+		Name string `json:"name" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
 		return
 	}
 
 	ctx, cancel := g.ctxWithUserID(c)
 	defer cancel()
 
-	resp, err := g.conversationClient.ListMyConversations(ctx, &imv1.ListMyConversationsRequest{Page: 1, PageSize: 100})
+	resp, err := g.conversationClient.RenameFolder(ctx, &imv1.RenameFolderRequest{FolderId: folderID, Name: req.Name})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeGRPCError(c, err)
 		return
 	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": folderItem(resp)})
+}
 
-	for _, item := range resp.Items {
-		if item.Id == convID {
-			c.JSON(http.StatusOK, gin.H{"code": 0, "data": item})
-			return
-		}
+func (g *Gateway) handleDeleteFolder(c *gin.Context) {
+	folderID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || folderID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid folder id"})
+		return
 	}
-	c.JSON(http.StatusNotFound, gin.H{"error": "conversation not found"})
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	if _, err := g.conversationClient.DeleteFolder(ctx, &imv1.DeleteFolderRequest{FolderId: folderID}); err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
 }
 
-func (g *Gateway) handleUpdateConversation(c *gin.Context) {
-	convID, err := strconv.ParseInt(c.Param("id"), 10, 64)
-	if err != nil || convID <= 0 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+func (g *Gateway) handleAddFolderConversations(c *gin.Context) {
+	folderID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || folderID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid folder id"})
 		return
 	}
 
 	var req struct {
-		Title string `json:"title"`
+		ConversationIDs []int64 `json:"conversation_ids" binding:"required"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
@@ -455,25 +2155,49 @@ func (g *Gateway) handleUpdateConversation(c *gin.Context) {
 	ctx, cancel := g.ctxWithUserID(c)
 	defer cancel()
 
-	resp, err := g.conversationClient.UpdateConversation(ctx, &imv1.UpdateConversationRequest{
-		ConversationId: convID,
-		Title:          req.Title,
-	})
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	if _, err := g.conversationClient.AddFolderConversations(ctx, &imv1.AddFolderConversationsRequest{
+		FolderId:        folderID,
+		ConversationIds: req.ConversationIDs,
+	}); err != nil {
+		writeGRPCError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp})
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
 }
 
-// ==================== 消息相关 Handler ====================
+func (g *Gateway) handleRemoveFolderConversation(c *gin.Context) {
+	folderID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || folderID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid folder id"})
+		return
+	}
+	convID, err := strconv.ParseInt(c.Param("conversation_id"), 10, 64)
+	if err != nil || convID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid conversation id"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	if _, err := g.conversationClient.RemoveFolderConversation(ctx, &imv1.RemoveFolderConversationRequest{
+		FolderId:       folderID,
+		ConversationId: convID,
+	}); err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
 
 func (g *Gateway) handleSendMessage(c *gin.Context) {
 	var req struct {
-		ConversationID int64  `json:"conversation_id" binding:"required"`
-		ClientMsgID    string `json:"client_msg_id" binding:"required"`
-		ContentType    int32  `json:"content_type" binding:"required"`
-		Text           string `json:"text"` // 文本消息内容
+		ConversationID int64   `json:"conversation_id" binding:"required"`
+		ClientMsgID    string  `json:"client_msg_id" binding:"required"`
+		ContentType    int32   `json:"content_type" binding:"required"`
+		Text           string  `json:"text"`             // 文本消息内容
+		MentionUserIDs []int64 `json:"mention_user_ids"` // 被@的成员
+		MentionAll     bool    `json:"mention_all"`      // @所有人
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
@@ -486,7 +2210,11 @@ func (g *Gateway) handleSendMessage(c *gin.Context) {
 	// 构建消息体
 	body := &imv1.MessageBody{
 		Body: &imv1.MessageBody_Text{
-			Text: &imv1.TextBody{Text: req.Text},
+			Text: &imv1.TextBody{
+				Text:           req.Text,
+				MentionUserIds: req.MentionUserIDs,
+				MentionAll:     req.MentionAll,
+			},
 		},
 	}
 
@@ -497,7 +2225,7 @@ func (g *Gateway) handleSendMessage(c *gin.Context) {
 		Body:           body,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeGRPCError(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp.Message})
@@ -680,3 +2408,207 @@ func (g *Gateway) handleCompleteUpload(c *gin.Context) {
 	}
 	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp.Message})
 }
+
+// ==================== 管理相关 Handler ====================
+
+type roleRequest struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+// handleGetMyPermissions 返回当前令牌中的角色与权限，供客户端决定是否展示管理入口
+func (g *Gateway) handleGetMyPermissions(c *gin.Context) {
+	claims := authn.ClaimsFromGin(c)
+	if claims == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "user_id not found"})
+		return
+	}
+	roles := claims.Roles
+	if roles == nil {
+		roles = []string{}
+	}
+	perms := claims.Permissions
+	if perms == nil {
+		perms = []string{}
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": gin.H{"roles": roles, "permissions": perms}})
+}
+
+func (g *Gateway) handleListRoles(c *gin.Context) {
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.identityClient.ListRoles(ctx, &emptypb.Empty{})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp.Roles})
+}
+
+func (g *Gateway) handleCreateRole(c *gin.Context) {
+	var req roleRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Name == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	role, err := g.identityClient.CreateRole(ctx, &imv1.RoleRequest{
+		Name:        req.Name,
+		Description: req.Description,
+		Permissions: req.Permissions,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success", "data": role})
+}
+
+func (g *Gateway) handleUpdateRole(c *gin.Context) {
+	var req roleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	role, err := g.identityClient.UpdateRole(ctx, &imv1.RoleRequest{
+		Name:        c.Param("name"),
+		Description: req.Description,
+		Permissions: req.Permissions,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success", "data": role})
+}
+
+func (g *Gateway) handleDeleteRole(c *gin.Context) {
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	if _, err := g.identityClient.DeleteRole(ctx, &imv1.DeleteRoleRequest{Name: c.Param("name")}); err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
+
+func (g *Gateway) handleListUserRoles(c *gin.Context) {
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || userID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user id"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	resp, err := g.identityClient.ListUserRoles(ctx, &imv1.ListUserRolesRequest{UserId: userID})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": resp.Roles})
+}
+
+func (g *Gateway) handleAssignUserRole(c *gin.Context) {
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || userID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user id"})
+		return
+	}
+	var req struct {
+		Role string `json:"role" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	if _, err := g.identityClient.AssignUserRole(ctx, &imv1.UserRoleRequest{UserId: userID, Role: req.Role}); err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
+
+func (g *Gateway) handleRevokeUserRole(c *gin.Context) {
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || userID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user id"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	if _, err := g.identityClient.RevokeUserRole(ctx, &imv1.UserRoleRequest{UserId: userID, Role: c.Param("role")}); err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
+
+func (g *Gateway) handleBlockUser(c *gin.Context) {
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || userID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user id"})
+		return
+	}
+	var req struct {
+		Reason          string `json:"reason"`
+		DurationSeconds int64  `json:"duration_seconds"`
+	}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+			return
+		}
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	_, err = g.identityClient.BlockUser(ctx, &imv1.BlockUserRequest{
+		UserId:          userID,
+		Reason:          req.Reason,
+		DurationSeconds: req.DurationSeconds,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	// 本实例立即拒绝该用户的令牌，其他实例由状态变更事件同步
+	g.verifier.Cache().InvalidateStatus(uint64(userID))
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
+
+func (g *Gateway) handleUnblockUser(c *gin.Context) {
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || userID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user id"})
+		return
+	}
+
+	ctx, cancel := g.ctxWithUserID(c)
+	defer cancel()
+
+	if _, err := g.identityClient.UnblockUser(ctx, &imv1.UnblockUserRequest{UserId: userID}); err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	g.verifier.Cache().InvalidateStatus(uint64(userID))
+	c.JSON(http.StatusOK, gin.H{"code": 0, "message": "success"})
+}
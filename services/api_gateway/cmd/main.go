@@ -11,12 +11,14 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
 	imv1 "github.com/EthanQC/IM/api/gen/im/v1"
+	"github.com/EthanQC/IM/pkg/authn"
 	"github.com/EthanQC/IM/pkg/zlog"
 )
 
@@ -32,10 +34,30 @@ type Config struct {
 		GrpcTimeout          time.Duration `mapstructure:"grpc_timeout"`
 		ReadTimeout          time.Duration `mapstructure:"read_timeout"`
 		WriteTimeout         time.Duration `mapstructure:"write_timeout"`
+		// TrustedProxies 可信反向代理（IP/CIDR），只有来自这些地址的 X-Forwarded-For 才被采信；为空时使用连接对端地址
+		TrustedProxies []string `mapstructure:"trusted_proxies"`
 	} `mapstructure:"server"`
 	JWT struct {
-		Secret string `mapstructure:"secret"`
+		Secret       string        `mapstructure:"secret"`
+		JWKSURL      string        `mapstructure:"jwks_url"`
+		JWKSRefresh  time.Duration `mapstructure:"jwks_refresh_interval"`
+		AcceptLegacy bool          `mapstructure:"accept_legacy_hs256"`
+		LegacySunset string        `mapstructure:"legacy_hs256_sunset"`
 	} `mapstructure:"jwt"`
+	Redis struct {
+		Addr     string `mapstructure:"addr"`
+		Password string `mapstructure:"password"`
+		DB       int    `mapstructure:"db"`
+	} `mapstructure:"redis"`
+	Kafka struct {
+		Brokers []string `mapstructure:"brokers"`
+	} `mapstructure:"kafka"`
+	Auth struct {
+		RevocationTopic string        `mapstructure:"revocation_topic"`
+		RevocationTTL   time.Duration `mapstructure:"revocation_ttl"`
+		StatusCacheTTL  time.Duration `mapstructure:"status_cache_ttl"`
+		FailOpen        bool          `mapstructure:"fail_open"`
+	} `mapstructure:"auth"`
 }
 
 type Gateway struct {
@@ -46,6 +68,8 @@ type Gateway struct {
 	messageClient      imv1.MessageServiceClient
 	presenceClient     imv1.PresenceServiceClient
 	fileClient         imv1.FileServiceClient
+	verifier           *authn.Verifier
+	authorizer         *authn.Authorizer
 	timeout            time.Duration
 }
 
@@ -81,9 +105,15 @@ func main() {
 
 	gw := &Gateway{
 		cfg:     cfg,
-		router:  gin.Default(),
+		router:  gin.New(),
 		timeout: cfg.Server.GrpcTimeout,
 	}
+	// 访问日志隐藏查询参数中的令牌
+	gw.router.Use(authn.GinLogger(), gin.Recovery())
+	// 客户端 IP 写入设备会话、登录防护与限流，不能采信任意来源的 X-Forwarded-For
+	if err := gw.router.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		logger.Fatal("invalid trusted proxies", zap.Error(err))
+	}
 
 	// 连接各个gRPC服务
 	if cfg.Server.GrpcAddrIdentity != "" {
@@ -131,6 +161,46 @@ func main() {
 		}
 	}
 
+	// 令牌校验：验签 + 撤销缓存 + Redis 黑名单 + 账号状态
+	legacySecret := ""
+	acceptLegacy, err := authn.AcceptLegacyHS256(cfg.JWT.AcceptLegacy, cfg.JWT.LegacySunset, time.Now())
+	if err != nil {
+		logger.Warn("legacy HS256 tokens rejected", zap.Error(err))
+	} else if cfg.JWT.AcceptLegacy && !acceptLegacy {
+		logger.Warn("legacy HS256 sunset passed, remove accept_legacy_hs256 and jwt.secret")
+	}
+	if acceptLegacy {
+		legacySecret = cfg.JWT.Secret
+	}
+	gw.verifier = authn.NewVerifier(legacySecret, nil)
+	gw.verifier.SetFailOpen(cfg.Auth.FailOpen)
+	if cfg.JWT.JWKSURL != "" {
+		gw.verifier.SetKeySource(authn.NewJWKSClient(cfg.JWT.JWKSURL, cfg.JWT.JWKSRefresh))
+	}
+	if cfg.Redis.Addr != "" {
+		rdb := redis.NewClient(&redis.Options{Addr: cfg.Redis.Addr, Password: cfg.Redis.Password, DB: cfg.Redis.DB})
+		defer rdb.Close()
+		gw.verifier.SetRevocationStore(authn.NewRedisRevocationStore(rdb))
+	}
+	if gw.identityClient != nil {
+		gw.verifier.SetStatusChecker(authn.NewIdentityStatusChecker(gw.identityClient, cfg.Server.GrpcTimeout), cfg.Auth.StatusCacheTTL)
+	}
+	if len(cfg.Kafka.Brokers) > 0 {
+		sub, err := authn.NewRevocationSubscriber(cfg.Kafka.Brokers, cfg.Auth.RevocationTopic, gw.verifier.Cache(), cfg.Auth.RevocationTTL)
+		if err != nil {
+			logger.Warn("failed to create revocation subscriber", zap.Error(err))
+		} else if err := sub.Start(context.Background()); err != nil {
+			logger.Warn("failed to start revocation subscriber", zap.Error(err))
+		} else {
+			defer sub.Stop()
+		}
+	}
+
+	gw.authorizer, err = authn.NewAuthorizer(accessRules())
+	if err != nil {
+		logger.Fatal("invalid access rules", zap.Error(err))
+	}
+
 	gw.registerRoutes()
 
 	addr := fmt.Sprintf(":%d", cfg.Server.HTTPPort)
@@ -172,6 +242,12 @@ func loadConfig() (Config, error) {
 	viper.SetDefault("server.grpc_timeout", "3s")
 	viper.SetDefault("server.read_timeout", "5s")
 	viper.SetDefault("server.write_timeout", "5s")
+	viper.SetDefault("jwt.accept_legacy_hs256", false)
+	viper.SetDefault("jwt.legacy_hs256_sunset", authn.DefaultLegacyHS256Sunset)
+	viper.SetDefault("auth.revocation_topic", authn.DefaultRevocationTopic)
+	viper.SetDefault("auth.revocation_ttl", "15m")
+	viper.SetDefault("auth.status_cache_ttl", "30s")
+	viper.SetDefault("auth.fail_open", false)
 
 	var cfg Config
 	if err := viper.ReadInConfig(); err != nil {
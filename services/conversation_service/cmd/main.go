@@ -6,17 +6,24 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	mysqlDriver "gorm.io/driver/mysql"
 	"gorm.io/gorm"
 
+	imv1 "github.com/EthanQC/IM/api/gen/im/v1"
 	"github.com/EthanQC/IM/pkg/zlog"
 	grpcAdapter "github.com/EthanQC/IM/services/conversation_service/internal/adapters/in/grpc"
+	mqIn "github.com/EthanQC/IM/services/conversation_service/internal/adapters/in/mq"
+	grpcOut "github.com/EthanQC/IM/services/conversation_service/internal/adapters/out/grpc"
+	"github.com/EthanQC/IM/services/conversation_service/internal/adapters/out/mq"
 	mysqlRepo "github.com/EthanQC/IM/services/conversation_service/internal/adapters/out/mysql"
 	"github.com/EthanQC/IM/services/conversation_service/internal/application/conversation"
+	"github.com/EthanQC/IM/services/conversation_service/internal/ports/out"
 )
 
 type Config struct {
@@ -28,6 +35,7 @@ type Config struct {
 	} `mapstructure:"mysql"`
 	Kafka struct {
 		Brokers []string `mapstructure:"brokers"`
+		GroupID string   `mapstructure:"group_id"`
 	} `mapstructure:"kafka"`
 }
 
@@ -82,9 +90,70 @@ func main() {
 	// 初始化仓储
 	convRepo := mysqlRepo.NewConversationRepositoryMySQL(db)
 	participantRepo := mysqlRepo.NewParticipantRepositoryMySQL(db)
+	joinReqRepo := mysqlRepo.NewJoinRequestRepositoryMySQL(db)
+	inviteRepo := mysqlRepo.NewGroupInviteRepositoryMySQL(db)
+	settingRepo := mysqlRepo.NewConversationSettingRepositoryMySQL(db)
+	announcementRepo := mysqlRepo.NewAnnouncementRepositoryMySQL(db)
+	pinRepo := mysqlRepo.NewPinnedMessageRepositoryMySQL(db)
+	ownershipRepo := mysqlRepo.NewOwnershipRepositoryMySQL(db)
+	folderRepo := mysqlRepo.NewFolderRepositoryMySQL(db)
+
+	// 初始化Kafka事件发布器（未配置时不发布事件）
+	var eventPub out.EventPublisher
+	if len(cfg.Kafka.Brokers) > 0 {
+		eventPub, err = mq.NewKafkaEventPublisher(cfg.Kafka.Brokers)
+		if err != nil {
+			logger.Fatal("初始化Kafka发布器失败", zap.Error(err))
+		}
+	} else {
+		logger.Warn("未配置Kafka，会话事件将不会发布")
+	}
 
 	// 初始化用例
-	convUC := conversation.NewConversationUseCaseImpl(convRepo, participantRepo, nil)
+	convUC := conversation.NewConversationUseCaseImpl(convRepo, participantRepo, joinReqRepo, inviteRepo, settingRepo, announcementRepo, pinRepo, ownershipRepo, folderRepo, eventPub)
+
+	grpcTimeout := viper.GetDuration("grpc.timeout")
+	if grpcTimeout == 0 {
+		grpcTimeout = 3 * time.Second
+	}
+
+	// 初始化消息服务客户端（置顶消息时校验消息、统计文件夹未读数，未配置时不可置顶）
+	if msgAddr := viper.GetString("grpc.message_addr"); msgAddr != "" {
+		msgConn, err := grpc.Dial(msgAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			logger.Fatal("连接消息服务失败", zap.Error(err))
+		}
+		defer msgConn.Close()
+		convUC.SetMessageReader(grpcOut.NewMessageClient(imv1.NewMessageServiceClient(msgConn), grpcTimeout))
+	} else {
+		logger.Warn("未配置消息服务地址，置顶消息功能不可用")
+	}
+
+	// 初始化身份服务客户端（成员列表附带用户资料、按展示名搜索成员）
+	if identityAddr := viper.GetString("grpc.identity_addr"); identityAddr != "" {
+		identityConn, err := grpc.Dial(identityAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			logger.Fatal("连接身份服务失败", zap.Error(err))
+		}
+		defer identityConn.Close()
+		convUC.SetUserReader(grpcOut.NewIdentityClient(imv1.NewIdentityServiceClient(identityConn), grpcTimeout))
+	} else {
+		logger.Warn("未配置身份服务地址，成员列表将不含用户资料，成员搜索仅匹配群昵称")
+	}
+
+	// 消费新消息事件，维护会话列表排序所需的最后消息时间
+	if len(cfg.Kafka.Brokers) > 0 {
+		groupID := cfg.Kafka.GroupID
+		if groupID == "" {
+			groupID = "conversation-service-group"
+		}
+		msgConsumer, err := mqIn.NewMessageEventConsumer(cfg.Kafka.Brokers, groupID, convUC)
+		if err != nil {
+			logger.Fatal("初始化消息事件消费者失败", zap.Error(err))
+		}
+		msgConsumer.Start(context.Background())
+		defer msgConsumer.Stop()
+	}
 
 	// 初始化gRPC服务器
 	grpcServer := grpc.NewServer()
@@ -2,9 +2,12 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"strconv"
+	"time"
 
 	imv1 "github.com/EthanQC/IM/api/gen/im/v1"
+	"github.com/EthanQC/IM/services/conversation_service/internal/application/conversation"
 	"github.com/EthanQC/IM/services/conversation_service/internal/domain/entity"
 	"github.com/EthanQC/IM/services/conversation_service/internal/ports/in"
 	"google.golang.org/grpc"
@@ -12,6 +15,7 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ConversationServer gRPC服务实现
@@ -36,6 +40,8 @@ func (s *ConversationServer) CreateConversation(ctx context.Context, req *imv1.C
 		convType = entity.ConversationTypeSingle
 	case imv1.ConversationType_CONVERSATION_TYPE_GROUP:
 		convType = entity.ConversationTypeGroup
+	case imv1.ConversationType_CONVERSATION_TYPE_CHANNEL:
+		convType = entity.ConversationTypeChannel
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, "invalid conversation type")
 	}
@@ -47,7 +53,7 @@ func (s *ConversationServer) CreateConversation(ctx context.Context, req *imv1.C
 
 	conv, err := s.convUC.CreateConversation(ctx, userID, convType, req.Title, memberIDs)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "create conversation failed: %v", err)
+		return nil, toStatusError(err, "create conversation failed")
 	}
 
 	return toConversationBrief(conv), nil
@@ -66,12 +72,50 @@ func (s *ConversationServer) UpdateConversation(ctx context.Context, req *imv1.U
 
 	conv, err := s.convUC.UpdateConversation(ctx, userID, uint64(req.ConversationId), title, nil)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "update conversation failed: %v", err)
+		return nil, toStatusError(err, "update conversation failed")
 	}
 
 	return toConversationBrief(conv), nil
 }
 
+func (s *ConversationServer) GetConversation(ctx context.Context, req *imv1.GetConversationRequest) (*imv1.ConversationDetail, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	detail, err := s.convUC.GetConversationDetail(ctx, userID, uint64(req.ConversationId))
+	if err != nil {
+		return nil, toStatusError(err, "get conversation failed")
+	}
+
+	conv := detail.Conversation
+	resp := &imv1.ConversationDetail{
+		Conversation:      toConversationBrief(conv),
+		MemberCount:       int32(detail.MemberCount),
+		MemberLimit:       int32(conv.MemberLimit),
+		NeedApproval:      !conv.IsFreeJoin(),
+		MuteAll:           conv.MuteAll,
+		Setting:           toConversationSetting(detail.Setting),
+		CreateTime:        timestamppb.New(conv.CreatedAt),
+		MessageTtlSeconds: conv.MessageTTL,
+	}
+	if conv.AvatarURL != nil {
+		resp.AvatarUrl = *conv.AvatarURL
+	}
+	if conv.OwnerID != nil {
+		resp.OwnerId = int64(*conv.OwnerID)
+	}
+	if detail.Announcement != nil {
+		resp.Announcement = toAnnouncement(detail.Announcement)
+	}
+	for _, p := range detail.PinnedMessages {
+		resp.PinnedMessages = append(resp.PinnedMessages, toPinnedMessageItem(p))
+	}
+
+	return resp, nil
+}
+
 func (s *ConversationServer) AddMembers(ctx context.Context, req *imv1.AddMembersRequest) (*emptypb.Empty, error) {
 	userID, err := getUserIDFromContext(ctx)
 	if err != nil {
@@ -84,7 +128,7 @@ func (s *ConversationServer) AddMembers(ctx context.Context, req *imv1.AddMember
 	}
 
 	if err := s.convUC.AddMembers(ctx, userID, uint64(req.ConversationId), memberIDs); err != nil {
-		return nil, status.Errorf(codes.Internal, "add members failed: %v", err)
+		return nil, toStatusError(err, "add members failed")
 	}
 
 	return &emptypb.Empty{}, nil
@@ -102,7 +146,7 @@ func (s *ConversationServer) RemoveMembers(ctx context.Context, req *imv1.Remove
 	}
 
 	if err := s.convUC.RemoveMembers(ctx, userID, uint64(req.ConversationId), memberIDs); err != nil {
-		return nil, status.Errorf(codes.Internal, "remove members failed: %v", err)
+		return nil, toStatusError(err, "remove members failed")
 	}
 
 	return &emptypb.Empty{}, nil
@@ -111,13 +155,14 @@ func (s *ConversationServer) RemoveMembers(ctx context.Context, req *imv1.Remove
 func (s *ConversationServer) GetMembers(ctx context.Context, req *imv1.GetMembersRequest) (*imv1.GetMembersResponse, error) {
 	members, err := s.convUC.GetMembers(ctx, uint64(req.ConversationId))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "get members failed: %v", err)
+		return nil, toStatusError(err, "get members failed")
 	}
 
 	var userBriefs []*imv1.UserBrief
 	for _, m := range members {
 		userBriefs = append(userBriefs, &imv1.UserBrief{
-			Id: int64(m.UserID),
+			Id:          int64(m.UserID),
+			DisplayName: m.EffectiveName(nil),
 			// 其他字段需要从用户服务获取
 		})
 	}
@@ -125,6 +170,268 @@ func (s *ConversationServer) GetMembers(ctx context.Context, req *imv1.GetMember
 	return &imv1.GetMembersResponse{Members: userBriefs}, nil
 }
 
+func (s *ConversationServer) ListMembers(ctx context.Context, req *imv1.ListMembersRequest) (*imv1.ListMembersResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	members, err := s.convUC.ListMembers(ctx, userID, uint64(req.ConversationId))
+	if err != nil {
+		return nil, toStatusError(err, "list members failed")
+	}
+
+	items := make([]*imv1.MemberItem, 0, len(members))
+	for _, m := range members {
+		items = append(items, toMemberItem(m))
+	}
+
+	return &imv1.ListMembersResponse{Members: items}, nil
+}
+
+func (s *ConversationServer) ScrollMembers(ctx context.Context, req *imv1.ScrollMembersRequest) (*imv1.ScrollMembersResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	query := &in.MemberQuery{
+		Cursor:  req.Cursor,
+		Limit:   int(req.Limit),
+		Muted:   req.Muted,
+		Keyword: req.Keyword,
+	}
+	if query.Limit < 1 || query.Limit > 200 {
+		query.Limit = 50
+	}
+	if req.Role != imv1.MemberRole_MEMBER_ROLE_UNSPECIFIED {
+		role, ok := toParticipantRole(req.Role)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid role")
+		}
+		query.Role = &role
+	}
+
+	page, err := s.convUC.ScrollMembers(ctx, userID, uint64(req.ConversationId), query)
+	if err != nil {
+		return nil, toStatusError(err, "scroll members failed")
+	}
+
+	items := make([]*imv1.MemberItem, 0, len(page.Members))
+	for _, m := range page.Members {
+		item := toMemberItem(m.Participant)
+		if m.Profile != nil {
+			item.User = &imv1.UserBrief{
+				Id:          int64(m.Profile.UserID),
+				Username:    m.Profile.Username,
+				DisplayName: m.Participant.EffectiveName(m.Profile),
+				AvatarUrl:   m.Profile.AvatarURL,
+			}
+		}
+		items = append(items, item)
+	}
+
+	resp := &imv1.ScrollMembersResponse{
+		Items:      items,
+		NextCursor: page.NextCursor,
+		HasMore:    page.NextCursor != "",
+	}
+	if page.Summary != nil {
+		resp.Summary = &imv1.MemberSummary{
+			Total:       int32(page.Summary.Total),
+			OwnerCount:  int32(page.Summary.Owners),
+			AdminCount:  int32(page.Summary.Admins),
+			MemberCount: int32(page.Summary.Members),
+		}
+	}
+	return resp, nil
+}
+
+func (s *ConversationServer) GetConversationState(ctx context.Context, req *imv1.GetConversationStateRequest) (*imv1.ConversationState, error) {
+	conv, err := s.convUC.GetConversation(ctx, uint64(req.ConversationId))
+	if err != nil {
+		return nil, toStatusError(err, "get conversation failed")
+	}
+
+	// 频道订阅者只读，只返回可发言的群主和管理员，订阅者的免打扰由客户端处理
+	var (
+		members  []*entity.Participant
+		settings map[uint64]*entity.ConversationSetting
+	)
+	if conv.IsChannel() {
+		members, err = s.convUC.GetManagers(ctx, conv.ID)
+		if err != nil {
+			return nil, toStatusError(err, "get managers failed")
+		}
+	} else {
+		members, err = s.convUC.GetMembers(ctx, conv.ID)
+		if err != nil {
+			return nil, toStatusError(err, "get members failed")
+		}
+		settings, err = s.convUC.ListMemberSettings(ctx, conv.ID)
+		if err != nil {
+			return nil, toStatusError(err, "get member settings failed")
+		}
+	}
+
+	now := time.Now()
+	states := make([]*imv1.MemberState, 0, len(members))
+	for _, m := range members {
+		item := toMemberItem(m)
+		// 发送路径不请求身份服务，只返回群昵称，用户展示名由 message_service 缓存补全
+		state := &imv1.MemberState{
+			UserId:      item.UserId,
+			Role:        item.Role,
+			Muted:       item.Muted,
+			MutedUntil:  item.MutedUntil,
+			DisplayName: m.EffectiveName(nil),
+		}
+		if setting, ok := settings[m.UserID]; ok {
+			state.Dnd = setting.IsDND(now)
+		}
+		states = append(states, state)
+	}
+
+	return &imv1.ConversationState{
+		ConversationId:    int64(conv.ID),
+		Dissolved:         !conv.IsActive(),
+		MuteAll:           conv.MuteAll,
+		Members:           states,
+		Type:              toConversationType(conv.Type),
+		MessageTtlSeconds: conv.MessageTTL,
+	}, nil
+}
+
+func (s *ConversationServer) IsMember(ctx context.Context, req *imv1.IsMemberRequest) (*imv1.IsMemberResponse, error) {
+	isMember, err := s.convUC.IsMember(ctx, uint64(req.ConversationId), uint64(req.UserId))
+	if err != nil {
+		return nil, toStatusError(err, "check member failed")
+	}
+	return &imv1.IsMemberResponse{IsMember: isMember}, nil
+}
+
+func (s *ConversationServer) LeaveConversation(ctx context.Context, req *imv1.LeaveConversationRequest) (*emptypb.Empty, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.convUC.LeaveConversation(ctx, userID, uint64(req.ConversationId)); err != nil {
+		return nil, toStatusError(err, "leave conversation failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ConversationServer) DissolveConversation(ctx context.Context, req *imv1.DissolveConversationRequest) (*emptypb.Empty, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.convUC.DeleteConversation(ctx, userID, uint64(req.ConversationId)); err != nil {
+		return nil, toStatusError(err, "dissolve conversation failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ConversationServer) SetMemberRole(ctx context.Context, req *imv1.SetMemberRoleRequest) (*emptypb.Empty, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	role, ok := toParticipantRole(req.Role)
+	if !ok || role == entity.ParticipantRoleOwner {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role")
+	}
+
+	if err := s.convUC.SetMemberRole(ctx, userID, uint64(req.ConversationId), uint64(req.UserId), role); err != nil {
+		return nil, toStatusError(err, "set member role failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ConversationServer) TransferOwnership(ctx context.Context, req *imv1.TransferOwnershipRequest) (*emptypb.Empty, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.convUC.TransferOwnership(ctx, userID, uint64(req.ConversationId), uint64(req.NewOwnerId)); err != nil {
+		return nil, toStatusError(err, "transfer ownership failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ConversationServer) MuteMember(ctx context.Context, req *imv1.MuteMemberRequest) (*emptypb.Empty, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.convUC.MuteMember(ctx, userID, uint64(req.ConversationId), uint64(req.UserId), req.DurationSeconds); err != nil {
+		return nil, toStatusError(err, "mute member failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ConversationServer) UnmuteMember(ctx context.Context, req *imv1.UnmuteMemberRequest) (*emptypb.Empty, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.convUC.UnmuteMember(ctx, userID, uint64(req.ConversationId), uint64(req.UserId)); err != nil {
+		return nil, toStatusError(err, "unmute member failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ConversationServer) SetMuteAll(ctx context.Context, req *imv1.SetMuteAllRequest) (*emptypb.Empty, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.convUC.SetMuteAll(ctx, userID, uint64(req.ConversationId), req.Mute); err != nil {
+		return nil, toStatusError(err, "set mute all failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ConversationServer) SetMessageTTL(ctx context.Context, req *imv1.SetMessageTTLRequest) (*emptypb.Empty, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.convUC.SetMessageTTL(ctx, userID, uint64(req.ConversationId), req.TtlSeconds); err != nil {
+		return nil, toStatusError(err, "set message ttl failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ConversationServer) SetMemberNickname(ctx context.Context, req *imv1.SetMemberNicknameRequest) (*imv1.MemberItem, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	participant, err := s.convUC.SetMemberNickname(ctx, userID, uint64(req.ConversationId), req.Nickname)
+	if err != nil {
+		return nil, toStatusError(err, "set member nickname failed")
+	}
+	return toMemberItem(participant), nil
+}
+
 func (s *ConversationServer) ListMyConversations(ctx context.Context, req *imv1.ListMyConversationsRequest) (*imv1.ListMyConversationsResponse, error) {
 	userID, err := getUserIDFromContext(ctx)
 	if err != nil {
@@ -140,22 +447,440 @@ func (s *ConversationServer) ListMyConversations(ctx context.Context, req *imv1.
 		pageSize = 20
 	}
 
-	conversations, total, err := s.convUC.ListMyConversations(ctx, userID, page, pageSize)
+	conversations, total, err := s.convUC.ListMyConversations(ctx, userID, req.Archived, page, pageSize)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "list conversations failed: %v", err)
+		return nil, toStatusError(err, "list conversations failed")
 	}
 
-	var items []*imv1.ConversationBrief
+	items := make([]*imv1.ConversationBrief, 0, len(conversations))
+	convItems := make([]*imv1.MyConversationItem, 0, len(conversations))
 	for _, c := range conversations {
-		items = append(items, toConversationBrief(c))
+		item := toMyConversationItem(c)
+		items = append(items, item.Conversation)
+		convItems = append(convItems, item)
 	}
 
 	return &imv1.ListMyConversationsResponse{
+		Items:         items,
+		Total:         int32(total),
+		Conversations: convItems,
+	}, nil
+}
+
+func (s *ConversationServer) ScrollMyConversations(ctx context.Context, req *imv1.ScrollMyConversationsRequest) (*imv1.ScrollMyConversationsResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	limit := int(req.Limit)
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	folder := in.FolderRef{ID: uint64(req.FolderId), Smart: entity.SmartFolder(req.SmartFolder)}
+	conversations, nextCursor, err := s.convUC.ScrollMyConversations(ctx, userID, req.Archived, folder, req.Cursor, limit)
+	if err != nil {
+		return nil, toStatusError(err, "scroll conversations failed")
+	}
+
+	items := make([]*imv1.MyConversationItem, 0, len(conversations))
+	for _, c := range conversations {
+		items = append(items, toMyConversationItem(c))
+	}
+
+	return &imv1.ScrollMyConversationsResponse{
+		Items:      items,
+		NextCursor: nextCursor,
+		HasMore:    nextCursor != "",
+	}, nil
+}
+
+func (s *ConversationServer) GetConversationSetting(ctx context.Context, req *imv1.GetConversationSettingRequest) (*imv1.ConversationSetting, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	setting, err := s.convUC.GetConversationSetting(ctx, userID, uint64(req.ConversationId))
+	if err != nil {
+		return nil, toStatusError(err, "get conversation setting failed")
+	}
+	return toConversationSetting(setting), nil
+}
+
+func (s *ConversationServer) UpdateConversationSetting(ctx context.Context, req *imv1.UpdateConversationSettingRequest) (*imv1.ConversationSetting, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	setting, err := s.convUC.UpdateConversationSetting(ctx, userID, uint64(req.ConversationId), &in.ConversationSettingUpdate{
+		Pinned:      req.Pinned,
+		Muted:       req.Muted,
+		MuteSeconds: req.MuteSeconds,
+		Archived:    req.Archived,
+		Remark:      req.Remark,
+	})
+	if err != nil {
+		return nil, toStatusError(err, "update conversation setting failed")
+	}
+	return toConversationSetting(setting), nil
+}
+
+func (s *ConversationServer) RequestJoin(ctx context.Context, req *imv1.RequestJoinRequest) (*imv1.JoinRequestItem, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	joinReq, err := s.convUC.RequestJoin(ctx, userID, uint64(req.ConversationId), req.Message)
+	if err != nil {
+		return nil, toStatusError(err, "request join failed")
+	}
+
+	return toJoinRequestItem(joinReq), nil
+}
+
+func (s *ConversationServer) ListJoinRequests(ctx context.Context, req *imv1.ListJoinRequestsRequest) (*imv1.ListJoinRequestsResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	page := int(req.Page)
+	pageSize := int(req.PageSize)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	joinStatus := entity.JoinRequestStatusPending
+	switch req.Status {
+	case imv1.JoinRequestStatus_JOIN_REQUEST_STATUS_APPROVED:
+		joinStatus = entity.JoinRequestStatusApproved
+	case imv1.JoinRequestStatus_JOIN_REQUEST_STATUS_REJECTED:
+		joinStatus = entity.JoinRequestStatusRejected
+	}
+
+	requests, total, err := s.convUC.ListJoinRequests(ctx, userID, uint64(req.ConversationId), joinStatus, page, pageSize)
+	if err != nil {
+		return nil, toStatusError(err, "list join requests failed")
+	}
+
+	var items []*imv1.JoinRequestItem
+	for _, r := range requests {
+		items = append(items, toJoinRequestItem(r))
+	}
+
+	return &imv1.ListJoinRequestsResponse{
 		Items: items,
 		Total: int32(total),
 	}, nil
 }
 
+func (s *ConversationServer) HandleJoinRequest(ctx context.Context, req *imv1.HandleJoinRequestRequest) (*imv1.JoinRequestItem, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	joinReq, err := s.convUC.HandleJoinRequest(ctx, userID, uint64(req.RequestId), req.Approve)
+	if err != nil {
+		return nil, toStatusError(err, "handle join request failed")
+	}
+
+	return toJoinRequestItem(joinReq), nil
+}
+
+func (s *ConversationServer) CreateInvite(ctx context.Context, req *imv1.CreateInviteRequest) (*imv1.InviteItem, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	expiresIn := time.Duration(req.ExpiresInSeconds) * time.Second
+	invite, err := s.convUC.CreateInvite(ctx, userID, uint64(req.ConversationId), expiresIn, int(req.MaxUses))
+	if err != nil {
+		return nil, toStatusError(err, "create invite failed")
+	}
+
+	return toInviteItem(invite), nil
+}
+
+func (s *ConversationServer) ListInvites(ctx context.Context, req *imv1.ListInvitesRequest) (*imv1.ListInvitesResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	invites, err := s.convUC.ListInvites(ctx, userID, uint64(req.ConversationId))
+	if err != nil {
+		return nil, toStatusError(err, "list invites failed")
+	}
+
+	var items []*imv1.InviteItem
+	for _, invite := range invites {
+		items = append(items, toInviteItem(invite))
+	}
+
+	return &imv1.ListInvitesResponse{Items: items}, nil
+}
+
+func (s *ConversationServer) RevokeInvite(ctx context.Context, req *imv1.RevokeInviteRequest) (*emptypb.Empty, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.convUC.RevokeInvite(ctx, userID, uint64(req.InviteId)); err != nil {
+		return nil, toStatusError(err, "revoke invite failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ConversationServer) PreviewInvite(ctx context.Context, req *imv1.PreviewInviteRequest) (*imv1.InvitePreview, error) {
+	preview, err := s.convUC.PreviewInvite(ctx, req.Code)
+	if err != nil {
+		return nil, toStatusError(err, "preview invite failed")
+	}
+
+	conv := preview.Conversation
+	resp := &imv1.InvitePreview{
+		ConversationId: int64(conv.ID),
+		MemberCount:    int32(preview.MemberCount),
+		MemberLimit:    int32(conv.MemberLimit),
+		NeedApproval:   !conv.IsFreeJoin(),
+	}
+	if conv.Title != nil {
+		resp.Title = *conv.Title
+	}
+	if conv.AvatarURL != nil {
+		resp.AvatarUrl = *conv.AvatarURL
+	}
+	if preview.Invite.ExpiresAt != nil {
+		resp.ExpireTime = timestamppb.New(*preview.Invite.ExpiresAt)
+	}
+
+	return resp, nil
+}
+
+func (s *ConversationServer) JoinByInvite(ctx context.Context, req *imv1.JoinByInviteRequest) (*imv1.JoinRequestItem, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	joinReq, err := s.convUC.JoinByInvite(ctx, userID, req.Code)
+	if err != nil {
+		return nil, toStatusError(err, "join by invite failed")
+	}
+
+	return toJoinRequestItem(joinReq), nil
+}
+
+func (s *ConversationServer) GetAnnouncement(ctx context.Context, req *imv1.GetAnnouncementRequest) (*imv1.Announcement, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	announcement, err := s.convUC.GetAnnouncement(ctx, userID, uint64(req.ConversationId))
+	if err != nil {
+		return nil, toStatusError(err, "get announcement failed")
+	}
+	return toAnnouncement(announcement), nil
+}
+
+func (s *ConversationServer) SetAnnouncement(ctx context.Context, req *imv1.SetAnnouncementRequest) (*imv1.Announcement, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	announcement, err := s.convUC.SetAnnouncement(ctx, userID, uint64(req.ConversationId), req.Content)
+	if err != nil {
+		return nil, toStatusError(err, "set announcement failed")
+	}
+	return toAnnouncement(announcement), nil
+}
+
+func (s *ConversationServer) DeleteAnnouncement(ctx context.Context, req *imv1.DeleteAnnouncementRequest) (*emptypb.Empty, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.convUC.DeleteAnnouncement(ctx, userID, uint64(req.ConversationId)); err != nil {
+		return nil, toStatusError(err, "delete announcement failed")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ConversationServer) ListPinnedMessages(ctx context.Context, req *imv1.ListPinnedMessagesRequest) (*imv1.ListPinnedMessagesResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	pins, err := s.convUC.ListPinnedMessages(ctx, userID, uint64(req.ConversationId))
+	if err != nil {
+		return nil, toStatusError(err, "list pinned messages failed")
+	}
+
+	items := make([]*imv1.PinnedMessageItem, 0, len(pins))
+	for _, p := range pins {
+		items = append(items, toPinnedMessageItem(p))
+	}
+	return &imv1.ListPinnedMessagesResponse{Items: items}, nil
+}
+
+func (s *ConversationServer) PinMessage(ctx context.Context, req *imv1.PinMessageRequest) (*imv1.PinnedMessageItem, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	pin, err := s.convUC.PinMessage(ctx, userID, uint64(req.ConversationId), uint64(req.MessageId))
+	if err != nil {
+		return nil, toStatusError(err, "pin message failed")
+	}
+	return toPinnedMessageItem(pin), nil
+}
+
+func (s *ConversationServer) UnpinMessage(ctx context.Context, req *imv1.UnpinMessageRequest) (*emptypb.Empty, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.convUC.UnpinMessage(ctx, userID, uint64(req.ConversationId), uint64(req.MessageId)); err != nil {
+		return nil, toStatusError(err, "unpin message failed")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ConversationServer) ListFolders(ctx context.Context, req *imv1.ListFoldersRequest) (*imv1.ListFoldersResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	views, err := s.convUC.ListFolders(ctx, userID)
+	if err != nil {
+		return nil, toStatusError(err, "list folders failed")
+	}
+
+	items := make([]*imv1.Folder, len(views))
+	for i, v := range views {
+		item := &imv1.Folder{
+			Smart:             imv1.SmartFolder(v.Smart),
+			ConversationCount: int32(v.ConversationCount),
+			UnreadCount:       int32(v.UnreadCount),
+		}
+		if v.Folder != nil {
+			item.Id = int64(v.Folder.ID)
+			item.Name = v.Folder.Name
+			item.SortOrder = int32(v.Folder.SortOrder)
+		} else {
+			item.Name = v.Smart.Name()
+		}
+		items[i] = item
+	}
+	return &imv1.ListFoldersResponse{Items: items}, nil
+}
+
+func (s *ConversationServer) CreateFolder(ctx context.Context, req *imv1.CreateFolderRequest) (*imv1.Folder, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	folder, err := s.convUC.CreateFolder(ctx, userID, req.Name)
+	if err != nil {
+		return nil, toStatusError(err, "create folder failed")
+	}
+	return toFolder(folder), nil
+}
+
+func (s *ConversationServer) RenameFolder(ctx context.Context, req *imv1.RenameFolderRequest) (*imv1.Folder, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	folder, err := s.convUC.RenameFolder(ctx, userID, uint64(req.FolderId), req.Name)
+	if err != nil {
+		return nil, toStatusError(err, "rename folder failed")
+	}
+	return toFolder(folder), nil
+}
+
+func (s *ConversationServer) DeleteFolder(ctx context.Context, req *imv1.DeleteFolderRequest) (*emptypb.Empty, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.convUC.DeleteFolder(ctx, userID, uint64(req.FolderId)); err != nil {
+		return nil, toStatusError(err, "delete folder failed")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ConversationServer) ReorderFolders(ctx context.Context, req *imv1.ReorderFoldersRequest) (*emptypb.Empty, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	folderIDs := make([]uint64, len(req.FolderIds))
+	for i, id := range req.FolderIds {
+		folderIDs[i] = uint64(id)
+	}
+	if err := s.convUC.ReorderFolders(ctx, userID, folderIDs); err != nil {
+		return nil, toStatusError(err, "reorder folders failed")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// maxFolderConversationsBatch 单次加入文件夹的会话数上限
+const maxFolderConversationsBatch = 100
+
+func (s *ConversationServer) AddFolderConversations(ctx context.Context, req *imv1.AddFolderConversationsRequest) (*emptypb.Empty, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	if len(req.ConversationIds) == 0 || len(req.ConversationIds) > maxFolderConversationsBatch {
+		return nil, status.Errorf(codes.InvalidArgument, "conversation_ids must contain 1 to %d items", maxFolderConversationsBatch)
+	}
+	convIDs := make([]uint64, len(req.ConversationIds))
+	for i, id := range req.ConversationIds {
+		convIDs[i] = uint64(id)
+	}
+	if err := s.convUC.AddFolderConversations(ctx, userID, uint64(req.FolderId), convIDs); err != nil {
+		return nil, toStatusError(err, "add folder conversations failed")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ConversationServer) RemoveFolderConversation(ctx context.Context, req *imv1.RemoveFolderConversationRequest) (*emptypb.Empty, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := s.convUC.RemoveFolderConversation(ctx, userID, uint64(req.FolderId), uint64(req.ConversationId)); err != nil {
+		return nil, toStatusError(err, "remove folder conversation failed")
+	}
+	return &emptypb.Empty{}, nil
+}
+
 // RegisterServer 注册gRPC服务
 func (s *ConversationServer) RegisterServer(gs *grpc.Server) {
 	imv1.RegisterConversationServiceServer(gs, s)
@@ -168,19 +893,214 @@ func toConversationBrief(conv *entity.Conversation) *imv1.ConversationBrief {
 		title = *conv.Title
 	}
 
-	var convType imv1.ConversationType
-	switch conv.Type {
+	return &imv1.ConversationBrief{
+		Id:    int64(conv.ID),
+		Type:  toConversationType(conv.Type),
+		Title: title,
+	}
+}
+
+func toConversationType(t entity.ConversationType) imv1.ConversationType {
+	switch t {
 	case entity.ConversationTypeSingle:
-		convType = imv1.ConversationType_CONVERSATION_TYPE_SINGLE
+		return imv1.ConversationType_CONVERSATION_TYPE_SINGLE
 	case entity.ConversationTypeGroup:
-		convType = imv1.ConversationType_CONVERSATION_TYPE_GROUP
+		return imv1.ConversationType_CONVERSATION_TYPE_GROUP
+	case entity.ConversationTypeChannel:
+		return imv1.ConversationType_CONVERSATION_TYPE_CHANNEL
 	}
+	return imv1.ConversationType_CONVERSATION_TYPE_UNSPECIFIED
+}
 
-	return &imv1.ConversationBrief{
-		Id:    int64(conv.ID),
-		Type:  convType,
-		Title: title,
+func toConversationSetting(st *entity.ConversationSetting) *imv1.ConversationSetting {
+	item := &imv1.ConversationSetting{
+		ConversationId: int64(st.ConversationID),
+		Pinned:         st.IsPinned(),
+		Muted:          st.IsDND(time.Now()),
+		Archived:       st.Archived,
+	}
+	if st.PinnedAt != nil {
+		item.PinnedAt = timestamppb.New(*st.PinnedAt)
+	}
+	if item.Muted && st.MuteUntil != nil {
+		item.MuteUntil = timestamppb.New(*st.MuteUntil)
+	}
+	if st.Remark != nil {
+		item.Remark = *st.Remark
+	}
+	return item
+}
+
+func toMyConversationItem(c *in.MyConversation) *imv1.MyConversationItem {
+	item := &imv1.MyConversationItem{
+		Conversation: toConversationBrief(c.Conversation),
+		Setting:      toConversationSetting(c.Setting),
+	}
+	for _, id := range c.FolderIDs {
+		item.FolderIds = append(item.FolderIds, int64(id))
+	}
+	if c.Conversation.LastMessageAt != nil {
+		item.LastMessageAt = timestamppb.New(*c.Conversation.LastMessageAt)
+	}
+	return item
+}
+
+func toMemberItem(m *entity.Participant) *imv1.MemberItem {
+	item := &imv1.MemberItem{
+		UserId:   int64(m.UserID),
+		Muted:    m.IsMuted(),
+		JoinTime: timestamppb.New(m.JoinedAt),
+	}
+	switch m.Role {
+	case entity.ParticipantRoleMember:
+		item.Role = imv1.MemberRole_MEMBER_ROLE_MEMBER
+	case entity.ParticipantRoleAdmin:
+		item.Role = imv1.MemberRole_MEMBER_ROLE_ADMIN
+	case entity.ParticipantRoleOwner:
+		item.Role = imv1.MemberRole_MEMBER_ROLE_OWNER
+	}
+	if m.Nickname != nil {
+		item.Nickname = *m.Nickname
+	}
+	if item.Muted && m.MutedUntil != nil {
+		item.MutedUntil = timestamppb.New(*m.MutedUntil)
+	}
+	return item
+}
+
+// toParticipantRole 转换成员角色，未指定角色时返回 false
+func toParticipantRole(role imv1.MemberRole) (entity.ParticipantRole, bool) {
+	switch role {
+	case imv1.MemberRole_MEMBER_ROLE_MEMBER:
+		return entity.ParticipantRoleMember, true
+	case imv1.MemberRole_MEMBER_ROLE_ADMIN:
+		return entity.ParticipantRoleAdmin, true
+	case imv1.MemberRole_MEMBER_ROLE_OWNER:
+		return entity.ParticipantRoleOwner, true
+	}
+	return 0, false
+}
+
+func toJoinRequestItem(r *entity.JoinRequest) *imv1.JoinRequestItem {
+	item := &imv1.JoinRequestItem{
+		Id:             int64(r.ID),
+		ConversationId: int64(r.ConversationID),
+		UserId:         int64(r.UserID),
+		CreateTime:     timestamppb.New(r.CreatedAt),
+		UpdateTime:     timestamppb.New(r.UpdatedAt),
+	}
+	if r.Message != nil {
+		item.Message = *r.Message
+	}
+	if r.HandlerID != nil {
+		item.HandlerId = int64(*r.HandlerID)
+	}
+	if r.InviteID != nil {
+		item.InviteId = int64(*r.InviteID)
+	}
+	switch r.Status {
+	case entity.JoinRequestStatusPending:
+		item.Status = imv1.JoinRequestStatus_JOIN_REQUEST_STATUS_PENDING
+	case entity.JoinRequestStatusApproved:
+		item.Status = imv1.JoinRequestStatus_JOIN_REQUEST_STATUS_APPROVED
+	case entity.JoinRequestStatusRejected:
+		item.Status = imv1.JoinRequestStatus_JOIN_REQUEST_STATUS_REJECTED
+	}
+	return item
+}
+
+func toInviteItem(invite *entity.GroupInvite) *imv1.InviteItem {
+	item := &imv1.InviteItem{
+		Id:             int64(invite.ID),
+		ConversationId: int64(invite.ConversationID),
+		Code:           invite.Code,
+		CreatorId:      int64(invite.CreatorID),
+		MaxUses:        int32(invite.MaxUses),
+		UsedCount:      int32(invite.UsedCount),
+		Revoked:        invite.Revoked,
+		CreateTime:     timestamppb.New(invite.CreatedAt),
+	}
+	if invite.ExpiresAt != nil {
+		item.ExpireTime = timestamppb.New(*invite.ExpiresAt)
+	}
+	return item
+}
+
+func toAnnouncement(a *entity.Announcement) *imv1.Announcement {
+	return &imv1.Announcement{
+		ConversationId: int64(a.ConversationID),
+		Content:        a.Content,
+		EditorId:       int64(a.EditorID),
+		CreateTime:     timestamppb.New(a.CreatedAt),
+		UpdateTime:     timestamppb.New(a.UpdatedAt),
+	}
+}
+
+func toPinnedMessageItem(p *entity.PinnedMessage) *imv1.PinnedMessageItem {
+	return &imv1.PinnedMessageItem{
+		ConversationId: int64(p.ConversationID),
+		MessageId:      int64(p.MessageID),
+		PinnedBy:       int64(p.PinnedBy),
+		PinTime:        timestamppb.New(p.PinnedAt),
+	}
+}
+
+// toStatusError 将用例错误映射为gRPC状态码
+func toFolder(f *entity.Folder) *imv1.Folder {
+	return &imv1.Folder{
+		Id:        int64(f.ID),
+		Name:      f.Name,
+		SortOrder: int32(f.SortOrder),
+	}
+}
+
+func toStatusError(err error, msg string) error {
+	var code codes.Code
+	switch {
+	case errors.Is(err, conversation.ErrConversationNotFound),
+		errors.Is(err, conversation.ErrJoinRequestNotFound),
+		errors.Is(err, conversation.ErrInviteNotFound),
+		errors.Is(err, conversation.ErrAnnouncementNotFound),
+		errors.Is(err, conversation.ErrMessageNotFound),
+		errors.Is(err, conversation.ErrPinnedMessageNotFound),
+		errors.Is(err, conversation.ErrFolderNotFound):
+		code = codes.NotFound
+	case errors.Is(err, conversation.ErrNoPermission),
+		errors.Is(err, conversation.ErrNotConversationMember),
+		errors.Is(err, conversation.ErrCannotRemoveOwner):
+		code = codes.PermissionDenied
+	case errors.Is(err, conversation.ErrAlreadyMember),
+		errors.Is(err, conversation.ErrJoinRequestHandled),
+		errors.Is(err, conversation.ErrFolderNameExists):
+		code = codes.AlreadyExists
+	case errors.Is(err, conversation.ErrNotGroupConversation),
+		errors.Is(err, conversation.ErrConversationDissolved),
+		errors.Is(err, conversation.ErrMemberLimitExceeded),
+		errors.Is(err, conversation.ErrInviteUnavailable),
+		errors.Is(err, conversation.ErrSingleConvCannotAddMore),
+		errors.Is(err, conversation.ErrOwnershipChanged),
+		errors.Is(err, conversation.ErrPinLimitExceeded),
+		errors.Is(err, conversation.ErrFolderLimitExceeded):
+		code = codes.FailedPrecondition
+	case errors.Is(err, conversation.ErrInvalidInvite),
+		errors.Is(err, conversation.ErrInvalidRole),
+		errors.Is(err, conversation.ErrInvalidMuteDuration),
+		errors.Is(err, conversation.ErrInvalidRemark),
+		errors.Is(err, conversation.ErrInvalidCursor),
+		errors.Is(err, conversation.ErrInvalidKeyword),
+		errors.Is(err, conversation.ErrInvalidNickname),
+		errors.Is(err, conversation.ErrInvalidAnnouncement),
+		errors.Is(err, conversation.ErrInvalidFolderName),
+		errors.Is(err, conversation.ErrInvalidFolderOrder),
+		errors.Is(err, conversation.ErrInvalidFolder),
+		errors.Is(err, conversation.ErrInvalidMessageTTL),
+		errors.Is(err, conversation.ErrCannotOperateSelf),
+		errors.Is(err, conversation.ErrCannotRemoveSelf):
+		code = codes.InvalidArgument
+	default:
+		code = codes.Internal
 	}
+	return status.Errorf(code, "%s: %v", msg, err)
 }
 
 func getUserIDFromContext(ctx context.Context) (uint64, error) {
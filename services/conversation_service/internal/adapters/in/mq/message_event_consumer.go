@@ -0,0 +1,108 @@
+package mq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+
+	"github.com/EthanQC/IM/services/conversation_service/internal/ports/in"
+)
+
+// TopicMessageNew 新消息Topic（由 message_service 发布）
+const TopicMessageNew = "im.message.new"
+
+// messageSentEvent 新消息事件中会话列表排序所需的字段
+type messageSentEvent struct {
+	ConversationID uint64 `json:"conversation_id"`
+	CreatedAt      int64  `json:"created_at"`
+}
+
+// MessageEventConsumer 消费新消息事件，维护会话最后消息时间
+type MessageEventConsumer struct {
+	consumerGroup sarama.ConsumerGroup
+	convUC        in.ConversationUseCase
+	cancel        context.CancelFunc
+}
+
+// NewMessageEventConsumer 创建新消息事件消费者
+func NewMessageEventConsumer(brokers []string, groupID string, convUC in.ConversationUseCase) (*MessageEventConsumer, error) {
+	config := sarama.NewConfig()
+	config.Version = sarama.V2_8_0_0
+	config.Consumer.Group.Rebalance.Strategy = sarama.NewBalanceStrategyRoundRobin()
+	config.Consumer.Offsets.Initial = sarama.OffsetNewest
+	config.Consumer.Return.Errors = true
+
+	consumerGroup, err := sarama.NewConsumerGroup(brokers, groupID, config)
+	if err != nil {
+		return nil, fmt.Errorf("create consumer group failed: %w", err)
+	}
+
+	return &MessageEventConsumer{
+		consumerGroup: consumerGroup,
+		convUC:        convUC,
+	}, nil
+}
+
+// Start 启动消费
+func (c *MessageEventConsumer) Start(ctx context.Context) {
+	ctx, c.cancel = context.WithCancel(ctx)
+	handler := &messageEventHandler{convUC: c.convUC}
+
+	go func() {
+		for {
+			if err := c.consumerGroup.Consume(ctx, []string{TopicMessageNew}, handler); err != nil {
+				zap.L().Warn("Error from message event consumer", zap.Error(err))
+			}
+			if ctx.Err() != nil {
+				return
+			}
+		}
+	}()
+}
+
+// Stop 停止消费
+func (c *MessageEventConsumer) Stop() error {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	return c.consumerGroup.Close()
+}
+
+type messageEventHandler struct {
+	convUC in.ConversationUseCase
+}
+
+func (h *messageEventHandler) Setup(sarama.ConsumerGroupSession) error   { return nil }
+func (h *messageEventHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+func (h *messageEventHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for message := range claim.Messages() {
+		if err := h.handle(session.Context(), message.Value); err != nil {
+			zap.L().Warn("Handle message event failed",
+				zap.String("key", string(message.Key)),
+				zap.Error(err))
+		}
+		session.MarkMessage(message, "")
+	}
+	return nil
+}
+
+func (h *messageEventHandler) handle(ctx context.Context, data []byte) error {
+	var event messageSentEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		return fmt.Errorf("unmarshal message event failed: %w", err)
+	}
+	if event.ConversationID == 0 {
+		return nil
+	}
+
+	at := time.Now()
+	if event.CreatedAt > 0 {
+		at = time.Unix(event.CreatedAt, 0)
+	}
+	return h.convUC.RecordLastMessage(ctx, event.ConversationID, at)
+}
@@ -0,0 +1,69 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	imv1 "github.com/EthanQC/IM/api/gen/im/v1"
+	"github.com/EthanQC/IM/services/conversation_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/conversation_service/internal/ports/out"
+)
+
+// IdentityClient gRPC身份服务适配器
+type IdentityClient struct {
+	client  imv1.IdentityServiceClient
+	timeout time.Duration
+}
+
+func NewIdentityClient(client imv1.IdentityServiceClient, timeout time.Duration) out.UserReader {
+	return &IdentityClient{client: client, timeout: timeout}
+}
+
+func (c *IdentityClient) BatchGetProfiles(ctx context.Context, userIDs []uint64) (map[uint64]*entity.UserProfile, error) {
+	if len(userIDs) == 0 {
+		return map[uint64]*entity.UserProfile{}, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	ids := make([]int64, len(userIDs))
+	for i, id := range userIDs {
+		ids[i] = int64(id)
+	}
+	resp, err := c.client.BatchGetProfiles(ctx, &imv1.BatchGetProfilesRequest{UserIds: ids})
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := make(map[uint64]*entity.UserProfile, len(resp.Users))
+	for _, u := range resp.Users {
+		profiles[uint64(u.Id)] = &entity.UserProfile{
+			UserID:      uint64(u.Id),
+			Username:    u.Username,
+			DisplayName: u.DisplayName,
+			AvatarURL:   u.AvatarUrl,
+		}
+	}
+	return profiles, nil
+}
+
+func (c *IdentityClient) MatchUserIDs(ctx context.Context, keyword string, candidateIDs []uint64) ([]uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req := &imv1.MatchUsersByNameRequest{Keyword: keyword, CandidateIds: make([]int64, len(candidateIDs))}
+	for i, id := range candidateIDs {
+		req.CandidateIds[i] = int64(id)
+	}
+	resp, err := c.client.MatchUsersByName(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, len(resp.UserIds))
+	for i, id := range resp.UserIds {
+		ids[i] = uint64(id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,92 @@
+package grpc
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	imv1 "github.com/EthanQC/IM/api/gen/im/v1"
+	"github.com/EthanQC/IM/services/conversation_service/internal/ports/out"
+)
+
+// MessageClient gRPC消息服务适配器
+type MessageClient struct {
+	client  imv1.MessageServiceClient
+	timeout time.Duration
+}
+
+func NewMessageClient(client imv1.MessageServiceClient, timeout time.Duration) out.MessageReader {
+	return &MessageClient{client: client, timeout: timeout}
+}
+
+func (c *MessageClient) GetMessage(ctx context.Context, userID, messageID uint64) (*out.MessageInfo, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	// 消息服务按调用者身份校验会话成员关系
+	ctx = metadata.AppendToOutgoingContext(ctx, "user_id", strconv.FormatUint(userID, 10))
+
+	resp, err := c.client.GetMessage(ctx, &imv1.GetMessageRequest{MessageId: int64(messageID)})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	info := &out.MessageInfo{
+		ID:             uint64(resp.Id),
+		ConversationID: uint64(resp.ConversationId),
+		SenderID:       uint64(resp.SenderId),
+	}
+	if resp.CreateTime != nil {
+		info.CreatedAt = resp.CreateTime.AsTime()
+	}
+	return info, nil
+}
+
+// unreadBatchSize 单次请求未读数的会话数，与消息服务的上限一致
+const unreadBatchSize = 1000
+
+func (c *MessageClient) BatchGetUnreadCounts(ctx context.Context, userID uint64, conversationIDs, channelIDs []uint64) (map[uint64]*out.UnreadCount, error) {
+	channels := make(map[uint64]bool, len(channelIDs))
+	for _, id := range channelIDs {
+		channels[id] = true
+	}
+
+	result := make(map[uint64]*out.UnreadCount)
+	for start := 0; start < len(conversationIDs); start += unreadBatchSize {
+		end := start + unreadBatchSize
+		if end > len(conversationIDs) {
+			end = len(conversationIDs)
+		}
+		req := &imv1.BatchGetUnreadCountsRequest{UserId: int64(userID)}
+		for _, id := range conversationIDs[start:end] {
+			req.ConversationIds = append(req.ConversationIds, int64(id))
+			if channels[id] {
+				req.ChannelIds = append(req.ChannelIds, int64(id))
+			}
+		}
+
+		resp, err := c.batchGetUnreadCounts(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range resp.Items {
+			result[uint64(item.ConversationId)] = &out.UnreadCount{
+				Count:      int(item.UnreadCount),
+				HasMention: item.HasMention,
+			}
+		}
+	}
+	return result, nil
+}
+
+func (c *MessageClient) batchGetUnreadCounts(ctx context.Context, req *imv1.BatchGetUnreadCountsRequest) (*imv1.BatchGetUnreadCountsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	return c.client.BatchGetUnreadCounts(ctx, req)
+}
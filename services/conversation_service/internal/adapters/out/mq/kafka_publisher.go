@@ -0,0 +1,67 @@
+package mq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/IBM/sarama"
+
+	"github.com/EthanQC/IM/services/conversation_service/internal/ports/out"
+)
+
+// KafkaEventPublisher Kafka事件发布器
+type KafkaEventPublisher struct {
+	producer sarama.SyncProducer
+}
+
+// NewKafkaEventPublisher 创建Kafka事件发布器
+func NewKafkaEventPublisher(brokers []string) (out.EventPublisher, error) {
+	config := sarama.NewConfig()
+	config.Producer.Return.Successes = true
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Retry.Max = 3
+	config.Producer.Timeout = 10 * time.Second
+	// 同一会话的事件发到同一分区，保证顺序
+	config.Producer.Partitioner = sarama.NewHashPartitioner
+
+	producer, err := sarama.NewSyncProducer(brokers, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kafka producer: %w", err)
+	}
+
+	return &KafkaEventPublisher{producer: producer}, nil
+}
+
+func (p *KafkaEventPublisher) Publish(ctx context.Context, topic string, event interface{}) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal event failed: %w", err)
+	}
+
+	msg := &sarama.ProducerMessage{
+		Topic: topic,
+		Value: sarama.ByteEncoder(data),
+		Headers: []sarama.RecordHeader{
+			{Key: []byte("timestamp"), Value: []byte(time.Now().UTC().Format(time.RFC3339))},
+		},
+	}
+	if m, ok := event.(map[string]interface{}); ok {
+		if convID, ok := m["conversation_id"]; ok {
+			msg.Key = sarama.StringEncoder(fmt.Sprintf("%v", convID)) // 按会话分区
+		}
+		if eventType, ok := m["type"].(string); ok {
+			msg.Headers = append(msg.Headers, sarama.RecordHeader{Key: []byte("event_type"), Value: []byte(eventType)})
+		}
+	}
+
+	if _, _, err := p.producer.SendMessage(msg); err != nil {
+		return fmt.Errorf("publish event failed: %w", err)
+	}
+	return nil
+}
+
+func (p *KafkaEventPublisher) Close() error {
+	return p.producer.Close()
+}
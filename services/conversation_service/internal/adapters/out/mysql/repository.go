@@ -3,9 +3,12 @@ package mysql
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
+	mysqldriver "github.com/go-sql-driver/mysql"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/EthanQC/IM/services/conversation_service/internal/domain/entity"
 	"github.com/EthanQC/IM/services/conversation_service/internal/ports/out"
@@ -21,9 +24,12 @@ type ConversationModel struct {
 	MemberLimit int       `gorm:"column:member_limit;default:500"`
 	JoinMode    int8      `gorm:"column:join_mode;default:0"`
 	MuteAll     int8      `gorm:"column:mute_all;default:0"`
+	MessageTTL  int64     `gorm:"column:message_ttl;default:0"`
 	Status      int8      `gorm:"column:status;default:1"`
 	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`
 	UpdatedAt   time.Time `gorm:"column:updated_at;autoUpdateTime"`
+
+	LastMessageAt *time.Time `gorm:"column:last_message_at"`
 }
 
 func (ConversationModel) TableName() string {
@@ -40,9 +46,12 @@ func (m *ConversationModel) toEntity() *entity.Conversation {
 		MemberLimit: m.MemberLimit,
 		JoinMode:    entity.JoinMode(m.JoinMode),
 		MuteAll:     m.MuteAll == 1,
+		MessageTTL:  m.MessageTTL,
 		Status:      entity.ConversationStatus(m.Status),
 		CreatedAt:   m.CreatedAt,
 		UpdatedAt:   m.UpdatedAt,
+
+		LastMessageAt: m.LastMessageAt,
 	}
 }
 
@@ -60,9 +69,12 @@ func conversationModelFromEntity(e *entity.Conversation) *ConversationModel {
 		MemberLimit: e.MemberLimit,
 		JoinMode:    int8(e.JoinMode),
 		MuteAll:     muteAll,
+		MessageTTL:  e.MessageTTL,
 		Status:      int8(e.Status),
 		CreatedAt:   e.CreatedAt,
 		UpdatedAt:   e.UpdatedAt,
+
+		LastMessageAt: e.LastMessageAt,
 	}
 }
 
@@ -125,23 +137,45 @@ func (r *ConversationRepositoryMySQL) GetSingleConversation(ctx context.Context,
 	return model.toEntity(), nil
 }
 
-func (r *ConversationRepositoryMySQL) ListByUserID(ctx context.Context, userID uint64, page, pageSize int) ([]*entity.Conversation, int, error) {
-	var models []ConversationModel
-	var total int64
+// conversationSortAt 会话列表中非置顶会话的排序时间
+const conversationSortAt = "COALESCE(c.last_message_at, c.created_at)"
 
-	subQuery := r.db.Model(&ParticipantModel{}).
-		Select("conversation_id").
-		Where("user_id = ?", userID)
+// userConversationQuery 用户会话列表基础查询
+// 未设置过的会话视为未置顶、未归档
+func (r *ConversationRepositoryMySQL) userConversationQuery(ctx context.Context, userID uint64, archived bool) *gorm.DB {
+	archivedFlag := 0
+	if archived {
+		archivedFlag = 1
+	}
+	return r.db.WithContext(ctx).
+		Table("conversations c").
+		Joins("JOIN participants p ON p.conversation_id = c.id AND p.user_id = ?", userID).
+		Joins("LEFT JOIN conversation_settings s ON s.conversation_id = c.id AND s.user_id = ?", userID).
+		Where("c.status = ? AND COALESCE(s.is_archived, 0) = ?", entity.ConversationStatusNormal, archivedFlag)
+}
 
-	query := r.db.WithContext(ctx).Model(&ConversationModel{}).
-		Where("id IN (?) AND status = ?", subQuery, entity.ConversationStatusNormal)
+// orderUserConversations 置顶在前按置顶时间倒序，其余按最后消息时间倒序，同时间按ID倒序
+func orderUserConversations(query *gorm.DB) *gorm.DB {
+	return query.
+		Order("s.pinned_at IS NULL, s.pinned_at DESC").
+		Order("CASE WHEN s.pinned_at IS NULL THEN " + conversationSortAt + " END DESC").
+		Order("c.id DESC")
+}
 
+func (r *ConversationRepositoryMySQL) ListByUserID(ctx context.Context, userID uint64, archived bool, page, pageSize int) ([]*entity.Conversation, int, error) {
+	var models []ConversationModel
+	var total int64
+
+	query := r.userConversationQuery(ctx, userID, archived)
 	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
 	offset := (page - 1) * pageSize
-	if err := query.Offset(offset).Limit(pageSize).Order("updated_at DESC").Find(&models).Error; err != nil {
+	err := orderUserConversations(query.Select("c.*")).
+		Offset(offset).Limit(pageSize).
+		Find(&models).Error
+	if err != nil {
 		return nil, 0, err
 	}
 
@@ -153,6 +187,75 @@ func (r *ConversationRepositoryMySQL) ListByUserID(ctx context.Context, userID u
 	return conversations, int(total), nil
 }
 
+func (r *ConversationRepositoryMySQL) ListByUserCursor(ctx context.Context, userID uint64, archived bool, filter *out.ConversationFilter, after *out.ConversationCursor, limit int) ([]*entity.Conversation, error) {
+	var models []ConversationModel
+
+	query := filterUserConversations(r.userConversationQuery(ctx, userID, archived), userID, filter)
+	if after != nil {
+		if after.Pinned {
+			query = query.Where("((s.pinned_at IS NOT NULL AND (s.pinned_at < ? OR (s.pinned_at = ? AND c.id < ?))) OR s.pinned_at IS NULL)",
+				after.At, after.At, after.ID)
+		} else {
+			query = query.Where("s.pinned_at IS NULL AND ("+conversationSortAt+" < ? OR ("+conversationSortAt+" = ? AND c.id < ?))",
+				after.At, after.At, after.ID)
+		}
+	}
+
+	if err := orderUserConversations(query.Select("c.*")).Limit(limit).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	conversations := make([]*entity.Conversation, len(models))
+	for i, m := range models {
+		conversations[i] = m.toEntity()
+	}
+	return conversations, nil
+}
+
+// filterUserConversations 按文件夹、类型和会话ID过滤用户会话列表
+func filterUserConversations(query *gorm.DB, userID uint64, filter *out.ConversationFilter) *gorm.DB {
+	if filter == nil {
+		return query
+	}
+	if filter.FolderID != 0 {
+		query = query.Joins("JOIN conversation_folder_items f ON f.conversation_id = c.id AND f.folder_id = ? AND f.user_id = ?", filter.FolderID, userID)
+	}
+	if len(filter.Types) > 0 {
+		types := make([]int8, len(filter.Types))
+		for i, t := range filter.Types {
+			types[i] = int8(t)
+		}
+		query = query.Where("c.type IN ?", types)
+	}
+	if filter.IDs != nil {
+		query = query.Where("c.id IN ?", filter.IDs)
+	}
+	return query
+}
+
+func (r *ConversationRepositoryMySQL) ListTypesByUser(ctx context.Context, userID uint64, archived bool) (map[uint64]entity.ConversationType, error) {
+	var rows []struct {
+		ID   uint64
+		Type int8
+	}
+	if err := r.userConversationQuery(ctx, userID, archived).Select("c.id, c.type").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(map[uint64]entity.ConversationType, len(rows))
+	for _, row := range rows {
+		result[row.ID] = entity.ConversationType(row.Type)
+	}
+	return result, nil
+}
+
+func (r *ConversationRepositoryMySQL) UpdateLastMessageAt(ctx context.Context, id uint64, at time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&ConversationModel{}).
+		Where("id = ? AND (last_message_at IS NULL OR last_message_at < ?)", id, at).
+		UpdateColumn("last_message_at", at).Error
+}
+
 // ParticipantModel GORM模型
 type ParticipantModel struct {
 	ID             uint64     `gorm:"column:id;primaryKey;autoIncrement"`
@@ -162,6 +265,7 @@ type ParticipantModel struct {
 	Nickname       *string    `gorm:"column:nickname;type:varchar(64)"`
 	Muted          int8       `gorm:"column:muted;default:0"`
 	MutedUntil     *time.Time `gorm:"column:muted_until"`
+	InviteID       *uint64    `gorm:"column:invite_id"`
 	JoinedAt       time.Time  `gorm:"column:joined_at;autoCreateTime"`
 	LastReadSeq    uint64     `gorm:"column:last_read_seq;default:0"`
 }
@@ -179,6 +283,7 @@ func (m *ParticipantModel) toEntity() *entity.Participant {
 		Nickname:       m.Nickname,
 		Muted:          m.Muted == 1,
 		MutedUntil:     m.MutedUntil,
+		InviteID:       m.InviteID,
 		JoinedAt:       m.JoinedAt,
 		LastReadSeq:    m.LastReadSeq,
 	}
@@ -197,6 +302,7 @@ func participantModelFromEntity(e *entity.Participant) *ParticipantModel {
 		Nickname:       e.Nickname,
 		Muted:          muted,
 		MutedUntil:     e.MutedUntil,
+		InviteID:       e.InviteID,
 		JoinedAt:       e.JoinedAt,
 		LastReadSeq:    e.LastReadSeq,
 	}
@@ -221,6 +327,22 @@ func (r *ParticipantRepositoryMySQL) Create(ctx context.Context, p *entity.Parti
 	return nil
 }
 
+func (r *ParticipantRepositoryMySQL) CreateWithinLimit(ctx context.Context, p *entity.Participant) error {
+	model := participantModelFromEntity(p)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkMemberLimit(tx, p.ConversationID); err != nil {
+			return err
+		}
+		return tx.Create(model).Error
+	})
+	if err != nil {
+		return err
+	}
+	p.ID = model.ID
+	p.JoinedAt = model.JoinedAt
+	return nil
+}
+
 func (r *ParticipantRepositoryMySQL) CreateBatch(ctx context.Context, participants []*entity.Participant) error {
 	if len(participants) == 0 {
 		return nil
@@ -275,6 +397,86 @@ func (r *ParticipantRepositoryMySQL) List(ctx context.Context, conversationID ui
 	return participants, nil
 }
 
+func (r *ParticipantRepositoryMySQL) ListManagers(ctx context.Context, conversationID uint64) ([]*entity.Participant, error) {
+	var models []ParticipantModel
+	err := r.db.WithContext(ctx).
+		Where("conversation_id = ? AND role IN ?", conversationID,
+			[]int8{int8(entity.ParticipantRoleAdmin), int8(entity.ParticipantRoleOwner)}).
+		Order("role DESC, joined_at ASC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	participants := make([]*entity.Participant, len(models))
+	for i, m := range models {
+		participants[i] = m.toEntity()
+	}
+	return participants, nil
+}
+
+func (r *ParticipantRepositoryMySQL) ListByCursor(ctx context.Context, conversationID uint64, filter *out.MemberFilter, after *out.MemberCursor, limit int) ([]*entity.Participant, error) {
+	query := r.db.WithContext(ctx).Where("conversation_id = ?", conversationID)
+	if filter != nil {
+		if filter.Role != nil {
+			query = query.Where("role = ?", int8(*filter.Role))
+		}
+		if filter.Muted != nil {
+			mutedCond := "(muted = 1 AND (muted_until IS NULL OR muted_until > ?))"
+			if *filter.Muted {
+				query = query.Where(mutedCond, time.Now())
+			} else {
+				query = query.Where("NOT "+mutedCond, time.Now())
+			}
+		}
+		if filter.Keyword != "" {
+			query = query.Where("nickname LIKE ?", "%"+escapeLike(filter.Keyword)+"%")
+		}
+	}
+	if after != nil {
+		query = query.Where("(role < ? OR (role = ? AND (joined_at > ? OR (joined_at = ? AND id > ?))))",
+			int8(after.Role), int8(after.Role), after.JoinedAt, after.JoinedAt, after.ID)
+	}
+
+	var models []ParticipantModel
+	if err := query.Order("role DESC, joined_at ASC, id ASC").Limit(limit).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	participants := make([]*entity.Participant, len(models))
+	for i, m := range models {
+		participants[i] = m.toEntity()
+	}
+	return participants, nil
+}
+
+func (r *ParticipantRepositoryMySQL) CountByRole(ctx context.Context, conversationID uint64) (map[entity.ParticipantRole]int, error) {
+	var rows []struct {
+		Role  int8
+		Count int
+	}
+	err := r.db.WithContext(ctx).
+		Model(&ParticipantModel{}).
+		Select("role, COUNT(*) AS count").
+		Where("conversation_id = ?", conversationID).
+		Group("role").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[entity.ParticipantRole]int, len(rows))
+	for _, row := range rows {
+		counts[entity.ParticipantRole(row.Role)] = row.Count
+	}
+	return counts, nil
+}
+
+// escapeLike 转义 LIKE 通配符
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
 func (r *ParticipantRepositoryMySQL) ListByUserID(ctx context.Context, userID uint64) ([]uint64, error) {
 	var convIDs []uint64
 	err := r.db.WithContext(ctx).
@@ -318,3 +520,812 @@ func (r *ParticipantRepositoryMySQL) IsMember(ctx context.Context, conversationI
 		Count(&count).Error
 	return count > 0, err
 }
+
+// JoinRequestModel GORM模型
+type JoinRequestModel struct {
+	ID             uint64    `gorm:"column:id;primaryKey;autoIncrement"`
+	ConversationID uint64    `gorm:"column:conversation_id;not null;index"`
+	UserID         uint64    `gorm:"column:user_id;not null;index"`
+	InviterID      *uint64   `gorm:"column:inviter_id"`
+	InviteID       *uint64   `gorm:"column:invite_id"`
+	Message        *string   `gorm:"column:message;type:varchar(255)"`
+	Status         int8      `gorm:"column:status;default:0"`
+	HandlerID      *uint64   `gorm:"column:handler_id"`
+	CreatedAt      time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt      time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (JoinRequestModel) TableName() string {
+	return "group_join_requests"
+}
+
+func (m *JoinRequestModel) toEntity() *entity.JoinRequest {
+	return &entity.JoinRequest{
+		ID:             m.ID,
+		ConversationID: m.ConversationID,
+		UserID:         m.UserID,
+		InviterID:      m.InviterID,
+		InviteID:       m.InviteID,
+		Message:        m.Message,
+		Status:         entity.JoinRequestStatus(m.Status),
+		HandlerID:      m.HandlerID,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
+
+func joinRequestModelFromEntity(e *entity.JoinRequest) *JoinRequestModel {
+	return &JoinRequestModel{
+		ID:             e.ID,
+		ConversationID: e.ConversationID,
+		UserID:         e.UserID,
+		InviterID:      e.InviterID,
+		InviteID:       e.InviteID,
+		Message:        e.Message,
+		Status:         int8(e.Status),
+		HandlerID:      e.HandlerID,
+		CreatedAt:      e.CreatedAt,
+		UpdatedAt:      e.UpdatedAt,
+	}
+}
+
+// JoinRequestRepositoryMySQL MySQL入群申请仓储实现
+type JoinRequestRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewJoinRequestRepositoryMySQL(db *gorm.DB) out.JoinRequestRepository {
+	return &JoinRequestRepositoryMySQL{db: db}
+}
+
+func (r *JoinRequestRepositoryMySQL) Create(ctx context.Context, req *entity.JoinRequest) error {
+	model := joinRequestModelFromEntity(req)
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+	req.ID = model.ID
+	req.CreatedAt = model.CreatedAt
+	req.UpdatedAt = model.UpdatedAt
+	return nil
+}
+
+func (r *JoinRequestRepositoryMySQL) GetByID(ctx context.Context, id uint64) (*entity.JoinRequest, error) {
+	var model JoinRequestModel
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return model.toEntity(), nil
+}
+
+func (r *JoinRequestRepositoryMySQL) GetPending(ctx context.Context, conversationID, userID uint64) (*entity.JoinRequest, error) {
+	var model JoinRequestModel
+	err := r.db.WithContext(ctx).
+		Where("conversation_id = ? AND user_id = ? AND status = ?", conversationID, userID, entity.JoinRequestStatusPending).
+		Order("id DESC").
+		First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return model.toEntity(), nil
+}
+
+func (r *JoinRequestRepositoryMySQL) ListByConversation(ctx context.Context, conversationID uint64, status entity.JoinRequestStatus, page, pageSize int) ([]*entity.JoinRequest, int, error) {
+	var models []JoinRequestModel
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&JoinRequestModel{}).
+		Where("conversation_id = ? AND status = ?", conversationID, status)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	if err := query.Offset(offset).Limit(pageSize).Order("created_at DESC").Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+
+	requests := make([]*entity.JoinRequest, len(models))
+	for i, m := range models {
+		requests[i] = m.toEntity()
+	}
+	return requests, int(total), nil
+}
+
+func (r *JoinRequestRepositoryMySQL) UpdateStatus(ctx context.Context, id uint64, from, to entity.JoinRequestStatus, handlerID *uint64) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&JoinRequestModel{}).
+		Where("id = ? AND status = ?", id, int8(from)).
+		Updates(map[string]interface{}{
+			"status":     int8(to),
+			"handler_id": handlerID,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// GroupInviteModel GORM模型
+type GroupInviteModel struct {
+	ID             uint64     `gorm:"column:id;primaryKey;autoIncrement"`
+	ConversationID uint64     `gorm:"column:conversation_id;not null;index"`
+	Code           string     `gorm:"column:code;type:varchar(32);uniqueIndex"`
+	CreatorID      uint64     `gorm:"column:creator_id;not null"`
+	ExpiresAt      *time.Time `gorm:"column:expires_at"`
+	MaxUses        int        `gorm:"column:max_uses;default:0"`
+	UsedCount      int        `gorm:"column:used_count;default:0"`
+	Revoked        int8       `gorm:"column:revoked;default:0"`
+	CreatedAt      time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt      time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (GroupInviteModel) TableName() string {
+	return "group_invites"
+}
+
+func (m *GroupInviteModel) toEntity() *entity.GroupInvite {
+	return &entity.GroupInvite{
+		ID:             m.ID,
+		ConversationID: m.ConversationID,
+		Code:           m.Code,
+		CreatorID:      m.CreatorID,
+		ExpiresAt:      m.ExpiresAt,
+		MaxUses:        m.MaxUses,
+		UsedCount:      m.UsedCount,
+		Revoked:        m.Revoked == 1,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
+
+func groupInviteModelFromEntity(e *entity.GroupInvite) *GroupInviteModel {
+	revoked := int8(0)
+	if e.Revoked {
+		revoked = 1
+	}
+	return &GroupInviteModel{
+		ID:             e.ID,
+		ConversationID: e.ConversationID,
+		Code:           e.Code,
+		CreatorID:      e.CreatorID,
+		ExpiresAt:      e.ExpiresAt,
+		MaxUses:        e.MaxUses,
+		UsedCount:      e.UsedCount,
+		Revoked:        revoked,
+		CreatedAt:      e.CreatedAt,
+		UpdatedAt:      e.UpdatedAt,
+	}
+}
+
+// GroupInviteRepositoryMySQL MySQL群邀请仓储实现
+type GroupInviteRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewGroupInviteRepositoryMySQL(db *gorm.DB) out.GroupInviteRepository {
+	return &GroupInviteRepositoryMySQL{db: db}
+}
+
+func (r *GroupInviteRepositoryMySQL) Create(ctx context.Context, invite *entity.GroupInvite) error {
+	model := groupInviteModelFromEntity(invite)
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+	invite.ID = model.ID
+	invite.CreatedAt = model.CreatedAt
+	invite.UpdatedAt = model.UpdatedAt
+	return nil
+}
+
+func (r *GroupInviteRepositoryMySQL) GetByID(ctx context.Context, id uint64) (*entity.GroupInvite, error) {
+	var model GroupInviteModel
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return model.toEntity(), nil
+}
+
+func (r *GroupInviteRepositoryMySQL) GetByCode(ctx context.Context, code string) (*entity.GroupInvite, error) {
+	var model GroupInviteModel
+	err := r.db.WithContext(ctx).Where("code = ?", code).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return model.toEntity(), nil
+}
+
+func (r *GroupInviteRepositoryMySQL) ListByConversation(ctx context.Context, conversationID uint64) ([]*entity.GroupInvite, error) {
+	var models []GroupInviteModel
+	err := r.db.WithContext(ctx).
+		Where("conversation_id = ?", conversationID).
+		Order("created_at DESC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	invites := make([]*entity.GroupInvite, len(models))
+	for i, m := range models {
+		invites[i] = m.toEntity()
+	}
+	return invites, nil
+}
+
+func (r *GroupInviteRepositoryMySQL) Update(ctx context.Context, invite *entity.GroupInvite) error {
+	model := groupInviteModelFromEntity(invite)
+	return r.db.WithContext(ctx).Save(model).Error
+}
+
+func (r *GroupInviteRepositoryMySQL) AddMember(ctx context.Context, inviteID uint64, p *entity.Participant) (bool, error) {
+	model := participantModelFromEntity(p)
+	used := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkMemberLimit(tx, p.ConversationID); err != nil {
+			return err
+		}
+		// 条件更新保证并发使用时不超过最大次数
+		result := tx.Model(&GroupInviteModel{}).
+			Where("id = ? AND revoked = 0", inviteID).
+			Where("max_uses = 0 OR used_count < max_uses").
+			Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+			UpdateColumn("used_count", gorm.Expr("used_count + 1"))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != 1 {
+			return nil
+		}
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+		used = true
+		return nil
+	})
+	if err != nil || !used {
+		return false, err
+	}
+	p.ID = model.ID
+	p.JoinedAt = model.JoinedAt
+	return true, nil
+}
+
+// ConversationSettingModel 用户会话设置GORM模型
+type ConversationSettingModel struct {
+	UserID         uint64     `gorm:"column:user_id;primaryKey"`
+	ConversationID uint64     `gorm:"column:conversation_id;primaryKey"`
+	PinnedAt       *time.Time `gorm:"column:pinned_at"`
+	IsMuted        int8       `gorm:"column:is_muted;default:0"`
+	MuteUntil      *time.Time `gorm:"column:mute_until"`
+	IsArchived     int8       `gorm:"column:is_archived;default:0"`
+	Remark         *string    `gorm:"column:remark;type:varchar(64)"`
+	UpdatedAt      time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (ConversationSettingModel) TableName() string {
+	return "conversation_settings"
+}
+
+func (m *ConversationSettingModel) toEntity() *entity.ConversationSetting {
+	return &entity.ConversationSetting{
+		UserID:         m.UserID,
+		ConversationID: m.ConversationID,
+		PinnedAt:       m.PinnedAt,
+		Muted:          m.IsMuted == 1,
+		MuteUntil:      m.MuteUntil,
+		Archived:       m.IsArchived == 1,
+		Remark:         m.Remark,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
+
+func settingModelFromEntity(e *entity.ConversationSetting) *ConversationSettingModel {
+	m := &ConversationSettingModel{
+		UserID:         e.UserID,
+		ConversationID: e.ConversationID,
+		PinnedAt:       e.PinnedAt,
+		MuteUntil:      e.MuteUntil,
+		Remark:         e.Remark,
+		UpdatedAt:      e.UpdatedAt,
+	}
+	if e.Muted {
+		m.IsMuted = 1
+	}
+	if e.Archived {
+		m.IsArchived = 1
+	}
+	return m
+}
+
+// ConversationSettingRepositoryMySQL MySQL会话设置仓储实现
+type ConversationSettingRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewConversationSettingRepositoryMySQL(db *gorm.DB) out.ConversationSettingRepository {
+	return &ConversationSettingRepositoryMySQL{db: db}
+}
+
+func (r *ConversationSettingRepositoryMySQL) Get(ctx context.Context, userID, conversationID uint64) (*entity.ConversationSetting, error) {
+	var model ConversationSettingModel
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND conversation_id = ?", userID, conversationID).
+		First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return model.toEntity(), nil
+}
+
+func (r *ConversationSettingRepositoryMySQL) BatchGet(ctx context.Context, userID uint64, conversationIDs []uint64) (map[uint64]*entity.ConversationSetting, error) {
+	result := make(map[uint64]*entity.ConversationSetting, len(conversationIDs))
+	if len(conversationIDs) == 0 {
+		return result, nil
+	}
+
+	var models []ConversationSettingModel
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND conversation_id IN ?", userID, conversationIDs).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range models {
+		result[models[i].ConversationID] = models[i].toEntity()
+	}
+	return result, nil
+}
+
+func (r *ConversationSettingRepositoryMySQL) ListByConversation(ctx context.Context, conversationID uint64) ([]*entity.ConversationSetting, error) {
+	var models []ConversationSettingModel
+	if err := r.db.WithContext(ctx).Where("conversation_id = ?", conversationID).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	settings := make([]*entity.ConversationSetting, len(models))
+	for i := range models {
+		settings[i] = models[i].toEntity()
+	}
+	return settings, nil
+}
+
+func (r *ConversationSettingRepositoryMySQL) Save(ctx context.Context, setting *entity.ConversationSetting) error {
+	model := settingModelFromEntity(setting)
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.AssignmentColumns([]string{
+			"pinned_at", "is_muted", "mute_until", "is_archived", "remark", "updated_at",
+		}),
+	}).Create(model).Error
+}
+
+// AnnouncementModel 群公告GORM模型
+type AnnouncementModel struct {
+	ConversationID uint64    `gorm:"column:conversation_id;primaryKey"`
+	Content        string    `gorm:"column:content;type:text"`
+	EditorID       uint64    `gorm:"column:editor_id;not null"`
+	CreatedAt      time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt      time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (AnnouncementModel) TableName() string {
+	return "conversation_announcements"
+}
+
+func (m *AnnouncementModel) toEntity() *entity.Announcement {
+	return &entity.Announcement{
+		ConversationID: m.ConversationID,
+		Content:        m.Content,
+		EditorID:       m.EditorID,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
+
+// AnnouncementRepositoryMySQL MySQL群公告仓储实现
+type AnnouncementRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewAnnouncementRepositoryMySQL(db *gorm.DB) out.AnnouncementRepository {
+	return &AnnouncementRepositoryMySQL{db: db}
+}
+
+func (r *AnnouncementRepositoryMySQL) Get(ctx context.Context, conversationID uint64) (*entity.Announcement, error) {
+	var model AnnouncementModel
+	err := r.db.WithContext(ctx).Where("conversation_id = ?", conversationID).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return model.toEntity(), nil
+}
+
+func (r *AnnouncementRepositoryMySQL) Save(ctx context.Context, announcement *entity.Announcement) error {
+	model := &AnnouncementModel{
+		ConversationID: announcement.ConversationID,
+		Content:        announcement.Content,
+		EditorID:       announcement.EditorID,
+		CreatedAt:      announcement.CreatedAt,
+		UpdatedAt:      announcement.UpdatedAt,
+	}
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.AssignmentColumns([]string{"content", "editor_id", "updated_at"}),
+	}).Create(model).Error
+}
+
+func (r *AnnouncementRepositoryMySQL) Delete(ctx context.Context, conversationID uint64) error {
+	return r.db.WithContext(ctx).Where("conversation_id = ?", conversationID).Delete(&AnnouncementModel{}).Error
+}
+
+// PinnedMessageModel 置顶消息GORM模型
+type PinnedMessageModel struct {
+	ConversationID uint64    `gorm:"column:conversation_id;primaryKey"`
+	MessageID      uint64    `gorm:"column:message_id;primaryKey"`
+	PinnedBy       uint64    `gorm:"column:pinned_by;not null"`
+	PinnedAt       time.Time `gorm:"column:pinned_at"`
+}
+
+func (PinnedMessageModel) TableName() string {
+	return "conversation_pinned_messages"
+}
+
+// PinnedMessageRepositoryMySQL MySQL置顶消息仓储实现
+type PinnedMessageRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewPinnedMessageRepositoryMySQL(db *gorm.DB) out.PinnedMessageRepository {
+	return &PinnedMessageRepositoryMySQL{db: db}
+}
+
+func (r *PinnedMessageRepositoryMySQL) List(ctx context.Context, conversationID uint64) ([]*entity.PinnedMessage, error) {
+	var models []PinnedMessageModel
+	err := r.db.WithContext(ctx).
+		Where("conversation_id = ?", conversationID).
+		Order("pinned_at DESC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	pins := make([]*entity.PinnedMessage, len(models))
+	for i, m := range models {
+		pins[i] = &entity.PinnedMessage{
+			ConversationID: m.ConversationID,
+			MessageID:      m.MessageID,
+			PinnedBy:       m.PinnedBy,
+			PinnedAt:       m.PinnedAt,
+		}
+	}
+	return pins, nil
+}
+
+func (r *PinnedMessageRepositoryMySQL) Create(ctx context.Context, pin *entity.PinnedMessage) error {
+	return r.db.WithContext(ctx).Create(&PinnedMessageModel{
+		ConversationID: pin.ConversationID,
+		MessageID:      pin.MessageID,
+		PinnedBy:       pin.PinnedBy,
+		PinnedAt:       pin.PinnedAt,
+	}).Error
+}
+
+func (r *PinnedMessageRepositoryMySQL) Delete(ctx context.Context, conversationID, messageID uint64) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Where("conversation_id = ? AND message_id = ?", conversationID, messageID).
+		Delete(&PinnedMessageModel{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// FolderModel 会话文件夹GORM模型
+type FolderModel struct {
+	ID        uint64    `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID    uint64    `gorm:"column:user_id;not null;uniqueIndex:uk_user_name,priority:1"`
+	Name      string    `gorm:"column:name;type:varchar(64);not null;uniqueIndex:uk_user_name,priority:2"`
+	SortOrder int       `gorm:"column:sort_order;default:0"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (FolderModel) TableName() string {
+	return "conversation_folders"
+}
+
+func (m *FolderModel) toEntity() *entity.Folder {
+	return &entity.Folder{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		Name:      m.Name,
+		SortOrder: m.SortOrder,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+func folderModelFromEntity(e *entity.Folder) *FolderModel {
+	return &FolderModel{
+		ID:        e.ID,
+		UserID:    e.UserID,
+		Name:      e.Name,
+		SortOrder: e.SortOrder,
+		CreatedAt: e.CreatedAt,
+		UpdatedAt: e.UpdatedAt,
+	}
+}
+
+// FolderItemModel 文件夹会话归属GORM模型
+type FolderItemModel struct {
+	FolderID       uint64    `gorm:"column:folder_id;primaryKey"`
+	ConversationID uint64    `gorm:"column:conversation_id;primaryKey"`
+	UserID         uint64    `gorm:"column:user_id;not null"`
+	CreatedAt      time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (FolderItemModel) TableName() string {
+	return "conversation_folder_items"
+}
+
+// FolderRepositoryMySQL MySQL会话文件夹仓储实现
+type FolderRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewFolderRepositoryMySQL(db *gorm.DB) out.FolderRepository {
+	return &FolderRepositoryMySQL{db: db}
+}
+
+func (r *FolderRepositoryMySQL) Create(ctx context.Context, folder *entity.Folder) error {
+	model := folderModelFromEntity(folder)
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return folderWriteError(err)
+	}
+	folder.ID = model.ID
+	folder.CreatedAt = model.CreatedAt
+	folder.UpdatedAt = model.UpdatedAt
+	return nil
+}
+
+func (r *FolderRepositoryMySQL) GetByID(ctx context.Context, id uint64) (*entity.Folder, error) {
+	var model FolderModel
+	err := r.db.WithContext(ctx).Where("id = ?", id).Take(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return model.toEntity(), nil
+}
+
+func (r *FolderRepositoryMySQL) ListByUser(ctx context.Context, userID uint64) ([]*entity.Folder, error) {
+	var models []FolderModel
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("sort_order ASC, id ASC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	folders := make([]*entity.Folder, len(models))
+	for i := range models {
+		folders[i] = models[i].toEntity()
+	}
+	return folders, nil
+}
+
+func (r *FolderRepositoryMySQL) Update(ctx context.Context, folder *entity.Folder) error {
+	return folderWriteError(r.db.WithContext(ctx).Save(folderModelFromEntity(folder)).Error)
+}
+
+// mysqlErrDuplicateEntry MySQL唯一键冲突错误码
+const mysqlErrDuplicateEntry = 1062
+
+// folderWriteError 将唯一索引冲突转换为 out.ErrFolderNameConflict
+func folderWriteError(err error) error {
+	var mysqlErr *mysqldriver.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry {
+		return out.ErrFolderNameConflict
+	}
+	return err
+}
+
+func (r *FolderRepositoryMySQL) Delete(ctx context.Context, id uint64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("folder_id = ?", id).Delete(&FolderItemModel{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", id).Delete(&FolderModel{}).Error
+	})
+}
+
+func (r *FolderRepositoryMySQL) Reorder(ctx context.Context, userID uint64, folderIDs []uint64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i, id := range folderIDs {
+			if err := tx.Model(&FolderModel{}).
+				Where("id = ? AND user_id = ?", id, userID).
+				Update("sort_order", i).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *FolderRepositoryMySQL) AddConversations(ctx context.Context, userID, folderID uint64, conversationIDs []uint64) error {
+	if len(conversationIDs) == 0 {
+		return nil
+	}
+	items := make([]*FolderItemModel, len(conversationIDs))
+	for i, convID := range conversationIDs {
+		items[i] = &FolderItemModel{FolderID: folderID, ConversationID: convID, UserID: userID}
+	}
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&items).Error
+}
+
+func (r *FolderRepositoryMySQL) RemoveConversation(ctx context.Context, folderID, conversationID uint64) error {
+	return r.db.WithContext(ctx).
+		Where("folder_id = ? AND conversation_id = ?", folderID, conversationID).
+		Delete(&FolderItemModel{}).Error
+}
+
+func (r *FolderRepositoryMySQL) ListAssignments(ctx context.Context, userID uint64) (map[uint64][]uint64, error) {
+	return r.listFolderIDs(r.db.WithContext(ctx).Where("user_id = ?", userID))
+}
+
+func (r *FolderRepositoryMySQL) ListFolderIDs(ctx context.Context, userID uint64, conversationIDs []uint64) (map[uint64][]uint64, error) {
+	if len(conversationIDs) == 0 {
+		return map[uint64][]uint64{}, nil
+	}
+	return r.listFolderIDs(r.db.WithContext(ctx).Where("user_id = ? AND conversation_id IN ?", userID, conversationIDs))
+}
+
+// listFolderIDs 按会话ID汇总归属的文件夹
+func (r *FolderRepositoryMySQL) listFolderIDs(query *gorm.DB) (map[uint64][]uint64, error) {
+	var models []FolderItemModel
+	if err := query.Order("folder_id ASC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(map[uint64][]uint64)
+	for _, m := range models {
+		result[m.ConversationID] = append(result[m.ConversationID], m.FolderID)
+	}
+	return result, nil
+}
+
+// OwnershipRepositoryMySQL MySQL群主变更仓储实现
+type OwnershipRepositoryMySQL struct {
+	db *gorm.DB
+}
+
+func NewOwnershipRepositoryMySQL(db *gorm.DB) out.OwnershipRepository {
+	return &OwnershipRepositoryMySQL{db: db}
+}
+
+func (r *OwnershipRepositoryMySQL) TransferOwner(ctx context.Context, conversationID, ownerID, newOwnerID uint64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockOwnedConversation(tx, conversationID, ownerID); err != nil {
+			return err
+		}
+
+		res := tx.Model(&ParticipantModel{}).
+			Where("conversation_id = ? AND user_id = ?", conversationID, newOwnerID).
+			Update("role", int8(entity.ParticipantRoleOwner))
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return out.ErrOwnershipConflict
+		}
+
+		if err := tx.Model(&ParticipantModel{}).
+			Where("conversation_id = ? AND user_id = ?", conversationID, ownerID).
+			Update("role", int8(entity.ParticipantRoleAdmin)).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&ConversationModel{}).
+			Where("id = ?", conversationID).
+			Update("owner_id", newOwnerID).Error
+	})
+}
+
+func (r *OwnershipRepositoryMySQL) RemoveOwner(ctx context.Context, conversationID, ownerID uint64) (uint64, error) {
+	var successorID uint64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockOwnedConversation(tx, conversationID, ownerID); err != nil {
+			return err
+		}
+
+		if err := tx.Where("conversation_id = ? AND user_id = ?", conversationID, ownerID).
+			Delete(&ParticipantModel{}).Error; err != nil {
+			return err
+		}
+
+		// 角色降序使管理员排在普通成员之前，同角色按入群时间先后
+		var successor ParticipantModel
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("conversation_id = ?", conversationID).
+			Order("role DESC, joined_at ASC, id ASC").
+			Take(&successor).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return tx.Model(&ConversationModel{}).
+				Where("id = ?", conversationID).
+				Update("status", int8(entity.ConversationStatusDissolved)).Error
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Model(&ParticipantModel{}).
+			Where("id = ?", successor.ID).
+			Update("role", int8(entity.ParticipantRoleOwner)).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&ConversationModel{}).
+			Where("id = ?", conversationID).
+			Update("owner_id", successor.UserID).Error; err != nil {
+			return err
+		}
+		successorID = successor.UserID
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return successorID, nil
+}
+
+// lockOwnedConversation 锁定会话行并校验群主未变更
+func lockOwnedConversation(tx *gorm.DB, conversationID, ownerID uint64) error {
+	var conv ConversationModel
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", conversationID).
+		Take(&conv).Error; err != nil {
+		return err
+	}
+	if conv.OwnerID == nil || *conv.OwnerID != ownerID {
+		return out.ErrOwnershipConflict
+	}
+	return nil
+}
+
+// checkMemberLimit 锁定会话行后统计成员数，使并发入群按顺序校验人数上限
+func checkMemberLimit(tx *gorm.DB, conversationID uint64) error {
+	var conv ConversationModel
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", conversationID).
+		Take(&conv).Error; err != nil {
+		return err
+	}
+	var count int64
+	if err := tx.Model(&ParticipantModel{}).
+		Where("conversation_id = ?", conversationID).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	if int(count) >= conv.MemberLimit {
+		return out.ErrMemberLimitReached
+	}
+	return nil
+}
@@ -0,0 +1,228 @@
+package conversation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/EthanQC/IM/services/conversation_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/conversation_service/internal/ports/in"
+	"github.com/EthanQC/IM/services/conversation_service/internal/ports/out"
+)
+
+var (
+	ErrInvalidAnnouncement   = errors.New("invalid announcement")
+	ErrAnnouncementNotFound  = errors.New("announcement not found")
+	ErrMessageNotFound       = errors.New("message not found")
+	ErrPinnedMessageNotFound = errors.New("pinned message not found")
+	ErrPinLimitExceeded      = errors.New("pinned message limit exceeded")
+)
+
+// SetMessageReader 设置消息读取器，用于置顶消息时校验消息归属和统计文件夹未读数
+func (uc *ConversationUseCaseImpl) SetMessageReader(reader out.MessageReader) {
+	uc.msgReader = reader
+}
+
+// GetConversationDetail 获取会话详情（含公告、置顶消息和个人设置）
+func (uc *ConversationUseCaseImpl) GetConversationDetail(ctx context.Context, userID, conversationID uint64) (*in.ConversationDetail, error) {
+	conv, err := uc.GetConversation(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.requireMember(ctx, conversationID, userID); err != nil {
+		return nil, err
+	}
+
+	count, err := uc.participantRepo.Count(ctx, conversationID)
+	if err != nil {
+		return nil, fmt.Errorf("count members: %w", err)
+	}
+	announcement, err := uc.announcementRepo.Get(ctx, conversationID)
+	if err != nil {
+		return nil, fmt.Errorf("get announcement: %w", err)
+	}
+	pins, err := uc.pinRepo.List(ctx, conversationID)
+	if err != nil {
+		return nil, fmt.Errorf("list pinned messages: %w", err)
+	}
+	setting, err := uc.getSetting(ctx, userID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &in.ConversationDetail{
+		Conversation:   conv,
+		MemberCount:    count,
+		Announcement:   announcement,
+		PinnedMessages: pins,
+		Setting:        setting,
+	}, nil
+}
+
+// GetAnnouncement 获取群公告
+func (uc *ConversationUseCaseImpl) GetAnnouncement(ctx context.Context, userID, conversationID uint64) (*entity.Announcement, error) {
+	if err := uc.requireMember(ctx, conversationID, userID); err != nil {
+		return nil, err
+	}
+
+	announcement, err := uc.announcementRepo.Get(ctx, conversationID)
+	if err != nil {
+		return nil, fmt.Errorf("get announcement: %w", err)
+	}
+	if announcement == nil {
+		return nil, ErrAnnouncementNotFound
+	}
+	return announcement, nil
+}
+
+// SetAnnouncement 发布或编辑群公告，并以@所有人的方式通知成员
+func (uc *ConversationUseCaseImpl) SetAnnouncement(ctx context.Context, operatorID, conversationID uint64, content string) (*entity.Announcement, error) {
+	content = strings.TrimSpace(content)
+	if content == "" || utf8.RuneCountInString(content) > entity.MaxAnnouncementLength {
+		return nil, ErrInvalidAnnouncement
+	}
+	if err := uc.requireGroupManager(ctx, conversationID, operatorID); err != nil {
+		return nil, err
+	}
+
+	announcement, err := uc.announcementRepo.Get(ctx, conversationID)
+	if err != nil {
+		return nil, fmt.Errorf("get announcement: %w", err)
+	}
+	if announcement == nil {
+		announcement = entity.NewAnnouncement(conversationID, operatorID, content)
+	} else {
+		announcement.Edit(operatorID, content)
+	}
+	if err := uc.announcementRepo.Save(ctx, announcement); err != nil {
+		return nil, fmt.Errorf("save announcement: %w", err)
+	}
+
+	uc.publishEvent(ctx, EventAnnouncementUpdated, conversationID, operatorID, nil, map[string]interface{}{
+		"content":   announcement.Content,
+		"editor_id": operatorID,
+	})
+
+	return announcement, nil
+}
+
+// DeleteAnnouncement 删除群公告
+func (uc *ConversationUseCaseImpl) DeleteAnnouncement(ctx context.Context, operatorID, conversationID uint64) error {
+	if err := uc.requireGroupManager(ctx, conversationID, operatorID); err != nil {
+		return err
+	}
+
+	announcement, err := uc.announcementRepo.Get(ctx, conversationID)
+	if err != nil {
+		return fmt.Errorf("get announcement: %w", err)
+	}
+	if announcement == nil {
+		return ErrAnnouncementNotFound
+	}
+	if err := uc.announcementRepo.Delete(ctx, conversationID); err != nil {
+		return fmt.Errorf("delete announcement: %w", err)
+	}
+
+	uc.publishEvent(ctx, EventAnnouncementDeleted, conversationID, operatorID, nil, nil)
+	return nil
+}
+
+// ListPinnedMessages 获取会话置顶消息
+func (uc *ConversationUseCaseImpl) ListPinnedMessages(ctx context.Context, userID, conversationID uint64) ([]*entity.PinnedMessage, error) {
+	if err := uc.requireMember(ctx, conversationID, userID); err != nil {
+		return nil, err
+	}
+	return uc.pinRepo.List(ctx, conversationID)
+}
+
+// PinMessage 置顶消息，消息需属于该会话且未撤回
+func (uc *ConversationUseCaseImpl) PinMessage(ctx context.Context, operatorID, conversationID, messageID uint64) (*entity.PinnedMessage, error) {
+	if uc.msgReader == nil {
+		return nil, fmt.Errorf("message reader not configured")
+	}
+	if err := uc.requireGroupManager(ctx, conversationID, operatorID); err != nil {
+		return nil, err
+	}
+
+	pins, err := uc.pinRepo.List(ctx, conversationID)
+	if err != nil {
+		return nil, fmt.Errorf("list pinned messages: %w", err)
+	}
+	for _, p := range pins {
+		if p.MessageID == messageID {
+			return p, nil
+		}
+	}
+	if len(pins) >= entity.MaxPinnedMessages {
+		return nil, ErrPinLimitExceeded
+	}
+
+	msg, err := uc.msgReader.GetMessage(ctx, operatorID, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("get message: %w", err)
+	}
+	if msg == nil || msg.ConversationID != conversationID {
+		return nil, ErrMessageNotFound
+	}
+
+	pin := entity.NewPinnedMessage(conversationID, messageID, operatorID)
+	if err := uc.pinRepo.Create(ctx, pin); err != nil {
+		return nil, fmt.Errorf("pin message: %w", err)
+	}
+
+	uc.publishEvent(ctx, EventMessagePinned, conversationID, operatorID, nil, map[string]interface{}{
+		"message_id": messageID,
+	})
+
+	return pin, nil
+}
+
+// UnpinMessage 取消置顶消息
+func (uc *ConversationUseCaseImpl) UnpinMessage(ctx context.Context, operatorID, conversationID, messageID uint64) error {
+	if err := uc.requireGroupManager(ctx, conversationID, operatorID); err != nil {
+		return err
+	}
+
+	deleted, err := uc.pinRepo.Delete(ctx, conversationID, messageID)
+	if err != nil {
+		return fmt.Errorf("unpin message: %w", err)
+	}
+	if !deleted {
+		return ErrPinnedMessageNotFound
+	}
+
+	uc.publishEvent(ctx, EventMessageUnpinned, conversationID, operatorID, nil, map[string]interface{}{
+		"message_id": messageID,
+	})
+	return nil
+}
+
+// requireMember 校验用户是会话成员
+func (uc *ConversationUseCaseImpl) requireMember(ctx context.Context, conversationID, userID uint64) error {
+	isMember, err := uc.participantRepo.IsMember(ctx, conversationID, userID)
+	if err != nil {
+		return fmt.Errorf("check member: %w", err)
+	}
+	if !isMember {
+		return ErrNotConversationMember
+	}
+	return nil
+}
+
+// requireGroupManager 校验会话为未解散的群聊，且操作者为群主或管理员
+func (uc *ConversationUseCaseImpl) requireGroupManager(ctx context.Context, conversationID, operatorID uint64) error {
+	if _, err := uc.getJoinableGroup(ctx, conversationID); err != nil {
+		return err
+	}
+
+	operator, err := uc.participantRepo.Get(ctx, conversationID, operatorID)
+	if err != nil {
+		return fmt.Errorf("get operator: %w", err)
+	}
+	if operator == nil || !operator.CanManageMembers() {
+		return ErrNoPermission
+	}
+	return nil
+}
@@ -19,13 +19,31 @@ var (
 	ErrCannotRemoveOwner        = errors.New("cannot remove owner")
 	ErrMemberLimitExceeded      = errors.New("member limit exceeded")
 	ErrSingleConvCannotAddMore  = errors.New("single conversation cannot add more members")
-	ErrOwnerCannotLeave         = errors.New("owner cannot leave, transfer ownership first")
+	ErrNotGroupConversation     = errors.New("not a group conversation")
+	ErrConversationDissolved    = errors.New("conversation dissolved")
+	ErrInvalidMessageTTL        = errors.New("unsupported message ttl")
+	ErrAlreadyMember            = errors.New("already a conversation member")
+	ErrJoinRequestNotFound      = errors.New("join request not found")
+	ErrJoinRequestHandled       = errors.New("join request already handled")
+	ErrInvalidRole              = errors.New("invalid member role")
+	ErrInvalidMuteDuration      = errors.New("invalid mute duration")
+	ErrCannotOperateSelf        = errors.New("cannot operate on yourself")
+	ErrOwnershipChanged         = errors.New("conversation ownership changed, please retry")
 )
 
 type ConversationUseCaseImpl struct {
-	convRepo        out.ConversationRepository
-	participantRepo out.ParticipantRepository
-	eventPub        out.EventPublisher
+	convRepo         out.ConversationRepository
+	participantRepo  out.ParticipantRepository
+	joinReqRepo      out.JoinRequestRepository
+	inviteRepo       out.GroupInviteRepository
+	settingRepo      out.ConversationSettingRepository
+	announcementRepo out.AnnouncementRepository
+	pinRepo          out.PinnedMessageRepository
+	ownershipRepo    out.OwnershipRepository
+	folderRepo       out.FolderRepository
+	eventPub         out.EventPublisher
+	msgReader        out.MessageReader
+	userReader       out.UserReader
 }
 
 var _ in.ConversationUseCase = (*ConversationUseCaseImpl)(nil)
@@ -33,12 +51,26 @@ var _ in.ConversationUseCase = (*ConversationUseCaseImpl)(nil)
 func NewConversationUseCaseImpl(
 	convRepo out.ConversationRepository,
 	participantRepo out.ParticipantRepository,
+	joinReqRepo out.JoinRequestRepository,
+	inviteRepo out.GroupInviteRepository,
+	settingRepo out.ConversationSettingRepository,
+	announcementRepo out.AnnouncementRepository,
+	pinRepo out.PinnedMessageRepository,
+	ownershipRepo out.OwnershipRepository,
+	folderRepo out.FolderRepository,
 	eventPub out.EventPublisher,
 ) *ConversationUseCaseImpl {
 	return &ConversationUseCaseImpl{
-		convRepo:        convRepo,
-		participantRepo: participantRepo,
-		eventPub:        eventPub,
+		convRepo:         convRepo,
+		participantRepo:  participantRepo,
+		joinReqRepo:      joinReqRepo,
+		inviteRepo:       inviteRepo,
+		settingRepo:      settingRepo,
+		announcementRepo: announcementRepo,
+		pinRepo:          pinRepo,
+		ownershipRepo:    ownershipRepo,
+		folderRepo:       folderRepo,
+		eventPub:         eventPub,
 	}
 }
 
@@ -63,6 +95,9 @@ func (uc *ConversationUseCaseImpl) CreateConversation(ctx context.Context, creat
 	case entity.ConversationTypeGroup:
 		conv = entity.NewGroupConversation(title, creatorID)
 
+	case entity.ConversationTypeChannel:
+		conv = entity.NewChannelConversation(title, creatorID)
+
 	default:
 		return nil, errors.New("invalid conversation type")
 	}
@@ -74,7 +109,7 @@ func (uc *ConversationUseCaseImpl) CreateConversation(ctx context.Context, creat
 
 	// 添加创建者为成员
 	creatorRole := entity.ParticipantRoleMember
-	if convType == entity.ConversationTypeGroup {
+	if convType != entity.ConversationTypeSingle {
 		creatorRole = entity.ParticipantRoleOwner
 	}
 	creatorParticipant := entity.NewParticipant(conv.ID, creatorID, creatorRole)
@@ -94,17 +129,11 @@ func (uc *ConversationUseCaseImpl) CreateConversation(ctx context.Context, creat
 	}
 
 	// 发布事件
-	if uc.eventPub != nil {
-		event := map[string]interface{}{
-			"type":            "conversation.created",
-			"conversation_id": conv.ID,
-			"creator_id":      creatorID,
-			"conv_type":       convType,
-			"member_ids":      append(memberIDs, creatorID),
-			"timestamp":       time.Now(),
-		}
-		_ = uc.eventPub.Publish(ctx, "conversation-events", event)
-	}
+	uc.publishEvent(ctx, EventConversationCreated, conv.ID, creatorID, nil, map[string]interface{}{
+		"creator_id": creatorID,
+		"conv_type":  convType,
+		"member_ids": append(memberIDs, creatorID),
+	})
 
 	return conv, nil
 }
@@ -138,19 +167,35 @@ func (uc *ConversationUseCaseImpl) UpdateConversation(ctx context.Context, userI
 		return nil, ErrNotConversationMember
 	}
 
-	// 只有群聊可以更新，且需要管理员权限
-	if conv.IsGroup() && !participant.CanManageMembers() {
+	// 群聊和频道需要管理员权限
+	if !conv.IsSingle() && !participant.CanManageMembers() {
 		return nil, ErrNoPermission
 	}
 
+	oldTitle := conv.Title
 	conv.Update(title, avatarURL)
 	if err := uc.convRepo.Update(ctx, conv); err != nil {
 		return nil, fmt.Errorf("update conversation: %w", err)
 	}
 
+	if !conv.IsSingle() && !sameTitle(conv.Title, oldTitle) {
+		uc.publishEvent(ctx, EventTitleChanged, conversationID, userID, nil, map[string]interface{}{
+			"old_title": oldTitle,
+			"title":     conv.Title,
+		})
+	}
+
 	return conv, nil
 }
 
+// sameTitle 按值比较群名，nil 与 nil 视为相同
+func sameTitle(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
 func (uc *ConversationUseCaseImpl) DeleteConversation(ctx context.Context, userID, conversationID uint64) error {
 	conv, err := uc.convRepo.GetByID(ctx, conversationID)
 	if err != nil {
@@ -160,15 +205,16 @@ func (uc *ConversationUseCaseImpl) DeleteConversation(ctx context.Context, userI
 		return ErrConversationNotFound
 	}
 
-	// 检查权限：单聊可以删除，群聊只有群主可以解散
-	if conv.IsGroup() {
-		participant, err := uc.participantRepo.Get(ctx, conversationID, userID)
-		if err != nil {
-			return fmt.Errorf("get participant: %w", err)
-		}
-		if participant == nil || !participant.IsOwner() {
-			return ErrNoPermission
-		}
+	// 检查权限：单聊成员可以删除，群聊和频道只有群主可以解散
+	participant, err := uc.participantRepo.Get(ctx, conversationID, userID)
+	if err != nil {
+		return fmt.Errorf("get participant: %w", err)
+	}
+	if participant == nil {
+		return ErrNotConversationMember
+	}
+	if !conv.IsSingle() && !participant.IsOwner() {
+		return ErrNoPermission
 	}
 
 	conv.Dissolve()
@@ -176,6 +222,8 @@ func (uc *ConversationUseCaseImpl) DeleteConversation(ctx context.Context, userI
 		return fmt.Errorf("dissolve conversation: %w", err)
 	}
 
+	uc.publishEvent(ctx, EventConversationDissolved, conversationID, userID, nil, nil)
+
 	return nil
 }
 
@@ -193,10 +241,6 @@ func (uc *ConversationUseCaseImpl) GetOrCreateSingleConversation(ctx context.Con
 	return uc.CreateConversation(ctx, userID1, entity.ConversationTypeSingle, "", []uint64{userID2})
 }
 
-func (uc *ConversationUseCaseImpl) ListMyConversations(ctx context.Context, userID uint64, page, pageSize int) ([]*entity.Conversation, int, error) {
-	return uc.convRepo.ListByUserID(ctx, userID, page, pageSize)
-}
-
 func (uc *ConversationUseCaseImpl) AddMembers(ctx context.Context, operatorID, conversationID uint64, userIDs []uint64) error {
 	conv, err := uc.convRepo.GetByID(ctx, conversationID)
 	if err != nil {
@@ -229,6 +273,7 @@ func (uc *ConversationUseCaseImpl) AddMembers(ctx context.Context, operatorID, c
 	}
 
 	// 添加成员
+	var added []uint64
 	for _, userID := range userIDs {
 		isMember, _ := uc.participantRepo.IsMember(ctx, conversationID, userID)
 		if isMember {
@@ -238,20 +283,17 @@ func (uc *ConversationUseCaseImpl) AddMembers(ctx context.Context, operatorID, c
 		if err := uc.participantRepo.Create(ctx, participant); err != nil {
 			return fmt.Errorf("add member %d: %w", userID, err)
 		}
+		added = append(added, userID)
 	}
-
-	// 发布事件
-	if uc.eventPub != nil {
-		event := map[string]interface{}{
-			"type":            "conversation.members_added",
-			"conversation_id": conversationID,
-			"operator_id":     operatorID,
-			"added_user_ids":  userIDs,
-			"timestamp":       time.Now(),
-		}
-		_ = uc.eventPub.Publish(ctx, "conversation-events", event)
+	if len(added) == 0 {
+		return nil
 	}
 
+	// 发布事件，只包含实际新加入的成员
+	uc.publishEvent(ctx, EventMembersAdded, conversationID, operatorID, nil, map[string]interface{}{
+		"added_user_ids": added,
+	})
+
 	return nil
 }
 
@@ -276,6 +318,7 @@ func (uc *ConversationUseCaseImpl) RemoveMembers(ctx context.Context, operatorID
 		return ErrNoPermission
 	}
 
+	var removed []uint64
 	for _, userID := range userIDs {
 		if userID == operatorID {
 			return ErrCannotRemoveSelf
@@ -296,7 +339,16 @@ func (uc *ConversationUseCaseImpl) RemoveMembers(ctx context.Context, operatorID
 		if err := uc.participantRepo.Delete(ctx, conversationID, userID); err != nil {
 			return fmt.Errorf("remove member %d: %w", userID, err)
 		}
+		removed = append(removed, userID)
 	}
+	if len(removed) == 0 {
+		return nil
+	}
+
+	// 被移除的成员已不在会话中，直接通知
+	uc.publishEvent(ctx, EventMembersRemoved, conversationID, operatorID, removed, map[string]interface{}{
+		"removed_user_ids": removed,
+	})
 
 	return nil
 }
@@ -305,6 +357,25 @@ func (uc *ConversationUseCaseImpl) GetMembers(ctx context.Context, conversationI
 	return uc.participantRepo.List(ctx, conversationID)
 }
 
+func (uc *ConversationUseCaseImpl) GetManagers(ctx context.Context, conversationID uint64) ([]*entity.Participant, error) {
+	return uc.participantRepo.ListManagers(ctx, conversationID)
+}
+
+func (uc *ConversationUseCaseImpl) IsMember(ctx context.Context, conversationID, userID uint64) (bool, error) {
+	return uc.participantRepo.IsMember(ctx, conversationID, userID)
+}
+
+func (uc *ConversationUseCaseImpl) ListMembers(ctx context.Context, userID, conversationID uint64) ([]*entity.Participant, error) {
+	isMember, err := uc.participantRepo.IsMember(ctx, conversationID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("check member: %w", err)
+	}
+	if !isMember {
+		return nil, ErrNotConversationMember
+	}
+	return uc.participantRepo.List(ctx, conversationID)
+}
+
 func (uc *ConversationUseCaseImpl) LeaveConversation(ctx context.Context, userID, conversationID uint64) error {
 	conv, err := uc.convRepo.GetByID(ctx, conversationID)
 	if err != nil {
@@ -322,19 +393,38 @@ func (uc *ConversationUseCaseImpl) LeaveConversation(ctx context.Context, userID
 		return ErrNotConversationMember
 	}
 
-	// 群主不能直接退出
-	if conv.IsGroup() && participant.IsOwner() {
-		return ErrOwnerCannotLeave
+	// 群主退出时在同一事务中移交群主，无人可继任时解散
+	if !conv.IsSingle() && participant.IsOwner() {
+		return uc.leaveAsOwner(ctx, conv, userID)
 	}
 
 	if err := uc.participantRepo.Delete(ctx, conversationID, userID); err != nil {
 		return fmt.Errorf("leave conversation: %w", err)
 	}
 
+	// 频道退订只通知本人，不生成系统消息
+	if conv.IsChannel() {
+		uc.publishEvent(ctx, EventChannelUnsubscribed, conversationID, userID, []uint64{userID}, map[string]interface{}{
+			"user_id": userID,
+		})
+		return nil
+	}
+	uc.publishEvent(ctx, EventMemberLeft, conversationID, userID, nil, map[string]interface{}{
+		"user_id": userID,
+	})
+
 	return nil
 }
 
 func (uc *ConversationUseCaseImpl) SetMemberRole(ctx context.Context, operatorID, conversationID, targetUserID uint64, role entity.ParticipantRole) error {
+	// 群主身份只能通过转让变更
+	if role != entity.ParticipantRoleMember && role != entity.ParticipantRoleAdmin {
+		return ErrInvalidRole
+	}
+	if operatorID == targetUserID {
+		return ErrCannotOperateSelf
+	}
+
 	participant, err := uc.participantRepo.Get(ctx, conversationID, operatorID)
 	if err != nil {
 		return fmt.Errorf("get operator: %w", err)
@@ -363,6 +453,13 @@ func (uc *ConversationUseCaseImpl) SetMemberRole(ctx context.Context, operatorID
 }
 
 func (uc *ConversationUseCaseImpl) MuteMember(ctx context.Context, operatorID, conversationID, targetUserID uint64, muteSeconds int64) error {
+	if muteSeconds < 0 {
+		return ErrInvalidMuteDuration
+	}
+	if operatorID == targetUserID {
+		return ErrCannotOperateSelf
+	}
+
 	operator, err := uc.participantRepo.Get(ctx, conversationID, operatorID)
 	if err != nil {
 		return fmt.Errorf("get operator: %w", err)
@@ -378,6 +475,10 @@ func (uc *ConversationUseCaseImpl) MuteMember(ctx context.Context, operatorID, c
 	if target == nil {
 		return ErrNotConversationMember
 	}
+	// 群主不可被禁言，管理员只能禁言普通成员
+	if target.IsOwner() || (operator.IsAdmin() && target.IsAdmin()) {
+		return ErrNoPermission
+	}
 
 	var mutedUntil *time.Time
 	if muteSeconds > 0 {
@@ -390,6 +491,15 @@ func (uc *ConversationUseCaseImpl) MuteMember(ctx context.Context, operatorID, c
 		return fmt.Errorf("mute member: %w", err)
 	}
 
+	data := map[string]interface{}{
+		"user_id":       targetUserID,
+		"duration_secs": muteSeconds,
+	}
+	if mutedUntil != nil {
+		data["muted_until"] = mutedUntil.Unix()
+	}
+	uc.publishEvent(ctx, EventMemberMuted, conversationID, operatorID, nil, data)
+
 	return nil
 }
 
@@ -415,5 +525,159 @@ func (uc *ConversationUseCaseImpl) UnmuteMember(ctx context.Context, operatorID,
 		return fmt.Errorf("unmute member: %w", err)
 	}
 
+	uc.publishEvent(ctx, EventMemberUnmuted, conversationID, operatorID, nil, map[string]interface{}{
+		"user_id": targetUserID,
+	})
+
+	return nil
+}
+
+func (uc *ConversationUseCaseImpl) SetMuteAll(ctx context.Context, operatorID, conversationID uint64, mute bool) error {
+	conv, err := uc.getJoinableGroup(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+
+	operator, err := uc.participantRepo.Get(ctx, conversationID, operatorID)
+	if err != nil {
+		return fmt.Errorf("get operator: %w", err)
+	}
+	if operator == nil || !operator.CanManageMembers() {
+		return ErrNoPermission
+	}
+
+	if conv.MuteAll == mute {
+		return nil
+	}
+	conv.SetMuteAll(mute)
+	if err := uc.convRepo.Update(ctx, conv); err != nil {
+		return fmt.Errorf("set mute all: %w", err)
+	}
+
+	uc.publishEvent(ctx, EventMuteAllUpdated, conversationID, operatorID, nil, map[string]interface{}{
+		"mute_all": mute,
+	})
+
+	return nil
+}
+
+// SetMessageTTL 设置消息自动删除时长，只对之后发送的消息生效
+func (uc *ConversationUseCaseImpl) SetMessageTTL(ctx context.Context, operatorID, conversationID uint64, ttlSeconds int64) error {
+	if !entity.IsValidMessageTTL(ttlSeconds) {
+		return ErrInvalidMessageTTL
+	}
+
+	conv, err := uc.convRepo.GetByID(ctx, conversationID)
+	if err != nil {
+		return fmt.Errorf("get conversation: %w", err)
+	}
+	if conv == nil {
+		return ErrConversationNotFound
+	}
+	if !conv.IsActive() {
+		return ErrConversationDissolved
+	}
+
+	operator, err := uc.participantRepo.Get(ctx, conversationID, operatorID)
+	if err != nil {
+		return fmt.Errorf("get operator: %w", err)
+	}
+	if operator == nil {
+		return ErrNotConversationMember
+	}
+	// 单聊任一成员可设置，群聊和频道需要管理员权限
+	if !conv.IsSingle() && !operator.CanManageMembers() {
+		return ErrNoPermission
+	}
+
+	if conv.MessageTTL == ttlSeconds {
+		return nil
+	}
+	conv.SetMessageTTL(ttlSeconds)
+	if err := uc.convRepo.Update(ctx, conv); err != nil {
+		return fmt.Errorf("set message ttl: %w", err)
+	}
+
+	uc.publishEvent(ctx, EventMessageTTLChanged, conversationID, operatorID, nil, map[string]interface{}{
+		"message_ttl": ttlSeconds,
+	})
+
+	return nil
+}
+
+func (uc *ConversationUseCaseImpl) TransferOwnership(ctx context.Context, operatorID, conversationID, newOwnerID uint64) error {
+	if operatorID == newOwnerID {
+		return ErrCannotOperateSelf
+	}
+
+	if _, err := uc.getJoinableGroup(ctx, conversationID); err != nil {
+		return err
+	}
+
+	owner, err := uc.participantRepo.Get(ctx, conversationID, operatorID)
+	if err != nil {
+		return fmt.Errorf("get operator: %w", err)
+	}
+	if owner == nil || !owner.IsOwner() {
+		return ErrNoPermission
+	}
+
+	target, err := uc.participantRepo.Get(ctx, conversationID, newOwnerID)
+	if err != nil {
+		return fmt.Errorf("get target: %w", err)
+	}
+	if target == nil {
+		return ErrNotConversationMember
+	}
+
+	// 原群主降为管理员，角色与会话群主在同一事务中变更
+	if err := uc.ownershipRepo.TransferOwner(ctx, conversationID, operatorID, newOwnerID); err != nil {
+		if errors.Is(err, out.ErrOwnershipConflict) {
+			return ErrOwnershipChanged
+		}
+		return fmt.Errorf("transfer owner: %w", err)
+	}
+
+	uc.publishEvent(ctx, EventOwnerTransferred, conversationID, operatorID, nil, map[string]interface{}{
+		"old_owner_id": operatorID,
+		"new_owner_id": newOwnerID,
+		"auto":         false,
+	})
+
+	return nil
+}
+
+// leaveAsOwner 群主退出
+// 任职最久的管理员优先继任，其次为任职最久的成员，会话无人时解散
+func (uc *ConversationUseCaseImpl) leaveAsOwner(ctx context.Context, conv *entity.Conversation, ownerID uint64) error {
+	successorID, err := uc.ownershipRepo.RemoveOwner(ctx, conv.ID, ownerID)
+	if err != nil {
+		if errors.Is(err, out.ErrOwnershipConflict) {
+			return ErrOwnershipChanged
+		}
+		return fmt.Errorf("remove owner: %w", err)
+	}
+
+	if conv.IsChannel() {
+		uc.publishEvent(ctx, EventChannelUnsubscribed, conv.ID, ownerID, []uint64{ownerID}, map[string]interface{}{
+			"user_id": ownerID,
+		})
+	} else {
+		uc.publishEvent(ctx, EventMemberLeft, conv.ID, ownerID, nil, map[string]interface{}{
+			"user_id": ownerID,
+		})
+	}
+
+	if successorID == 0 {
+		uc.publishEvent(ctx, EventConversationDissolved, conv.ID, ownerID, nil, map[string]interface{}{
+			"reason": "empty",
+		})
+		return nil
+	}
+	uc.publishEvent(ctx, EventOwnerTransferred, conv.ID, ownerID, nil, map[string]interface{}{
+		"old_owner_id": ownerID,
+		"new_owner_id": successorID,
+		"auto":         true,
+	})
 	return nil
 }
@@ -0,0 +1,71 @@
+package conversation
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	// TopicConversationEvents 会话事件Topic
+	TopicConversationEvents = "im.conversation.events"
+
+	EventConversationCreated   = "conversation.created"
+	EventMembersAdded          = "conversation.members_added"
+	EventMemberJoined          = "conversation.member_joined"
+	EventJoinRequested         = "conversation.join_requested"
+	EventJoinRequestHandled    = "conversation.join_request_handled"
+	EventSettingUpdated        = "conversation.setting_updated"
+	EventMembersRemoved        = "conversation.members_removed"
+	EventMemberLeft            = "conversation.member_left"
+	EventMemberMuted           = "conversation.member_muted"
+	EventMemberUnmuted         = "conversation.member_unmuted"
+	EventMuteAllUpdated        = "conversation.mute_all_updated"
+	EventTitleChanged          = "conversation.title_changed"
+	EventConversationDissolved = "conversation.dissolved"
+	EventAnnouncementUpdated   = "conversation.announcement_updated"
+	EventAnnouncementDeleted   = "conversation.announcement_deleted"
+	EventMessagePinned         = "conversation.message_pinned"
+	EventMessageUnpinned       = "conversation.message_unpinned"
+	EventChannelSubscribed     = "conversation.channel_subscribed"
+	EventChannelUnsubscribed   = "conversation.channel_unsubscribed"
+	EventOwnerTransferred      = "conversation.owner_transferred"
+	EventMemberNicknameChanged = "conversation.member_nickname_changed"
+	EventFoldersUpdated        = "conversation.folders_updated"
+	EventMessageTTLChanged     = "conversation.message_ttl_changed"
+)
+
+// publishEvent 发布会话事件
+// receiverIDs 为需要直接通知的用户，为空时仅供下游（如系统消息）消费
+func (uc *ConversationUseCaseImpl) publishEvent(ctx context.Context, eventType string, conversationID, operatorID uint64, receiverIDs []uint64, data map[string]interface{}) {
+	if uc.eventPub == nil {
+		return
+	}
+
+	now := time.Now()
+	event := map[string]interface{}{
+		"event_id":        fmt.Sprintf("%d:%d", conversationID, now.UnixNano()),
+		"type":            eventType,
+		"conversation_id": conversationID,
+		"operator_id":     operatorID,
+		"receiver_ids":    receiverIDs,
+		"timestamp":       now.Unix(),
+	}
+	for k, v := range data {
+		event[k] = v
+	}
+	_ = uc.eventPub.Publish(ctx, TopicConversationEvents, event)
+}
+
+// managerIDs 获取群主和管理员ID
+func (uc *ConversationUseCaseImpl) managerIDs(ctx context.Context, conversationID uint64) ([]uint64, error) {
+	managers, err := uc.participantRepo.ListManagers(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uint64, len(managers))
+	for i, m := range managers {
+		ids[i] = m.UserID
+	}
+	return ids, nil
+}
@@ -0,0 +1,342 @@
+package conversation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/EthanQC/IM/services/conversation_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/conversation_service/internal/ports/in"
+	"github.com/EthanQC/IM/services/conversation_service/internal/ports/out"
+)
+
+var (
+	ErrFolderNotFound      = errors.New("folder not found")
+	ErrInvalidFolderName   = errors.New("invalid folder name")
+	ErrFolderNameExists    = errors.New("folder name already exists")
+	ErrFolderLimitExceeded = errors.New("folder limit exceeded")
+	ErrInvalidFolderOrder  = errors.New("folder order must contain every folder exactly once")
+	ErrInvalidFolder       = errors.New("invalid folder")
+)
+
+// ListFolders 获取文件夹列表
+// 计数只统计未归档的会话，未读数来自消息服务收件箱，未配置消息服务时为0
+func (uc *ConversationUseCaseImpl) ListFolders(ctx context.Context, userID uint64) ([]*in.FolderView, error) {
+	types, err := uc.convRepo.ListTypesByUser(ctx, userID, false)
+	if err != nil {
+		return nil, fmt.Errorf("list conversations: %w", err)
+	}
+	folders, err := uc.folderRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list folders: %w", err)
+	}
+	assignments, err := uc.folderRepo.ListAssignments(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list folder assignments: %w", err)
+	}
+	unread, err := uc.unreadCounts(ctx, userID, types)
+	if err != nil {
+		return nil, err
+	}
+
+	views := make([]*in.FolderView, 0, len(entity.SmartFolders)+len(folders))
+	for _, smart := range entity.SmartFolders {
+		view := &in.FolderView{Smart: smart}
+		for convID, convType := range types {
+			if !smartFolderContains(smart, convType, unread[convID]) {
+				continue
+			}
+			view.ConversationCount++
+			if u := unread[convID]; u != nil {
+				view.UnreadCount += u.Count
+			}
+		}
+		views = append(views, view)
+	}
+
+	custom := make(map[uint64]*in.FolderView, len(folders))
+	for _, f := range folders {
+		view := &in.FolderView{Folder: f}
+		custom[f.ID] = view
+		views = append(views, view)
+	}
+	for convID, folderIDs := range assignments {
+		if _, ok := types[convID]; !ok {
+			continue
+		}
+		for _, folderID := range folderIDs {
+			view, ok := custom[folderID]
+			if !ok {
+				continue
+			}
+			view.ConversationCount++
+			if u := unread[convID]; u != nil {
+				view.UnreadCount += u.Count
+			}
+		}
+	}
+	return views, nil
+}
+
+// CreateFolder 创建文件夹
+func (uc *ConversationUseCaseImpl) CreateFolder(ctx context.Context, userID uint64, name string) (*entity.Folder, error) {
+	name, err := normalizeFolderName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	folders, err := uc.folderRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list folders: %w", err)
+	}
+	if len(folders) >= entity.MaxFoldersPerUser {
+		return nil, ErrFolderLimitExceeded
+	}
+	sortOrder := 0
+	for _, f := range folders {
+		if f.Name == name {
+			return nil, ErrFolderNameExists
+		}
+		if f.SortOrder >= sortOrder {
+			sortOrder = f.SortOrder + 1
+		}
+	}
+
+	folder := entity.NewFolder(userID, name, sortOrder)
+	if err := uc.folderRepo.Create(ctx, folder); err != nil {
+		// 并发创建同名文件夹由唯一索引兜底
+		if errors.Is(err, out.ErrFolderNameConflict) {
+			return nil, ErrFolderNameExists
+		}
+		return nil, fmt.Errorf("create folder: %w", err)
+	}
+
+	uc.publishFoldersUpdated(ctx, userID, folder.ID, "created")
+	return folder, nil
+}
+
+// RenameFolder 重命名文件夹
+func (uc *ConversationUseCaseImpl) RenameFolder(ctx context.Context, userID, folderID uint64, name string) (*entity.Folder, error) {
+	name, err := normalizeFolderName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	folders, err := uc.folderRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list folders: %w", err)
+	}
+	var folder *entity.Folder
+	for _, f := range folders {
+		if f.ID == folderID {
+			folder = f
+		} else if f.Name == name {
+			return nil, ErrFolderNameExists
+		}
+	}
+	if folder == nil {
+		return nil, ErrFolderNotFound
+	}
+	if folder.Name == name {
+		return folder, nil
+	}
+
+	folder.Rename(name)
+	if err := uc.folderRepo.Update(ctx, folder); err != nil {
+		if errors.Is(err, out.ErrFolderNameConflict) {
+			return nil, ErrFolderNameExists
+		}
+		return nil, fmt.Errorf("update folder: %w", err)
+	}
+
+	uc.publishFoldersUpdated(ctx, userID, folder.ID, "renamed")
+	return folder, nil
+}
+
+// DeleteFolder 删除文件夹
+func (uc *ConversationUseCaseImpl) DeleteFolder(ctx context.Context, userID, folderID uint64) error {
+	if _, err := uc.getFolder(ctx, userID, folderID); err != nil {
+		return err
+	}
+	if err := uc.folderRepo.Delete(ctx, folderID); err != nil {
+		return fmt.Errorf("delete folder: %w", err)
+	}
+
+	uc.publishFoldersUpdated(ctx, userID, folderID, "deleted")
+	return nil
+}
+
+// ReorderFolders 调整文件夹顺序
+func (uc *ConversationUseCaseImpl) ReorderFolders(ctx context.Context, userID uint64, folderIDs []uint64) error {
+	folders, err := uc.folderRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("list folders: %w", err)
+	}
+	if len(folderIDs) != len(folders) {
+		return ErrInvalidFolderOrder
+	}
+	owned := make(map[uint64]bool, len(folders))
+	for _, f := range folders {
+		owned[f.ID] = true
+	}
+	for _, id := range folderIDs {
+		if !owned[id] {
+			return ErrInvalidFolderOrder
+		}
+		// 删除已出现的ID，重复出现时视为无效
+		delete(owned, id)
+	}
+
+	if err := uc.folderRepo.Reorder(ctx, userID, folderIDs); err != nil {
+		return fmt.Errorf("reorder folders: %w", err)
+	}
+
+	uc.publishFoldersUpdated(ctx, userID, 0, "reordered")
+	return nil
+}
+
+// AddFolderConversations 将会话加入文件夹
+func (uc *ConversationUseCaseImpl) AddFolderConversations(ctx context.Context, userID, folderID uint64, conversationIDs []uint64) error {
+	if _, err := uc.getFolder(ctx, userID, folderID); err != nil {
+		return err
+	}
+
+	joined, err := uc.participantRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("list user conversations: %w", err)
+	}
+	isMember := make(map[uint64]bool, len(joined))
+	for _, id := range joined {
+		isMember[id] = true
+	}
+	for _, id := range conversationIDs {
+		if !isMember[id] {
+			return ErrNotConversationMember
+		}
+	}
+
+	if err := uc.folderRepo.AddConversations(ctx, userID, folderID, conversationIDs); err != nil {
+		return fmt.Errorf("add folder conversations: %w", err)
+	}
+
+	uc.publishFoldersUpdated(ctx, userID, folderID, "conversations_added")
+	return nil
+}
+
+// RemoveFolderConversation 将会话移出文件夹
+func (uc *ConversationUseCaseImpl) RemoveFolderConversation(ctx context.Context, userID, folderID, conversationID uint64) error {
+	if _, err := uc.getFolder(ctx, userID, folderID); err != nil {
+		return err
+	}
+	if err := uc.folderRepo.RemoveConversation(ctx, folderID, conversationID); err != nil {
+		return fmt.Errorf("remove folder conversation: %w", err)
+	}
+
+	uc.publishFoldersUpdated(ctx, userID, folderID, "conversation_removed")
+	return nil
+}
+
+// folderFilter 将文件夹转换为会话列表过滤条件
+// 未读、@我 两个智能文件夹按当前未读数筛选出会话ID
+func (uc *ConversationUseCaseImpl) folderFilter(ctx context.Context, userID uint64, archived bool, folder in.FolderRef) (*out.ConversationFilter, error) {
+	if folder.ID != 0 && folder.Smart != entity.SmartFolderNone {
+		return nil, ErrInvalidFolder
+	}
+	if folder.ID != 0 {
+		if _, err := uc.getFolder(ctx, userID, folder.ID); err != nil {
+			return nil, err
+		}
+		return &out.ConversationFilter{FolderID: folder.ID}, nil
+	}
+
+	switch folder.Smart {
+	case entity.SmartFolderNone:
+		return nil, nil
+	case entity.SmartFolderGroups:
+		return &out.ConversationFilter{Types: []entity.ConversationType{entity.ConversationTypeGroup, entity.ConversationTypeChannel}}, nil
+	case entity.SmartFolderDirect:
+		return &out.ConversationFilter{Types: []entity.ConversationType{entity.ConversationTypeSingle}}, nil
+	case entity.SmartFolderUnread, entity.SmartFolderMentions:
+		types, err := uc.convRepo.ListTypesByUser(ctx, userID, archived)
+		if err != nil {
+			return nil, fmt.Errorf("list conversations: %w", err)
+		}
+		unread, err := uc.unreadCounts(ctx, userID, types)
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]uint64, 0, len(unread))
+		for convID, convType := range types {
+			if smartFolderContains(folder.Smart, convType, unread[convID]) {
+				ids = append(ids, convID)
+			}
+		}
+		return &out.ConversationFilter{IDs: ids}, nil
+	default:
+		return nil, ErrInvalidFolder
+	}
+}
+
+// smartFolderContains 会话是否属于智能文件夹，unread 为空表示没有未读
+func smartFolderContains(smart entity.SmartFolder, convType entity.ConversationType, unread *out.UnreadCount) bool {
+	switch smart {
+	case entity.SmartFolderUnread:
+		return unread != nil
+	case entity.SmartFolderMentions:
+		return unread != nil && unread.HasMention
+	default:
+		return smart.Matches(convType)
+	}
+}
+
+// unreadCounts 从消息服务获取会话未读数，未配置消息服务时返回空
+func (uc *ConversationUseCaseImpl) unreadCounts(ctx context.Context, userID uint64, types map[uint64]entity.ConversationType) (map[uint64]*out.UnreadCount, error) {
+	if uc.msgReader == nil || len(types) == 0 {
+		return map[uint64]*out.UnreadCount{}, nil
+	}
+
+	convIDs := make([]uint64, 0, len(types))
+	var channelIDs []uint64
+	for id, t := range types {
+		convIDs = append(convIDs, id)
+		if t == entity.ConversationTypeChannel {
+			channelIDs = append(channelIDs, id)
+		}
+	}
+	unread, err := uc.msgReader.BatchGetUnreadCounts(ctx, userID, convIDs, channelIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get unread counts: %w", err)
+	}
+	return unread, nil
+}
+
+// getFolder 获取用户自己的文件夹
+func (uc *ConversationUseCaseImpl) getFolder(ctx context.Context, userID, folderID uint64) (*entity.Folder, error) {
+	folder, err := uc.folderRepo.GetByID(ctx, folderID)
+	if err != nil {
+		return nil, fmt.Errorf("get folder: %w", err)
+	}
+	if folder == nil || folder.UserID != userID {
+		return nil, ErrFolderNotFound
+	}
+	return folder, nil
+}
+
+// publishFoldersUpdated 通知用户的其他设备同步文件夹
+func (uc *ConversationUseCaseImpl) publishFoldersUpdated(ctx context.Context, userID, folderID uint64, action string) {
+	uc.publishEvent(ctx, EventFoldersUpdated, 0, userID, []uint64{userID}, map[string]interface{}{
+		"user_id":   userID,
+		"folder_id": folderID,
+		"action":    action,
+	})
+}
+
+func normalizeFolderName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > entity.MaxFolderNameLength {
+		return "", ErrInvalidFolderName
+	}
+	return name, nil
+}
@@ -0,0 +1,182 @@
+package conversation
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/EthanQC/IM/services/conversation_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/conversation_service/internal/ports/in"
+)
+
+var (
+	ErrInviteNotFound    = errors.New("invite not found")
+	ErrInviteUnavailable = errors.New("invite expired, revoked or used up")
+	ErrInvalidInvite     = errors.New("invalid invite parameters")
+)
+
+// inviteCodeBytes 邀请码随机字节数，base64编码后为12个字符
+const inviteCodeBytes = 9
+
+// CreateInvite 创建邀请链接
+func (uc *ConversationUseCaseImpl) CreateInvite(ctx context.Context, operatorID, conversationID uint64, expiresIn time.Duration, maxUses int) (*entity.GroupInvite, error) {
+	if expiresIn < 0 || maxUses < 0 {
+		return nil, ErrInvalidInvite
+	}
+
+	if _, err := uc.getJoinableGroup(ctx, conversationID); err != nil {
+		return nil, err
+	}
+
+	operator, err := uc.participantRepo.Get(ctx, conversationID, operatorID)
+	if err != nil {
+		return nil, fmt.Errorf("get operator: %w", err)
+	}
+	if operator == nil || !operator.CanManageMembers() {
+		return nil, ErrNoPermission
+	}
+
+	code, err := generateInviteCode()
+	if err != nil {
+		return nil, fmt.Errorf("generate invite code: %w", err)
+	}
+
+	var expiresAt *time.Time
+	if expiresIn > 0 {
+		t := time.Now().Add(expiresIn)
+		expiresAt = &t
+	}
+
+	invite := entity.NewGroupInvite(conversationID, operatorID, code, expiresAt, maxUses)
+	if err := uc.inviteRepo.Create(ctx, invite); err != nil {
+		return nil, fmt.Errorf("create invite: %w", err)
+	}
+	return invite, nil
+}
+
+// ListInvites 获取会话的邀请链接
+func (uc *ConversationUseCaseImpl) ListInvites(ctx context.Context, operatorID, conversationID uint64) ([]*entity.GroupInvite, error) {
+	operator, err := uc.participantRepo.Get(ctx, conversationID, operatorID)
+	if err != nil {
+		return nil, fmt.Errorf("get operator: %w", err)
+	}
+	if operator == nil || !operator.CanManageMembers() {
+		return nil, ErrNoPermission
+	}
+
+	return uc.inviteRepo.ListByConversation(ctx, conversationID)
+}
+
+// RevokeInvite 撤销邀请链接
+func (uc *ConversationUseCaseImpl) RevokeInvite(ctx context.Context, operatorID, inviteID uint64) error {
+	invite, err := uc.inviteRepo.GetByID(ctx, inviteID)
+	if err != nil {
+		return fmt.Errorf("get invite: %w", err)
+	}
+	if invite == nil {
+		return ErrInviteNotFound
+	}
+
+	operator, err := uc.participantRepo.Get(ctx, invite.ConversationID, operatorID)
+	if err != nil {
+		return fmt.Errorf("get operator: %w", err)
+	}
+	if operator == nil || !operator.CanManageMembers() {
+		return ErrNoPermission
+	}
+
+	if invite.Revoked {
+		return nil
+	}
+	invite.Revoke()
+	if err := uc.inviteRepo.Update(ctx, invite); err != nil {
+		return fmt.Errorf("revoke invite: %w", err)
+	}
+	return nil
+}
+
+// PreviewInvite 预览邀请对应的群信息
+func (uc *ConversationUseCaseImpl) PreviewInvite(ctx context.Context, code string) (*in.InvitePreview, error) {
+	invite, err := uc.getUsableInvite(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	conv, err := uc.getJoinableGroup(ctx, invite.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := uc.participantRepo.Count(ctx, conv.ID)
+	if err != nil {
+		return nil, fmt.Errorf("count members: %w", err)
+	}
+
+	return &in.InvitePreview{
+		Conversation: conv,
+		MemberCount:  count,
+		Invite:       invite,
+	}, nil
+}
+
+// JoinByInvite 通过邀请码入群
+// 邀请码只代替"找到群"这一步，仍需遵守群的人数上限和加入方式
+func (uc *ConversationUseCaseImpl) JoinByInvite(ctx context.Context, userID uint64, code string) (*entity.JoinRequest, error) {
+	invite, err := uc.getUsableInvite(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	conv, err := uc.getJoinableGroup(ctx, invite.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := uc.checkJoinable(ctx, conv.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if pending != nil {
+		return pending, nil
+	}
+
+	count, err := uc.participantRepo.Count(ctx, conv.ID)
+	if err != nil {
+		return nil, fmt.Errorf("count members: %w", err)
+	}
+	if count >= conv.MemberLimit {
+		return nil, ErrMemberLimitExceeded
+	}
+
+	// 邀请次数在成员直接入群时消耗，需要审批的群由管理员决定，审批通过时不再消耗
+	req := entity.NewJoinRequest(conv.ID, userID, "")
+	req.InviterID = &invite.CreatorID
+	req.InviteID = &invite.ID
+	return uc.submitJoin(ctx, conv, req)
+}
+
+// getUsableInvite 获取可用的邀请
+func (uc *ConversationUseCaseImpl) getUsableInvite(ctx context.Context, code string) (*entity.GroupInvite, error) {
+	invite, err := uc.inviteRepo.GetByCode(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("get invite: %w", err)
+	}
+	if invite == nil {
+		return nil, ErrInviteNotFound
+	}
+	if !invite.IsUsable() {
+		return nil, ErrInviteUnavailable
+	}
+	return invite, nil
+}
+
+func generateInviteCode() (string, error) {
+	b := make([]byte, inviteCodeBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,219 @@
+package conversation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/EthanQC/IM/services/conversation_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/conversation_service/internal/ports/out"
+)
+
+// RequestJoin 申请入群
+// 自由加入的群直接通过并加入，否则等待群主/管理员审批
+func (uc *ConversationUseCaseImpl) RequestJoin(ctx context.Context, userID, conversationID uint64, message string) (*entity.JoinRequest, error) {
+	conv, err := uc.getJoinableGroup(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := uc.checkJoinable(ctx, conversationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if pending != nil {
+		return pending, nil
+	}
+
+	return uc.submitJoin(ctx, conv, entity.NewJoinRequest(conversationID, userID, message))
+}
+
+// ListJoinRequests 获取入群申请列表
+func (uc *ConversationUseCaseImpl) ListJoinRequests(ctx context.Context, operatorID, conversationID uint64, status entity.JoinRequestStatus, page, pageSize int) ([]*entity.JoinRequest, int, error) {
+	operator, err := uc.participantRepo.Get(ctx, conversationID, operatorID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get operator: %w", err)
+	}
+	if operator == nil || !operator.CanManageMembers() {
+		return nil, 0, ErrNoPermission
+	}
+
+	return uc.joinReqRepo.ListByConversation(ctx, conversationID, status, page, pageSize)
+}
+
+// HandleJoinRequest 审批入群申请
+func (uc *ConversationUseCaseImpl) HandleJoinRequest(ctx context.Context, operatorID, requestID uint64, approve bool) (*entity.JoinRequest, error) {
+	req, err := uc.joinReqRepo.GetByID(ctx, requestID)
+	if err != nil {
+		return nil, fmt.Errorf("get join request: %w", err)
+	}
+	if req == nil {
+		return nil, ErrJoinRequestNotFound
+	}
+	if !req.IsPending() {
+		return nil, ErrJoinRequestHandled
+	}
+
+	operator, err := uc.participantRepo.Get(ctx, req.ConversationID, operatorID)
+	if err != nil {
+		return nil, fmt.Errorf("get operator: %w", err)
+	}
+	if operator == nil || !operator.CanManageMembers() {
+		return nil, ErrNoPermission
+	}
+
+	var conv *entity.Conversation
+	var isMember bool
+	if approve {
+		if conv, err = uc.getJoinableGroup(ctx, req.ConversationID); err != nil {
+			return nil, err
+		}
+		// 申请期间已被拉入群的，只更新申请状态
+		if isMember, err = uc.participantRepo.IsMember(ctx, req.ConversationID, req.UserID); err != nil {
+			return nil, fmt.Errorf("check member: %w", err)
+		}
+		req.Approve(operatorID)
+	} else {
+		req.Reject(operatorID)
+	}
+
+	// 条件更新认领申请，并发审批时只有成功认领的一方继续执行入群和通知
+	handled, err := uc.joinReqRepo.UpdateStatus(ctx, req.ID, entity.JoinRequestStatusPending, req.Status, &operatorID)
+	if err != nil {
+		return nil, fmt.Errorf("update join request: %w", err)
+	}
+	if !handled {
+		return nil, ErrJoinRequestHandled
+	}
+
+	// 审批通过即可入群，不再消耗邀请次数，避免邀请在等待期间失效导致申请无法通过
+	if approve && !isMember {
+		if err := uc.joinMember(ctx, conv, req.UserID, operatorID, req.InviteID, false); err != nil {
+			// 入群失败时恢复为待审批，允许管理员稍后重试
+			_, _ = uc.joinReqRepo.UpdateStatus(ctx, req.ID, req.Status, entity.JoinRequestStatusPending, nil)
+			return nil, err
+		}
+	}
+
+	uc.publishEvent(ctx, EventJoinRequestHandled, req.ConversationID, operatorID, []uint64{req.UserID}, map[string]interface{}{
+		"request_id": req.ID,
+		"user_id":    req.UserID,
+		"approved":   approve,
+	})
+
+	return req, nil
+}
+
+// getJoinableGroup 获取可加入的群聊或频道
+func (uc *ConversationUseCaseImpl) getJoinableGroup(ctx context.Context, conversationID uint64) (*entity.Conversation, error) {
+	conv, err := uc.convRepo.GetByID(ctx, conversationID)
+	if err != nil {
+		return nil, fmt.Errorf("get conversation: %w", err)
+	}
+	if conv == nil {
+		return nil, ErrConversationNotFound
+	}
+	if conv.IsSingle() {
+		return nil, ErrNotGroupConversation
+	}
+	if !conv.IsActive() {
+		return nil, ErrConversationDissolved
+	}
+	return conv, nil
+}
+
+// checkJoinable 检查用户能否申请入群，已有待审批申请时返回该申请
+func (uc *ConversationUseCaseImpl) checkJoinable(ctx context.Context, conversationID, userID uint64) (*entity.JoinRequest, error) {
+	isMember, err := uc.participantRepo.IsMember(ctx, conversationID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("check member: %w", err)
+	}
+	if isMember {
+		return nil, ErrAlreadyMember
+	}
+
+	// 已有待审批申请时直接返回，避免重复打扰管理员
+	pending, err := uc.joinReqRepo.GetPending(ctx, conversationID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get pending request: %w", err)
+	}
+	return pending, nil
+}
+
+// submitJoin 提交入群申请，自由加入的群直接入群
+func (uc *ConversationUseCaseImpl) submitJoin(ctx context.Context, conv *entity.Conversation, req *entity.JoinRequest) (*entity.JoinRequest, error) {
+	if conv.IsFreeJoin() {
+		if err := uc.joinMember(ctx, conv, req.UserID, req.UserID, req.InviteID, true); err != nil {
+			return nil, err
+		}
+		req.Approve(req.UserID)
+		if err := uc.joinReqRepo.Create(ctx, req); err != nil {
+			return nil, fmt.Errorf("create join request: %w", err)
+		}
+		return req, nil
+	}
+
+	if err := uc.joinReqRepo.Create(ctx, req); err != nil {
+		return nil, fmt.Errorf("create join request: %w", err)
+	}
+
+	// 通知群主和管理员
+	managers, err := uc.managerIDs(ctx, conv.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list managers: %w", err)
+	}
+	data := map[string]interface{}{
+		"request_id": req.ID,
+		"user_id":    req.UserID,
+	}
+	if req.Message != nil {
+		data["message"] = *req.Message
+	}
+	if req.InviteID != nil {
+		data["invite_id"] = *req.InviteID
+	}
+	uc.publishEvent(ctx, EventJoinRequested, conv.ID, req.UserID, managers, data)
+
+	return req, nil
+}
+
+// joinMember 将用户加入群聊并发布入群事件
+// inviteID 记录入群来源，useInvite 为 true 时同时消耗一次邀请次数
+// 人数上限在插入成员的事务中校验，并发入群时不会超出
+func (uc *ConversationUseCaseImpl) joinMember(ctx context.Context, conv *entity.Conversation, userID, operatorID uint64, inviteID *uint64, useInvite bool) error {
+	participant := entity.NewParticipant(conv.ID, userID, entity.ParticipantRoleMember)
+	participant.InviteID = inviteID
+	if inviteID != nil && useInvite {
+		// 通过邀请入群时，消耗邀请次数与添加成员在同一事务中完成
+		ok, err := uc.inviteRepo.AddMember(ctx, *inviteID, participant)
+		if err != nil {
+			return joinMemberError(userID, err)
+		}
+		if !ok {
+			return ErrInviteUnavailable
+		}
+	} else if err := uc.participantRepo.CreateWithinLimit(ctx, participant); err != nil {
+		return joinMemberError(userID, err)
+	}
+
+	data := map[string]interface{}{
+		"user_id": userID,
+	}
+	if inviteID != nil {
+		data["invite_id"] = *inviteID
+	}
+	// 频道订阅只通知本人，不生成系统消息
+	if conv.IsChannel() {
+		uc.publishEvent(ctx, EventChannelSubscribed, conv.ID, operatorID, []uint64{userID}, data)
+		return nil
+	}
+	uc.publishEvent(ctx, EventMemberJoined, conv.ID, operatorID, nil, data)
+	return nil
+}
+
+func joinMemberError(userID uint64, err error) error {
+	if errors.Is(err, out.ErrMemberLimitReached) {
+		return ErrMemberLimitExceeded
+	}
+	return fmt.Errorf("add member %d: %w", userID, err)
+}
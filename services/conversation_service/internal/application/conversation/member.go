@@ -0,0 +1,304 @@
+package conversation
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/EthanQC/IM/services/conversation_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/conversation_service/internal/ports/in"
+	"github.com/EthanQC/IM/services/conversation_service/internal/ports/out"
+)
+
+const (
+	// maxMemberKeywordLength 成员搜索关键字最大长度（字符）
+	maxMemberKeywordLength = 32
+	// profileBatchSize 单次从身份服务批量获取用户资料的数量
+	profileBatchSize = 500
+	// maxMemberSearchScan 按关键字搜索成员时单次请求最多扫描的成员数
+	maxMemberSearchScan = 5000
+	// maxNicknameLength 群昵称最大长度（字符）
+	maxNicknameLength = 32
+)
+
+var (
+	ErrInvalidKeyword  = errors.New("invalid search keyword")
+	ErrInvalidNickname = errors.New("invalid member nickname")
+)
+
+// SetUserReader 设置用户资料读取器，用于成员列表附带用户资料和按展示名搜索
+// 未设置时成员列表不含用户资料，搜索只匹配群昵称
+func (uc *ConversationUseCaseImpl) SetUserReader(reader out.UserReader) {
+	uc.userReader = reader
+}
+
+// ScrollMembers 按游标获取会话成员
+func (uc *ConversationUseCaseImpl) ScrollMembers(ctx context.Context, userID, conversationID uint64, query *in.MemberQuery) (*in.MemberPage, error) {
+	isMember, err := uc.participantRepo.IsMember(ctx, conversationID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("check member: %w", err)
+	}
+	if !isMember {
+		return nil, ErrNotConversationMember
+	}
+
+	var after *out.MemberCursor
+	if query.Cursor != "" {
+		c, err := decodeMemberCursor(query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		after = c
+	}
+
+	filter, err := memberFilter(query)
+	if err != nil {
+		return nil, err
+	}
+
+	var participants []*entity.Participant
+	var next *entity.Participant
+	if filter.Keyword != "" && uc.userReader != nil {
+		participants, next, err = uc.searchMembers(ctx, conversationID, filter, after, query.Limit)
+	} else {
+		participants, next, err = uc.listMembers(ctx, conversationID, filter, after, query.Limit)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := uc.withProfiles(ctx, participants)
+	if err != nil {
+		return nil, err
+	}
+	page := &in.MemberPage{Members: members}
+	if next != nil {
+		page.NextCursor = encodeMemberCursor(next)
+	}
+
+	if query.Cursor == "" {
+		counts, err := uc.participantRepo.CountByRole(ctx, conversationID)
+		if err != nil {
+			return nil, fmt.Errorf("count members: %w", err)
+		}
+		page.Summary = &in.MemberSummary{
+			Owners:  counts[entity.ParticipantRoleOwner],
+			Admins:  counts[entity.ParticipantRoleAdmin],
+			Members: counts[entity.ParticipantRoleMember],
+		}
+		page.Summary.Total = page.Summary.Owners + page.Summary.Admins + page.Summary.Members
+	}
+	return page, nil
+}
+
+// memberFilter 构造成员过滤条件
+func memberFilter(query *in.MemberQuery) (*out.MemberFilter, error) {
+	filter := &out.MemberFilter{Role: query.Role, Muted: query.Muted}
+
+	keyword := strings.TrimSpace(query.Keyword)
+	if keyword == "" {
+		return filter, nil
+	}
+	if utf8.RuneCountInString(keyword) > maxMemberKeywordLength {
+		return nil, ErrInvalidKeyword
+	}
+	filter.Keyword = keyword
+	return filter, nil
+}
+
+// listMembers 按游标获取一页成员，有下一页时返回本页最后一个成员作为游标位置
+func (uc *ConversationUseCaseImpl) listMembers(ctx context.Context, conversationID uint64, filter *out.MemberFilter, after *out.MemberCursor, limit int) ([]*entity.Participant, *entity.Participant, error) {
+	// 多取一条判断是否还有下一页
+	participants, err := uc.participantRepo.ListByCursor(ctx, conversationID, filter, after, limit+1)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list members: %w", err)
+	}
+	if len(participants) <= limit {
+		return participants, nil, nil
+	}
+	participants = participants[:limit]
+	return participants, participants[len(participants)-1], nil
+}
+
+// searchMembers 按成员排序分批扫描，在每批成员中匹配群昵称、展示名或用户名
+// 单次请求最多扫描 maxMemberSearchScan 个成员，未扫描完时以扫描位置作为游标，本页可能不足 limit 条
+func (uc *ConversationUseCaseImpl) searchMembers(ctx context.Context, conversationID uint64, filter *out.MemberFilter, after *out.MemberCursor, limit int) ([]*entity.Participant, *entity.Participant, error) {
+	scanFilter := *filter
+	scanFilter.Keyword = ""
+	keyword := strings.ToLower(filter.Keyword)
+
+	var matched []*entity.Participant
+	var last *entity.Participant
+	for scanned := 0; scanned < maxMemberSearchScan; scanned += profileBatchSize {
+		batch, err := uc.participantRepo.ListByCursor(ctx, conversationID, &scanFilter, after, profileBatchSize)
+		if err != nil {
+			return nil, nil, fmt.Errorf("list members: %w", err)
+		}
+		nameMatched, err := uc.matchMemberNames(ctx, filter.Keyword, batch)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, p := range batch {
+			if !nameMatched[p.UserID] && (p.Nickname == nil || !strings.Contains(strings.ToLower(*p.Nickname), keyword)) {
+				continue
+			}
+			// 多匹配一条判断是否还有下一页
+			if len(matched) == limit {
+				return matched, matched[limit-1], nil
+			}
+			matched = append(matched, p)
+		}
+		if len(batch) < profileBatchSize {
+			return matched, nil, nil
+		}
+
+		last = batch[len(batch)-1]
+		after = &out.MemberCursor{Role: last.Role, JoinedAt: last.JoinedAt, ID: last.ID}
+	}
+	return matched, last, nil
+}
+
+// matchMemberNames 在一批成员中匹配身份服务中的展示名或用户名
+func (uc *ConversationUseCaseImpl) matchMemberNames(ctx context.Context, keyword string, participants []*entity.Participant) (map[uint64]bool, error) {
+	if len(participants) == 0 {
+		return nil, nil
+	}
+	candidateIDs := make([]uint64, len(participants))
+	for i, p := range participants {
+		candidateIDs[i] = p.UserID
+	}
+	ids, err := uc.userReader.MatchUserIDs(ctx, keyword, candidateIDs)
+	if err != nil {
+		return nil, fmt.Errorf("match users: %w", err)
+	}
+
+	matched := make(map[uint64]bool, len(ids))
+	for _, id := range ids {
+		matched[id] = true
+	}
+	return matched, nil
+}
+
+// withProfiles 为成员附加用户资料
+func (uc *ConversationUseCaseImpl) withProfiles(ctx context.Context, participants []*entity.Participant) ([]*in.Member, error) {
+	userIDs := make([]uint64, len(participants))
+	for i, p := range participants {
+		userIDs[i] = p.UserID
+	}
+	profiles, err := uc.GetMemberProfiles(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]*in.Member, len(participants))
+	for i, p := range participants {
+		members[i] = &in.Member{Participant: p, Profile: profiles[p.UserID]}
+	}
+	return members, nil
+}
+
+// GetMemberProfiles 分批从身份服务获取用户资料
+func (uc *ConversationUseCaseImpl) GetMemberProfiles(ctx context.Context, userIDs []uint64) (map[uint64]*entity.UserProfile, error) {
+	profiles := make(map[uint64]*entity.UserProfile, len(userIDs))
+	if uc.userReader == nil {
+		return profiles, nil
+	}
+	for start := 0; start < len(userIDs); start += profileBatchSize {
+		end := start + profileBatchSize
+		if end > len(userIDs) {
+			end = len(userIDs)
+		}
+		batch, err := uc.userReader.BatchGetProfiles(ctx, userIDs[start:end])
+		if err != nil {
+			return nil, fmt.Errorf("get profiles: %w", err)
+		}
+		for id, profile := range batch {
+			profiles[id] = profile
+		}
+	}
+	return profiles, nil
+}
+
+// SetMemberNickname 设置群昵称，变更会通知所有成员以便更新展示名
+// 频道订阅者不展示在消息中，只通知本人
+func (uc *ConversationUseCaseImpl) SetMemberNickname(ctx context.Context, userID, conversationID uint64, nickname string) (*entity.Participant, error) {
+	nickname = strings.TrimSpace(nickname)
+	if utf8.RuneCountInString(nickname) > maxNicknameLength {
+		return nil, ErrInvalidNickname
+	}
+
+	conv, err := uc.getJoinableGroup(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	participant, err := uc.participantRepo.Get(ctx, conversationID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get participant: %w", err)
+	}
+	if participant == nil {
+		return nil, ErrNotConversationMember
+	}
+	if participant.EffectiveName(nil) == nickname {
+		return participant, nil
+	}
+
+	participant.SetNickname(nickname)
+	if err := uc.participantRepo.Update(ctx, participant); err != nil {
+		return nil, fmt.Errorf("update nickname: %w", err)
+	}
+
+	receiverIDs := []uint64{userID}
+	if !conv.IsChannel() {
+		members, err := uc.participantRepo.List(ctx, conversationID)
+		if err != nil {
+			return nil, fmt.Errorf("list members: %w", err)
+		}
+		receiverIDs = make([]uint64, len(members))
+		for i, m := range members {
+			receiverIDs[i] = m.UserID
+		}
+	}
+	uc.publishEvent(ctx, EventMemberNicknameChanged, conversationID, userID, receiverIDs, map[string]interface{}{
+		"user_id":  userID,
+		"nickname": nickname,
+	})
+
+	return participant, nil
+}
+
+// encodeMemberCursor 以成员的排序键生成游标，格式为 "角色:秒级入群时间:成员ID" 的 base64
+func encodeMemberCursor(p *entity.Participant) string {
+	raw := fmt.Sprintf("%d:%d:%d", p.Role, p.JoinedAt.Unix(), p.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeMemberCursor(cursor string) (*out.MemberCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 3 {
+		return nil, ErrInvalidCursor
+	}
+	role, err := strconv.ParseInt(parts[0], 10, 8)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	joinedAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	id, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &out.MemberCursor{Role: entity.ParticipantRole(role), JoinedAt: time.Unix(joinedAt, 0), ID: id}, nil
+}
@@ -0,0 +1,252 @@
+package conversation
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/EthanQC/IM/services/conversation_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/conversation_service/internal/ports/in"
+	"github.com/EthanQC/IM/services/conversation_service/internal/ports/out"
+)
+
+// maxRemarkLength 会话备注最大长度（字符）
+const maxRemarkLength = 64
+
+var (
+	ErrInvalidRemark = errors.New("invalid conversation remark")
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+func (uc *ConversationUseCaseImpl) ListMyConversations(ctx context.Context, userID uint64, archived bool, page, pageSize int) ([]*in.MyConversation, int, error) {
+	convs, total, err := uc.convRepo.ListByUserID(ctx, userID, archived, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	items, err := uc.withSettings(ctx, userID, convs)
+	if err != nil {
+		return nil, 0, err
+	}
+	return items, total, nil
+}
+
+// ScrollMyConversations 按游标获取会话列表
+func (uc *ConversationUseCaseImpl) ScrollMyConversations(ctx context.Context, userID uint64, archived bool, folder in.FolderRef, cursor string, limit int) ([]*in.MyConversation, string, error) {
+	filter, err := uc.folderFilter(ctx, userID, archived, folder)
+	if err != nil {
+		return nil, "", err
+	}
+	if filter != nil && filter.IDs != nil && len(filter.IDs) == 0 {
+		return []*in.MyConversation{}, "", nil
+	}
+
+	var after *out.ConversationCursor
+	if cursor != "" {
+		c, err := decodeConversationCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		after = c
+	}
+
+	// 多取一条判断是否还有下一页
+	convs, err := uc.convRepo.ListByUserCursor(ctx, userID, archived, filter, after, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	hasMore := len(convs) > limit
+	if hasMore {
+		convs = convs[:limit]
+	}
+
+	items, err := uc.withSettings(ctx, userID, convs)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextCursor := ""
+	if hasMore && len(items) > 0 {
+		nextCursor = encodeConversationCursor(items[len(items)-1])
+	}
+	return items, nextCursor, nil
+}
+
+// withSettings 为会话列表附加个人设置和所在文件夹
+func (uc *ConversationUseCaseImpl) withSettings(ctx context.Context, userID uint64, convs []*entity.Conversation) ([]*in.MyConversation, error) {
+	convIDs := make([]uint64, len(convs))
+	for i, c := range convs {
+		convIDs[i] = c.ID
+	}
+	settings, err := uc.settingRepo.BatchGet(ctx, userID, convIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get settings: %w", err)
+	}
+	folderIDs, err := uc.folderRepo.ListFolderIDs(ctx, userID, convIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get folder ids: %w", err)
+	}
+
+	items := make([]*in.MyConversation, len(convs))
+	for i, c := range convs {
+		setting, ok := settings[c.ID]
+		if !ok {
+			setting = entity.NewConversationSetting(c.ID, userID)
+		}
+		items[i] = &in.MyConversation{Conversation: c, Setting: setting, FolderIDs: folderIDs[c.ID]}
+	}
+	return items, nil
+}
+
+// encodeConversationCursor 以列表项的排序键生成游标，格式为 "p|u:秒级时间戳:会话ID" 的 base64
+func encodeConversationCursor(item *in.MyConversation) string {
+	kind, at := "u", item.Conversation.CreatedAt
+	if item.Setting.IsPinned() {
+		kind, at = "p", *item.Setting.PinnedAt
+	} else if item.Conversation.LastMessageAt != nil {
+		at = *item.Conversation.LastMessageAt
+	}
+	raw := fmt.Sprintf("%s:%d:%d", kind, at.Unix(), item.Conversation.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeConversationCursor(cursor string) (*out.ConversationCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 3 || (parts[0] != "p" && parts[0] != "u") {
+		return nil, ErrInvalidCursor
+	}
+	at, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	id, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &out.ConversationCursor{Pinned: parts[0] == "p", At: time.Unix(at, 0), ID: id}, nil
+}
+
+// GetConversationSetting 获取会话设置，未设置过时返回默认设置
+func (uc *ConversationUseCaseImpl) GetConversationSetting(ctx context.Context, userID, conversationID uint64) (*entity.ConversationSetting, error) {
+	isMember, err := uc.participantRepo.IsMember(ctx, conversationID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("check member: %w", err)
+	}
+	if !isMember {
+		return nil, ErrNotConversationMember
+	}
+
+	return uc.getSetting(ctx, userID, conversationID)
+}
+
+// UpdateConversationSetting 更新会话设置
+// 变更会通知用户的其他设备，并供 message_service 同步收件箱状态
+func (uc *ConversationUseCaseImpl) UpdateConversationSetting(ctx context.Context, userID, conversationID uint64, update *in.ConversationSettingUpdate) (*entity.ConversationSetting, error) {
+	if update.MuteSeconds < 0 {
+		return nil, ErrInvalidMuteDuration
+	}
+	if update.Remark != nil && utf8.RuneCountInString(*update.Remark) > maxRemarkLength {
+		return nil, ErrInvalidRemark
+	}
+
+	isMember, err := uc.participantRepo.IsMember(ctx, conversationID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("check member: %w", err)
+	}
+	if !isMember {
+		return nil, ErrNotConversationMember
+	}
+
+	setting, err := uc.getSetting(ctx, userID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if update.Pinned != nil {
+		if *update.Pinned {
+			setting.Pin()
+		} else {
+			setting.Unpin()
+		}
+	}
+	if update.Muted != nil {
+		if *update.Muted {
+			var until *time.Time
+			if update.MuteSeconds > 0 {
+				t := time.Now().Add(time.Duration(update.MuteSeconds) * time.Second)
+				until = &t
+			}
+			setting.SetDND(until)
+		} else {
+			setting.ClearDND()
+		}
+	}
+	if update.Archived != nil {
+		setting.Archived = *update.Archived
+	}
+	if update.Remark != nil {
+		if *update.Remark == "" {
+			setting.Remark = nil
+		} else {
+			remark := *update.Remark
+			setting.Remark = &remark
+		}
+	}
+	setting.UpdatedAt = time.Now()
+
+	if err := uc.settingRepo.Save(ctx, setting); err != nil {
+		return nil, fmt.Errorf("save setting: %w", err)
+	}
+
+	data := map[string]interface{}{
+		"user_id":  userID,
+		"pinned":   setting.IsPinned(),
+		"muted":    setting.Muted,
+		"archived": setting.Archived,
+	}
+	if setting.MuteUntil != nil {
+		data["mute_until"] = setting.MuteUntil.Unix()
+	}
+	uc.publishEvent(ctx, EventSettingUpdated, conversationID, userID, []uint64{userID}, data)
+
+	return setting, nil
+}
+
+// ListMemberSettings 获取会话成员设置，按用户ID索引
+func (uc *ConversationUseCaseImpl) ListMemberSettings(ctx context.Context, conversationID uint64) (map[uint64]*entity.ConversationSetting, error) {
+	settings, err := uc.settingRepo.ListByConversation(ctx, conversationID)
+	if err != nil {
+		return nil, fmt.Errorf("list settings: %w", err)
+	}
+
+	result := make(map[uint64]*entity.ConversationSetting, len(settings))
+	for _, s := range settings {
+		result[s.UserID] = s
+	}
+	return result, nil
+}
+
+// RecordLastMessage 记录会话最后消息时间
+func (uc *ConversationUseCaseImpl) RecordLastMessage(ctx context.Context, conversationID uint64, at time.Time) error {
+	return uc.convRepo.UpdateLastMessageAt(ctx, conversationID, at)
+}
+
+func (uc *ConversationUseCaseImpl) getSetting(ctx context.Context, userID, conversationID uint64) (*entity.ConversationSetting, error) {
+	setting, err := uc.settingRepo.Get(ctx, userID, conversationID)
+	if err != nil {
+		return nil, fmt.Errorf("get setting: %w", err)
+	}
+	if setting == nil {
+		setting = entity.NewConversationSetting(conversationID, userID)
+	}
+	return setting, nil
+}
@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"time"
+)
+
+const (
+	// MaxAnnouncementLength 群公告最大字符数
+	MaxAnnouncementLength = 2000
+	// MaxPinnedMessages 每个会话最多置顶的消息数
+	MaxPinnedMessages = 10
+)
+
+// Announcement 群公告，每个会话一条
+type Announcement struct {
+	ConversationID uint64
+	Content        string
+	EditorID       uint64 // 最后编辑人
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Edit 编辑公告
+func (a *Announcement) Edit(editorID uint64, content string) {
+	a.Content = content
+	a.EditorID = editorID
+	a.UpdatedAt = time.Now()
+}
+
+// NewAnnouncement 创建群公告
+func NewAnnouncement(conversationID, editorID uint64, content string) *Announcement {
+	now := time.Now()
+	return &Announcement{
+		ConversationID: conversationID,
+		Content:        content,
+		EditorID:       editorID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+// PinnedMessage 会话置顶消息
+type PinnedMessage struct {
+	ConversationID uint64
+	MessageID      uint64
+	PinnedBy       uint64
+	PinnedAt       time.Time
+}
+
+// NewPinnedMessage 创建置顶消息
+func NewPinnedMessage(conversationID, messageID, pinnedBy uint64) *PinnedMessage {
+	return &PinnedMessage{
+		ConversationID: conversationID,
+		MessageID:      messageID,
+		PinnedBy:       pinnedBy,
+		PinnedAt:       time.Now(),
+	}
+}
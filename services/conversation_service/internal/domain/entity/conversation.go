@@ -14,19 +14,26 @@ type Conversation struct {
 	MemberLimit int
 	JoinMode    JoinMode
 	MuteAll     bool
+	MessageTTL  int64 // 消息自动删除时长(秒)，0表示关闭
 	Status      ConversationStatus
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+
+	LastMessageAt *time.Time // 最后一条消息时间，用于会话列表排序
 }
 
 // ConversationType 会话类型
 type ConversationType int8
 
 const (
-	ConversationTypeSingle ConversationType = 1 // 单聊
-	ConversationTypeGroup  ConversationType = 2 // 群聊
+	ConversationTypeSingle  ConversationType = 1 // 单聊
+	ConversationTypeGroup   ConversationType = 2 // 群聊
+	ConversationTypeChannel ConversationType = 3 // 频道
 )
 
+// ChannelMemberLimit 频道订阅人数上限
+const ChannelMemberLimit = 1000000
+
 // ConversationStatus 会话状态
 type ConversationStatus int8
 
@@ -35,6 +42,22 @@ const (
 	ConversationStatusNormal    ConversationStatus = 1 // 正常
 )
 
+// MessageTTLOptions 支持的消息自动删除时长(秒)：1天、7天、30天
+var MessageTTLOptions = []int64{86400, 7 * 86400, 30 * 86400}
+
+// IsValidMessageTTL 是否为支持的消息自动删除时长，0表示关闭
+func IsValidMessageTTL(ttl int64) bool {
+	if ttl == 0 {
+		return true
+	}
+	for _, option := range MessageTTLOptions {
+		if ttl == option {
+			return true
+		}
+	}
+	return false
+}
+
 // JoinMode 加入方式
 type JoinMode int8
 
@@ -53,11 +76,21 @@ func (c *Conversation) IsGroup() bool {
 	return c.Type == ConversationTypeGroup
 }
 
+// IsChannel 是否为频道
+func (c *Conversation) IsChannel() bool {
+	return c.Type == ConversationTypeChannel
+}
+
 // IsActive 会话是否活跃
 func (c *Conversation) IsActive() bool {
 	return c.Status == ConversationStatusNormal
 }
 
+// IsFreeJoin 是否允许自由加入
+func (c *Conversation) IsFreeJoin() bool {
+	return c.JoinMode == JoinModeFree
+}
+
 // Update 更新会话信息
 func (c *Conversation) Update(title, avatarURL *string) {
 	if title != nil {
@@ -81,6 +114,18 @@ func (c *Conversation) SetMuteAll(mute bool) {
 	c.UpdatedAt = time.Now()
 }
 
+// SetMessageTTL 设置消息自动删除时长
+func (c *Conversation) SetMessageTTL(ttl int64) {
+	c.MessageTTL = ttl
+	c.UpdatedAt = time.Now()
+}
+
+// TransferOwner 变更群主
+func (c *Conversation) TransferOwner(newOwnerID uint64) {
+	c.OwnerID = &newOwnerID
+	c.UpdatedAt = time.Now()
+}
+
 // NewSingleConversation 创建单聊会话
 func NewSingleConversation() *Conversation {
 	now := time.Now()
@@ -108,3 +153,18 @@ func NewGroupConversation(title string, ownerID uint64) *Conversation {
 		UpdatedAt:   now,
 	}
 }
+
+// NewChannelConversation 创建频道，订阅者可自由加入
+func NewChannelConversation(title string, ownerID uint64) *Conversation {
+	now := time.Now()
+	return &Conversation{
+		Type:        ConversationTypeChannel,
+		Title:       &title,
+		OwnerID:     &ownerID,
+		MemberLimit: ChannelMemberLimit,
+		JoinMode:    JoinModeFree,
+		Status:      ConversationStatusNormal,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
@@ -0,0 +1,82 @@
+package entity
+
+import (
+	"time"
+)
+
+const (
+	// MaxFolderNameLength 文件夹名称最大字符数
+	MaxFolderNameLength = 32
+	// MaxFoldersPerUser 每个用户最多创建的文件夹数
+	MaxFoldersPerUser = 20
+)
+
+// SmartFolder 智能文件夹，由系统按会话状态自动归类，不落库
+type SmartFolder int8
+
+const (
+	SmartFolderNone     SmartFolder = 0
+	SmartFolderUnread   SmartFolder = 1 // 有未读消息
+	SmartFolderMentions SmartFolder = 2 // 未读消息中有@我
+	SmartFolderGroups   SmartFolder = 3 // 群聊和频道
+	SmartFolderDirect   SmartFolder = 4 // 单聊
+)
+
+// SmartFolders 智能文件夹的展示顺序
+var SmartFolders = []SmartFolder{SmartFolderUnread, SmartFolderMentions, SmartFolderGroups, SmartFolderDirect}
+
+// Name 智能文件夹默认名称
+func (f SmartFolder) Name() string {
+	switch f {
+	case SmartFolderUnread:
+		return "未读"
+	case SmartFolderMentions:
+		return "@我"
+	case SmartFolderGroups:
+		return "群聊"
+	case SmartFolderDirect:
+		return "单聊"
+	default:
+		return ""
+	}
+}
+
+// Matches 会话类型是否属于该智能文件夹，未读类文件夹不按类型区分
+func (f SmartFolder) Matches(convType ConversationType) bool {
+	switch f {
+	case SmartFolderGroups:
+		return convType == ConversationTypeGroup || convType == ConversationTypeChannel
+	case SmartFolderDirect:
+		return convType == ConversationTypeSingle
+	default:
+		return true
+	}
+}
+
+// Folder 用户自定义的会话文件夹
+type Folder struct {
+	ID        uint64
+	UserID    uint64
+	Name      string
+	SortOrder int // 越小越靠前
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Rename 重命名文件夹
+func (f *Folder) Rename(name string) {
+	f.Name = name
+	f.UpdatedAt = time.Now()
+}
+
+// NewFolder 创建文件夹
+func NewFolder(userID uint64, name string, sortOrder int) *Folder {
+	now := time.Now()
+	return &Folder{
+		UserID:    userID,
+		Name:      name,
+		SortOrder: sortOrder,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
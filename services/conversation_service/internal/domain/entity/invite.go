@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"time"
+)
+
+// GroupInvite 群邀请链接
+type GroupInvite struct {
+	ID             uint64
+	ConversationID uint64
+	Code           string
+	CreatorID      uint64
+	ExpiresAt      *time.Time // 为空表示永不过期
+	MaxUses        int        // 0 表示不限次数
+	UsedCount      int
+	Revoked        bool
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// IsExpired 是否已过期
+func (i *GroupInvite) IsExpired() bool {
+	return i.ExpiresAt != nil && !time.Now().Before(*i.ExpiresAt)
+}
+
+// IsExhausted 使用次数是否已用完
+func (i *GroupInvite) IsExhausted() bool {
+	return i.MaxUses > 0 && i.UsedCount >= i.MaxUses
+}
+
+// IsUsable 是否可用
+func (i *GroupInvite) IsUsable() bool {
+	return !i.Revoked && !i.IsExpired() && !i.IsExhausted()
+}
+
+// Revoke 撤销邀请
+func (i *GroupInvite) Revoke() {
+	i.Revoked = true
+	i.UpdatedAt = time.Now()
+}
+
+// NewGroupInvite 创建群邀请
+func NewGroupInvite(conversationID, creatorID uint64, code string, expiresAt *time.Time, maxUses int) *GroupInvite {
+	now := time.Now()
+	return &GroupInvite{
+		ConversationID: conversationID,
+		Code:           code,
+		CreatorID:      creatorID,
+		ExpiresAt:      expiresAt,
+		MaxUses:        maxUses,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"time"
+)
+
+// JoinRequest 入群申请
+type JoinRequest struct {
+	ID             uint64
+	ConversationID uint64
+	UserID         uint64
+	InviterID      *uint64
+	InviteID       *uint64 // 通过邀请链接发起的申请
+	Message        *string
+	Status         JoinRequestStatus
+	HandlerID      *uint64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// JoinRequestStatus 申请状态
+type JoinRequestStatus int8
+
+const (
+	JoinRequestStatusPending  JoinRequestStatus = 0 // 待审批
+	JoinRequestStatusApproved JoinRequestStatus = 1 // 已同意
+	JoinRequestStatusRejected JoinRequestStatus = 2 // 已拒绝
+)
+
+// IsPending 是否待审批
+func (r *JoinRequest) IsPending() bool {
+	return r.Status == JoinRequestStatusPending
+}
+
+// Approve 同意申请
+func (r *JoinRequest) Approve(handlerID uint64) {
+	r.Status = JoinRequestStatusApproved
+	r.HandlerID = &handlerID
+	r.UpdatedAt = time.Now()
+}
+
+// Reject 拒绝申请
+func (r *JoinRequest) Reject(handlerID uint64) {
+	r.Status = JoinRequestStatusRejected
+	r.HandlerID = &handlerID
+	r.UpdatedAt = time.Now()
+}
+
+// NewJoinRequest 创建入群申请
+func NewJoinRequest(conversationID, userID uint64, message string) *JoinRequest {
+	now := time.Now()
+	req := &JoinRequest{
+		ConversationID: conversationID,
+		UserID:         userID,
+		Status:         JoinRequestStatusPending,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if message != "" {
+		req.Message = &message
+	}
+	return req
+}
@@ -13,6 +13,7 @@ type Participant struct {
 	Nickname       *string
 	Muted          bool
 	MutedUntil     *time.Time
+	InviteID       *uint64 // 通过邀请链接加入时记录所用邀请
 	JoinedAt       time.Time
 	LastReadSeq    uint64
 }
@@ -69,6 +70,26 @@ func (p *Participant) SetRole(role ParticipantRole) {
 	p.Role = role
 }
 
+// SetNickname 设置群昵称，为空表示清除
+func (p *Participant) SetNickname(nickname string) {
+	if nickname == "" {
+		p.Nickname = nil
+		return
+	}
+	p.Nickname = &nickname
+}
+
+// EffectiveName 成员在会话中的展示名：群昵称优先，其次为用户资料中的展示名
+func (p *Participant) EffectiveName(profile *UserProfile) string {
+	if p.Nickname != nil && *p.Nickname != "" {
+		return *p.Nickname
+	}
+	if profile != nil {
+		return profile.DisplayName
+	}
+	return ""
+}
+
 // UpdateLastReadSeq 更新最后已读序号
 func (p *Participant) UpdateLastReadSeq(seq uint64) {
 	if seq > p.LastReadSeq {
@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"time"
+)
+
+// ConversationSetting 用户对会话的个人设置（置顶、免打扰、归档、备注）
+type ConversationSetting struct {
+	UserID         uint64
+	ConversationID uint64
+	PinnedAt       *time.Time // 非空表示已置顶，置顶会话按置顶时间倒序
+	Muted          bool       // 免打扰
+	MuteUntil      *time.Time // 为空且 Muted 为 true 表示永久免打扰
+	Archived       bool
+	Remark         *string
+	UpdatedAt      time.Time
+}
+
+// IsPinned 是否置顶
+func (s *ConversationSetting) IsPinned() bool {
+	return s.PinnedAt != nil
+}
+
+// IsDND 指定时间是否处于免打扰
+func (s *ConversationSetting) IsDND(now time.Time) bool {
+	if !s.Muted {
+		return false
+	}
+	return s.MuteUntil == nil || now.Before(*s.MuteUntil)
+}
+
+// Pin 置顶，已置顶时保持原置顶时间
+func (s *ConversationSetting) Pin() {
+	if s.PinnedAt == nil {
+		now := time.Now()
+		s.PinnedAt = &now
+	}
+}
+
+// Unpin 取消置顶
+func (s *ConversationSetting) Unpin() {
+	s.PinnedAt = nil
+}
+
+// SetDND 开启免打扰，until 为空表示永久
+func (s *ConversationSetting) SetDND(until *time.Time) {
+	s.Muted = true
+	s.MuteUntil = until
+}
+
+// ClearDND 关闭免打扰
+func (s *ConversationSetting) ClearDND() {
+	s.Muted = false
+	s.MuteUntil = nil
+}
+
+// NewConversationSetting 创建默认设置
+func NewConversationSetting(conversationID, userID uint64) *ConversationSetting {
+	return &ConversationSetting{
+		UserID:         userID,
+		ConversationID: conversationID,
+		UpdatedAt:      time.Now(),
+	}
+}
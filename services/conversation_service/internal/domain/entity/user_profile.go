@@ -0,0 +1,9 @@
+package entity
+
+// UserProfile 用户资料（来自身份服务）
+type UserProfile struct {
+	UserID      uint64
+	Username    string
+	DisplayName string
+	AvatarURL   string
+}
@@ -2,6 +2,7 @@ package in
 
 import (
 	"context"
+	"time"
 
 	"github.com/EthanQC/IM/services/conversation_service/internal/domain/entity"
 )
@@ -14,6 +15,9 @@ type ConversationUseCase interface {
 	// GetConversation 获取会话
 	GetConversation(ctx context.Context, conversationID uint64) (*entity.Conversation, error)
 
+	// GetConversationDetail 成员获取会话详情（含群公告、置顶消息和个人设置）
+	GetConversationDetail(ctx context.Context, userID, conversationID uint64) (*ConversationDetail, error)
+
 	// UpdateConversation 更新会话
 	UpdateConversation(ctx context.Context, userID, conversationID uint64, title *string, avatarURL *string) (*entity.Conversation, error)
 
@@ -23,8 +27,25 @@ type ConversationUseCase interface {
 	// GetOrCreateSingleConversation 获取或创建单聊会话
 	GetOrCreateSingleConversation(ctx context.Context, userID1, userID2 uint64) (*entity.Conversation, error)
 
-	// ListMyConversations 获取用户的会话列表
-	ListMyConversations(ctx context.Context, userID uint64, page, pageSize int) ([]*entity.Conversation, int, error)
+	// ListMyConversations 获取用户的会话列表，置顶在前，其余按最后消息时间倒序
+	// archived 为 true 时只列出已归档会话，否则只列出未归档会话
+	ListMyConversations(ctx context.Context, userID uint64, archived bool, page, pageSize int) ([]*MyConversation, int, error)
+
+	// ScrollMyConversations 按游标获取用户的会话列表，排序同 ListMyConversations
+	// folder 非零时只列出文件夹内的会话；cursor 为空表示从头开始，返回的 nextCursor 为空表示没有更多
+	ScrollMyConversations(ctx context.Context, userID uint64, archived bool, folder FolderRef, cursor string, limit int) (items []*MyConversation, nextCursor string, err error)
+
+	// GetConversationSetting 获取用户对会话的个人设置
+	GetConversationSetting(ctx context.Context, userID, conversationID uint64) (*entity.ConversationSetting, error)
+
+	// UpdateConversationSetting 更新用户对会话的个人设置（置顶、免打扰、归档、备注）
+	UpdateConversationSetting(ctx context.Context, userID, conversationID uint64, update *ConversationSettingUpdate) (*entity.ConversationSetting, error)
+
+	// ListMemberSettings 获取会话所有成员的个人设置（供发送、投递时判断免打扰）
+	ListMemberSettings(ctx context.Context, conversationID uint64) (map[uint64]*entity.ConversationSetting, error)
+
+	// RecordLastMessage 记录会话最后消息时间
+	RecordLastMessage(ctx context.Context, conversationID uint64, at time.Time) error
 
 	// AddMembers 添加成员
 	AddMembers(ctx context.Context, operatorID, conversationID uint64, userIDs []uint64) error
@@ -35,6 +56,24 @@ type ConversationUseCase interface {
 	// GetMembers 获取会话成员
 	GetMembers(ctx context.Context, conversationID uint64) ([]*entity.Participant, error)
 
+	// GetManagers 获取会话的群主和管理员
+	GetManagers(ctx context.Context, conversationID uint64) ([]*entity.Participant, error)
+
+	// IsMember 用户是否为会话成员
+	IsMember(ctx context.Context, conversationID, userID uint64) (bool, error)
+
+	// ListMembers 成员查看会话成员列表（含角色、昵称）
+	ListMembers(ctx context.Context, userID, conversationID uint64) ([]*entity.Participant, error)
+
+	// ScrollMembers 成员按游标查看会话成员列表，支持按角色、禁言状态过滤和按名称搜索
+	ScrollMembers(ctx context.Context, userID, conversationID uint64, query *MemberQuery) (*MemberPage, error)
+
+	// GetMemberProfiles 批量获取成员的用户资料，未配置身份服务时返回空
+	GetMemberProfiles(ctx context.Context, userIDs []uint64) (map[uint64]*entity.UserProfile, error)
+
+	// SetMemberNickname 设置自己的群昵称，为空表示清除
+	SetMemberNickname(ctx context.Context, userID, conversationID uint64, nickname string) (*entity.Participant, error)
+
 	// LeaveConversation 退出会话
 	LeaveConversation(ctx context.Context, userID, conversationID uint64) error
 
@@ -46,4 +85,152 @@ type ConversationUseCase interface {
 
 	// UnmuteMember 取消禁言
 	UnmuteMember(ctx context.Context, operatorID, conversationID, targetUserID uint64) error
+
+	// SetMuteAll 设置全员禁言
+	SetMuteAll(ctx context.Context, operatorID, conversationID uint64, mute bool) error
+
+	// SetMessageTTL 设置消息自动删除时长，0表示关闭
+	SetMessageTTL(ctx context.Context, operatorID, conversationID uint64, ttlSeconds int64) error
+
+	// TransferOwnership 转让群主
+	TransferOwnership(ctx context.Context, operatorID, conversationID, newOwnerID uint64) error
+
+	// RequestJoin 申请入群，自由加入的群直接通过
+	RequestJoin(ctx context.Context, userID, conversationID uint64, message string) (*entity.JoinRequest, error)
+
+	// ListJoinRequests 获取入群申请列表（群主/管理员）
+	ListJoinRequests(ctx context.Context, operatorID, conversationID uint64, status entity.JoinRequestStatus, page, pageSize int) ([]*entity.JoinRequest, int, error)
+
+	// HandleJoinRequest 审批入群申请
+	HandleJoinRequest(ctx context.Context, operatorID, requestID uint64, approve bool) (*entity.JoinRequest, error)
+
+	// CreateInvite 创建邀请链接，expiresIn 为0表示永不过期，maxUses 为0表示不限次数
+	CreateInvite(ctx context.Context, operatorID, conversationID uint64, expiresIn time.Duration, maxUses int) (*entity.GroupInvite, error)
+
+	// ListInvites 获取会话的邀请链接（群主/管理员）
+	ListInvites(ctx context.Context, operatorID, conversationID uint64) ([]*entity.GroupInvite, error)
+
+	// RevokeInvite 撤销邀请链接
+	RevokeInvite(ctx context.Context, operatorID, inviteID uint64) error
+
+	// PreviewInvite 通过邀请码预览群信息（无需加入）
+	PreviewInvite(ctx context.Context, code string) (*InvitePreview, error)
+
+	// JoinByInvite 通过邀请码加入，需审批的群会生成入群申请
+	JoinByInvite(ctx context.Context, userID uint64, code string) (*entity.JoinRequest, error)
+
+	// GetAnnouncement 获取群公告
+	GetAnnouncement(ctx context.Context, userID, conversationID uint64) (*entity.Announcement, error)
+
+	// SetAnnouncement 发布或编辑群公告（群主/管理员），会以@所有人的方式通知成员
+	SetAnnouncement(ctx context.Context, operatorID, conversationID uint64, content string) (*entity.Announcement, error)
+
+	// DeleteAnnouncement 删除群公告（群主/管理员）
+	DeleteAnnouncement(ctx context.Context, operatorID, conversationID uint64) error
+
+	// ListPinnedMessages 获取会话置顶消息
+	ListPinnedMessages(ctx context.Context, userID, conversationID uint64) ([]*entity.PinnedMessage, error)
+
+	// PinMessage 置顶消息（群主/管理员），消息需属于该会话
+	PinMessage(ctx context.Context, operatorID, conversationID, messageID uint64) (*entity.PinnedMessage, error)
+
+	// UnpinMessage 取消置顶消息（群主/管理员）
+	UnpinMessage(ctx context.Context, operatorID, conversationID, messageID uint64) error
+
+	// ListFolders 获取用户的文件夹（智能文件夹在前），附带会话数和未读数
+	ListFolders(ctx context.Context, userID uint64) ([]*FolderView, error)
+
+	// CreateFolder 创建文件夹，追加到末尾
+	CreateFolder(ctx context.Context, userID uint64, name string) (*entity.Folder, error)
+
+	// RenameFolder 重命名文件夹
+	RenameFolder(ctx context.Context, userID, folderID uint64, name string) (*entity.Folder, error)
+
+	// DeleteFolder 删除文件夹，其中的会话不受影响
+	DeleteFolder(ctx context.Context, userID, folderID uint64) error
+
+	// ReorderFolders 调整文件夹顺序，folderIDs 须包含用户的全部文件夹
+	ReorderFolders(ctx context.Context, userID uint64, folderIDs []uint64) error
+
+	// AddFolderConversations 将会话加入文件夹，会话须为用户参与的会话
+	AddFolderConversations(ctx context.Context, userID, folderID uint64, conversationIDs []uint64) error
+
+	// RemoveFolderConversation 将会话移出文件夹
+	RemoveFolderConversation(ctx context.Context, userID, folderID, conversationID uint64) error
+}
+
+// FolderRef 会话列表所属的文件夹，ID 与 Smart 至多一个非零，均为零表示全部会话
+type FolderRef struct {
+	ID    uint64
+	Smart entity.SmartFolder
+}
+
+// FolderView 文件夹列表项
+type FolderView struct {
+	Folder            *entity.Folder     // 智能文件夹为 nil
+	Smart             entity.SmartFolder // 自定义文件夹为 SmartFolderNone
+	ConversationCount int
+	UnreadCount       int
+}
+
+// InvitePreview 邀请预览信息
+type InvitePreview struct {
+	Conversation *entity.Conversation
+	MemberCount  int
+	Invite       *entity.GroupInvite
+}
+
+// ConversationDetail 会话详情
+type ConversationDetail struct {
+	Conversation   *entity.Conversation
+	MemberCount    int
+	Announcement   *entity.Announcement // 无公告时为 nil
+	PinnedMessages []*entity.PinnedMessage
+	Setting        *entity.ConversationSetting
+}
+
+// MemberQuery 成员列表查询条件，过滤字段为空表示不过滤
+type MemberQuery struct {
+	Cursor  string
+	Limit   int
+	Role    *entity.ParticipantRole
+	Muted   *bool
+	Keyword string // 匹配群昵称、展示名或用户名
+}
+
+// MemberPage 成员列表分页结果
+type MemberPage struct {
+	Members    []*Member
+	NextCursor string         // 为空表示没有更多，关键字搜索时本页可能不足 Limit 条
+	Summary    *MemberSummary // 仅首页返回
+}
+
+// Member 会话成员及其用户资料
+type Member struct {
+	Participant *entity.Participant
+	Profile     *entity.UserProfile // 未获取到资料时为 nil
+}
+
+// MemberSummary 各角色成员数
+type MemberSummary struct {
+	Total   int
+	Owners  int
+	Admins  int
+	Members int
+}
+
+// MyConversation 会话列表项
+type MyConversation struct {
+	Conversation *entity.Conversation
+	Setting      *entity.ConversationSetting
+	FolderIDs    []uint64 // 会话所在的自定义文件夹
+}
+
+// ConversationSettingUpdate 会话设置更新，字段为空表示不修改
+type ConversationSettingUpdate struct {
+	Pinned      *bool
+	Muted       *bool
+	MuteSeconds int64 // 开启免打扰时的时长，0表示永久
+	Archived    *bool
+	Remark      *string
 }
@@ -0,0 +1,31 @@
+package out
+
+import (
+	"context"
+	"time"
+)
+
+// MessageInfo 消息基本信息
+type MessageInfo struct {
+	ID             uint64
+	ConversationID uint64
+	SenderID       uint64
+	CreatedAt      time.Time
+}
+
+// MessageReader 从消息服务读取消息
+type MessageReader interface {
+	// GetMessage 以 userID 的身份获取消息
+	// 不存在、已撤回、删除或 userID 不是消息所在会话的成员时返回 nil
+	GetMessage(ctx context.Context, userID, messageID uint64) (*MessageInfo, error)
+
+	// BatchGetUnreadCounts 批量获取用户的未读数，只返回有未读的会话
+	// channelIDs 为其中的频道会话
+	BatchGetUnreadCounts(ctx context.Context, userID uint64, conversationIDs, channelIDs []uint64) (map[uint64]*UnreadCount, error)
+}
+
+// UnreadCount 会话未读数
+type UnreadCount struct {
+	Count      int
+	HasMention bool
+}
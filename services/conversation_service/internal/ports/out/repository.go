@@ -2,6 +2,8 @@ package out
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/EthanQC/IM/services/conversation_service/internal/domain/entity"
 )
@@ -24,7 +26,33 @@ type ConversationRepository interface {
 	GetSingleConversation(ctx context.Context, userID1, userID2 uint64) (*entity.Conversation, error)
 
 	// ListByUserID 获取用户的会话列表
-	ListByUserID(ctx context.Context, userID uint64, page, pageSize int) ([]*entity.Conversation, int, error)
+	// 置顶会话在前（按置顶时间倒序），其余按最后消息时间倒序；archived 为 true 时只列出已归档会话
+	ListByUserID(ctx context.Context, userID uint64, archived bool, page, pageSize int) ([]*entity.Conversation, int, error)
+
+	// ListByUserCursor 按游标获取用户的会话列表，排序同 ListByUserID，after 为空表示从头开始
+	// filter 为空表示不过滤
+	ListByUserCursor(ctx context.Context, userID uint64, archived bool, filter *ConversationFilter, after *ConversationCursor, limit int) ([]*entity.Conversation, error)
+
+	// ListTypesByUser 获取用户参与的正常会话及其类型，archived 含义同 ListByUserID
+	ListTypesByUser(ctx context.Context, userID uint64, archived bool) (map[uint64]entity.ConversationType, error)
+
+	// UpdateLastMessageAt 更新最后消息时间，只前进不后退
+	UpdateLastMessageAt(ctx context.Context, id uint64, at time.Time) error
+}
+
+// ConversationCursor 会话列表游标，记录上一页最后一项的排序键
+// 置顶会话按 (置顶时间, ID) 排序，其余按 (最后消息时间, ID) 排序
+type ConversationCursor struct {
+	Pinned bool
+	At     time.Time
+	ID     uint64
+}
+
+// ConversationFilter 会话列表过滤条件，字段为空表示不过滤
+type ConversationFilter struct {
+	FolderID uint64 // 只列出该自定义文件夹内的会话
+	Types    []entity.ConversationType
+	IDs      []uint64 // 只列出这些会话
 }
 
 // ParticipantRepository 会话成员仓储接口
@@ -32,6 +60,10 @@ type ParticipantRepository interface {
 	// Create 添加成员
 	Create(ctx context.Context, p *entity.Participant) error
 
+	// CreateWithinLimit 在同一事务中锁定会话并校验人数上限后添加成员
+	// 会话成员已达上限时返回 ErrMemberLimitReached
+	CreateWithinLimit(ctx context.Context, p *entity.Participant) error
+
 	// CreateBatch 批量添加成员
 	CreateBatch(ctx context.Context, participants []*entity.Participant) error
 
@@ -44,6 +76,15 @@ type ParticipantRepository interface {
 	// List 获取会话成员列表
 	List(ctx context.Context, conversationID uint64) ([]*entity.Participant, error)
 
+	// ListManagers 获取会话的群主和管理员
+	ListManagers(ctx context.Context, conversationID uint64) ([]*entity.Participant, error)
+
+	// ListByCursor 按游标获取会话成员，排序同 List，after 为空表示从头开始
+	ListByCursor(ctx context.Context, conversationID uint64, filter *MemberFilter, after *MemberCursor, limit int) ([]*entity.Participant, error)
+
+	// CountByRole 按角色统计会话成员数
+	CountByRole(ctx context.Context, conversationID uint64) (map[entity.ParticipantRole]int, error)
+
 	// ListByUserID 获取用户参与的会话ID列表
 	ListByUserID(ctx context.Context, userID uint64) ([]uint64, error)
 
@@ -63,6 +104,156 @@ type ParticipantRepository interface {
 	IsMember(ctx context.Context, conversationID, userID uint64) (bool, error)
 }
 
+// ErrMemberLimitReached 事务内校验失败：会话成员已达人数上限
+var ErrMemberLimitReached = errors.New("conversation member limit reached")
+
+// MemberFilter 成员列表过滤条件，字段为空表示不过滤
+type MemberFilter struct {
+	Role  *entity.ParticipantRole
+	Muted *bool
+	// Keyword 匹配群昵称
+	Keyword string
+}
+
+// MemberCursor 成员列表游标，记录上一页最后一项的排序键
+// 成员按 (角色降序, 入群时间, ID) 排序
+type MemberCursor struct {
+	Role     entity.ParticipantRole
+	JoinedAt time.Time
+	ID       uint64
+}
+
+// ErrOwnershipConflict 事务内校验失败：群主已变更或新群主已不在会话中
+var ErrOwnershipConflict = errors.New("conversation ownership changed concurrently")
+
+// OwnershipRepository 群主变更仓储接口
+// 每个方法在同一事务中完成成员角色、成员关系与会话群主的变更
+type OwnershipRepository interface {
+	// TransferOwner 转让群主，原群主降为管理员
+	TransferOwner(ctx context.Context, conversationID, ownerID, newOwnerID uint64) error
+
+	// RemoveOwner 群主退出会话
+	// 由任职最久的管理员继任，没有管理员时由任职最久的成员继任，返回继任者ID
+	// 没有其他成员时解散会话并返回0
+	RemoveOwner(ctx context.Context, conversationID, ownerID uint64) (uint64, error)
+}
+
+// JoinRequestRepository 入群申请仓储接口
+type JoinRequestRepository interface {
+	// Create 创建申请
+	Create(ctx context.Context, req *entity.JoinRequest) error
+
+	// GetByID 根据ID获取申请
+	GetByID(ctx context.Context, id uint64) (*entity.JoinRequest, error)
+
+	// GetPending 获取用户在指定会话中待审批的申请
+	GetPending(ctx context.Context, conversationID, userID uint64) (*entity.JoinRequest, error)
+
+	// ListByConversation 按状态分页获取会话的申请列表
+	ListByConversation(ctx context.Context, conversationID uint64, status entity.JoinRequestStatus, page, pageSize int) ([]*entity.JoinRequest, int, error)
+
+	// UpdateStatus 仅当申请仍处于 from 状态时更新为 to 并记录处理人，返回是否更新成功
+	// 并发审批同一申请时只有一方成功
+	UpdateStatus(ctx context.Context, id uint64, from, to entity.JoinRequestStatus, handlerID *uint64) (bool, error)
+}
+
+// GroupInviteRepository 群邀请仓储接口
+type GroupInviteRepository interface {
+	// Create 创建邀请
+	Create(ctx context.Context, invite *entity.GroupInvite) error
+
+	// GetByID 根据ID获取邀请
+	GetByID(ctx context.Context, id uint64) (*entity.GroupInvite, error)
+
+	// GetByCode 根据邀请码获取邀请
+	GetByCode(ctx context.Context, code string) (*entity.GroupInvite, error)
+
+	// ListByConversation 获取会话的邀请列表
+	ListByConversation(ctx context.Context, conversationID uint64) ([]*entity.GroupInvite, error)
+
+	// Update 更新邀请
+	Update(ctx context.Context, invite *entity.GroupInvite) error
+
+	// AddMember 在同一事务中校验人数上限、消耗一次邀请并添加成员
+	// 邀请已撤销、过期或次数用尽时返回 false，会话成员已达上限时返回 ErrMemberLimitReached，均不添加成员
+	AddMember(ctx context.Context, inviteID uint64, p *entity.Participant) (bool, error)
+}
+
+// ConversationSettingRepository 用户会话设置仓储接口
+type ConversationSettingRepository interface {
+	// Get 获取用户对会话的设置，不存在时返回 nil
+	Get(ctx context.Context, userID, conversationID uint64) (*entity.ConversationSetting, error)
+
+	// BatchGet 批量获取用户对多个会话的设置
+	BatchGet(ctx context.Context, userID uint64, conversationIDs []uint64) (map[uint64]*entity.ConversationSetting, error)
+
+	// ListByConversation 获取会话所有成员的设置
+	ListByConversation(ctx context.Context, conversationID uint64) ([]*entity.ConversationSetting, error)
+
+	// Save 保存设置（不存在时创建）
+	Save(ctx context.Context, setting *entity.ConversationSetting) error
+}
+
+// AnnouncementRepository 群公告仓储接口
+type AnnouncementRepository interface {
+	// Get 获取会话公告，不存在时返回 nil
+	Get(ctx context.Context, conversationID uint64) (*entity.Announcement, error)
+
+	// Save 保存公告（不存在时创建）
+	Save(ctx context.Context, announcement *entity.Announcement) error
+
+	// Delete 删除公告
+	Delete(ctx context.Context, conversationID uint64) error
+}
+
+// PinnedMessageRepository 置顶消息仓储接口
+type PinnedMessageRepository interface {
+	// List 获取会话的置顶消息，按置顶时间倒序
+	List(ctx context.Context, conversationID uint64) ([]*entity.PinnedMessage, error)
+
+	// Create 置顶消息
+	Create(ctx context.Context, pin *entity.PinnedMessage) error
+
+	// Delete 取消置顶，返回是否存在该置顶
+	Delete(ctx context.Context, conversationID, messageID uint64) (bool, error)
+}
+
+// FolderRepository 会话文件夹仓储接口
+// ErrFolderNameConflict 同一用户下已存在同名文件夹（唯一索引 uk_user_name 冲突）
+var ErrFolderNameConflict = errors.New("folder name conflict")
+
+type FolderRepository interface {
+	// Create 创建文件夹，同名时返回 ErrFolderNameConflict
+	Create(ctx context.Context, folder *entity.Folder) error
+
+	// GetByID 根据ID获取文件夹，不存在时返回 nil
+	GetByID(ctx context.Context, id uint64) (*entity.Folder, error)
+
+	// ListByUser 获取用户的文件夹，按排序值、ID升序
+	ListByUser(ctx context.Context, userID uint64) ([]*entity.Folder, error)
+
+	// Update 更新文件夹，重名时返回 ErrFolderNameConflict
+	Update(ctx context.Context, folder *entity.Folder) error
+
+	// Delete 删除文件夹及其会话归属
+	Delete(ctx context.Context, id uint64) error
+
+	// Reorder 按 folderIDs 的顺序重设排序值
+	Reorder(ctx context.Context, userID uint64, folderIDs []uint64) error
+
+	// AddConversations 将会话加入文件夹，已在文件夹中的会话忽略
+	AddConversations(ctx context.Context, userID, folderID uint64, conversationIDs []uint64) error
+
+	// RemoveConversation 将会话移出文件夹
+	RemoveConversation(ctx context.Context, folderID, conversationID uint64) error
+
+	// ListAssignments 获取用户全部文件夹的会话归属，按会话ID索引文件夹ID
+	ListAssignments(ctx context.Context, userID uint64) (map[uint64][]uint64, error)
+
+	// ListFolderIDs 获取指定会话所在的文件夹，按会话ID索引
+	ListFolderIDs(ctx context.Context, userID uint64, conversationIDs []uint64) (map[uint64][]uint64, error)
+}
+
 // EventPublisher 事件发布器接口
 type EventPublisher interface {
 	// Publish 发布事件
@@ -0,0 +1,16 @@
+package out
+
+import (
+	"context"
+
+	"github.com/EthanQC/IM/services/conversation_service/internal/domain/entity"
+)
+
+// UserReader 从身份服务读取用户资料
+type UserReader interface {
+	// BatchGetProfiles 批量获取用户资料，不存在的用户不在结果中
+	BatchGetProfiles(ctx context.Context, userIDs []uint64) (map[uint64]*entity.UserProfile, error)
+
+	// MatchUserIDs 在 candidateIDs 中获取展示名或用户名包含关键字的用户ID
+	MatchUserIDs(ctx context.Context, keyword string, candidateIDs []uint64) ([]uint64, error)
+}
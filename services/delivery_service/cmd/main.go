@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/pprof"
@@ -13,16 +14,22 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/redis/go-redis/v9"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
+	imv1 "github.com/EthanQC/IM/api/gen/im/v1"
+	"github.com/EthanQC/IM/pkg/authn"
 	"github.com/EthanQC/IM/pkg/zlog"
 	"github.com/EthanQC/IM/services/delivery_service/internal/adapters/in/ws"
+	"github.com/EthanQC/IM/services/delivery_service/internal/adapters/metrics"
 	"github.com/EthanQC/IM/services/delivery_service/internal/adapters/out/db"
 	"github.com/EthanQC/IM/services/delivery_service/internal/adapters/out/mq"
 	redisRepo "github.com/EthanQC/IM/services/delivery_service/internal/adapters/out/redis"
@@ -115,6 +122,12 @@ func main() {
 	}
 	signalingUseCase := application.NewSignalingUseCase(signalingConfig, connManager)
 
+	// 注册通话质量指标
+	metrics.Register(prometheus.DefaultRegisterer)
+	if su, ok := signalingUseCase.(*application.SignalingUseCaseImpl); ok {
+		su.SetQualityRecorder(metrics.NewCallQualityRecorder())
+	}
+
 	// 初始化Kafka消费者（使用可靠消费者）
 	kafkaBrokers := viper.GetStringSlice("kafka.brokers")
 	groupID := viper.GetString("kafka.group_id")
@@ -139,19 +152,49 @@ func main() {
 		signalingUseCase,
 	)
 
+	// 令牌校验器（与网关共用校验链）
+	verifier, revocationSub := initVerifier(ctx, redisClient, kafkaBrokers, sessionEventHandler(wsServer))
+	allowQueryUserID := viper.GetBool("auth.allow_query_user_id")
+	if allowQueryUserID {
+		logger.Warn("auth.allow_query_user_id enabled, /ws trusts user_id query parameter")
+	}
+
 	// 初始化HTTP服务器
-	router := gin.Default()
+	// 访问日志隐藏 /ws?token= 中的令牌
+	router := gin.New()
+	router.Use(authn.GinLogger(), gin.Recovery())
 
 	// WebSocket端点
 	router.GET("/ws", func(c *gin.Context) {
-		// 从JWT或Query中获取用户信息
-		userID := c.GetUint64("user_id")
-		if userID == 0 {
-			// 尝试从query获取（用于测试或内网）
-			if userIDStr := c.Query("user_id"); userIDStr != "" {
-				if parsed, err := strconv.ParseUint(userIDStr, 10, 64); err == nil {
-					userID = parsed
+		// 浏览器 WebSocket 无法自定义请求头，令牌可放在 token 查询参数中
+		token := c.Query("token")
+		if token == "" {
+			token, _ = authn.BearerToken(c.GetHeader("Authorization"))
+		}
+
+		var (
+			userID    uint64
+			sessionID string
+		)
+		if token != "" {
+			claims, err := verifier.Verify(c.Request.Context(), token)
+			if err != nil {
+				status := http.StatusUnauthorized
+				switch {
+				case errors.Is(err, authn.ErrUserInactive):
+					status = http.StatusForbidden
+				case errors.Is(err, authn.ErrAuthUnavailable):
+					status = http.StatusServiceUnavailable
 				}
+				c.JSON(status, gin.H{"error": err.Error()})
+				return
+			}
+			userID = claims.UserID
+			sessionID = claims.SessionID
+		} else if allowQueryUserID {
+			// 仅压测环境开启：直接信任 query 中的 user_id
+			if parsed, err := strconv.ParseUint(c.Query("user_id"), 10, 64); err == nil {
+				userID = parsed
 			}
 		}
 		if userID == 0 {
@@ -168,7 +211,7 @@ func main() {
 			platform = "web"
 		}
 
-		wsServer.HandleConnection(c.Writer, c.Request, userID, deviceID, platform)
+		wsServer.HandleConnection(c.Writer, c.Request, userID, deviceID, sessionID, platform)
 	})
 
 	// 健康检查
@@ -231,6 +274,11 @@ func main() {
 	if err := consumer.Stop(); err != nil {
 		logger.Warn("Kafka consumer stop error", zap.Error(err))
 	}
+	if revocationSub != nil {
+		if err := revocationSub.Stop(); err != nil {
+			logger.Warn("Revocation subscriber stop error", zap.Error(err))
+		}
+	}
 
 	// 停止信令服务
 	if su, ok := signalingUseCase.(*application.SignalingUseCaseImpl); ok {
@@ -251,6 +299,13 @@ func loadConfig() error {
 	viper.AddConfigPath("./configs")
 	viper.AddConfigPath("../configs")
 	viper.AddConfigPath("../../configs")
+	viper.SetDefault("jwt.accept_legacy_hs256", false)
+	viper.SetDefault("jwt.legacy_hs256_sunset", authn.DefaultLegacyHS256Sunset)
+	viper.SetDefault("auth.revocation_topic", authn.DefaultRevocationTopic)
+	viper.SetDefault("auth.revocation_ttl", "15m")
+	viper.SetDefault("auth.status_cache_ttl", "30s")
+	viper.SetDefault("auth.identity_timeout", "3s")
+	viper.SetDefault("auth.fail_open", false)
 
 	return viper.ReadInConfig()
 }
@@ -295,6 +350,80 @@ func initRedis() (*redis.Client, error) {
 	return client, nil
 }
 
+// initVerifier 初始化 /ws 令牌校验器；identity 地址与 Kafka 未配置时跳过对应检查
+func initVerifier(ctx context.Context, redisClient *redis.Client, brokers []string, onEvent authn.EventHandler) (*authn.Verifier, *authn.RevocationSubscriber) {
+	legacySecret := ""
+	acceptLegacy, err := authn.AcceptLegacyHS256(viper.GetBool("jwt.accept_legacy_hs256"), viper.GetString("jwt.legacy_hs256_sunset"), time.Now())
+	if err != nil {
+		zap.L().Warn("Legacy HS256 tokens rejected", zap.Error(err))
+	} else if viper.GetBool("jwt.accept_legacy_hs256") && !acceptLegacy {
+		zap.L().Warn("Legacy HS256 sunset passed, remove accept_legacy_hs256 and jwt.secret")
+	}
+	if acceptLegacy {
+		legacySecret = viper.GetString("jwt.secret")
+	}
+	verifier := authn.NewVerifier(legacySecret, nil)
+	verifier.SetFailOpen(viper.GetBool("auth.fail_open"))
+	if url := viper.GetString("jwt.jwks_url"); url != "" {
+		verifier.SetKeySource(authn.NewJWKSClient(url, viper.GetDuration("jwt.jwks_refresh_interval")))
+	}
+	verifier.SetRevocationStore(authn.NewRedisRevocationStore(redisClient))
+
+	if addr := viper.GetString("auth.identity_addr"); addr != "" {
+		conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			zap.L().Warn("Failed to connect identity service", zap.Error(err))
+		} else {
+			checker := authn.NewIdentityStatusChecker(imv1.NewIdentityServiceClient(conn), viper.GetDuration("auth.identity_timeout"))
+			verifier.SetStatusChecker(checker, viper.GetDuration("auth.status_cache_ttl"))
+		}
+	}
+
+	if len(brokers) == 0 {
+		return verifier, nil
+	}
+	sub, err := authn.NewRevocationSubscriber(brokers, viper.GetString("auth.revocation_topic"), verifier.Cache(), viper.GetDuration("auth.revocation_ttl"))
+	if err != nil {
+		zap.L().Warn("Failed to create revocation subscriber", zap.Error(err))
+		return verifier, nil
+	}
+	sub.SetEventHandler(onEvent)
+	if err := sub.Start(ctx); err != nil {
+		zap.L().Warn("Failed to start revocation subscriber", zap.Error(err))
+		sub.Stop()
+		return verifier, nil
+	}
+	return verifier, sub
+}
+
+// sessionEventHandler 会话撤销时断开对应连接，新设备登录时提醒用户的在线设备
+func sessionEventHandler(wsServer *ws.EnhancedWSServer) authn.EventHandler {
+	return func(ctx context.Context, event authn.AuthEvent) {
+		userID, err := strconv.ParseUint(event.UserID, 10, 64)
+		if err != nil {
+			return
+		}
+		switch event.Type {
+		case authn.EventSessionRevoked:
+			if n := wsServer.CloseSession(userID, event.SessionID); n > 0 {
+				zap.L().Info("Closed connections of revoked session",
+					zap.Uint64("userID", userID),
+					zap.String("sessionID", event.SessionID),
+					zap.Int("count", n))
+			}
+		case authn.EventNewDeviceLogin:
+			wsServer.Notify(userID, map[string]interface{}{
+				"event":       authn.EventNewDeviceLogin,
+				"session_id":  event.SessionID,
+				"device_id":   event.DeviceID,
+				"platform":    event.Platform,
+				"ip":          event.IP,
+				"occurred_at": event.OccurredAt,
+			})
+		}
+	}
+}
+
 // getHostname 获取当前服务器的主机名或IP
 func getHostname() string {
 	// 优先使用配置的地址
@@ -3,6 +3,8 @@ module github.com/EthanQC/IM/services/delivery_service
 go 1.24.2
 
 require (
+	github.com/EthanQC/IM/api v0.0.0
+	github.com/EthanQC/IM/pkg/authn v0.0.0
 	github.com/EthanQC/IM/pkg/zlog v0.0.0-20260125144904-8ad1288b1283
 	github.com/IBM/sarama v1.43.0
 	github.com/gin-gonic/gin v1.10.1
@@ -12,6 +14,7 @@ require (
 	github.com/redis/go-redis/v9 v9.5.1
 	github.com/spf13/viper v1.20.1
 	go.uber.org/zap v1.27.0
+	google.golang.org/grpc v1.73.0
 	gorm.io/driver/mysql v1.5.7
 	gorm.io/gorm v1.30.0
 )
@@ -38,6 +41,7 @@ require (
 	github.com/go-sql-driver/mysql v1.8.1 // indirect
 	github.com/go-viper/mapstructure/v2 v2.2.1 // indirect
 	github.com/goccy/go-json v0.10.2 // indirect
+	github.com/golang-jwt/jwt/v5 v5.2.1 // indirect
 	github.com/golang/snappy v0.0.4 // indirect
 	github.com/hashicorp/errwrap v1.0.0 // indirect
 	github.com/hashicorp/go-multierror v1.1.1 // indirect
@@ -78,7 +82,12 @@ require (
 	golang.org/x/net v0.43.0 // indirect
 	golang.org/x/sys v0.35.0 // indirect
 	golang.org/x/text v0.28.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250324211829-b45e905df463 // indirect
 	google.golang.org/protobuf v1.36.8 // indirect
 	gopkg.in/natefinch/lumberjack.v2 v2.2.1 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace github.com/EthanQC/IM/api => ../../api
+
+replace github.com/EthanQC/IM/pkg/authn => ../../pkg/authn
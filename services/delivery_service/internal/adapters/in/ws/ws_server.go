@@ -31,6 +31,8 @@ const (
 	reconnectCheckInterval = 5 * time.Second
 	// 发送缓冲区大小 - 增大以避免阻塞
 	sendBufferSize = 1024
+	// 会话被撤销时的关闭码（4000-4999 为应用自定义）
+	CloseSessionRevoked = 4001
 )
 
 // WSMessageType WebSocket消息类型
@@ -84,6 +86,7 @@ type EnhancedWSConnection struct {
 	conn        *websocket.Conn
 	userID      uint64
 	deviceID    string
+	sessionID   string // 建立连接所用令牌的登录会话
 	platform    string
 	serverAddr  string
 	send        chan []byte
@@ -171,6 +174,15 @@ func (c *EnhancedWSConnection) Close() error {
 	return c.conn.Close()
 }
 
+// CloseWithReason 发送关闭帧告知客户端原因后关闭连接
+func (c *EnhancedWSConnection) CloseWithReason(code int, reason string) error {
+	if c.IsClosed() {
+		return nil
+	}
+	_ = c.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(writeWait))
+	return c.Close()
+}
+
 func (c *EnhancedWSConnection) IsClosed() bool {
 	return atomic.LoadInt32(&c.closed) == 1
 }
@@ -392,7 +404,7 @@ func (c *EnhancedWSConnection) handleSignaling(ctx context.Context, msgID string
 
 	// 解析信令消息
 	var signalMsg struct {
-		Action  string          `json:"action"` // offer, answer, ice_candidate, call, accept, reject, hangup
+		Action  string          `json:"action"` // offer, answer, ice_candidate, call, accept, reject, hangup, stats
 		Payload json.RawMessage `json:"payload"`
 	}
 	if err := json.Unmarshal(data, &signalMsg); err != nil {
@@ -599,6 +611,27 @@ func (m *EnhancedConnectionManager) SendToDevice(userID uint64, deviceID string,
 	return conn.Send(message)
 }
 
+// CloseSession 关闭用户指定登录会话的全部连接，返回关闭数量；连接的注销由读协程退出时完成
+func (m *EnhancedConnectionManager) CloseSession(userID uint64, sessionID string) int {
+	if sessionID == "" {
+		return 0
+	}
+	shard := m.getShard(userID)
+	shard.mu.RLock()
+	var conns []*EnhancedWSConnection
+	for _, conn := range shard.connections[userID] {
+		if conn.sessionID == sessionID {
+			conns = append(conns, conn)
+		}
+	}
+	shard.mu.RUnlock()
+
+	for _, conn := range conns {
+		conn.CloseWithReason(CloseSessionRevoked, "session revoked")
+	}
+	return len(conns)
+}
+
 func (m *EnhancedConnectionManager) Broadcast(userIDs []uint64, message []byte) error {
 	for _, userID := range userIDs {
 		m.Send(userID, message)
@@ -661,8 +694,8 @@ func NewEnhancedWSServer(
 	}
 }
 
-// HandleConnection 处理WebSocket连接
-func (s *EnhancedWSServer) HandleConnection(w http.ResponseWriter, r *http.Request, userID uint64, deviceID, platform string) {
+// HandleConnection 处理WebSocket连接；sessionID 为令牌所属的登录会话，撤销会话时据此断开
+func (s *EnhancedWSServer) HandleConnection(w http.ResponseWriter, r *http.Request, userID uint64, deviceID, sessionID, platform string) {
 	conn, err := s.upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		zap.L().Warn("WebSocket upgrade error", zap.Error(err))
@@ -671,6 +704,7 @@ func (s *EnhancedWSServer) HandleConnection(w http.ResponseWriter, r *http.Reque
 
 	serverAddr := r.Host
 	wsConn := NewEnhancedWSConnection(conn, userID, deviceID, platform, serverAddr)
+	wsConn.sessionID = sessionID
 	wsConn.SetDependencies(s.connManager, s.connUseCase, s.syncUseCase, s.ackUseCase, s.signalingUC)
 
 	// 注册连接
@@ -699,6 +733,28 @@ func (s *EnhancedWSServer) HandleConnection(w http.ResponseWriter, r *http.Reque
 	})
 }
 
+// CloseSession 断开被撤销会话的连接
+func (s *EnhancedWSServer) CloseSession(userID uint64, sessionID string) int {
+	return s.connManager.CloseSession(userID, sessionID)
+}
+
+// Notify 向用户所有在线设备推送通知
+func (s *EnhancedWSServer) Notify(userID uint64, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	msg, err := json.Marshal(WSMessage{
+		Type: MsgTypeNotify,
+		Data: payload,
+		Ts:   time.Now().UnixMilli(),
+	})
+	if err != nil {
+		return err
+	}
+	return s.connManager.Send(userID, msg)
+}
+
 // GetStats 获取服务器统计
 func (s *EnhancedWSServer) GetStats() map[string]int64 {
 	return s.connManager.GetStats()
@@ -1,5 +1,111 @@
-// Package metrics provides placeholder metrics for the delivery service.
+// Package metrics provides Prometheus metrics for the delivery service.
 package metrics
 
-// Placeholder metrics package to satisfy imports.
-// Extend with real Prometheus metrics when needed.
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/EthanQC/IM/services/delivery_service/internal/domain/call"
+	"github.com/EthanQC/IM/services/delivery_service/internal/ports/out"
+)
+
+var (
+	callSampleRTT = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "delivery_call_rtt_ms",
+			Help:    "Round-trip time reported by WebRTC clients, in milliseconds.",
+			Buckets: []float64{20, 50, 100, 150, 200, 300, 500, 800, 1200},
+		},
+		[]string{"call_type", "candidate_type"},
+	)
+	callSampleJitter = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "delivery_call_jitter_ms",
+			Help:    "Jitter reported by WebRTC clients, in milliseconds.",
+			Buckets: []float64{5, 10, 20, 30, 50, 80, 120, 200},
+		},
+		[]string{"call_type", "candidate_type"},
+	)
+	callSamplePacketLoss = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "delivery_call_packet_loss_ratio",
+			Help:    "Packet loss ratio reported by WebRTC clients.",
+			Buckets: []float64{0.001, 0.005, 0.01, 0.02, 0.05, 0.1, 0.2},
+		},
+		[]string{"call_type", "candidate_type"},
+	)
+	callSampleBitrate = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "delivery_call_bitrate_kbps",
+			Help:    "Media bitrate reported by WebRTC clients, in kbps.",
+			Buckets: []float64{16, 32, 64, 128, 256, 512, 1024, 2048, 4096},
+		},
+		[]string{"call_type", "candidate_type"},
+	)
+	callMOS = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "delivery_call_mos",
+			Help:    "Estimated mean opinion score per finished call.",
+			Buckets: []float64{1.5, 2, 2.5, 3, 3.1, 3.6, 4, 4.3},
+		},
+		[]string{"call_type"},
+	)
+	callQualityLevel = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "delivery_call_quality_total",
+			Help: "Number of finished calls by quality level.",
+		},
+		[]string{"call_type", "level"},
+	)
+	callRelayRatio = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "delivery_call_relay_ratio",
+			Help:    "Fraction of quality samples per call that went through a TURN relay.",
+			Buckets: []float64{0, 0.1, 0.25, 0.5, 0.75, 0.9, 1},
+		},
+		[]string{"call_type"},
+	)
+)
+
+// Register 注册所有指标，在 main 包里调用一次
+func Register(reg prometheus.Registerer) {
+	reg.MustRegister(
+		callSampleRTT,
+		callSampleJitter,
+		callSamplePacketLoss,
+		callSampleBitrate,
+		callMOS,
+		callQualityLevel,
+		callRelayRatio,
+	)
+}
+
+// CallQualityRecorder 基于 Prometheus 的通话质量记录器
+type CallQualityRecorder struct{}
+
+// NewCallQualityRecorder 创建通话质量记录器
+func NewCallQualityRecorder() out.CallQualityRecorder {
+	return &CallQualityRecorder{}
+}
+
+func (r *CallQualityRecorder) RecordSample(callType string, sample call.QualitySample) {
+	candidateType := string(sample.CandidateType)
+	if candidateType == "" {
+		candidateType = "unknown"
+	}
+
+	callSampleRTT.WithLabelValues(callType, candidateType).Observe(sample.RTTMs)
+	callSampleJitter.WithLabelValues(callType, candidateType).Observe(sample.JitterMs)
+	callSamplePacketLoss.WithLabelValues(callType, candidateType).Observe(sample.PacketLoss)
+	if sample.BitrateKbps > 0 {
+		callSampleBitrate.WithLabelValues(callType, candidateType).Observe(sample.BitrateKbps)
+	}
+}
+
+func (r *CallQualityRecorder) RecordCallSummary(callType string, summary call.QualitySummary) {
+	callQualityLevel.WithLabelValues(callType, string(summary.Level)).Inc()
+	if summary.Samples == 0 {
+		return
+	}
+	callMOS.WithLabelValues(callType).Observe(summary.MOS)
+	callRelayRatio.WithLabelValues(callType).Observe(summary.RelayRatio)
+}
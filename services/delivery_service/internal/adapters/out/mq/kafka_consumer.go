@@ -17,6 +17,10 @@ const (
 	TopicMessageNew     = "im.message.new"
 	TopicMessageRead    = "im.message.read"
 	TopicMessageRevoked = "im.message.revoked"
+	TopicMessageExpired = "im.message.expired"
+
+	// TopicConversationEvents 会话事件（入群申请、审批结果等）
+	TopicConversationEvents = "im.conversation.events"
 )
 
 // KafkaMessageConsumer Kafka消息消费者
@@ -43,7 +47,7 @@ func NewKafkaMessageConsumer(brokers []string, groupID string, deliveryUseCase i
 
 	return &KafkaMessageConsumer{
 		consumerGroup:   consumerGroup,
-		topics:          []string{TopicMessageNew, TopicMessageRead, TopicMessageRevoked},
+		topics:          []string{TopicMessageNew, TopicMessageRead, TopicMessageRevoked, TopicMessageExpired},
 		deliveryUseCase: deliveryUseCase,
 		ready:           make(chan bool),
 	}, nil
@@ -128,6 +132,8 @@ func (h *consumerGroupHandler) handleMessage(ctx context.Context, message *saram
 		h.handleMessageRead(ctx, message.Value)
 	case TopicMessageRevoked:
 		h.handleMessageRevoked(ctx, message.Value)
+	case TopicMessageExpired:
+		h.handleMessagesExpired(ctx, message.Value)
 	default:
 		zap.L().Warn("Unknown topic", zap.String("topic", message.Topic))
 	}
@@ -143,6 +149,11 @@ func (h *consumerGroupHandler) handleNewMessage(ctx context.Context, data []byte
 		ContentType    int8     `json:"content_type"`
 		Content        string   `json:"content"`
 		CreatedAt      int64    `json:"created_at"`
+
+		MutedReceiverIDs []uint64 `json:"muted_receiver_ids"`
+		Channel          bool     `json:"channel"`
+		SenderName       string   `json:"sender_name"`
+		ExpiresAt        int64    `json:"expires_at"`
 	}
 
 	if err := json.Unmarshal(data, &event); err != nil {
@@ -160,6 +171,11 @@ func (h *consumerGroupHandler) handleNewMessage(ctx context.Context, data []byte
 		ContentType:    event.ContentType,
 		Content:        event.Content,
 		CreatedAt:      time.Unix(event.CreatedAt, 0),
+
+		MutedReceiverIDs: event.MutedReceiverIDs,
+		Channel:          event.Channel,
+		SenderName:       event.SenderName,
+		ExpiresAt:        event.ExpiresAt,
 	}
 
 	if err := h.deliveryUseCase.DeliverMessage(ctx, msgEvent); err != nil {
@@ -239,3 +255,40 @@ func (h *consumerGroupHandler) handleMessageRevoked(ctx context.Context, data []
 		zap.L().Warn("Failed to deliver revoke notification", zap.Error(err))
 	}
 }
+
+func (h *consumerGroupHandler) handleMessagesExpired(ctx context.Context, data []byte) {
+	payload, receiverIDs, err := messagesExpiredPayload(data)
+	if err != nil {
+		zap.L().Warn("Failed to unmarshal messages expired event", zap.Error(err))
+		return
+	}
+
+	for _, receiverID := range receiverIDs {
+		if err := h.deliveryUseCase.DeliverToUser(ctx, receiverID, payload); err != nil {
+			zap.L().Warn("Failed to deliver expired notification", zap.Error(err))
+		}
+	}
+}
+
+// messagesExpiredPayload 构建消息过期通知，客户端据此删除本地副本
+func messagesExpiredPayload(data []byte) ([]byte, []uint64, error) {
+	var event struct {
+		ConversationID uint64   `json:"conversation_id"`
+		MessageIDs     []uint64 `json:"message_ids"`
+		ReceiverIDs    []uint64 `json:"receiver_ids"`
+		ExpiredAt      int64    `json:"expired_at"`
+	}
+	if err := json.Unmarshal(data, &event); err != nil {
+		return nil, nil, err
+	}
+
+	payload, _ := json.Marshal(map[string]interface{}{
+		"type": "messages_expired",
+		"data": map[string]interface{}{
+			"conversation_id": event.ConversationID,
+			"message_ids":     event.MessageIDs,
+			"expired_at":      event.ExpiredAt,
+		},
+	})
+	return payload, event.ReceiverIDs, nil
+}
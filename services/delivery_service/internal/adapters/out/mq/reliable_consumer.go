@@ -88,7 +88,7 @@ func NewReliableKafkaConsumer(brokers []string, groupID string, deliveryUseCase
 	return &ReliableKafkaConsumer{
 		consumerGroup:   consumerGroup,
 		producer:        producer,
-		topics:          []string{TopicMessageNew, TopicMessageRead, TopicMessageRevoked, TopicRetry},
+		topics:          []string{TopicMessageNew, TopicMessageRead, TopicMessageRevoked, TopicMessageExpired, TopicConversationEvents, TopicRetry},
 		deliveryUseCase: deliveryUseCase,
 		ready:           make(chan bool),
 	}, nil
@@ -261,6 +261,10 @@ func (h *reliableConsumerHandler) processMessage(ctx context.Context, topic stri
 		return h.handleMessageRead(ctx, payload)
 	case TopicMessageRevoked:
 		return h.handleMessageRevoked(ctx, payload)
+	case TopicMessageExpired:
+		return h.handleMessagesExpired(ctx, payload)
+	case TopicConversationEvents:
+		return h.handleConversationEvent(ctx, payload)
 	default:
 		return fmt.Errorf("unknown topic: %s", topic)
 	}
@@ -357,6 +361,11 @@ func (h *reliableConsumerHandler) handleNewMessage(ctx context.Context, data []b
 		ContentType    int8     `json:"content_type"`
 		Content        string   `json:"content"`
 		CreatedAt      int64    `json:"created_at"`
+
+		MutedReceiverIDs []uint64 `json:"muted_receiver_ids"`
+		Channel          bool     `json:"channel"`
+		SenderName       string   `json:"sender_name"`
+		ExpiresAt        int64    `json:"expires_at"`
 	}
 
 	if err := json.Unmarshal(data, &event); err != nil {
@@ -373,6 +382,11 @@ func (h *reliableConsumerHandler) handleNewMessage(ctx context.Context, data []b
 		ContentType:    event.ContentType,
 		Content:        event.Content,
 		CreatedAt:      time.Unix(event.CreatedAt, 0),
+
+		MutedReceiverIDs: event.MutedReceiverIDs,
+		Channel:          event.Channel,
+		SenderName:       event.SenderName,
+		ExpiresAt:        event.ExpiresAt,
 	}
 
 	return h.deliveryUseCase.DeliverMessage(ctx, msgEvent)
@@ -446,3 +460,47 @@ func (h *reliableConsumerHandler) handleMessageRevoked(ctx context.Context, data
 
 	return h.deliveryUseCase.DeliverMessage(ctx, msgEvent)
 }
+
+func (h *reliableConsumerHandler) handleMessagesExpired(ctx context.Context, data []byte) error {
+	payload, receiverIDs, err := messagesExpiredPayload(data)
+	if err != nil {
+		return fmt.Errorf("unmarshal messages expired event failed: %w", err)
+	}
+
+	var lastErr error
+	for _, receiverID := range receiverIDs {
+		if err := h.deliveryUseCase.DeliverToUser(ctx, receiverID, payload); err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// handleConversationEvent 将会话事件推送给事件指定的接收者（如入群申请通知管理员）
+func (h *reliableConsumerHandler) handleConversationEvent(ctx context.Context, data []byte) error {
+	var event struct {
+		ReceiverIDs []uint64 `json:"receiver_ids"`
+	}
+
+	if err := json.Unmarshal(data, &event); err != nil {
+		return fmt.Errorf("unmarshal conversation event failed: %w", err)
+	}
+	if len(event.ReceiverIDs) == 0 {
+		return nil
+	}
+
+	payload, _ := json.Marshal(map[string]interface{}{
+		"type": "conversation_event",
+		"data": json.RawMessage(data),
+	})
+
+	var lastErr error
+	for _, receiverID := range event.ReceiverIDs {
+		if err := h.deliveryUseCase.DeliverToUser(ctx, receiverID, payload); err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
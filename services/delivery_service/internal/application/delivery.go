@@ -388,17 +388,23 @@ func (uc *DeliveryUseCaseImpl) SetPendingAckRepo(repo out.PendingAckRepository)
 // DeliverMessage 投递消息
 func (uc *DeliveryUseCaseImpl) DeliverMessage(ctx context.Context, event *entity.MessageEvent) error {
 	// 构建消息载荷
+	data := map[string]interface{}{
+		"message_id":      event.MessageID,
+		"conversation_id": event.ConversationID,
+		"sender_id":       event.SenderID,
+		"sender_name":     event.SenderName,
+		"seq":             event.Seq,
+		"content_type":    event.ContentType,
+		"content":         event.Content,
+		"created_at":      event.CreatedAt.Unix(),
+	}
+	// 开启自动删除的会话，客户端到期后自行清理本地副本
+	if event.ExpiresAt > 0 {
+		data["expires_at"] = event.ExpiresAt
+	}
 	payload, err := json.Marshal(map[string]interface{}{
 		"type": event.Type,
-		"data": map[string]interface{}{
-			"message_id":      event.MessageID,
-			"conversation_id": event.ConversationID,
-			"sender_id":       event.SenderID,
-			"seq":             event.Seq,
-			"content_type":    event.ContentType,
-			"content":         event.Content,
-			"created_at":      event.CreatedAt.Unix(),
-		},
+		"data": data,
 	})
 	if err != nil {
 		return fmt.Errorf("marshal message payload failed: %w", err)
@@ -410,7 +416,13 @@ func (uc *DeliveryUseCaseImpl) DeliverMessage(ctx context.Context, event *entity
 		return fmt.Errorf("get online users failed: %w", err)
 	}
 
-	// 分流处理：在线推送，离线入库
+	// 免打扰的接收者仍正常投递，但不发离线推送通知
+	muted := make(map[uint64]struct{}, len(event.MutedReceiverIDs))
+	for _, id := range event.MutedReceiverIDs {
+		muted[id] = struct{}{}
+	}
+
+	// 分流处理：在线推送，离线入库（频道消息离线时由客户端从 Timeline 拉取）
 	for _, receiverID := range event.ReceiverIDs {
 		if receiverID == event.SenderID {
 			continue // 跳过发送者自己
@@ -418,16 +430,16 @@ func (uc *DeliveryUseCaseImpl) DeliverMessage(ctx context.Context, event *entity
 
 		if devices, ok := onlineUsers[receiverID]; ok && len(devices) > 0 {
 			// 在线：直接推送
-			if err := uc.deliverToOnlineUser(ctx, receiverID, event, payload); err != nil {
+			if err := uc.deliverToOnlineUser(ctx, receiverID, event, payload); err != nil && !event.Channel {
 				// 推送失败，转入离线队列
 				uc.saveForOffline(ctx, receiverID, event, payload)
 			}
-		} else {
+		} else if !event.Channel {
 			// 离线：保存待投递消息
 			uc.saveForOffline(ctx, receiverID, event, payload)
 
 			// 发送离线推送通知
-			if uc.pushService != nil {
+			if _, isMuted := muted[receiverID]; uc.pushService != nil && !isMuted {
 				uc.sendPushNotification(ctx, receiverID, event)
 			}
 		}
@@ -480,9 +492,13 @@ func (uc *DeliveryUseCaseImpl) saveForOffline(ctx context.Context, userID uint64
 
 // sendPushNotification 发送离线推送通知
 func (uc *DeliveryUseCaseImpl) sendPushNotification(ctx context.Context, userID uint64, event *entity.MessageEvent) {
+	title := "新消息"
+	if event.SenderName != "" {
+		title = event.SenderName
+	}
 	notification := &entity.PushNotification{
 		UserID: userID,
-		Title:  "新消息",
+		Title:  title,
 		Body:   "您有一条新消息",
 		Data: map[string]string{
 			"conversation_id": fmt.Sprintf("%d", event.ConversationID),
@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/EthanQC/IM/services/delivery_service/internal/domain/call"
 	"github.com/EthanQC/IM/services/delivery_service/internal/ports/in"
 	"github.com/EthanQC/IM/services/delivery_service/internal/ports/out"
 )
@@ -18,6 +19,8 @@ const (
 	callTimeout = 60 * time.Second
 	// 通话最大时长
 	maxCallDuration = 4 * time.Hour
+	// 已结束会话的保留时间（供查询通话质量汇总）
+	endedSessionRetention = 60 * time.Second
 )
 
 // SignalingConfig 信令服务配置
@@ -49,6 +52,10 @@ type CallSession struct {
 	CallerCandidates []string
 	CalleeCandidates []string
 
+	// 通话质量
+	quality *call.QualityAggregator
+	Quality *call.QualitySummary // 通话结束时生成
+
 	mu sync.RWMutex
 }
 
@@ -76,16 +83,28 @@ func (s *CallSession) GetState() *in.CallState {
 		ConnectedAt:    s.ConnectedAt,
 		EndedAt:        s.EndedAt,
 		Duration:       duration,
+		Quality:        s.Quality,
+	}
+}
+
+// isFinished 会话是否已处于终止状态（调用方需持有锁）
+func (s *CallSession) isFinished() bool {
+	switch s.Status {
+	case in.CallStatusEnded, in.CallStatusRejected, in.CallStatusTimeout, in.CallStatusCancelled:
+		return true
+	default:
+		return false
 	}
 }
 
 // SignalingUseCaseImpl 信令用例实现
 type SignalingUseCaseImpl struct {
-	config       SignalingConfig
-	connManager  out.ConnectionManager
-	callSessions map[string]*CallSession // callID -> session
-	userCalls    map[uint64]string       // userID -> callID (当前通话)
-	mu           sync.RWMutex
+	config          SignalingConfig
+	connManager     out.ConnectionManager
+	qualityRecorder out.CallQualityRecorder
+	callSessions    map[string]*CallSession // callID -> session
+	userCalls       map[uint64]string       // userID -> callID (当前通话)
+	mu              sync.RWMutex
 
 	// 用于清理超时会话
 	stopCleaner chan struct{}
@@ -106,6 +125,11 @@ func NewSignalingUseCase(config SignalingConfig, connManager out.ConnectionManag
 	return uc
 }
 
+// SetQualityRecorder 设置通话质量指标记录器
+func (uc *SignalingUseCaseImpl) SetQualityRecorder(recorder out.CallQualityRecorder) {
+	uc.qualityRecorder = recorder
+}
+
 // HandleSignaling 处理信令消息
 func (uc *SignalingUseCaseImpl) HandleSignaling(ctx context.Context, userID uint64, deviceID, action string, payload json.RawMessage) (interface{}, error) {
 	switch action {
@@ -161,11 +185,15 @@ func (uc *SignalingUseCaseImpl) HandleSignaling(ctx context.Context, userID uint
 		if err := json.Unmarshal(payload, &req); err != nil {
 			return nil, fmt.Errorf("invalid hangup request: %w", err)
 		}
-		return nil, uc.HangupCall(ctx, &in.HangupCallRequest{
+		if err := uc.HangupCall(ctx, &in.HangupCallRequest{
 			CallID:   req.CallID,
 			UserID:   userID,
 			DeviceID: deviceID,
-		})
+		}); err != nil {
+			return nil, err
+		}
+		// 返回结束后的通话状态（含质量汇总）
+		return uc.GetCallState(ctx, req.CallID)
 
 	case "offer":
 		var req in.SDPRequest
@@ -196,6 +224,15 @@ func (uc *SignalingUseCaseImpl) HandleSignaling(ctx context.Context, userID uint
 		req.DeviceID = deviceID
 		return nil, uc.SendIceCandidate(ctx, &req)
 
+	case "stats":
+		var req in.CallStatsRequest
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return nil, fmt.Errorf("invalid stats request: %w", err)
+		}
+		req.UserID = userID
+		req.DeviceID = deviceID
+		return nil, uc.ReportCallStats(ctx, &req)
+
 	case "get_state":
 		var req struct {
 			CallID string `json:"call_id"`
@@ -239,6 +276,7 @@ func (uc *SignalingUseCaseImpl) InitiateCall(ctx context.Context, req *in.CallRe
 		CallType:       req.CallType,
 		Status:         in.CallStatusInitiated,
 		StartedAt:      now,
+		quality:        call.NewQualityAggregator(),
 	}
 
 	uc.callSessions[callID] = session
@@ -358,8 +396,18 @@ func (uc *SignalingUseCaseImpl) HangupCall(ctx context.Context, req *in.HangupCa
 	}
 
 	session.mu.Lock()
+	if session.CallerID != req.UserID && session.CalleeID != req.UserID {
+		session.mu.Unlock()
+		return fmt.Errorf("user is not a participant of the call")
+	}
+	// 双方都可能发送挂断，重复挂断直接忽略
+	if session.isFinished() {
+		session.mu.Unlock()
+		return nil
+	}
 	session.Status = in.CallStatusEnded
 	session.EndedAt = time.Now().Unix()
+	summary := uc.finalizeQuality(session)
 	session.mu.Unlock()
 
 	// 确定对方用户
@@ -370,18 +418,23 @@ func (uc *SignalingUseCaseImpl) HangupCall(ctx context.Context, req *in.HangupCa
 		targetID = session.CallerID
 	}
 
-	// 通知对方通话已挂断
+	// 通知对方通话已挂断，附带质量汇总
+	var payload json.RawMessage
+	if summary != nil {
+		payload, _ = json.Marshal(map[string]interface{}{"quality": summary})
+	}
 	signalMsg := in.SignalingMessage{
 		Action:     "call_ended",
 		CallID:     req.CallID,
 		FromUser:   req.UserID,
 		FromDevice: req.DeviceID,
+		Payload:    payload,
 		Timestamp:  time.Now().Unix(),
 	}
 	uc.sendSignalingMessage(targetID, signalMsg)
 
-	// 清理会话
-	uc.cleanupSession(req.CallID)
+	// 释放双方的通话占用，会话保留一段时间供查询质量汇总
+	uc.releaseUsers(req.CallID)
 
 	return nil
 }
@@ -484,6 +537,43 @@ func (uc *SignalingUseCaseImpl) SendIceCandidate(ctx context.Context, req *in.IC
 	return nil
 }
 
+// ReportCallStats 上报通话质量统计
+func (uc *SignalingUseCaseImpl) ReportCallStats(ctx context.Context, req *in.CallStatsRequest) error {
+	session, err := uc.getSession(req.CallID)
+	if err != nil {
+		return err
+	}
+
+	sample := call.QualitySample{
+		RTTMs:         req.RTTMs,
+		JitterMs:      req.JitterMs,
+		PacketLoss:    req.PacketLoss,
+		BitrateKbps:   req.BitrateKbps,
+		CandidateType: req.CandidateType,
+	}
+
+	session.mu.RLock()
+	if session.CallerID != req.UserID && session.CalleeID != req.UserID {
+		session.mu.RUnlock()
+		return fmt.Errorf("user is not a participant of the call")
+	}
+	if session.Status != in.CallStatusAccepted && session.Status != in.CallStatusConnected {
+		session.mu.RUnlock()
+		return fmt.Errorf("call is not active")
+	}
+	callType := session.CallType
+	err = session.quality.Add(sample)
+	session.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	if uc.qualityRecorder != nil {
+		uc.qualityRecorder.RecordSample(string(callType), sample)
+	}
+	return nil
+}
+
 // GetCallState 获取通话状态
 func (uc *SignalingUseCaseImpl) GetCallState(ctx context.Context, callID string) (*in.CallState, error) {
 	session, err := uc.getSession(callID)
@@ -518,6 +608,20 @@ func (uc *SignalingUseCaseImpl) sendSignalingMessage(userID uint64, msg in.Signa
 	}
 }
 
+// finalizeQuality 生成通话质量汇总并上报指标（调用方需持有 session 写锁）
+func (uc *SignalingUseCaseImpl) finalizeQuality(session *CallSession) *call.QualitySummary {
+	if session.quality == nil || session.ConnectedAt == 0 {
+		return nil
+	}
+
+	summary := session.quality.Summary()
+	session.Quality = &summary
+	if uc.qualityRecorder != nil {
+		uc.qualityRecorder.RecordCallSummary(string(session.CallType), summary)
+	}
+	return session.Quality
+}
+
 func (uc *SignalingUseCaseImpl) cleanupSession(callID string) {
 	uc.mu.Lock()
 	defer uc.mu.Unlock()
@@ -527,11 +631,30 @@ func (uc *SignalingUseCaseImpl) cleanupSession(callID string) {
 		return
 	}
 
-	delete(uc.userCalls, session.CallerID)
-	delete(uc.userCalls, session.CalleeID)
+	uc.releaseUsersUnsafe(callID, session)
 	delete(uc.callSessions, callID)
 }
 
+// releaseUsers 释放双方的通话占用，但保留会话
+func (uc *SignalingUseCaseImpl) releaseUsers(callID string) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if session, ok := uc.callSessions[callID]; ok {
+		uc.releaseUsersUnsafe(callID, session)
+	}
+}
+
+// releaseUsersUnsafe 仅当用户当前通话仍是该通话时才释放（调用方需持有 uc.mu）
+func (uc *SignalingUseCaseImpl) releaseUsersUnsafe(callID string, session *CallSession) {
+	if uc.userCalls[session.CallerID] == callID {
+		delete(uc.userCalls, session.CallerID)
+	}
+	if uc.userCalls[session.CalleeID] == callID {
+		delete(uc.userCalls, session.CalleeID)
+	}
+}
+
 func (uc *SignalingUseCaseImpl) cleanupRoutine() {
 	ticker := time.NewTicker(10 * time.Second)
 	defer ticker.Stop()
@@ -586,12 +709,18 @@ func (uc *SignalingUseCaseImpl) cleanupTimeoutSessions() {
 				session.mu.Lock()
 				session.Status = in.CallStatusEnded
 				session.EndedAt = now
+				summary := uc.finalizeQuality(session)
 				session.mu.Unlock()
 
 				// 通知双方通话结束
+				var payload json.RawMessage
+				if summary != nil {
+					payload, _ = json.Marshal(map[string]interface{}{"quality": summary})
+				}
 				signalMsg := in.SignalingMessage{
 					Action:    "call_ended",
 					CallID:    callID,
+					Payload:   payload,
 					Timestamp: now,
 				}
 				go uc.sendSignalingMessage(session.CallerID, signalMsg)
@@ -603,9 +732,8 @@ func (uc *SignalingUseCaseImpl) cleanupTimeoutSessions() {
 		}
 
 		// 清理已结束的会话
-		if session.Status == in.CallStatusEnded || session.Status == in.CallStatusRejected ||
-			session.Status == in.CallStatusTimeout || session.Status == in.CallStatusCancelled {
-			if now-session.EndedAt > 60 { // 保留60秒后清理
+		if session.isFinished() {
+			if now-session.EndedAt > int64(endedSessionRetention.Seconds()) {
 				toCleanup = append(toCleanup, callID)
 			}
 		}
@@ -616,8 +744,7 @@ func (uc *SignalingUseCaseImpl) cleanupTimeoutSessions() {
 	// 执行清理
 	for _, callID := range toCleanup {
 		if session, ok := uc.callSessions[callID]; ok {
-			delete(uc.userCalls, session.CallerID)
-			delete(uc.userCalls, session.CalleeID)
+			uc.releaseUsersUnsafe(callID, session)
 			delete(uc.callSessions, callID)
 		}
 	}
@@ -0,0 +1,181 @@
+package call
+
+import (
+	"errors"
+	"sync"
+)
+
+// CandidatePairType ICE候选对类型（取本端选中候选的类型）
+type CandidatePairType string
+
+const (
+	CandidateHost  CandidatePairType = "host"  // 直连
+	CandidateSrflx CandidatePairType = "srflx" // STUN反射
+	CandidatePrflx CandidatePairType = "prflx" // 对端反射
+	CandidateRelay CandidatePairType = "relay" // TURN中继
+)
+
+// QualityLevel 通话质量等级
+type QualityLevel string
+
+const (
+	QualityExcellent QualityLevel = "excellent"
+	QualityGood      QualityLevel = "good"
+	QualityFair      QualityLevel = "fair"
+	QualityPoor      QualityLevel = "poor"
+	QualityUnknown   QualityLevel = "unknown" // 无上报数据
+)
+
+var ErrInvalidQualitySample = errors.New("invalid call quality sample")
+
+// QualitySample 客户端周期性上报的WebRTC统计快照
+type QualitySample struct {
+	RTTMs         float64           // 往返时延（毫秒）
+	JitterMs      float64           // 抖动（毫秒）
+	PacketLoss    float64           // 丢包率 [0, 1]
+	BitrateKbps   float64           // 码率（kbps）
+	CandidateType CandidatePairType // 选中的候选对类型
+}
+
+// Validate 校验上报数据的取值范围
+func (s QualitySample) Validate() error {
+	if s.RTTMs < 0 || s.JitterMs < 0 || s.BitrateKbps < 0 {
+		return ErrInvalidQualitySample
+	}
+	if s.PacketLoss < 0 || s.PacketLoss > 1 {
+		return ErrInvalidQualitySample
+	}
+	switch s.CandidateType {
+	case "", CandidateHost, CandidateSrflx, CandidatePrflx, CandidateRelay:
+		return nil
+	default:
+		return ErrInvalidQualitySample
+	}
+}
+
+// QualitySummary 通话质量汇总
+type QualitySummary struct {
+	Samples        int          `json:"samples"`
+	AvgRTTMs       float64      `json:"avg_rtt_ms"`
+	MaxRTTMs       float64      `json:"max_rtt_ms"`
+	AvgJitterMs    float64      `json:"avg_jitter_ms"`
+	AvgPacketLoss  float64      `json:"avg_packet_loss"`
+	MaxPacketLoss  float64      `json:"max_packet_loss"`
+	AvgBitrateKbps float64      `json:"avg_bitrate_kbps"`
+	RelayRatio     float64      `json:"relay_ratio"` // 经TURN中继的样本占比
+	MOS            float64      `json:"mos"`         // 估算的平均意见分 [1, 4.5]
+	Level          QualityLevel `json:"level"`
+}
+
+// QualityAggregator 按通话聚合质量样本（双方上报合并统计）
+type QualityAggregator struct {
+	mu            sync.Mutex
+	samples       int
+	relaySamples  int
+	sumRTT        float64
+	maxRTT        float64
+	sumJitter     float64
+	sumLoss       float64
+	maxLoss       float64
+	sumBitrate    float64
+	bitrateCounts int
+}
+
+// NewQualityAggregator 创建聚合器
+func NewQualityAggregator() *QualityAggregator {
+	return &QualityAggregator{}
+}
+
+// Add 加入一条样本
+func (a *QualityAggregator) Add(s QualitySample) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.samples++
+	a.sumRTT += s.RTTMs
+	a.sumJitter += s.JitterMs
+	a.sumLoss += s.PacketLoss
+	if s.RTTMs > a.maxRTT {
+		a.maxRTT = s.RTTMs
+	}
+	if s.PacketLoss > a.maxLoss {
+		a.maxLoss = s.PacketLoss
+	}
+	// 码率为0通常表示该端未发送媒体（如静音），不计入平均
+	if s.BitrateKbps > 0 {
+		a.sumBitrate += s.BitrateKbps
+		a.bitrateCounts++
+	}
+	if s.CandidateType == CandidateRelay {
+		a.relaySamples++
+	}
+	return nil
+}
+
+// Summary 生成当前汇总
+func (a *QualityAggregator) Summary() QualitySummary {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.samples == 0 {
+		return QualitySummary{Level: QualityUnknown}
+	}
+
+	n := float64(a.samples)
+	summary := QualitySummary{
+		Samples:       a.samples,
+		AvgRTTMs:      a.sumRTT / n,
+		MaxRTTMs:      a.maxRTT,
+		AvgJitterMs:   a.sumJitter / n,
+		AvgPacketLoss: a.sumLoss / n,
+		MaxPacketLoss: a.maxLoss,
+		RelayRatio:    float64(a.relaySamples) / n,
+	}
+	if a.bitrateCounts > 0 {
+		summary.AvgBitrateKbps = a.sumBitrate / float64(a.bitrateCounts)
+	}
+	summary.MOS = EstimateMOS(summary.AvgRTTMs, summary.AvgJitterMs, summary.AvgPacketLoss)
+	summary.Level = LevelFromMOS(summary.MOS)
+	return summary
+}
+
+// EstimateMOS 使用简化的 E-Model 由时延、抖动和丢包估算 MOS
+func EstimateMOS(rttMs, jitterMs, packetLoss float64) float64 {
+	// 单向有效时延：RTT的一半加上抖动缓冲，再加编解码固定开销
+	effectiveLatency := rttMs/2 + jitterMs*2 + 10
+
+	var r float64
+	if effectiveLatency < 160 {
+		r = 93.2 - effectiveLatency/40
+	} else {
+		r = 93.2 - (effectiveLatency-120)/10
+	}
+	r -= packetLoss * 100 * 2.5
+
+	if r < 0 {
+		r = 0
+	}
+	if r > 100 {
+		r = 100
+	}
+
+	return 1 + 0.035*r + 0.000007*r*(r-60)*(100-r)
+}
+
+// LevelFromMOS MOS分映射到质量等级
+func LevelFromMOS(mos float64) QualityLevel {
+	switch {
+	case mos >= 4.0:
+		return QualityExcellent
+	case mos >= 3.6:
+		return QualityGood
+	case mos >= 3.1:
+		return QualityFair
+	default:
+		return QualityPoor
+	}
+}
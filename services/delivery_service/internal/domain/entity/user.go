@@ -56,6 +56,14 @@ type MessageEvent struct {
 	ContentType    int8      `json:"content_type"`
 	Content        string    `json:"content"`
 	CreatedAt      time.Time `json:"created_at"`
+	// MutedReceiverIDs 开启免打扰的接收者，不发送离线推送通知
+	MutedReceiverIDs []uint64 `json:"muted_receiver_ids,omitempty"`
+	// Channel 频道消息（读扩散），接收者离线时不入离线队列，上线后从 Timeline 拉取
+	Channel bool `json:"channel,omitempty"`
+	// SenderName 发送者在会话中的展示名（群昵称优先），可能为空
+	SenderName string `json:"sender_name,omitempty"`
+	// ExpiresAt 消息自动删除时间（Unix秒），0表示不会过期
+	ExpiresAt int64 `json:"expires_at,omitempty"`
 }
 
 // PushNotification 推送通知
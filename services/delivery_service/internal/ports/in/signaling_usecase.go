@@ -3,6 +3,8 @@ package in
 import (
 	"context"
 	"encoding/json"
+
+	"github.com/EthanQC/IM/services/delivery_service/internal/domain/call"
 )
 
 // SignalingUseCase WebRTC信令用例接口
@@ -31,6 +33,9 @@ type SignalingUseCase interface {
 	// SendIceCandidate 发送ICE候选
 	SendIceCandidate(ctx context.Context, req *ICECandidateRequest) error
 
+	// ReportCallStats 上报通话质量统计
+	ReportCallStats(ctx context.Context, req *CallStatsRequest) error
+
 	// GetCallState 获取通话状态
 	GetCallState(ctx context.Context, callID string) (*CallState, error)
 }
@@ -125,6 +130,18 @@ type ICECandidateRequest struct {
 	SDPMLineIndex int    `json:"sdp_mline_index"`
 }
 
+// CallStatsRequest 通话质量统计上报请求（客户端周期性上报WebRTC getStats结果）
+type CallStatsRequest struct {
+	CallID        string                 `json:"call_id"`
+	UserID        uint64                 `json:"user_id"`
+	DeviceID      string                 `json:"device_id"`
+	RTTMs         float64                `json:"rtt_ms"`
+	JitterMs      float64                `json:"jitter_ms"`
+	PacketLoss    float64                `json:"packet_loss"` // 丢包率 [0, 1]
+	BitrateKbps   float64                `json:"bitrate_kbps"`
+	CandidateType call.CandidatePairType `json:"candidate_type"` // host, srflx, prflx, relay
+}
+
 // CallState 通话状态
 type CallState struct {
 	CallID         string     `json:"call_id"`
@@ -137,6 +154,9 @@ type CallState struct {
 	ConnectedAt    int64      `json:"connected_at,omitempty"`
 	EndedAt        int64      `json:"ended_at,omitempty"`
 	Duration       int64      `json:"duration,omitempty"` // 通话时长（秒）
+
+	// Quality 通话质量汇总，仅在通话结束后返回
+	Quality *call.QualitySummary `json:"quality,omitempty"`
 }
 
 // SignalingMessage 信令消息（用于推送给对方）
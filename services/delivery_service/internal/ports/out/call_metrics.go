@@ -0,0 +1,11 @@
+package out
+
+import "github.com/EthanQC/IM/services/delivery_service/internal/domain/call"
+
+// CallQualityRecorder 通话质量指标记录接口
+type CallQualityRecorder interface {
+	// RecordSample 记录单条质量样本
+	RecordSample(callType string, sample call.QualitySample)
+	// RecordCallSummary 记录通话结束时的质量汇总
+	RecordCallSummary(callType string, summary call.QualitySummary)
+}
@@ -8,12 +8,16 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	pb "github.com/EthanQC/IM/api/gen/im/v1"
 	"github.com/EthanQC/IM/services/file_service/internal/domain/entity"
 	"github.com/EthanQC/IM/services/file_service/internal/ports/in"
 )
 
+// maxDeleteObjects 单次删除的对象数上限
+const maxDeleteObjects = 500
+
 // FileServer gRPC文件服务
 type FileServer struct {
 	pb.UnimplementedFileServiceServer
@@ -130,6 +134,20 @@ func (s *FileServer) CompleteUpload(ctx context.Context, req *pb.CompleteUploadR
 	}, nil
 }
 
+// DeleteObjects 删除过期消息引用的文件对象（内部接口）
+func (s *FileServer) DeleteObjects(ctx context.Context, req *pb.DeleteObjectsRequest) (*emptypb.Empty, error) {
+	if req.OwnerId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "owner_id is required")
+	}
+	if len(req.ObjectKeys) > maxDeleteObjects {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d object keys per request", maxDeleteObjects)
+	}
+	if err := s.fileUseCase.DeleteObjects(ctx, uint64(req.OwnerId), req.ObjectKeys); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func buildMessageBody(file *entity.FileUpload) (pb.MessageContentType, *pb.MessageBody) {
 	media := &pb.MediaRef{
 		ObjectKey:    file.ObjectKey,
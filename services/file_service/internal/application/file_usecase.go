@@ -188,6 +188,31 @@ func (uc *FileUseCaseImpl) DeleteFile(ctx context.Context, userID, fileID uint64
 	return uc.fileRepo.UpdateStatus(ctx, fileID, entity.FileStatusDeleted)
 }
 
+// DeleteObjects 按ObjectKey删除 ownerID 上传的文件
+// ObjectKey来自客户端消息内容，只删除上传记录属于 ownerID 的对象（缩略图同样需有自己的上传记录）
+// 没有上传记录或属于其他用户的对象一律跳过，避免借消息删除他人文件
+func (uc *FileUseCaseImpl) DeleteObjects(ctx context.Context, ownerID uint64, objectKeys []string) error {
+	for _, key := range objectKeys {
+		if key == "" {
+			continue
+		}
+		file, err := uc.fileRepo.GetByObjectKey(ctx, key)
+		if err != nil {
+			return fmt.Errorf("get file %s: %w", key, err)
+		}
+		if file == nil || file.UserID != ownerID || file.Status == entity.FileStatusDeleted {
+			continue
+		}
+		if err := uc.objectStorage.Delete(ctx, file.Bucket, file.ObjectKey); err != nil {
+			return fmt.Errorf("delete object %s: %w", key, err)
+		}
+		if err := uc.fileRepo.UpdateStatus(ctx, file.ID, entity.FileStatusDeleted); err != nil {
+			return fmt.Errorf("update file status: %w", err)
+		}
+	}
+	return nil
+}
+
 // validateFileSize 验证文件大小
 func (uc *FileUseCaseImpl) validateFileSize(kind entity.FileKind, size int64) error {
 	var maxSize int64
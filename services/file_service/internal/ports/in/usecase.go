@@ -18,6 +18,8 @@ type FileUseCase interface {
 	GetDownloadURL(ctx context.Context, id uint64) (string, error)
 	// DeleteFile 删除文件
 	DeleteFile(ctx context.Context, userID, fileID uint64) error
+	// DeleteObjects 按ObjectKey删除 ownerID 上传的文件（内部清理用）
+	DeleteObjects(ctx context.Context, ownerID uint64, objectKeys []string) error
 }
 
 // CreateUploadInput 创建上传输入
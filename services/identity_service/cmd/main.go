@@ -17,14 +17,29 @@ import (
 	mysqlDriver "gorm.io/driver/mysql"
 	"gorm.io/gorm"
 
+	"github.com/EthanQC/IM/pkg/authn"
 	"github.com/EthanQC/IM/pkg/zlog"
 	grpcAdapter "github.com/EthanQC/IM/services/identity_service/internal/adapters/in/gRPC"
 	httpAdapter "github.com/EthanQC/IM/services/identity_service/internal/adapters/in/http"
+	"github.com/EthanQC/IM/services/identity_service/internal/adapters/in/scheduler"
 	aliyunSms "github.com/EthanQC/IM/services/identity_service/internal/adapters/out/aliyun"
+	"github.com/EthanQC/IM/services/identity_service/internal/adapters/out/captcha"
+	kafkaPub "github.com/EthanQC/IM/services/identity_service/internal/adapters/out/kafka"
+	"github.com/EthanQC/IM/services/identity_service/internal/adapters/out/kek"
 	mysqlRepo "github.com/EthanQC/IM/services/identity_service/internal/adapters/out/mysql"
+	oidcClient "github.com/EthanQC/IM/services/identity_service/internal/adapters/out/oidc"
 	redisRepo "github.com/EthanQC/IM/services/identity_service/internal/adapters/out/redis"
+	smtpMail "github.com/EthanQC/IM/services/identity_service/internal/adapters/out/smtp"
 	authApp "github.com/EthanQC/IM/services/identity_service/internal/application/auth"
 	contactApp "github.com/EthanQC/IM/services/identity_service/internal/application/contact"
+	discoveryApp "github.com/EthanQC/IM/services/identity_service/internal/application/discovery"
+	loginGuardApp "github.com/EthanQC/IM/services/identity_service/internal/application/loginguard"
+	mfaApp "github.com/EthanQC/IM/services/identity_service/internal/application/mfa"
+	oidcApp "github.com/EthanQC/IM/services/identity_service/internal/application/oidc"
+	passwordApp "github.com/EthanQC/IM/services/identity_service/internal/application/password"
+	rbacApp "github.com/EthanQC/IM/services/identity_service/internal/application/rbac"
+	sessionApp "github.com/EthanQC/IM/services/identity_service/internal/application/session"
+	keyApp "github.com/EthanQC/IM/services/identity_service/internal/application/signingkey"
 	smsApp "github.com/EthanQC/IM/services/identity_service/internal/application/sms"
 	statusApp "github.com/EthanQC/IM/services/identity_service/internal/application/status"
 	userApp "github.com/EthanQC/IM/services/identity_service/internal/application/user"
@@ -42,6 +57,18 @@ type Config struct {
 		Secret     string        `mapstructure:"secret"`
 		AccessTTL  time.Duration `mapstructure:"access_ttl"`
 		RefreshTTL time.Duration `mapstructure:"refresh_ttl"`
+		// Algorithm 为 RS256/EdDSA 时使用轮换密钥签发；HS256 沿用 Secret
+		Algorithm        string        `mapstructure:"algorithm"`
+		AcceptLegacy     bool          `mapstructure:"accept_legacy_hs256"`
+		LegacySunset     string        `mapstructure:"legacy_hs256_sunset"`
+		RotationInterval time.Duration `mapstructure:"rotation_interval"`
+		ActivationDelay  time.Duration `mapstructure:"key_activation_delay"`
+		KeySyncInterval  time.Duration `mapstructure:"key_sync_interval"`
+		// SigningKEK 加密入库签名私钥的密钥，keys 为 id -> base64 编码的 32 字节 AES 密钥
+		SigningKEK struct {
+			Current string            `mapstructure:"current"`
+			Keys    map[string]string `mapstructure:"keys"`
+		} `mapstructure:"signing_kek"`
 	} `mapstructure:"jwt"`
 	Redis struct {
 		Addr     string `mapstructure:"addr"`
@@ -52,9 +79,23 @@ type Config struct {
 		DSN string `mapstructure:"dsn"`
 	} `mapstructure:"mysql"`
 	Kafka struct {
-		Brokers []string `mapstructure:"brokers"`
-		Topic   string   `mapstructure:"topic"`
+		Brokers   []string `mapstructure:"brokers"`
+		Topic     string   `mapstructure:"topic"`
+		AuthTopic string   `mapstructure:"auth_topic"`
 	} `mapstructure:"kafka"`
+	MFA struct {
+		// Issuer 验证器应用中显示的服务名
+		Issuer       string        `mapstructure:"issuer"`
+		ChallengeTTL time.Duration `mapstructure:"challenge_ttl"`
+		StepUpTTL    time.Duration `mapstructure:"step_up_ttl"`
+		// LockAfter 账号在 LockWindow 内二次验证失败达到该次数后锁定二次验证
+		LockAfter  int           `mapstructure:"lock_after"`
+		LockWindow time.Duration `mapstructure:"lock_window"`
+	} `mapstructure:"mfa"`
+	RBAC struct {
+		// BootstrapAdmins 启动时授予 admin 角色的用户ID，用于初始化第一个管理员
+		BootstrapAdmins []uint64 `mapstructure:"bootstrap_admins"`
+	} `mapstructure:"rbac"`
 	Code struct {
 		TTL         time.Duration `mapstructure:"ttl"`
 		MaxAttempts int           `mapstructure:"max_attempts"`
@@ -66,6 +107,73 @@ type Config struct {
 		SignName        string `mapstructure:"sign_name"`
 		TemplateCode    string `mapstructure:"template_code"`
 	} `mapstructure:"sms"`
+	Email struct {
+		// Host 为空时不支持通过邮箱找回密码
+		Host     string `mapstructure:"host"`
+		Port     int    `mapstructure:"port"`
+		Username string `mapstructure:"username"`
+		Password string `mapstructure:"password"`
+		From     string `mapstructure:"from"`
+		Subject  string `mapstructure:"subject"`
+	} `mapstructure:"email"`
+	PasswordReset struct {
+		CodeTTL     time.Duration `mapstructure:"code_ttl"`
+		TokenTTL    time.Duration `mapstructure:"token_ttl"`
+		MaxAttempts int           `mapstructure:"max_attempts"`
+		// LimitWindow 内每个账号、每个 IP 的请求上限
+		AccountLimit int           `mapstructure:"account_limit"`
+		IPLimit      int           `mapstructure:"ip_limit"`
+		LimitWindow  time.Duration `mapstructure:"limit_window"`
+	} `mapstructure:"password_reset"`
+	LoginGuard struct {
+		// Window 登录失败计数的滑动窗口，各阈值为 0 时不启用对应策略
+		Window         time.Duration `mapstructure:"window"`
+		DelayAfter     int           `mapstructure:"delay_after"`
+		BaseDelay      time.Duration `mapstructure:"base_delay"`
+		MaxDelay       time.Duration `mapstructure:"max_delay"`
+		CaptchaAfter   int           `mapstructure:"captcha_after"`
+		IPCaptchaAfter int           `mapstructure:"ip_captcha_after"`
+		LockAfter      int           `mapstructure:"lock_after"`
+		LockDuration   time.Duration `mapstructure:"lock_duration"`
+		IPLimit        int           `mapstructure:"ip_limit"`
+		// IPAccountsAlert 同一 IP 尝试的不同账号数达到该值时发出安全审计事件
+		IPAccountsAlert int `mapstructure:"ip_accounts_alert"`
+	} `mapstructure:"login_guard"`
+	Captcha struct {
+		// VerifyURL 为空时不要求人机验证
+		VerifyURL string `mapstructure:"verify_url"`
+		Secret    string `mapstructure:"secret"`
+	} `mapstructure:"captcha"`
+	Discovery struct {
+		// LimitWindow 内每个用户最多 SearchLimit 次搜索或名片码查询
+		SearchLimit int           `mapstructure:"search_limit"`
+		LimitWindow time.Duration `mapstructure:"limit_window"`
+		// ShareURL 名片分享链接前缀，名片码追加在末尾
+		ShareURL string `mapstructure:"share_url"`
+	} `mapstructure:"discovery"`
+	OIDC struct {
+		StateTTL  time.Duration        `mapstructure:"state_ttl"`
+		Providers []OIDCProviderConfig `mapstructure:"providers"`
+	} `mapstructure:"oidc"`
+}
+
+// OIDCProviderConfig 单个第三方登录提供方；端点留空时通过 issuer 自动发现
+type OIDCProviderConfig struct {
+	Name         string   `mapstructure:"name"`
+	DisplayName  string   `mapstructure:"display_name"`
+	Issuer       string   `mapstructure:"issuer"`
+	ClientID     string   `mapstructure:"client_id"`
+	ClientSecret string   `mapstructure:"client_secret"`
+	RedirectURL  string   `mapstructure:"redirect_url"`
+	Scopes       []string `mapstructure:"scopes"`
+	AuthURL      string   `mapstructure:"auth_url"`
+	TokenURL     string   `mapstructure:"token_url"`
+	JWKSURL      string   `mapstructure:"jwks_url"`
+	// 账号绑定与开户策略
+	LinkByEmail    bool     `mapstructure:"link_by_email"`
+	LinkByPhone    bool     `mapstructure:"link_by_phone"`
+	AutoProvision  bool     `mapstructure:"auto_provision"`
+	AllowedDomains []string `mapstructure:"allowed_domains"`
 }
 
 func main() {
@@ -79,6 +187,39 @@ func main() {
 	viper.AddConfigPath("./configs")
 	viper.AddConfigPath("../configs")
 	viper.SetDefault("server.grpc_port", 9080)
+	viper.SetDefault("kafka.auth_topic", "im.auth.events")
+	viper.SetDefault("jwt.algorithm", jwt.AlgRS256)
+	viper.SetDefault("jwt.accept_legacy_hs256", false)
+	viper.SetDefault("jwt.legacy_hs256_sunset", authn.DefaultLegacyHS256Sunset)
+	viper.SetDefault("jwt.rotation_interval", "720h")
+	viper.SetDefault("jwt.key_activation_delay", "10m")
+	viper.SetDefault("jwt.key_sync_interval", "1m")
+	viper.SetDefault("mfa.issuer", "IM")
+	viper.SetDefault("mfa.challenge_ttl", "5m")
+	viper.SetDefault("mfa.step_up_ttl", "5m")
+	viper.SetDefault("mfa.lock_after", 10)
+	viper.SetDefault("mfa.lock_window", "15m")
+	viper.SetDefault("email.port", 587)
+	viper.SetDefault("email.subject", "IM 验证码")
+	viper.SetDefault("password_reset.code_ttl", "10m")
+	viper.SetDefault("password_reset.token_ttl", "15m")
+	viper.SetDefault("password_reset.max_attempts", 5)
+	viper.SetDefault("password_reset.account_limit", 5)
+	viper.SetDefault("password_reset.ip_limit", 20)
+	viper.SetDefault("password_reset.limit_window", "1h")
+	viper.SetDefault("oidc.state_ttl", "10m")
+	viper.SetDefault("discovery.search_limit", 30)
+	viper.SetDefault("discovery.limit_window", "1h")
+	viper.SetDefault("login_guard.window", "15m")
+	viper.SetDefault("login_guard.delay_after", 3)
+	viper.SetDefault("login_guard.base_delay", "500ms")
+	viper.SetDefault("login_guard.max_delay", "3s")
+	viper.SetDefault("login_guard.captcha_after", 5)
+	viper.SetDefault("login_guard.ip_captcha_after", 20)
+	viper.SetDefault("login_guard.lock_after", 10)
+	viper.SetDefault("login_guard.lock_duration", "15m")
+	viper.SetDefault("login_guard.ip_limit", 100)
+	viper.SetDefault("login_guard.ip_accounts_alert", 10)
 	if err := viper.ReadInConfig(); err != nil {
 		fmt.Fprintf(os.Stderr, "读取配置文件失败: %v\n", err)
 		os.Exit(1)
@@ -118,8 +259,8 @@ func main() {
 		logger.Fatal("连接 MySQL 失败", zap.Error(err))
 	}
 	if cfg.Server.Mode != "release" {
-		if err := db.AutoMigrate(&mysqlRepo.RefreshTokenModel{}); err != nil {
-			logger.Fatal("初始化 refresh_tokens 表失败", zap.Error(err))
+		if err := db.AutoMigrate(&mysqlRepo.RefreshTokenModel{}, &mysqlRepo.SigningKeyModel{}, &mysqlRepo.RoleModel{}, &mysqlRepo.UserRoleModel{}, &mysqlRepo.SessionModel{}, &mysqlRepo.UserMFAModel{}, &mysqlRepo.MFARecoveryCodeModel{}, &mysqlRepo.UserIdentityModel{}, &mysqlRepo.UserPrivacyModel{}); err != nil {
+			logger.Fatal("初始化 refresh_tokens/signing_keys/roles/user_sessions/user_mfa/user_mfa_recovery_codes/user_identities/user_privacy 表失败", zap.Error(err))
 		}
 	}
 	logger.Info("MySQL 连接成功")
@@ -132,8 +273,46 @@ func main() {
 	}
 	logger.Info("Redis 连接成功")
 
-	// JWT 管理器
-	jwtMgr := jwt.NewManager(cfg.JWT.Secret)
+	// JWT 管理器：非对称算法使用轮换密钥，过渡期内仍接受旧的 HS256 令牌
+	var (
+		jwtMgr jwt.Manager
+		keyUC  *keyApp.KeyRotationUseCase
+	)
+	if jwt.IsSupportedAlgorithm(cfg.JWT.Algorithm) {
+		keyCipher, err := kek.NewAESGCMCipher(cfg.JWT.SigningKEK.Current, cfg.JWT.SigningKEK.Keys)
+		if err != nil {
+			logger.Fatal("初始化签名密钥 KEK 失败", zap.Error(err))
+		}
+		keyRing := jwt.NewKeyRing()
+		keyUC = keyApp.NewKeyRotationUseCase(mysqlRepo.NewSigningKeyRepoMysql(db), keyCipher, keyRing, keyApp.Config{
+			Algorithm:        cfg.JWT.Algorithm,
+			RotationInterval: cfg.JWT.RotationInterval,
+			ActivationDelay:  cfg.JWT.ActivationDelay,
+			RetireGrace:      max(cfg.JWT.AccessTTL, cfg.JWT.RefreshTTL),
+		})
+		if err := keyUC.Sync(ctx); err != nil {
+			logger.Fatal("初始化签名密钥失败", zap.Error(err))
+		}
+		keyRotator := scheduler.NewKeyRotator(keyUC, cfg.JWT.KeySyncInterval)
+		if err := keyRotator.Start(); err != nil {
+			logger.Fatal("启动密钥轮换失败", zap.Error(err))
+		}
+		defer keyRotator.Stop()
+
+		legacySecret := ""
+		acceptLegacy, err := authn.AcceptLegacyHS256(cfg.JWT.AcceptLegacy, cfg.JWT.LegacySunset, time.Now())
+		if err != nil {
+			logger.Warn("不再接受 HS256 旧令牌", zap.Error(err))
+		} else if cfg.JWT.AcceptLegacy && !acceptLegacy {
+			logger.Warn("HS256 兼容已过移除日期，请删除 accept_legacy_hs256 与 jwt.secret")
+		}
+		if acceptLegacy {
+			legacySecret = cfg.JWT.Secret
+		}
+		jwtMgr = jwt.NewKeyRingManager(keyRing, legacySecret)
+	} else {
+		jwtMgr = jwt.NewManager(cfg.JWT.Secret)
+	}
 
 	// 仓库
 	authCodeRepo := redisRepo.NewAuthCodeRepoRedis(rdb, cfg.Code.TTL)
@@ -144,10 +323,35 @@ func main() {
 	contactRepo := mysqlRepo.NewContactRepositoryMySQL(db)
 	contactApplyRepo := mysqlRepo.NewContactApplyRepositoryMySQL(db)
 	blacklistRepo := mysqlRepo.NewBlacklistRepositoryMySQL(db)
+	roleRepo := mysqlRepo.NewRoleRepoMysql(db)
+	sessionRepo := mysqlRepo.NewSessionRepoMysql(db)
+	mfaRepo := mysqlRepo.NewMFARepoMysql(db)
+	mfaStateRepo := redisRepo.NewMFAStateRepoRedis(rdb)
+	resetCodeRepo := redisRepo.NewAuthCodeRepoRedisWithPrefix(rdb, cfg.PasswordReset.CodeTTL, "password_reset_code")
+	resetTokenRepo := redisRepo.NewPasswordResetRepoRedis(rdb)
+	rateLimiter := redisRepo.NewRateLimiterRedis(rdb)
+	identityRepo := mysqlRepo.NewUserIdentityRepoMysql(db)
+	loginWindow := redisRepo.NewSlidingWindowRedis(rdb)
+	privacyRepo := mysqlRepo.NewUserPrivacyRepoMysql(db)
+	oidcStateRepo := redisRepo.NewOIDCStateRepoRedis(rdb)
+
+	// 角色权限：写入系统角色并初始化管理员
+	rbacUC := rbacApp.NewRBACUseCase(roleRepo, userRepo)
+	if err := rbacUC.EnsureSystemRoles(ctx); err != nil {
+		logger.Fatal("初始化系统角色失败", zap.Error(err))
+	}
+	if err := rbacUC.BootstrapAdmins(ctx, cfg.RBAC.BootstrapAdmins); err != nil {
+		logger.Fatal("初始化管理员失败", zap.Error(err))
+	}
 
 	// 用户用例
 	userUC := userApp.NewUserUseCaseImpl(userRepo, jwtMgr, nil)
 	contactUC := contactApp.NewContactUseCaseImpl(contactRepo, contactApplyRepo, blacklistRepo, userRepo)
+	discoveryUC := discoveryApp.NewDiscoveryUseCase(userRepo, privacyRepo, blacklistRepo, rateLimiter, discoveryApp.Config{
+		SearchLimit: cfg.Discovery.SearchLimit,
+		LimitWindow: cfg.Discovery.LimitWindow,
+		ShareURL:    cfg.Discovery.ShareURL,
+	})
 
 	// 短信服务用例
 	smsClient, _ := aliyunSms.NewAliyunSMSClient(
@@ -160,10 +364,14 @@ func main() {
 	smsVerifyUC := smsApp.NewVerifyCodeUseCase(authCodeRepo, cfg.Code.MaxAttempts)
 	smsSendUC := smsApp.NewSendCodeUseCase(authCodeRepo, smsClient, cfg.Code.TTL)
 
-	// Kafka 发布者（预留事件用）
-	writer := kafka.NewWriter(kafka.WriterConfig{Brokers: cfg.Kafka.Brokers, Topic: cfg.Kafka.Topic})
+	// Kafka 发布者：不绑定 Topic，由每条消息指定
+	writer := &kafka.Writer{
+		Addr:                   kafka.TCP(cfg.Kafka.Brokers...),
+		Balancer:               &kafka.Hash{},
+		AllowAutoTopicCreation: true,
+	}
 	defer writer.Close()
-	_ = writer // 仅示意，事件发布后续接入
+	eventPublisher := kafkaPub.NewKafkaPublisher(writer)
 
 	// 认证用例
 	genUC := authApp.NewGenerateTokenUseCase(
@@ -180,8 +388,26 @@ func main() {
 		cfg.JWT.AccessTTL,
 		cfg.JWT.RefreshTTL,
 	)
+	genUC.SetGrantResolver(rbacUC)
+	refreshUC.SetGrantResolver(rbacUC)
 	revokeUC := authApp.NewRevokeTokenUseCase(accessTokenRepo, refreshTokenRepo)
 	statusUC := statusApp.NewCheckUserStatusUseCase(userStatusRepo)
+	sessionUC := sessionApp.NewSessionUseCase(
+		sessionRepo,
+		refreshTokenRepo,
+		accessTokenRepo,
+		cfg.JWT.AccessTTL,
+		cfg.JWT.RefreshTTL,
+	)
+	refreshUC.SetSessionTracker(sessionUC)
+	refreshUC.SetRevoker(revokeUC)
+	if len(cfg.Kafka.Brokers) > 0 {
+		revokeUC.SetEventPublisher(eventPublisher, cfg.Kafka.AuthTopic)
+		statusUC.SetEventPublisher(eventPublisher, cfg.Kafka.AuthTopic)
+		sessionUC.SetEventPublisher(eventPublisher, cfg.Kafka.AuthTopic)
+		// 展示名变更需通知 message_service 更新发送者名称缓存
+		userUC.SetEventPublisher(eventPublisher)
+	}
 	authUC := authApp.NewDefaultAuthUseCase(
 		genUC,
 		refreshUC,
@@ -190,12 +416,91 @@ func main() {
 		smsVerifyUC,
 		userRepo,
 	)
+	authUC.SetSessionTracker(sessionUC)
+	mfaUC := mfaApp.NewMFAUseCase(mfaRepo, mfaStateRepo, loginWindow, mfaApp.Config{
+		Issuer:       cfg.MFA.Issuer,
+		ChallengeTTL: cfg.MFA.ChallengeTTL,
+		StepUpTTL:    cfg.MFA.StepUpTTL,
+		LockAfter:    cfg.MFA.LockAfter,
+		LockWindow:   cfg.MFA.LockWindow,
+	})
+	authUC.SetMFAGate(mfaUC)
+
+	// 密码登录防暴力破解
+	loginGuard := loginGuardApp.NewLoginGuard(loginWindow, rateLimiter, redisRepo.NewTemporaryLockRedis(rdb), statusUC, loginGuardApp.Config{
+		Window:          cfg.LoginGuard.Window,
+		DelayAfter:      cfg.LoginGuard.DelayAfter,
+		BaseDelay:       cfg.LoginGuard.BaseDelay,
+		MaxDelay:        cfg.LoginGuard.MaxDelay,
+		CaptchaAfter:    cfg.LoginGuard.CaptchaAfter,
+		IPCaptchaAfter:  cfg.LoginGuard.IPCaptchaAfter,
+		LockAfter:       cfg.LoginGuard.LockAfter,
+		LockDuration:    cfg.LoginGuard.LockDuration,
+		IPLimit:         cfg.LoginGuard.IPLimit,
+		IPAccountsAlert: cfg.LoginGuard.IPAccountsAlert,
+	})
+	if cfg.Captcha.VerifyURL != "" {
+		loginGuard.SetCaptchaVerifier(captcha.NewSiteVerifyClient(cfg.Captcha.VerifyURL, cfg.Captcha.Secret))
+	}
+	if len(cfg.Kafka.Brokers) > 0 {
+		loginGuard.SetEventPublisher(eventPublisher, cfg.Kafka.AuthTopic)
+	}
+	authUC.SetLoginGuard(loginGuard)
+
+	// 修改/找回密码
+	passwordUC := passwordApp.NewPasswordUseCase(userRepo, resetCodeRepo, resetTokenRepo, rateLimiter, sessionUC, passwordApp.Config{
+		CodeTTL:      cfg.PasswordReset.CodeTTL,
+		TokenTTL:     cfg.PasswordReset.TokenTTL,
+		MaxAttempts:  cfg.PasswordReset.MaxAttempts,
+		AccountLimit: cfg.PasswordReset.AccountLimit,
+		IPLimit:      cfg.PasswordReset.IPLimit,
+		LimitWindow:  cfg.PasswordReset.LimitWindow,
+	})
+	if smsClient != nil {
+		passwordUC.SetSMSClient(smsClient)
+	}
+	if cfg.Email.Host != "" {
+		passwordUC.SetEmailClient(smtpMail.NewSMTPEmailClient(
+			cfg.Email.Host,
+			cfg.Email.Port,
+			cfg.Email.Username,
+			cfg.Email.Password,
+			cfg.Email.From,
+			cfg.Email.Subject,
+		))
+	}
+
+	// 第三方 / 企业 OIDC 登录
+	oidcUC := oidcApp.NewOIDCUseCase(oidcStateRepo, identityRepo, userRepo, authUC, cfg.OIDC.StateTTL)
+	for _, p := range cfg.OIDC.Providers {
+		oidcUC.Register(oidcClient.NewProvider(oidcClient.Config{
+			Name:         p.Name,
+			DisplayName:  p.DisplayName,
+			Issuer:       p.Issuer,
+			ClientID:     p.ClientID,
+			ClientSecret: p.ClientSecret,
+			RedirectURL:  p.RedirectURL,
+			Scopes:       p.Scopes,
+			AuthURL:      p.AuthURL,
+			TokenURL:     p.TokenURL,
+			JWKSURL:      p.JWKSURL,
+		}), oidcApp.Policy{
+			LinkByEmail:    p.LinkByEmail,
+			LinkByPhone:    p.LinkByPhone,
+			AutoProvision:  p.AutoProvision,
+			AllowedDomains: p.AllowedDomains,
+		})
+		logger.Info("注册 OIDC 提供方", zap.String("name", p.Name), zap.String("issuer", p.Issuer))
+	}
 
 	// 启动 HTTP 服务
 	mux := http.NewServeMux()
 	httpAdapter.NewAuthHandler(authUC).RegisterRoutes(mux)
 
 	httpAdapter.NewSMSHandler(smsSendUC).RegisterRoutes(mux)
+	if keyUC != nil {
+		httpAdapter.NewJWKSHandler(keyUC).RegisterRoutes(mux)
+	}
 
 	go func() {
 		logger.Info("HTTP 服务启动", zap.String("addr", httpAddr))
@@ -209,12 +514,23 @@ func main() {
 	if err != nil {
 		logger.Fatal("监听 gRPC 失败", zap.Error(err))
 	}
-	grpcServer := grpc.NewServer()
+	// 管理接口按访问规则校验网关转发的权限
+	adminAuthz, err := authn.NewAuthorizer(grpcAdapter.AdminAccessRules())
+	if err != nil {
+		logger.Fatal("初始化访问规则失败", zap.Error(err))
+	}
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(authn.UnaryServerInterceptor(adminAuthz)))
 	grpcAdapter.NewAuthServer(
 		authUC,
 		userUC,
 		contactUC,
 		smsSendUC,
+		rbacUC,
+		sessionUC,
+		mfaUC,
+		passwordUC,
+		oidcUC,
+		discoveryUC,
 	).RegisterServer(grpcServer)
 	logger.Info("gRPC 服务启动", zap.String("addr", grpcAddr))
 	if err := grpcServer.Serve(lis); err != nil {
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	oidcadapter "github.com/EthanQC/IM/services/identity_service/internal/adapters/out/oidc"
+	oidcapp "github.com/EthanQC/IM/services/identity_service/internal/application/oidc"
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+)
+
+// 端到端：真实的 OIDC 客户端适配器对接进程内启动的 mock_oidc，覆盖 PKCE、nonce、state 重放与浏览器绑定
+
+const (
+	testProvider    = "mock"
+	testRedirectURL = "https://im.example.com/api/auth/oidc/mock/callback"
+	linkedUserID    = uint64(7)
+)
+
+type memStateRepo struct {
+	mu     sync.Mutex
+	states map[string]entity.OIDCState
+}
+
+func (r *memStateRepo) Save(_ context.Context, st *entity.OIDCState) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.states[st.State] = *st
+	return nil
+}
+
+func (r *memStateRepo) Consume(_ context.Context, state string) (*entity.OIDCState, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	st, ok := r.states[state]
+	if !ok || time.Now().After(st.ExpiresAt) {
+		return nil, nil
+	}
+	delete(r.states, state)
+	return &st, nil
+}
+
+func (r *memStateRepo) update(state string, fn func(*entity.OIDCState)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	st := r.states[state]
+	fn(&st)
+	r.states[state] = st
+}
+
+// linkedIdentities 任何 subject 都已绑定到 linkedUserID，测试不涉及开户
+type linkedIdentities struct{}
+
+func (linkedIdentities) Get(_ context.Context, provider, subject string) (*entity.UserIdentity, error) {
+	return &entity.UserIdentity{ID: 1, Provider: provider, Subject: subject, UserID: linkedUserID}, nil
+}
+
+func (linkedIdentities) Create(context.Context, *entity.UserIdentity) error { return nil }
+
+func (linkedIdentities) Touch(context.Context, uint64) error { return nil }
+
+type stubIssuer struct{}
+
+func (stubIssuer) LoginVerified(_ context.Context, userID string, _ entity.DeviceInfo) (*entity.AuthToken, error) {
+	return &entity.AuthToken{UserID: userID, AccessToken: "access-" + userID}, nil
+}
+
+type e2e struct {
+	uc     *oidcapp.OIDCUseCase
+	states *memStateRepo
+}
+
+func newE2E(t *testing.T) *e2e {
+	t.Helper()
+	s, err := newServer(Config{ClientID: "im-dev", ClientSecret: "im-dev-secret", Email: "alice@example.com", TokenTTL: time.Minute})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := httptest.NewServer(s.routes())
+	t.Cleanup(ts.Close)
+	s.cfg.Issuer = ts.URL
+
+	states := &memStateRepo{states: map[string]entity.OIDCState{}}
+	uc := oidcapp.NewOIDCUseCase(states, linkedIdentities{}, nil, stubIssuer{}, time.Minute)
+	uc.Register(oidcadapter.NewProvider(oidcadapter.Config{
+		Name:         testProvider,
+		Issuer:       ts.URL,
+		ClientID:     "im-dev",
+		ClientSecret: "im-dev-secret",
+		RedirectURL:  testRedirectURL,
+	}), oidcapp.Policy{})
+	return &e2e{uc: uc, states: states}
+}
+
+// authorize 模拟浏览器访问授权地址，返回提供方重定向回 callback 时携带的参数
+func authorize(t *testing.T, authURL string) url.Values {
+	t.Helper()
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	resp, err := client.Get(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusFound {
+		t.Fatalf("authorize status = %d", resp.StatusCode)
+	}
+	loc, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := loc.Query()
+	if q.Get("error") != "" {
+		t.Fatalf("authorize error: %s %s", q.Get("error"), q.Get("error_description"))
+	}
+	return q
+}
+
+func TestOIDCLoginWithPKCEAndStateReplay(t *testing.T) {
+	e := newE2E(t)
+	ctx := context.Background()
+
+	authURL, state, binding, err := e.uc.Begin(ctx, testProvider, entity.DeviceInfo{})
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored := e.states.states[state]
+	sum := sha256.Sum256([]byte(stored.CodeVerifier))
+	if got := u.Query().Get("code_challenge"); got != base64.RawURLEncoding.EncodeToString(sum[:]) {
+		t.Fatalf("code_challenge = %q does not match S256(code_verifier)", got)
+	}
+	if u.Query().Get("code_challenge_method") != "S256" {
+		t.Fatalf("code_challenge_method = %q", u.Query().Get("code_challenge_method"))
+	}
+
+	cb := authorize(t, authURL)
+	if cb.Get("state") != state {
+		t.Fatalf("callback state = %q, want %q", cb.Get("state"), state)
+	}
+	at, err := e.uc.Complete(ctx, testProvider, cb.Get("code"), state, binding)
+	if err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	if at.UserID != "7" {
+		t.Fatalf("UserID = %q, want 7", at.UserID)
+	}
+
+	// 同一回调再次提交：state 已被消费
+	if _, err := e.uc.Complete(ctx, testProvider, cb.Get("code"), state, binding); !errors.Is(err, oidcapp.ErrInvalidState) {
+		t.Fatalf("replayed state: got %v, want ErrInvalidState", err)
+	}
+}
+
+func TestOIDCRejectsCallbackFromAnotherBrowser(t *testing.T) {
+	e := newE2E(t)
+	ctx := context.Background()
+
+	// 攻击者发起登录，把回调地址交给受害者浏览器打开，受害者没有攻击者的绑定 Cookie
+	authURL, state, binding, err := e.uc.Begin(ctx, testProvider, entity.DeviceInfo{})
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	cb := authorize(t, authURL)
+	if _, err := e.uc.Complete(ctx, testProvider, cb.Get("code"), state, ""); !errors.Is(err, oidcapp.ErrInvalidState) {
+		t.Fatalf("missing binding: got %v, want ErrInvalidState", err)
+	}
+	// 绑定校验失败的 state 同样作废
+	if _, err := e.uc.Complete(ctx, testProvider, cb.Get("code"), state, binding); !errors.Is(err, oidcapp.ErrInvalidState) {
+		t.Fatalf("state after failed binding: got %v, want ErrInvalidState", err)
+	}
+
+	authURL, state, _, err = e.uc.Begin(ctx, testProvider, entity.DeviceInfo{})
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	cb = authorize(t, authURL)
+	if _, err := e.uc.Complete(ctx, testProvider, cb.Get("code"), state, binding); !errors.Is(err, oidcapp.ErrInvalidState) {
+		t.Fatalf("binding of another flow: got %v, want ErrInvalidState", err)
+	}
+}
+
+func TestOIDCRejectsWrongCodeVerifier(t *testing.T) {
+	e := newE2E(t)
+	ctx := context.Background()
+
+	authURL, state, binding, err := e.uc.Begin(ctx, testProvider, entity.DeviceInfo{})
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	cb := authorize(t, authURL)
+	// 截获授权码的一方没有 code_verifier，提供方拒绝换取令牌
+	e.states.update(state, func(st *entity.OIDCState) { st.CodeVerifier = "intercepted" })
+	if _, err := e.uc.Complete(ctx, testProvider, cb.Get("code"), state, binding); !errors.Is(err, oidcapp.ErrInvalidIDToken) {
+		t.Fatalf("wrong code_verifier: got %v, want ErrInvalidIDToken", err)
+	}
+}
+
+func TestOIDCRejectsNonceMismatch(t *testing.T) {
+	e := newE2E(t)
+	ctx := context.Background()
+
+	authURL, state, binding, err := e.uc.Begin(ctx, testProvider, entity.DeviceInfo{})
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	// ID Token 中的 nonce 来自授权请求，被替换后与发起时保存的不一致
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := u.Query()
+	q.Set("nonce", "forged")
+	u.RawQuery = q.Encode()
+	cb := authorize(t, u.String())
+	if _, err := e.uc.Complete(ctx, testProvider, cb.Get("code"), state, binding); !errors.Is(err, oidcapp.ErrInvalidIDToken) {
+		t.Fatalf("nonce mismatch: got %v, want ErrInvalidIDToken", err)
+	}
+}
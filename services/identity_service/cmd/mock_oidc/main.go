@@ -0,0 +1,281 @@
+// mock_oidc 本地联调用的 OIDC 提供方：授权请求直接通过，按 login_hint 或启动参数返回用户身份
+//
+//	go run ./cmd/mock_oidc -addr :9999 -issuer http://localhost:9999 -email alice@example.com
+//
+// 授权地址追加 login_hint=<邮箱> 可切换登录用户，sub 由邮箱派生，同一邮箱多次登录得到同一身份
+package main
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const codeTTL = time.Minute
+
+// Config 启动参数
+type Config struct {
+	Addr         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string // 为空时按公共客户端处理，只校验 client_id
+	Email        string
+	Phone        string
+	Name         string
+	TokenTTL     time.Duration
+}
+
+// authCode 授权码对应的一次性授权上下文
+type authCode struct {
+	clientID    string
+	redirectURI string
+	nonce       string
+	challenge   string
+	email       string
+	expiresAt   time.Time
+}
+
+type server struct {
+	cfg Config
+	key *rsa.PrivateKey
+	kid string
+
+	mu    sync.Mutex
+	codes map[string]*authCode
+}
+
+func main() {
+	var cfg Config
+	flag.StringVar(&cfg.Addr, "addr", ":9999", "监听地址")
+	flag.StringVar(&cfg.Issuer, "issuer", "http://localhost:9999", "issuer，需与 identity 配置一致")
+	flag.StringVar(&cfg.ClientID, "client-id", "im-dev", "客户端 ID")
+	flag.StringVar(&cfg.ClientSecret, "client-secret", "im-dev-secret", "客户端密钥")
+	flag.StringVar(&cfg.Email, "email", "alice@example.com", "默认登录用户邮箱")
+	flag.StringVar(&cfg.Phone, "phone", "", "默认登录用户手机号(E.164)，为空时不返回")
+	flag.StringVar(&cfg.Name, "name", "", "默认登录用户昵称，为空时取邮箱前缀")
+	flag.DurationVar(&cfg.TokenTTL, "token-ttl", 5*time.Minute, "ID Token 有效期")
+	flag.Parse()
+
+	s, err := newServer(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("mock oidc provider listening on %s, issuer %s", cfg.Addr, cfg.Issuer)
+	if err := http.ListenAndServe(cfg.Addr, s.routes()); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func newServer(cfg Config) (*server, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, fmt.Errorf("generate key: %w", err)
+	}
+	return &server{cfg: cfg, key: key, kid: randomString(8), codes: make(map[string]*authCode)}, nil
+}
+
+func (s *server) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", s.discovery)
+	mux.HandleFunc("/authorize", s.authorize)
+	mux.HandleFunc("/token", s.token)
+	mux.HandleFunc("/jwks", s.jwks)
+	return mux
+}
+
+func (s *server) discovery(w http.ResponseWriter, r *http.Request) {
+	base := strings.TrimSuffix(s.cfg.Issuer, "/")
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"issuer":                                s.cfg.Issuer,
+		"authorization_endpoint":                base + "/authorize",
+		"token_endpoint":                        base + "/token",
+		"jwks_uri":                              base + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post", "none"},
+	})
+}
+
+// authorize 不展示登录页，校验参数后直接带授权码重定向回客户端
+func (s *server) authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	redirectURI := q.Get("redirect_uri")
+	if q.Get("client_id") != s.cfg.ClientID || redirectURI == "" {
+		http.Error(w, "invalid client_id or redirect_uri", http.StatusBadRequest)
+		return
+	}
+	target, err := url.Parse(redirectURI)
+	if err != nil {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+	reply := target.Query()
+	reply.Set("state", q.Get("state"))
+	switch {
+	case q.Get("response_type") != "code":
+		reply.Set("error", "unsupported_response_type")
+	case q.Get("code_challenge") == "" || q.Get("code_challenge_method") != "S256":
+		reply.Set("error", "invalid_request")
+		reply.Set("error_description", "PKCE S256 required")
+	case !strings.Contains(" "+q.Get("scope")+" ", " openid "):
+		reply.Set("error", "invalid_scope")
+	default:
+		email := q.Get("login_hint")
+		if email == "" {
+			email = s.cfg.Email
+		}
+		code := randomString(16)
+		s.mu.Lock()
+		s.codes[code] = &authCode{
+			clientID:    s.cfg.ClientID,
+			redirectURI: redirectURI,
+			nonce:       q.Get("nonce"),
+			challenge:   q.Get("code_challenge"),
+			email:       email,
+			expiresAt:   time.Now().Add(codeTTL),
+		}
+		s.mu.Unlock()
+		reply.Set("code", code)
+	}
+	target.RawQuery = reply.Encode()
+	http.Redirect(w, r, target.String(), http.StatusFound)
+}
+
+// token 授权码换取 ID Token：校验客户端、redirect_uri 与 PKCE code_verifier
+func (s *server) token(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		tokenError(w, http.StatusBadRequest, "invalid_request", err.Error())
+		return
+	}
+	if !s.authenticateClient(r) {
+		tokenError(w, http.StatusUnauthorized, "invalid_client", "client authentication failed")
+		return
+	}
+	if r.PostForm.Get("grant_type") != "authorization_code" {
+		tokenError(w, http.StatusBadRequest, "unsupported_grant_type", "")
+		return
+	}
+
+	s.mu.Lock()
+	ac, ok := s.codes[r.PostForm.Get("code")]
+	delete(s.codes, r.PostForm.Get("code"))
+	s.mu.Unlock()
+	if !ok || time.Now().After(ac.expiresAt) || ac.redirectURI != r.PostForm.Get("redirect_uri") {
+		tokenError(w, http.StatusBadRequest, "invalid_grant", "code invalid, expired or redirect_uri mismatch")
+		return
+	}
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if subtle.ConstantTimeCompare([]byte(base64.RawURLEncoding.EncodeToString(sum[:])), []byte(ac.challenge)) != 1 {
+		tokenError(w, http.StatusBadRequest, "invalid_grant", "code_verifier mismatch")
+		return
+	}
+
+	idToken, err := s.issueIDToken(ac)
+	if err != nil {
+		tokenError(w, http.StatusInternalServerError, "server_error", err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": randomString(16),
+		"token_type":   "Bearer",
+		"expires_in":   int64(s.cfg.TokenTTL / time.Second),
+		"id_token":     idToken,
+	})
+}
+
+// authenticateClient 支持 client_secret_basic、client_secret_post，未配置密钥时只比对 client_id
+func (s *server) authenticateClient(r *http.Request) bool {
+	id, secret, ok := r.BasicAuth()
+	if ok {
+		id, _ = url.QueryUnescape(id)
+		secret, _ = url.QueryUnescape(secret)
+	} else {
+		id, secret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if id != s.cfg.ClientID {
+		return false
+	}
+	return s.cfg.ClientSecret == "" || subtle.ConstantTimeCompare([]byte(secret), []byte(s.cfg.ClientSecret)) == 1
+}
+
+func (s *server) issueIDToken(ac *authCode) (string, error) {
+	now := time.Now()
+	sub := sha256.Sum256([]byte(strings.ToLower(ac.email)))
+	name := s.cfg.Name
+	if name == "" {
+		name, _, _ = strings.Cut(ac.email, "@")
+	}
+	claims := jwt.MapClaims{
+		"iss":                s.cfg.Issuer,
+		"sub":                hex.EncodeToString(sub[:8]),
+		"aud":                ac.clientID,
+		"iat":                now.Unix(),
+		"exp":                now.Add(s.cfg.TokenTTL).Unix(),
+		"nonce":              ac.nonce,
+		"email":              ac.email,
+		"email_verified":     true,
+		"name":               name,
+		"preferred_username": ac.email,
+	}
+	if s.cfg.Phone != "" {
+		claims["phone_number"] = s.cfg.Phone
+		claims["phone_number_verified"] = true
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = s.kid
+	return token.SignedString(s.key)
+}
+
+func (s *server) jwks(w http.ResponseWriter, r *http.Request) {
+	pub := s.key.PublicKey
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": s.kid,
+			"alg": "RS256",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}},
+	})
+}
+
+func tokenError(w http.ResponseWriter, status int, code, desc string) {
+	writeJSON(w, status, map[string]string{"error": code, "error_description": desc})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func randomString(n int) string {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,171 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	imv1 "github.com/EthanQC/IM/api/gen/im/v1"
+	"github.com/EthanQC/IM/pkg/authn"
+	rbacapp "github.com/EthanQC/IM/services/identity_service/internal/application/rbac"
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// AdminAccessRules 管理接口的访问规则，由 authn.UnaryServerInterceptor 执行；
+// 调用方（网关）通过 metadata 转发令牌中的权限
+func AdminAccessRules() []authn.AccessRule {
+	methods := []string{
+		imv1.IdentityService_ListRoles_FullMethodName,
+		imv1.IdentityService_CreateRole_FullMethodName,
+		imv1.IdentityService_UpdateRole_FullMethodName,
+		imv1.IdentityService_DeleteRole_FullMethodName,
+		imv1.IdentityService_ListUserRoles_FullMethodName,
+		imv1.IdentityService_AssignUserRole_FullMethodName,
+		imv1.IdentityService_RevokeUserRole_FullMethodName,
+		imv1.IdentityService_BlockUser_FullMethodName,
+		imv1.IdentityService_UnblockUser_FullMethodName,
+	}
+	rules := make([]authn.AccessRule, 0, len(methods))
+	for _, m := range methods {
+		rules = append(rules, authn.AccessRule{Path: m, Permission: authn.PermUserManage})
+	}
+	return rules
+}
+
+func (s *AuthServer) ListRoles(ctx context.Context, _ *emptypb.Empty) (*imv1.ListRolesResponse, error) {
+	roles, err := s.RBACUC.ListRoles(ctx)
+	if err != nil {
+		return nil, rbacStatus("list roles failed", err)
+	}
+	return toRolesResp(roles), nil
+}
+
+func (s *AuthServer) CreateRole(ctx context.Context, req *imv1.RoleRequest) (*imv1.Role, error) {
+	role, err := s.RBACUC.CreateRole(ctx, req.Name, req.Description, req.Permissions)
+	if err != nil {
+		return nil, rbacStatus("create role failed", err)
+	}
+	return toRoleProto(role), nil
+}
+
+func (s *AuthServer) UpdateRole(ctx context.Context, req *imv1.RoleRequest) (*imv1.Role, error) {
+	role, err := s.RBACUC.UpdateRole(ctx, req.Name, req.Description, req.Permissions)
+	if err != nil {
+		return nil, rbacStatus("update role failed", err)
+	}
+	return toRoleProto(role), nil
+}
+
+func (s *AuthServer) DeleteRole(ctx context.Context, req *imv1.DeleteRoleRequest) (*emptypb.Empty, error) {
+	if err := s.RBACUC.DeleteRole(ctx, req.Name); err != nil {
+		return nil, rbacStatus("delete role failed", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServer) ListUserRoles(ctx context.Context, req *imv1.ListUserRolesRequest) (*imv1.ListRolesResponse, error) {
+	if req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id required")
+	}
+	roles, err := s.RBACUC.ListUserRoles(ctx, uint64(req.UserId))
+	if err != nil {
+		return nil, rbacStatus("list user roles failed", err)
+	}
+	return toRolesResp(roles), nil
+}
+
+func (s *AuthServer) AssignUserRole(ctx context.Context, req *imv1.UserRoleRequest) (*emptypb.Empty, error) {
+	operatorID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserId <= 0 || req.Role == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id and role required")
+	}
+	if err := s.RBACUC.AssignRole(ctx, operatorID, uint64(req.UserId), req.Role); err != nil {
+		return nil, rbacStatus("assign role failed", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServer) RevokeUserRole(ctx context.Context, req *imv1.UserRoleRequest) (*emptypb.Empty, error) {
+	operatorID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserId <= 0 || req.Role == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id and role required")
+	}
+	// 防止管理员误操作撤销自己的 admin 角色后无人可管理
+	if uint64(req.UserId) == operatorID && req.Role == entity.RoleAdmin {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot revoke own admin role")
+	}
+	if err := s.RBACUC.RevokeRole(ctx, uint64(req.UserId), req.Role); err != nil {
+		return nil, rbacStatus("revoke role failed", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServer) BlockUser(ctx context.Context, req *imv1.BlockUserRequest) (*emptypb.Empty, error) {
+	operatorID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id required")
+	}
+	if uint64(req.UserId) == operatorID {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot block yourself")
+	}
+	duration := time.Duration(req.DurationSeconds) * time.Second
+	if err := s.AuthUC.BlockUser(ctx, uint64(req.UserId), req.Reason, duration); err != nil {
+		return nil, status.Errorf(codes.Internal, "block user failed: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServer) UnblockUser(ctx context.Context, req *imv1.UnblockUserRequest) (*emptypb.Empty, error) {
+	if req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id required")
+	}
+	if err := s.AuthUC.UnblockUser(ctx, uint64(req.UserId)); err != nil {
+		return nil, status.Errorf(codes.Internal, "unblock user failed: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// rbacStatus 按业务错误映射 gRPC 状态码
+func rbacStatus(msg string, err error) error {
+	code := codes.Internal
+	switch {
+	case errors.Is(err, rbacapp.ErrInvalidRoleName), errors.Is(err, rbacapp.ErrInvalidPermission):
+		code = codes.InvalidArgument
+	case errors.Is(err, rbacapp.ErrRoleNotFound), errors.Is(err, rbacapp.ErrUserNotFound):
+		code = codes.NotFound
+	case errors.Is(err, rbacapp.ErrRoleExists):
+		code = codes.AlreadyExists
+	case errors.Is(err, rbacapp.ErrSystemRole):
+		code = codes.FailedPrecondition
+	}
+	return status.Errorf(code, "%s: %v", msg, err)
+}
+
+func toRoleProto(role *entity.Role) *imv1.Role {
+	return &imv1.Role{
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions,
+		IsSystem:    role.IsSystem,
+	}
+}
+
+func toRolesResp(roles []*entity.Role) *imv1.ListRolesResponse {
+	resp := &imv1.ListRolesResponse{Roles: make([]*imv1.Role, 0, len(roles))}
+	for _, r := range roles {
+		resp.Roles = append(resp.Roles, toRoleProto(r))
+	}
+	return resp
+}
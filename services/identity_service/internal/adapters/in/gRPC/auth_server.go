@@ -2,30 +2,41 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"strconv"
 	"time"
 
 	imv1 "github.com/EthanQC/IM/api/gen/im/v1"
+	userapp "github.com/EthanQC/IM/services/identity_service/internal/application/user"
 	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
 	"github.com/EthanQC/IM/services/identity_service/internal/ports/in"
+	authErr "github.com/EthanQC/IM/services/identity_service/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // AuthServer implements the shared IdentityService proto for MVP.
 type AuthServer struct {
 	imv1.UnimplementedIdentityServiceServer
-	AuthUC    in.AuthUseCase
-	UserUC    in.UserUseCase
-	ContactUC in.ContactUseCase
-	SMSUC     in.SMSUseCase
+	AuthUC      in.AuthUseCase
+	UserUC      in.UserUseCase
+	ContactUC   in.ContactUseCase
+	SMSUC       in.SMSUseCase
+	RBACUC      in.RBACUseCase
+	SessionUC   in.SessionUseCase
+	MFAUC       in.MFAUseCase
+	PasswordUC  in.PasswordUseCase
+	OIDCUC      in.OIDCUseCase
+	DiscoveryUC in.DiscoveryUseCase
 }
 
-func NewAuthServer(authUC in.AuthUseCase, userUC in.UserUseCase, contactUC in.ContactUseCase, smsUC in.SMSUseCase) *AuthServer {
-	return &AuthServer{AuthUC: authUC, UserUC: userUC, ContactUC: contactUC, SMSUC: smsUC}
+func NewAuthServer(authUC in.AuthUseCase, userUC in.UserUseCase, contactUC in.ContactUseCase, smsUC in.SMSUseCase, rbacUC in.RBACUseCase, sessionUC in.SessionUseCase, mfaUC in.MFAUseCase, passwordUC in.PasswordUseCase, oidcUC in.OIDCUseCase, discoveryUC in.DiscoveryUseCase) *AuthServer {
+	return &AuthServer{AuthUC: authUC, UserUC: userUC, ContactUC: contactUC, SMSUC: smsUC, RBACUC: rbacUC, SessionUC: sessionUC, MFAUC: mfaUC, PasswordUC: passwordUC, OIDCUC: oidcUC, DiscoveryUC: discoveryUC}
 }
 
 func (s *AuthServer) Register(ctx context.Context, req *imv1.RegisterRequest) (*imv1.AuthResponse, error) {
@@ -35,8 +46,8 @@ func (s *AuthServer) Register(ctx context.Context, req *imv1.RegisterRequest) (*
 		return nil, status.Errorf(codes.Internal, "register failed: %v", err)
 	}
 
-	// 注册成功后自动登录获取 token
-	at, err := s.AuthUC.LoginByPassword(ctx, req.Username, req.Password)
+	// 注册成功后自动登录获取 token，不经过密码登录的失败计数与人机验证
+	at, err := s.AuthUC.LoginVerified(ctx, strconv.FormatUint(user.ID, 10), deviceFromProto(req.Device))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "register succeeded but login failed: %v", err)
 	}
@@ -45,6 +56,7 @@ func (s *AuthServer) Register(ctx context.Context, req *imv1.RegisterRequest) (*
 		AccessToken:  at.AccessToken,
 		RefreshToken: at.RefreshToken,
 		ExpiresIn:    expiresInSeconds(at),
+		SessionId:    at.SessionID,
 		Profile: &imv1.UserProfile{
 			User: &imv1.UserBrief{
 				Id:          int64(user.ID),
@@ -57,9 +69,12 @@ func (s *AuthServer) Register(ctx context.Context, req *imv1.RegisterRequest) (*
 }
 
 func (s *AuthServer) Login(ctx context.Context, req *imv1.LoginRequest) (*imv1.AuthResponse, error) {
-	at, err := s.AuthUC.LoginByPassword(ctx, req.Username, req.Password)
+	at, err := s.AuthUC.LoginByPassword(ctx, req.Username, req.Password, req.CaptchaToken, deviceFromProto(req.Device))
+	if resp, ok := mfaRequiredResp(err); ok {
+		return resp, nil
+	}
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "login failed: %v", err)
+		return nil, loginStatus(err)
 	}
 
 	userID, err := strconv.ParseUint(at.UserID, 10, 64)
@@ -79,6 +94,7 @@ func (s *AuthServer) Login(ctx context.Context, req *imv1.LoginRequest) (*imv1.A
 		AccessToken:  at.AccessToken,
 		RefreshToken: at.RefreshToken,
 		ExpiresIn:    expiresInSeconds(at),
+		SessionId:    at.SessionID,
 		Profile: &imv1.UserProfile{
 			User: &imv1.UserBrief{
 				Id:          int64(user.ID),
@@ -90,14 +106,50 @@ func (s *AuthServer) Login(ctx context.Context, req *imv1.LoginRequest) (*imv1.A
 	}, nil
 }
 
+// loginStatus 密码登录失败：需要人机验证时返回 FailedPrecondition，锁定或限流时返回 ResourceExhausted
+func loginStatus(err error) error {
+	switch {
+	case errors.Is(err, authErr.ErrCaptchaRequired):
+		return status.Errorf(codes.FailedPrecondition, "login failed: %v", err)
+	case errors.Is(err, authErr.ErrAccountLocked), errors.Is(err, authErr.ErrTooManyRequests):
+		return statusWithRetry(codes.ResourceExhausted, "login failed", err)
+	}
+	return status.Errorf(codes.Unauthenticated, "login failed: %v", err)
+}
+
+// statusWithRetry 错误带有建议重试时间时附加 RetryInfo，网关据此返回 Retry-After
+func statusWithRetry(code codes.Code, msg string, err error) error {
+	st := status.Newf(code, "%s: %v", msg, err)
+	if d, ok := authErr.RetryAfter(err); ok {
+		if detailed, derr := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(d)}); derr == nil {
+			st = detailed
+		}
+	}
+	return st.Err()
+}
+
 func (s *AuthServer) Refresh(ctx context.Context, req *imv1.RefreshRequest) (*imv1.AuthResponse, error) {
-	at, err := s.AuthUC.RefreshToken(ctx, req.RefreshToken)
+	at, err := s.AuthUC.RefreshToken(ctx, req.RefreshToken, deviceFromProto(req.Device))
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "refresh failed: %v", err)
 	}
 	return s.toAuthResp(at), nil
 }
 
+func (s *AuthServer) Logout(ctx context.Context, req *imv1.LogoutRequest) (*emptypb.Empty, error) {
+	if req.AccessJti == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "access_jti required")
+	}
+	var expiresAt time.Time
+	if req.AccessExpiresAt > 0 {
+		expiresAt = time.Unix(req.AccessExpiresAt, 0)
+	}
+	if err := s.AuthUC.Logout(ctx, req.AccessJti, req.RefreshToken, expiresAt); err != nil {
+		return nil, status.Errorf(codes.Internal, "logout failed: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (s *AuthServer) GetProfile(ctx context.Context, req *imv1.GetProfileRequest) (*imv1.UserProfile, error) {
 	uid := req.UserId
 	if uid == 0 {
@@ -291,6 +343,73 @@ func (s *AuthServer) ListContacts(ctx context.Context, req *imv1.ListContactsReq
 	return resp, nil
 }
 
+func (s *AuthServer) BatchGetProfiles(ctx context.Context, req *imv1.BatchGetProfilesRequest) (*imv1.BatchGetProfilesResponse, error) {
+	userIDs := make([]uint64, 0, len(req.UserIds))
+	for _, id := range req.UserIds {
+		userIDs = append(userIDs, uint64(id))
+	}
+
+	users, err := s.UserUC.BatchGetProfiles(ctx, userIDs)
+	if err != nil {
+		if errors.Is(err, userapp.ErrTooManyUsers) {
+			return nil, status.Errorf(codes.InvalidArgument, "batch get profiles failed: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "batch get profiles failed: %v", err)
+	}
+
+	resp := &imv1.BatchGetProfilesResponse{Users: make([]*imv1.UserBrief, 0, len(users))}
+	for _, u := range users {
+		avatarURL := ""
+		if u.AvatarURL != nil {
+			avatarURL = *u.AvatarURL
+		}
+		resp.Users = append(resp.Users, &imv1.UserBrief{
+			Id:          int64(u.ID),
+			Username:    u.Username,
+			DisplayName: u.DisplayName,
+			AvatarUrl:   avatarURL,
+		})
+	}
+	return resp, nil
+}
+
+func (s *AuthServer) MatchUsersByName(ctx context.Context, req *imv1.MatchUsersByNameRequest) (*imv1.MatchUsersByNameResponse, error) {
+	candidateIDs := make([]uint64, 0, len(req.CandidateIds))
+	for _, id := range req.CandidateIds {
+		candidateIDs = append(candidateIDs, uint64(id))
+	}
+
+	ids, err := s.UserUC.MatchUsersByName(ctx, req.Keyword, candidateIDs, int(req.Limit))
+	if err != nil {
+		if errors.Is(err, userapp.ErrTooManyUsers) {
+			return nil, status.Errorf(codes.InvalidArgument, "match users failed: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "match users failed: %v", err)
+	}
+
+	resp := &imv1.MatchUsersByNameResponse{UserIds: make([]int64, 0, len(ids))}
+	for _, id := range ids {
+		resp.UserIds = append(resp.UserIds, int64(id))
+	}
+	return resp, nil
+}
+
+// CheckUserStatus 供网关令牌校验调用：账号不可用时 active=false 并给出原因
+func (s *AuthServer) CheckUserStatus(ctx context.Context, req *imv1.CheckUserStatusRequest) (*imv1.CheckUserStatusResponse, error) {
+	if req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id required")
+	}
+	err := s.AuthUC.CheckUserStatus(ctx, uint64(req.UserId))
+	switch {
+	case err == nil:
+		return &imv1.CheckUserStatusResponse{Active: true}, nil
+	case errors.Is(err, authErr.ErrUserBlocked), errors.Is(err, authErr.ErrUserDisabled):
+		return &imv1.CheckUserStatusResponse{Active: false, Reason: err.Error()}, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "check user status failed: %v", err)
+	}
+}
+
 // RegisterServer registers the gRPC server implementation.
 func (s *AuthServer) RegisterServer(gs *grpc.Server) {
 	imv1.RegisterIdentityServiceServer(gs, s)
@@ -301,6 +420,7 @@ func (s *AuthServer) toAuthResp(at *entity.AuthToken) *imv1.AuthResponse {
 		AccessToken:  at.AccessToken,
 		RefreshToken: at.RefreshToken,
 		ExpiresIn:    expiresInSeconds(at),
+		SessionId:    at.SessionID,
 		Profile:      &imv1.UserProfile{},
 	}
 }
@@ -0,0 +1,122 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	imv1 "github.com/EthanQC/IM/api/gen/im/v1"
+	discoveryapp "github.com/EthanQC/IM/services/identity_service/internal/application/discovery"
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+	authErr "github.com/EthanQC/IM/services/identity_service/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *AuthServer) SearchUser(ctx context.Context, req *imv1.SearchUserRequest) (*imv1.UserBrief, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.DiscoveryUC.SearchUser(ctx, userID, req.Query)
+	if err != nil {
+		return nil, discoveryStatus("search user failed", err)
+	}
+	return toUserBrief(user), nil
+}
+
+func (s *AuthServer) ResolveShareCode(ctx context.Context, req *imv1.ResolveShareCodeRequest) (*imv1.UserBrief, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.DiscoveryUC.ResolveShareCode(ctx, userID, req.Code)
+	if err != nil {
+		return nil, discoveryStatus("resolve share code failed", err)
+	}
+	return toUserBrief(user), nil
+}
+
+func (s *AuthServer) GetShareCode(ctx context.Context, _ *emptypb.Empty) (*imv1.ShareCodeResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	code, shareURL, err := s.DiscoveryUC.GetShareCode(ctx, userID)
+	if err != nil {
+		return nil, discoveryStatus("get share code failed", err)
+	}
+	return &imv1.ShareCodeResponse{Code: code, ShareUrl: shareURL}, nil
+}
+
+func (s *AuthServer) ResetShareCode(ctx context.Context, _ *emptypb.Empty) (*imv1.ShareCodeResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	code, shareURL, err := s.DiscoveryUC.ResetShareCode(ctx, userID)
+	if err != nil {
+		return nil, discoveryStatus("reset share code failed", err)
+	}
+	return &imv1.ShareCodeResponse{Code: code, ShareUrl: shareURL}, nil
+}
+
+func (s *AuthServer) GetPrivacySettings(ctx context.Context, _ *emptypb.Empty) (*imv1.PrivacySettings, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, err := s.DiscoveryUC.GetPrivacy(ctx, userID)
+	if err != nil {
+		return nil, discoveryStatus("get privacy settings failed", err)
+	}
+	return toPrivacySettings(p), nil
+}
+
+func (s *AuthServer) UpdatePrivacySettings(ctx context.Context, req *imv1.PrivacySettings) (*imv1.PrivacySettings, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, err := s.DiscoveryUC.UpdatePrivacy(ctx, userID, req.AllowFindByUsername, req.AllowFindByPhone, req.AllowFindByEmail)
+	if err != nil {
+		return nil, discoveryStatus("update privacy settings failed", err)
+	}
+	return toPrivacySettings(p), nil
+}
+
+// toUserBrief 查找结果只暴露公开资料，不含手机号与邮箱
+func toUserBrief(user *entity.User) *imv1.UserBrief {
+	avatarURL := ""
+	if user.AvatarURL != nil {
+		avatarURL = *user.AvatarURL
+	}
+	return &imv1.UserBrief{
+		Id:          int64(user.ID),
+		Username:    user.Username,
+		DisplayName: user.DisplayName,
+		AvatarUrl:   avatarURL,
+	}
+}
+
+func toPrivacySettings(p *entity.UserPrivacy) *imv1.PrivacySettings {
+	return &imv1.PrivacySettings{
+		AllowFindByUsername: p.AllowFindByUsername,
+		AllowFindByPhone:    p.AllowFindByPhone,
+		AllowFindByEmail:    p.AllowFindByEmail,
+	}
+}
+
+// discoveryStatus 按业务错误映射 gRPC 状态码
+func discoveryStatus(msg string, err error) error {
+	code := codes.Internal
+	switch {
+	case errors.Is(err, discoveryapp.ErrInvalidQuery), errors.Is(err, discoveryapp.ErrInvalidShareCode):
+		code = codes.InvalidArgument
+	case errors.Is(err, discoveryapp.ErrUserNotFound):
+		code = codes.NotFound
+	case errors.Is(err, authErr.ErrTooManyRequests):
+		code = codes.ResourceExhausted
+	}
+	return status.Errorf(code, "%s: %v", msg, err)
+}
@@ -0,0 +1,159 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	imv1 "github.com/EthanQC/IM/api/gen/im/v1"
+	"github.com/EthanQC/IM/pkg/authn"
+	authapp "github.com/EthanQC/IM/services/identity_service/internal/application/auth"
+	mfaapp "github.com/EthanQC/IM/services/identity_service/internal/application/mfa"
+	authErr "github.com/EthanQC/IM/services/identity_service/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *AuthServer) VerifyMFALogin(ctx context.Context, req *imv1.VerifyMFALoginRequest) (*imv1.AuthResponse, error) {
+	if req.MfaToken == "" || req.Code == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "mfa_token and code required")
+	}
+	at, err := s.AuthUC.CompleteMFALogin(ctx, req.MfaToken, req.Code)
+	if err != nil {
+		if errors.Is(err, mfaapp.ErrMFALocked) || errors.Is(err, authErr.ErrAccountLocked) ||
+			errors.Is(err, authErr.ErrTooManyRequests) {
+			return nil, statusWithRetry(codes.ResourceExhausted, "mfa login failed", err)
+		}
+		return nil, status.Errorf(codes.Unauthenticated, "mfa login failed: %v", err)
+	}
+	return s.toAuthResp(at), nil
+}
+
+func (s *AuthServer) GetMFAStatus(ctx context.Context, _ *emptypb.Empty) (*imv1.MFAStatus, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	enabled, left, err := s.MFAUC.Status(ctx, userID)
+	if err != nil {
+		return nil, mfaStatus("get mfa status failed", err)
+	}
+	return &imv1.MFAStatus{Enabled: enabled, RecoveryCodesLeft: int32(left)}, nil
+}
+
+func (s *AuthServer) EnrollTOTP(ctx context.Context, _ *emptypb.Empty) (*imv1.EnrollTOTPResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.UserUC.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get profile failed: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	secret, uri, err := s.MFAUC.Enroll(ctx, userID, user.Username)
+	if err != nil {
+		return nil, mfaStatus("enroll totp failed", err)
+	}
+	return &imv1.EnrollTOTPResponse{Secret: secret, OtpauthUri: uri}, nil
+}
+
+func (s *AuthServer) ConfirmTOTP(ctx context.Context, req *imv1.MFACodeRequest) (*imv1.RecoveryCodesResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	recovery, err := s.MFAUC.Confirm(ctx, userID, req.Code)
+	if err != nil {
+		return nil, mfaStatus("confirm totp failed", err)
+	}
+	return &imv1.RecoveryCodesResponse{RecoveryCodes: recovery}, nil
+}
+
+func (s *AuthServer) DisableTOTP(ctx context.Context, req *imv1.MFACodeRequest) (*emptypb.Empty, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.MFAUC.Disable(ctx, userID, req.Code); err != nil {
+		return nil, mfaStatus("disable totp failed", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServer) RegenerateRecoveryCodes(ctx context.Context, req *imv1.MFACodeRequest) (*imv1.RecoveryCodesResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	recovery, err := s.MFAUC.RegenerateRecoveryCodes(ctx, userID, req.Code)
+	if err != nil {
+		return nil, mfaStatus("regenerate recovery codes failed", err)
+	}
+	return &imv1.RecoveryCodesResponse{RecoveryCodes: recovery}, nil
+}
+
+func (s *AuthServer) StepUpMFA(ctx context.Context, req *imv1.MFACodeRequest) (*imv1.StepUpMFAResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ttl, err := s.MFAUC.StepUp(ctx, userID, sessionKeyFromContext(ctx), req.Code)
+	if err != nil {
+		return nil, mfaStatus("step-up failed", err)
+	}
+	return &imv1.StepUpMFAResponse{ExpiresIn: int64(ttl / time.Second)}, nil
+}
+
+// requireStepUp 敏感操作前检查当前会话是否已完成再次验证
+func (s *AuthServer) requireStepUp(ctx context.Context, userID uint64) error {
+	if s.MFAUC == nil {
+		return nil
+	}
+	if err := s.MFAUC.RequireStepUp(ctx, userID, sessionKeyFromContext(ctx)); err != nil {
+		return mfaStatus("step-up required", err)
+	}
+	return nil
+}
+
+// sessionKeyFromContext 网关转发的当前会话 ID，会话上线前签发的令牌为空
+func sessionKeyFromContext(ctx context.Context) string {
+	if claims := authn.ClaimsFromIncoming(ctx); claims != nil {
+		return claims.SessionID
+	}
+	return ""
+}
+
+// mfaRequiredResp 第一步登录成功、等待二次验证的响应
+func mfaRequiredResp(err error) (*imv1.AuthResponse, bool) {
+	var required *authapp.MFARequiredError
+	if !errors.As(err, &required) {
+		return nil, false
+	}
+	ch := required.Challenge
+	return &imv1.AuthResponse{
+		MfaRequired:  true,
+		MfaToken:     ch.Token,
+		MfaExpiresIn: int64(time.Until(ch.ExpiresAt) / time.Second),
+	}, true
+}
+
+// mfaStatus 按业务错误映射 gRPC 状态码
+func mfaStatus(msg string, err error) error {
+	code := codes.Internal
+	switch {
+	case errors.Is(err, mfaapp.ErrInvalidCode):
+		code = codes.InvalidArgument
+	case errors.Is(err, mfaapp.ErrMFANotEnabled), errors.Is(err, mfaapp.ErrMFAAlreadyEnabled),
+		errors.Is(err, mfaapp.ErrEnrollmentNotFound):
+		code = codes.FailedPrecondition
+	case errors.Is(err, mfaapp.ErrStepUpRequired):
+		code = codes.PermissionDenied
+	case errors.Is(err, mfaapp.ErrMFALocked):
+		code = codes.ResourceExhausted
+	}
+	return status.Errorf(code, "%s: %v", msg, err)
+}
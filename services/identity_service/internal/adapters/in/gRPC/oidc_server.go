@@ -0,0 +1,86 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	imv1 "github.com/EthanQC/IM/api/gen/im/v1"
+	oidcapp "github.com/EthanQC/IM/services/identity_service/internal/application/oidc"
+	authErr "github.com/EthanQC/IM/services/identity_service/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *AuthServer) ListOIDCProviders(ctx context.Context, _ *emptypb.Empty) (*imv1.ListOIDCProvidersResponse, error) {
+	resp := &imv1.ListOIDCProvidersResponse{}
+	if s.OIDCUC == nil {
+		return resp, nil
+	}
+	for _, p := range s.OIDCUC.Providers() {
+		resp.Providers = append(resp.Providers, &imv1.OIDCProvider{Name: p.Name, DisplayName: p.DisplayName})
+	}
+	return resp, nil
+}
+
+func (s *AuthServer) BeginOIDCLogin(ctx context.Context, req *imv1.BeginOIDCLoginRequest) (*imv1.BeginOIDCLoginResponse, error) {
+	if s.OIDCUC == nil {
+		return nil, status.Errorf(codes.NotFound, "oidc provider not found")
+	}
+	authURL, state, binding, err := s.OIDCUC.Begin(ctx, req.Provider, deviceFromProto(req.Device))
+	if err != nil {
+		return nil, oidcStatus("begin oidc login failed", err)
+	}
+	return &imv1.BeginOIDCLoginResponse{AuthorizationUrl: authURL, State: state, BrowserBinding: binding}, nil
+}
+
+func (s *AuthServer) CompleteOIDCLogin(ctx context.Context, req *imv1.CompleteOIDCLoginRequest) (*imv1.AuthResponse, error) {
+	if s.OIDCUC == nil {
+		return nil, status.Errorf(codes.NotFound, "oidc provider not found")
+	}
+	if req.Code == "" || req.State == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "code and state required")
+	}
+	at, err := s.OIDCUC.Complete(ctx, req.Provider, req.Code, req.State, req.BrowserBinding)
+	if resp, ok := mfaRequiredResp(err); ok {
+		return resp, nil
+	}
+	if err != nil {
+		return nil, oidcStatus("oidc login failed", err)
+	}
+
+	resp := s.toAuthResp(at)
+	userID, err := strconv.ParseUint(at.UserID, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid user id: %v", err)
+	}
+	user, err := s.UserUC.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get profile failed: %v", err)
+	}
+	resp.Profile = &imv1.UserProfile{
+		User: &imv1.UserBrief{
+			Id:          int64(user.ID),
+			Username:    user.Username,
+			DisplayName: user.DisplayName,
+		},
+		Status: "active",
+	}
+	return resp, nil
+}
+
+// oidcStatus 按业务错误映射 gRPC 状态码
+func oidcStatus(msg string, err error) error {
+	code := codes.Internal
+	switch {
+	case errors.Is(err, oidcapp.ErrProviderNotFound):
+		code = codes.NotFound
+	case errors.Is(err, oidcapp.ErrInvalidState), errors.Is(err, oidcapp.ErrInvalidIDToken):
+		code = codes.Unauthenticated
+	case errors.Is(err, oidcapp.ErrAccountNotLinked), errors.Is(err, oidcapp.ErrDomainNotAllowed),
+		errors.Is(err, authErr.ErrUserBlocked), errors.Is(err, authErr.ErrUserDisabled):
+		code = codes.PermissionDenied
+	}
+	return status.Errorf(code, "%s: %v", msg, err)
+}
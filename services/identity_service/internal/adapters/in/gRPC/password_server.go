@@ -0,0 +1,80 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	imv1 "github.com/EthanQC/IM/api/gen/im/v1"
+	passwordapp "github.com/EthanQC/IM/services/identity_service/internal/application/password"
+	authErr "github.com/EthanQC/IM/services/identity_service/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *AuthServer) ChangePassword(ctx context.Context, req *imv1.ChangePasswordRequest) (*imv1.ChangePasswordResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.OldPassword == "" || req.NewPassword == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "old_password and new_password required")
+	}
+	if err := s.requireStepUp(ctx, userID); err != nil {
+		return nil, err
+	}
+	revoked, err := s.PasswordUC.Change(ctx, userID, sessionKeyFromContext(ctx), req.OldPassword, req.NewPassword)
+	if err != nil {
+		return nil, passwordStatus("change password failed", err)
+	}
+	return &imv1.ChangePasswordResponse{RevokedSessions: int32(revoked)}, nil
+}
+
+func (s *AuthServer) RequestPasswordReset(ctx context.Context, req *imv1.RequestPasswordResetRequest) (*imv1.RequestPasswordResetResponse, error) {
+	ttl, err := s.PasswordUC.RequestReset(ctx, req.Channel, req.Target, req.Ip)
+	if err != nil {
+		return nil, passwordStatus("request password reset failed", err)
+	}
+	return &imv1.RequestPasswordResetResponse{ExpiresIn: int64(ttl / time.Second)}, nil
+}
+
+func (s *AuthServer) VerifyPasswordReset(ctx context.Context, req *imv1.VerifyPasswordResetRequest) (*imv1.VerifyPasswordResetResponse, error) {
+	if req.Code == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "code required")
+	}
+	token, ttl, err := s.PasswordUC.VerifyReset(ctx, req.Channel, req.Target, req.Code, req.Ip)
+	if err != nil {
+		return nil, passwordStatus("verify password reset failed", err)
+	}
+	return &imv1.VerifyPasswordResetResponse{ResetToken: token, ExpiresIn: int64(ttl / time.Second)}, nil
+}
+
+func (s *AuthServer) ResetPassword(ctx context.Context, req *imv1.ResetPasswordRequest) (*emptypb.Empty, error) {
+	if req.ResetToken == "" || req.NewPassword == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "reset_token and new_password required")
+	}
+	if err := s.PasswordUC.Reset(ctx, req.ResetToken, req.NewPassword); err != nil {
+		return nil, passwordStatus("reset password failed", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// passwordStatus 按业务错误映射 gRPC 状态码
+func passwordStatus(msg string, err error) error {
+	code := codes.Internal
+	switch {
+	case errors.Is(err, authErr.ErrTooManyRequests), errors.Is(err, authErr.ErrTooManyAttempts):
+		code = codes.ResourceExhausted
+	case errors.Is(err, passwordapp.ErrWrongPassword), errors.Is(err, passwordapp.ErrSamePassword),
+		errors.Is(err, passwordapp.ErrInvalidTarget), errors.Is(err, passwordapp.ErrUnsupportedChannel),
+		errors.Is(err, authErr.ErrInvalidPassword), errors.Is(err, authErr.ErrCodeNotFound),
+		errors.Is(err, authErr.ErrCodeExpired), errors.Is(err, authErr.ErrCodeInvalid):
+		code = codes.InvalidArgument
+	case errors.Is(err, passwordapp.ErrInvalidResetToken):
+		code = codes.Unauthenticated
+	case errors.Is(err, passwordapp.ErrUserNotFound):
+		code = codes.NotFound
+	}
+	return status.Errorf(code, "%s: %v", msg, err)
+}
@@ -0,0 +1,89 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	imv1 "github.com/EthanQC/IM/api/gen/im/v1"
+	sessionapp "github.com/EthanQC/IM/services/identity_service/internal/application/session"
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *AuthServer) ListSessions(ctx context.Context, req *imv1.ListSessionsRequest) (*imv1.ListSessionsResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := s.SessionUC.List(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list sessions failed: %v", err)
+	}
+	resp := &imv1.ListSessionsResponse{Sessions: make([]*imv1.Session, 0, len(sessions))}
+	for _, sess := range sessions {
+		resp.Sessions = append(resp.Sessions, &imv1.Session{
+			Id:         sess.ID,
+			DeviceId:   sess.DeviceID,
+			Platform:   sess.Platform,
+			Ip:         sess.IP,
+			UserAgent:  sess.UserAgent,
+			CreatedAt:  sess.CreatedAt.Unix(),
+			LastSeenAt: sess.LastSeenAt.Unix(),
+			Current:    sess.ID == req.CurrentSessionId,
+		})
+	}
+	return resp, nil
+}
+
+func (s *AuthServer) RevokeSession(ctx context.Context, req *imv1.RevokeSessionRequest) (*emptypb.Empty, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.SessionId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "session_id required")
+	}
+	if err := s.requireStepUp(ctx, userID); err != nil {
+		return nil, err
+	}
+	if err := s.SessionUC.Revoke(ctx, userID, req.SessionId); err != nil {
+		if errors.Is(err, sessionapp.ErrSessionNotFound) {
+			return nil, status.Errorf(codes.NotFound, "revoke session failed: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "revoke session failed: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServer) RevokeOtherSessions(ctx context.Context, req *imv1.RevokeOtherSessionsRequest) (*imv1.RevokeOtherSessionsResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 没有当前会话时会撤销全部会话，要求调用方显式传入
+	if req.CurrentSessionId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "current_session_id required")
+	}
+	if err := s.requireStepUp(ctx, userID); err != nil {
+		return nil, err
+	}
+	n, err := s.SessionUC.RevokeOthers(ctx, userID, req.CurrentSessionId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "revoke sessions failed: %v", err)
+	}
+	return &imv1.RevokeOtherSessionsResponse{Revoked: int32(n)}, nil
+}
+
+func deviceFromProto(d *imv1.DeviceInfo) entity.DeviceInfo {
+	if d == nil {
+		return entity.DeviceInfo{}
+	}
+	return entity.DeviceInfo{
+		DeviceID:  d.DeviceId,
+		Platform:  d.Platform,
+		IP:        d.Ip,
+		UserAgent: d.UserAgent,
+	}
+}
@@ -3,8 +3,14 @@ package http
 import (
 	"encoding/json"
 	"errors"
+	"net"
 	"net/http"
+	"strconv"
+	"time"
 
+	authapp "github.com/EthanQC/IM/services/identity_service/internal/application/auth"
+	mfaapp "github.com/EthanQC/IM/services/identity_service/internal/application/mfa"
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
 	"github.com/EthanQC/IM/services/identity_service/internal/domain/vo"
 	"github.com/EthanQC/IM/services/identity_service/internal/ports/in"
 	authErr "github.com/EthanQC/IM/services/identity_service/pkg/errors"
@@ -21,18 +27,35 @@ func NewAuthHandler(authUC in.AuthUseCase) *AuthHandler {
 func (h *AuthHandler) RegisterRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("/login/password", h.loginByPassword)
 	mux.HandleFunc("/login/sms", h.loginBySMS)
+	mux.HandleFunc("/login/mfa", h.loginByMFA)
 	mux.HandleFunc("/token/refresh", h.refreshToken)
 	mux.HandleFunc("/logout", h.logout)
 }
 
 type authRequest struct {
-	Identifier string `json:"identifier"`
-	Password   string `json:"password"`
+	Identifier   string `json:"identifier"`
+	Password     string `json:"password"`
+	CaptchaToken string `json:"captcha_token"`
+	DeviceID     string `json:"device_id"`
+	Platform     string `json:"platform"`
 }
 
 type smsLoginRequest struct {
-	Phone string `json:"phone"`
-	Code  string `json:"code"`
+	Phone    string `json:"phone"`
+	Code     string `json:"code"`
+	DeviceID string `json:"device_id"`
+	Platform string `json:"platform"`
+}
+
+type mfaLoginRequest struct {
+	MFAToken string `json:"mfa_token"`
+	Code     string `json:"code"`
+}
+
+type mfaChallengeResponse struct {
+	MFARequired bool   `json:"mfa_required"`
+	MFAToken    string `json:"mfa_token"`
+	ExpiresIn   int64  `json:"expires_in"`
 }
 
 type refreshRequest struct {
@@ -40,7 +63,8 @@ type refreshRequest struct {
 }
 
 type logoutRequest struct {
-	AccessJTI string `json:"access_jti"`
+	AccessJTI    string `json:"access_jti"`
+	RefreshToken string `json:"refresh_token"`
 }
 
 type authResponse struct {
@@ -52,6 +76,12 @@ type errorResponse struct {
 	Error string `json:"error"`
 }
 
+// captchaRequiredResponse 连续登录失败后，客户端需完成人机验证并携带 captcha_token 重试
+type captchaRequiredResponse struct {
+	Error           string `json:"error"`
+	CaptchaRequired bool   `json:"captcha_required"`
+}
+
 func (h *AuthHandler) loginByPassword(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	var req authRequest
@@ -59,8 +89,16 @@ func (h *AuthHandler) loginByPassword(w http.ResponseWriter, r *http.Request) {
 		writeJSON(w, http.StatusBadRequest, errorResponse{"invalid request"})
 		return
 	}
-	at, err := h.authUC.LoginByPassword(ctx, req.Identifier, req.Password)
+	at, err := h.authUC.LoginByPassword(ctx, req.Identifier, req.Password, req.CaptchaToken, deviceInfo(r, req.DeviceID, req.Platform))
+	if writeMFAChallenge(w, err) {
+		return
+	}
+	if errors.Is(err, authErr.ErrCaptchaRequired) {
+		writeJSON(w, http.StatusUnauthorized, captchaRequiredResponse{err.Error(), true})
+		return
+	}
 	if err != nil {
+		setRetryAfter(w, err)
 		status := mapAuthError(err)
 		writeJSON(w, status, errorResponse{err.Error()})
 		return
@@ -80,8 +118,12 @@ func (h *AuthHandler) loginBySMS(w http.ResponseWriter, r *http.Request) {
 		writeJSON(w, http.StatusBadRequest, errorResponse{authErr.ErrInvalidPhone.Error()})
 		return
 	}
-	at, err := h.authUC.LoginBySMS(ctx, *phoneVO, req.Code)
+	at, err := h.authUC.LoginBySMS(ctx, *phoneVO, req.Code, deviceInfo(r, req.DeviceID, req.Platform))
+	if writeMFAChallenge(w, err) {
+		return
+	}
 	if err != nil {
+		setRetryAfter(w, err)
 		status := mapAuthError(err)
 		writeJSON(w, status, errorResponse{err.Error()})
 		return
@@ -89,6 +131,27 @@ func (h *AuthHandler) loginBySMS(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, authResponse{at.AccessToken, at.RefreshToken})
 }
 
+func (h *AuthHandler) loginByMFA(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var req mfaLoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, errorResponse{"invalid request"})
+		return
+	}
+	at, err := h.authUC.CompleteMFALogin(ctx, req.MFAToken, req.Code)
+	if err != nil {
+		code := http.StatusUnauthorized
+		if errors.Is(err, mfaapp.ErrMFALocked) || errors.Is(err, authErr.ErrAccountLocked) ||
+			errors.Is(err, authErr.ErrTooManyRequests) {
+			code = http.StatusTooManyRequests
+		}
+		setRetryAfter(w, err)
+		writeJSON(w, code, errorResponse{err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, authResponse{at.AccessToken, at.RefreshToken})
+}
+
 func (h *AuthHandler) refreshToken(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	var req refreshRequest
@@ -96,7 +159,7 @@ func (h *AuthHandler) refreshToken(w http.ResponseWriter, r *http.Request) {
 		writeJSON(w, http.StatusBadRequest, errorResponse{"invalid request"})
 		return
 	}
-	at, err := h.authUC.RefreshToken(ctx, req.RefreshToken)
+	at, err := h.authUC.RefreshToken(ctx, req.RefreshToken, deviceInfo(r, "", ""))
 	if err != nil {
 		writeJSON(w, http.StatusUnauthorized, errorResponse{err.Error()})
 		return
@@ -111,13 +174,41 @@ func (h *AuthHandler) logout(w http.ResponseWriter, r *http.Request) {
 		writeJSON(w, http.StatusBadRequest, errorResponse{"invalid request"})
 		return
 	}
-	if err := h.authUC.Logout(ctx, req.AccessJTI); err != nil {
+	if err := h.authUC.Logout(ctx, req.AccessJTI, req.RefreshToken, time.Time{}); err != nil {
 		writeJSON(w, http.StatusInternalServerError, errorResponse{"logout failed"})
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// writeMFAChallenge 账号需要二次验证时返回挑战令牌
+func writeMFAChallenge(w http.ResponseWriter, err error) bool {
+	var required *authapp.MFARequiredError
+	if !errors.As(err, &required) {
+		return false
+	}
+	writeJSON(w, http.StatusOK, mfaChallengeResponse{
+		MFARequired: true,
+		MFAToken:    required.Challenge.Token,
+		ExpiresIn:   int64(time.Until(required.Challenge.ExpiresAt) / time.Second),
+	})
+	return true
+}
+
+// deviceInfo 登录设备信息，IP 取连接的远端地址
+func deviceInfo(r *http.Request, deviceID, platform string) entity.DeviceInfo {
+	ip := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		ip = host
+	}
+	return entity.DeviceInfo{
+		DeviceID:  deviceID,
+		Platform:  platform,
+		IP:        ip,
+		UserAgent: r.UserAgent(),
+	}
+}
+
 func mapAuthError(err error) int {
 	switch {
 	case errors.Is(err, authErr.ErrInvalidPassword),
@@ -125,11 +216,21 @@ func mapAuthError(err error) int {
 		return http.StatusUnauthorized
 	case errors.Is(err, authErr.ErrUserBlocked):
 		return http.StatusForbidden
+	case errors.Is(err, authErr.ErrAccountLocked),
+		errors.Is(err, authErr.ErrTooManyRequests):
+		return http.StatusTooManyRequests
 	default:
 		return http.StatusBadRequest
 	}
 }
 
+// setRetryAfter 限流或锁定错误带有建议重试时间时设置 Retry-After（秒，向上取整）
+func setRetryAfter(w http.ResponseWriter, err error) {
+	if d, ok := authErr.RetryAfter(err); ok {
+		w.Header().Set("Retry-After", strconv.FormatInt(int64((d+time.Second-1)/time.Second), 10))
+	}
+}
+
 func writeJSON(w http.ResponseWriter, status int, v interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
@@ -0,0 +1,32 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/in"
+)
+
+// JWKSPath 公钥集合的标准发现路径
+const JWKSPath = "/.well-known/jwks.json"
+
+type JWKSHandler struct {
+	keyUC in.SigningKeyUseCase
+}
+
+func NewJWKSHandler(keyUC in.SigningKeyUseCase) *JWKSHandler {
+	return &JWKSHandler{keyUC: keyUC}
+}
+
+func (h *JWKSHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc(JWKSPath, h.jwks)
+}
+
+func (h *JWKSHandler) jwks(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeJSON(w, http.StatusMethodNotAllowed, errorResponse{"method not allowed"})
+		return
+	}
+	// 验签方按 kid 未命中时会主动刷新，这里允许短时缓存
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	writeJSON(w, http.StatusOK, h.keyUC.JWKS())
+}
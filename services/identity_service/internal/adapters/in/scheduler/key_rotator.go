@@ -0,0 +1,80 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/in"
+)
+
+// KeyRotator 定期同步签名密钥，驱动计划轮换
+type KeyRotator struct {
+	interval time.Duration
+	keyUC    in.SigningKeyUseCase
+	ctx      context.Context
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+	mu       sync.Mutex
+	running  bool
+}
+
+// NewKeyRotator 创建密钥轮换调度器，interval 应小于密钥激活延迟
+func NewKeyRotator(keyUC in.SigningKeyUseCase, interval time.Duration) *KeyRotator {
+	return &KeyRotator{interval: interval, keyUC: keyUC}
+}
+
+// Start 启动调度
+func (r *KeyRotator) Start() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.running {
+		return fmt.Errorf("key rotator already running")
+	}
+	r.running = true
+	r.ctx, r.cancel = context.WithCancel(context.Background())
+
+	r.wg.Add(1)
+	go r.loop()
+
+	zap.L().Info("Key rotator started", zap.Duration("interval", r.interval))
+	return nil
+}
+
+// Stop 停止调度
+func (r *KeyRotator) Stop() {
+	r.mu.Lock()
+	if !r.running {
+		r.mu.Unlock()
+		return
+	}
+	r.running = false
+	r.mu.Unlock()
+
+	r.cancel()
+	r.wg.Wait()
+	zap.L().Info("Key rotator stopped")
+}
+
+func (r *KeyRotator) loop() {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(r.ctx, 30*time.Second)
+			if err := r.keyUC.Sync(ctx); err != nil {
+				zap.L().Warn("Sync signing keys failed", zap.Error(err))
+			}
+			cancel()
+		}
+	}
+}
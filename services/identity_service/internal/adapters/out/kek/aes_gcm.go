@@ -0,0 +1,99 @@
+package kek
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
+)
+
+// ciphertextPrefix 密文格式：kek:<kek_id>:<base64(nonce|ciphertext)>
+const ciphertextPrefix = "kek:"
+
+// AESGCMCipher 使用配置中的 KEK（AES-256-GCM）加密签名私钥
+// 支持多把 KEK 按 id 共存：current 加密新数据，其余只用于解密，便于轮换 KEK
+type AESGCMCipher struct {
+	current string
+	aeads   map[string]cipher.AEAD
+}
+
+// NewAESGCMCipher keys 为 id -> base64 编码的 32 字节密钥，current 必须在 keys 中
+func NewAESGCMCipher(current string, keys map[string]string) (out.PrivateKeyCipher, error) {
+	if current == "" {
+		return nil, errors.New("current kek id is required")
+	}
+	aeads := make(map[string]cipher.AEAD, len(keys))
+	for id, encoded := range keys {
+		if strings.Contains(id, ":") {
+			return nil, fmt.Errorf("kek id %q must not contain ':'", id)
+		}
+		raw, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("decode kek %s: %w", id, err)
+		}
+		if len(raw) != 32 {
+			return nil, fmt.Errorf("kek %s must be 32 bytes, got %d", id, len(raw))
+		}
+		block, err := aes.NewCipher(raw)
+		if err != nil {
+			return nil, fmt.Errorf("init kek %s: %w", id, err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("init kek %s: %w", id, err)
+		}
+		aeads[id] = aead
+	}
+	if _, ok := aeads[current]; !ok {
+		return nil, fmt.Errorf("current kek %s not configured", current)
+	}
+	return &AESGCMCipher{current: current, aeads: aeads}, nil
+}
+
+// Encrypt 以 kid 作为附加数据，密文不能挪用到其他密钥记录
+func (c *AESGCMCipher) Encrypt(_ context.Context, kid, plaintext string) (string, error) {
+	aead := c.aeads[c.current]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("generate nonce: %w", err)
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), []byte(kid))
+	return ciphertextPrefix + c.current + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (c *AESGCMCipher) Decrypt(_ context.Context, kid, ciphertext string) (string, error) {
+	if !c.IsEncrypted(ciphertext) {
+		return "", errors.New("private key is not encrypted")
+	}
+	kekID, encoded, ok := strings.Cut(strings.TrimPrefix(ciphertext, ciphertextPrefix), ":")
+	if !ok {
+		return "", errors.New("malformed encrypted private key")
+	}
+	aead, ok := c.aeads[kekID]
+	if !ok {
+		return "", fmt.Errorf("kek %s not configured", kekID)
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("decode encrypted private key: %w", err)
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("malformed encrypted private key")
+	}
+	nonce, body := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, body, []byte(kid))
+	if err != nil {
+		return "", fmt.Errorf("decrypt private key: %w", err)
+	}
+	return string(plaintext), nil
+}
+
+func (c *AESGCMCipher) IsEncrypted(stored string) bool {
+	return strings.HasPrefix(stored, ciphertextPrefix)
+}
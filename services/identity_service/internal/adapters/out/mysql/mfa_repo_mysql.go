@@ -0,0 +1,141 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
+)
+
+type UserMFAModel struct {
+	UserID       uint64     `gorm:"column:user_id;primaryKey"`
+	Secret       string     `gorm:"column:secret;type:varchar(64);not null"`
+	Enabled      bool       `gorm:"column:enabled;not null;default:false"`
+	LastUsedStep int64      `gorm:"column:last_used_step;not null;default:0"`
+	CreatedAt    time.Time  `gorm:"column:created_at;not null"`
+	EnabledAt    *time.Time `gorm:"column:enabled_at"`
+	UpdatedAt    time.Time  `gorm:"column:updated_at;not null"`
+}
+
+func (UserMFAModel) TableName() string {
+	return "user_mfa"
+}
+
+// MFARecoveryCodeModel 每个恢复码一行，使用时按摘要删除，删除成功即视为消费成功
+type MFARecoveryCodeModel struct {
+	UserID   uint64 `gorm:"column:user_id;primaryKey"`
+	CodeHash string `gorm:"column:code_hash;type:char(64);primaryKey"`
+}
+
+func (MFARecoveryCodeModel) TableName() string {
+	return "user_mfa_recovery_codes"
+}
+
+func (m *UserMFAModel) toEntity(codes []string) *entity.UserMFA {
+	return &entity.UserMFA{
+		UserID:        m.UserID,
+		Secret:        m.Secret,
+		Enabled:       m.Enabled,
+		RecoveryCodes: codes,
+		LastUsedStep:  m.LastUsedStep,
+		CreatedAt:     m.CreatedAt,
+		EnabledAt:     m.EnabledAt,
+		UpdatedAt:     m.UpdatedAt,
+	}
+}
+
+type MFARepoMysql struct {
+	db *gorm.DB
+}
+
+func NewMFARepoMysql(db *gorm.DB) out.MFARepository {
+	return &MFARepoMysql{db: db}
+}
+
+func (r *MFARepoMysql) Get(ctx context.Context, userID uint64) (*entity.UserMFA, error) {
+	var m UserMFAModel
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&m).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var codes []string
+	if err := r.db.WithContext(ctx).Model(&MFARecoveryCodeModel{}).
+		Where("user_id = ?", userID).
+		Pluck("code_hash", &codes).Error; err != nil {
+		return nil, err
+	}
+	return m.toEntity(codes), nil
+}
+
+func (r *MFARepoMysql) Save(ctx context.Context, mfa *entity.UserMFA) error {
+	m := &UserMFAModel{
+		UserID:       mfa.UserID,
+		Secret:       mfa.Secret,
+		Enabled:      mfa.Enabled,
+		LastUsedStep: mfa.LastUsedStep,
+		CreatedAt:    mfa.CreatedAt,
+		EnabledAt:    mfa.EnabledAt,
+		UpdatedAt:    mfa.UpdatedAt,
+	}
+	// last_used_step 只由 ConsumeStep 推进，覆盖写入会让已用过的验证码重新可用
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"secret", "enabled", "enabled_at", "updated_at"}),
+	}).Create(m).Error
+}
+
+func (r *MFARepoMysql) Delete(ctx context.Context, userID uint64) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&MFARecoveryCodeModel{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userID).Delete(&UserMFAModel{}).Error
+	})
+}
+
+func (r *MFARepoMysql) ConsumeStep(ctx context.Context, userID uint64, step int64) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&UserMFAModel{}).
+		Where("user_id = ? AND last_used_step < ?", userID, step).
+		Updates(map[string]interface{}{
+			"last_used_step": step,
+			"updated_at":     time.Now(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *MFARepoMysql) ConsumeRecoveryCode(ctx context.Context, userID uint64, codeHash string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? AND code_hash = ?", userID, codeHash).
+		Delete(&MFARecoveryCodeModel{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *MFARepoMysql) ReplaceRecoveryCodes(ctx context.Context, userID uint64, codeHashes []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&MFARecoveryCodeModel{}).Error; err != nil {
+			return err
+		}
+		if len(codeHashes) == 0 {
+			return nil
+		}
+		rows := make([]MFARecoveryCodeModel, 0, len(codeHashes))
+		for _, h := range codeHashes {
+			rows = append(rows, MFARecoveryCodeModel{UserID: userID, CodeHash: h})
+		}
+		return tx.Create(&rows).Error
+	})
+}
@@ -0,0 +1,103 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	mysqldriver "github.com/go-sql-driver/mysql"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
+)
+
+type UserPrivacyModel struct {
+	UserID              uint64    `gorm:"column:user_id;primaryKey"`
+	AllowFindByUsername bool      `gorm:"column:allow_find_by_username;not null;default:true"`
+	AllowFindByPhone    bool      `gorm:"column:allow_find_by_phone;not null;default:true"`
+	AllowFindByEmail    bool      `gorm:"column:allow_find_by_email;not null;default:false"`
+	ShareCode           *string   `gorm:"column:share_code;type:varchar(16);uniqueIndex:uk_share_code"` // NULL 表示未生成
+	UpdatedAt           time.Time `gorm:"column:updated_at;not null"`
+}
+
+func (UserPrivacyModel) TableName() string {
+	return "user_privacy"
+}
+
+func (m *UserPrivacyModel) toEntity() *entity.UserPrivacy {
+	p := &entity.UserPrivacy{
+		UserID:              m.UserID,
+		AllowFindByUsername: m.AllowFindByUsername,
+		AllowFindByPhone:    m.AllowFindByPhone,
+		AllowFindByEmail:    m.AllowFindByEmail,
+		UpdatedAt:           m.UpdatedAt,
+	}
+	if m.ShareCode != nil {
+		p.ShareCode = *m.ShareCode
+	}
+	return p
+}
+
+type UserPrivacyRepoMysql struct {
+	db *gorm.DB
+}
+
+func NewUserPrivacyRepoMysql(db *gorm.DB) out.UserPrivacyRepository {
+	return &UserPrivacyRepoMysql{db: db}
+}
+
+func (r *UserPrivacyRepoMysql) Get(ctx context.Context, userID uint64) (*entity.UserPrivacy, error) {
+	return r.first(ctx, "user_id = ?", userID)
+}
+
+func (r *UserPrivacyRepoMysql) GetByShareCode(ctx context.Context, code string) (*entity.UserPrivacy, error) {
+	return r.first(ctx, "share_code = ?", code)
+}
+
+func (r *UserPrivacyRepoMysql) first(ctx context.Context, query string, arg interface{}) (*entity.UserPrivacy, error) {
+	var m UserPrivacyModel
+	err := r.db.WithContext(ctx).Where(query, arg).First(&m).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return m.toEntity(), nil
+}
+
+func (r *UserPrivacyRepoMysql) Save(ctx context.Context, p *entity.UserPrivacy) error {
+	m := &UserPrivacyModel{
+		UserID:              p.UserID,
+		AllowFindByUsername: p.AllowFindByUsername,
+		AllowFindByPhone:    p.AllowFindByPhone,
+		AllowFindByEmail:    p.AllowFindByEmail,
+		UpdatedAt:           p.UpdatedAt,
+	}
+	if p.ShareCode != "" {
+		code := p.ShareCode
+		m.ShareCode = &code
+	}
+	// 布尔字段带数据库默认值，需显式列出才能写入 false
+	return shareCodeWriteError(r.db.WithContext(ctx).Select("*").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		UpdateAll: true,
+	}).Create(m).Error)
+}
+
+// mysqlErrDuplicateEntry MySQL唯一键冲突错误码
+const mysqlErrDuplicateEntry = 1062
+
+// shareCodeWriteError 将 uk_share_code 上的唯一键冲突转换为 out.ErrShareCodeTaken
+func shareCodeWriteError(err error) error {
+	var mysqlErr *mysqldriver.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry &&
+		strings.Contains(mysqlErr.Message, "uk_share_code") {
+		return out.ErrShareCodeTaken
+	}
+	return err
+}
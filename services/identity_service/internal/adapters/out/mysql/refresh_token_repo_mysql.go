@@ -14,6 +14,9 @@ import (
 type RefreshTokenModel struct {
 	ID               string    `gorm:"column:id;primaryKey;type:varchar(64)"`
 	UserID           string    `gorm:"column:user_id;type:varchar(64);not null;index"`
+	SessionID        string    `gorm:"column:session_id;type:varchar(64);not null;default:'';index"`
+	FamilyID         string    `gorm:"column:family_id;type:varchar(64);not null;default:'';index"`
+	ReplacedBy       string    `gorm:"column:replaced_by;type:varchar(64);not null;default:''"`
 	AccessToken      string    `gorm:"column:access_token;type:text"`
 	RefreshToken     string    `gorm:"column:refresh_token;type:text;not null"`
 	RefreshExpiresAt time.Time `gorm:"column:refresh_expires_at;not null"`
@@ -30,6 +33,9 @@ func refreshTokenModelFromEntity(token *entity.AuthToken) *RefreshTokenModel {
 	return &RefreshTokenModel{
 		ID:               token.ID,
 		UserID:           token.UserID,
+		SessionID:        token.SessionID,
+		FamilyID:         token.FamilyID,
+		ReplacedBy:       token.ReplacedBy,
 		AccessToken:      token.AccessToken,
 		RefreshToken:     token.RefreshToken,
 		RefreshExpiresAt: token.RefreshExpiresAt,
@@ -42,6 +48,9 @@ func (m *RefreshTokenModel) toEntity() *entity.AuthToken {
 	return &entity.AuthToken{
 		ID:               m.ID,
 		UserID:           m.UserID,
+		SessionID:        m.SessionID,
+		FamilyID:         m.FamilyID,
+		ReplacedBy:       m.ReplacedBy,
 		AccessToken:      m.AccessToken,
 		RefreshToken:     m.RefreshToken,
 		RefreshExpiresAt: m.RefreshExpiresAt,
@@ -88,3 +97,76 @@ func (r *RefreshTokenRepoMysql) Revoke(ctx context.Context, token string) error
 		Where("id = ?", token).
 		Update("is_revoked", true).Error
 }
+
+func (r *RefreshTokenRepoMysql) ListBySessionSince(ctx context.Context, sessionID string, since time.Time) ([]*entity.AuthToken, error) {
+	var models []RefreshTokenModel
+	err := r.db.WithContext(ctx).
+		Where("session_id = ? AND created_at > ?", sessionID, since).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	tokens := make([]*entity.AuthToken, 0, len(models))
+	for i := range models {
+		tokens = append(tokens, models[i].toEntity())
+	}
+	return tokens, nil
+}
+
+func (r *RefreshTokenRepoMysql) RevokeBySession(ctx context.Context, sessionID string) error {
+	return r.db.WithContext(ctx).
+		Model(&RefreshTokenModel{}).
+		Where("session_id = ? AND is_revoked = ?", sessionID, false).
+		Update("is_revoked", true).Error
+}
+
+func (r *RefreshTokenRepoMysql) Rotate(ctx context.Context, token string, next *entity.AuthToken) (bool, error) {
+	rotated := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 条件更新保证并发刷新时只有一个请求能轮换成功
+		result := tx.Model(&RefreshTokenModel{}).
+			Where("id = ? AND is_revoked = ?", token, false).
+			Updates(map[string]interface{}{
+				"is_revoked":  true,
+				"replaced_by": next.ID,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != 1 {
+			return nil
+		}
+		// 新令牌保存失败时回滚旧令牌的撤销，客户端可用旧令牌重试
+		if err := tx.Create(refreshTokenModelFromEntity(next)).Error; err != nil {
+			return err
+		}
+		rotated = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return rotated, nil
+}
+
+func (r *RefreshTokenRepoMysql) ListByFamily(ctx context.Context, familyID string) ([]*entity.AuthToken, error) {
+	var models []RefreshTokenModel
+	err := r.db.WithContext(ctx).
+		Where("family_id = ? OR id = ?", familyID, familyID).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	tokens := make([]*entity.AuthToken, 0, len(models))
+	for i := range models {
+		tokens = append(tokens, models[i].toEntity())
+	}
+	return tokens, nil
+}
+
+func (r *RefreshTokenRepoMysql) RevokeFamily(ctx context.Context, familyID string) error {
+	return r.db.WithContext(ctx).
+		Model(&RefreshTokenModel{}).
+		Where("(family_id = ? OR id = ?) AND is_revoked = ?", familyID, familyID, false).
+		Update("is_revoked", true).Error
+}
@@ -0,0 +1,177 @@
+package mysql
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
+)
+
+type RoleModel struct {
+	Name        string    `gorm:"column:name;primaryKey;type:varchar(32)"`
+	Description string    `gorm:"column:description;type:varchar(255);not null;default:''"`
+	Permissions string    `gorm:"column:permissions;type:text;not null"` // JSON 数组
+	IsSystem    bool      `gorm:"column:is_system;not null;default:false"`
+	CreatedAt   time.Time `gorm:"column:created_at;not null"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;not null"`
+}
+
+func (RoleModel) TableName() string {
+	return "roles"
+}
+
+func (m *RoleModel) toEntity() *entity.Role {
+	var perms []string
+	_ = json.Unmarshal([]byte(m.Permissions), &perms)
+	return &entity.Role{
+		Name:        m.Name,
+		Description: m.Description,
+		Permissions: perms,
+		IsSystem:    m.IsSystem,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func toRoleModel(role *entity.Role) (*RoleModel, error) {
+	perms := role.Permissions
+	if perms == nil {
+		perms = []string{}
+	}
+	data, err := json.Marshal(perms)
+	if err != nil {
+		return nil, err
+	}
+	return &RoleModel{
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: string(data),
+		IsSystem:    role.IsSystem,
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}, nil
+}
+
+type UserRoleModel struct {
+	UserID    uint64    `gorm:"column:user_id;primaryKey"`
+	RoleName  string    `gorm:"column:role_name;primaryKey;type:varchar(32);index"`
+	GrantedBy uint64    `gorm:"column:granted_by;not null;default:0"`
+	CreatedAt time.Time `gorm:"column:created_at;not null"`
+}
+
+func (UserRoleModel) TableName() string {
+	return "user_roles"
+}
+
+type RoleRepoMysql struct {
+	db *gorm.DB
+}
+
+func NewRoleRepoMysql(db *gorm.DB) out.RoleRepository {
+	return &RoleRepoMysql{db: db}
+}
+
+func (r *RoleRepoMysql) List(ctx context.Context) ([]*entity.Role, error) {
+	var models []RoleModel
+	if err := r.db.WithContext(ctx).Order("is_system DESC, name ASC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+	roles := make([]*entity.Role, 0, len(models))
+	for i := range models {
+		roles = append(roles, models[i].toEntity())
+	}
+	return roles, nil
+}
+
+func (r *RoleRepoMysql) Get(ctx context.Context, name string) (*entity.Role, error) {
+	var m RoleModel
+	err := r.db.WithContext(ctx).Where("name = ?", name).First(&m).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return m.toEntity(), nil
+}
+
+func (r *RoleRepoMysql) Create(ctx context.Context, role *entity.Role) error {
+	m, err := toRoleModel(role)
+	if err != nil {
+		return err
+	}
+	return r.db.WithContext(ctx).Create(m).Error
+}
+
+func (r *RoleRepoMysql) Update(ctx context.Context, role *entity.Role) error {
+	m, err := toRoleModel(role)
+	if err != nil {
+		return err
+	}
+	return r.db.WithContext(ctx).
+		Model(&RoleModel{}).
+		Where("name = ?", role.Name).
+		Updates(map[string]interface{}{
+			"description": m.Description,
+			"permissions": m.Permissions,
+			"updated_at":  m.UpdatedAt,
+		}).Error
+}
+
+func (r *RoleRepoMysql) Delete(ctx context.Context, name string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("role_name = ?", name).Delete(&UserRoleModel{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("name = ?", name).Delete(&RoleModel{}).Error
+	})
+}
+
+func (r *RoleRepoMysql) EnsureSystemRole(ctx context.Context, role *entity.Role) error {
+	m, err := toRoleModel(role)
+	if err != nil {
+		return err
+	}
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"description", "permissions", "is_system", "updated_at"}),
+	}).Create(m).Error
+}
+
+func (r *RoleRepoMysql) ListByUser(ctx context.Context, userID uint64) ([]*entity.Role, error) {
+	var models []RoleModel
+	err := r.db.WithContext(ctx).
+		Table("roles").
+		Joins("JOIN user_roles ON user_roles.role_name = roles.name").
+		Where("user_roles.user_id = ?", userID).
+		Order("roles.name ASC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	roles := make([]*entity.Role, 0, len(models))
+	for i := range models {
+		roles = append(roles, models[i].toEntity())
+	}
+	return roles, nil
+}
+
+func (r *RoleRepoMysql) Assign(ctx context.Context, userID uint64, roleName string, grantedBy uint64) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&UserRoleModel{
+		UserID:    userID,
+		RoleName:  roleName,
+		GrantedBy: grantedBy,
+		CreatedAt: time.Now(),
+	}).Error
+}
+
+func (r *RoleRepoMysql) Unassign(ctx context.Context, userID uint64, roleName string) error {
+	return r.db.WithContext(ctx).
+		Where("user_id = ? AND role_name = ?", userID, roleName).
+		Delete(&UserRoleModel{}).Error
+}
@@ -0,0 +1,128 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
+)
+
+type SessionModel struct {
+	ID         string     `gorm:"column:id;primaryKey;type:varchar(64)"`
+	UserID     string     `gorm:"column:user_id;type:varchar(64);not null;index:idx_user_device,priority:1"`
+	DeviceID   string     `gorm:"column:device_id;type:varchar(128);not null;index:idx_user_device,priority:2"`
+	Platform   string     `gorm:"column:platform;type:varchar(32);not null;default:''"`
+	IP         string     `gorm:"column:ip;type:varchar(64);not null;default:''"`
+	UserAgent  string     `gorm:"column:user_agent;type:varchar(512);not null;default:''"`
+	CreatedAt  time.Time  `gorm:"column:created_at;not null"`
+	LastSeenAt time.Time  `gorm:"column:last_seen_at;not null"`
+	ExpiresAt  time.Time  `gorm:"column:expires_at;not null"`
+	RevokedAt  *time.Time `gorm:"column:revoked_at"`
+}
+
+func (SessionModel) TableName() string {
+	return "user_sessions"
+}
+
+func sessionModelFromEntity(s *entity.Session) *SessionModel {
+	return &SessionModel{
+		ID:         s.ID,
+		UserID:     s.UserID,
+		DeviceID:   s.DeviceID,
+		Platform:   s.Platform,
+		IP:         s.IP,
+		UserAgent:  s.UserAgent,
+		CreatedAt:  s.CreatedAt,
+		LastSeenAt: s.LastSeenAt,
+		ExpiresAt:  s.ExpiresAt,
+		RevokedAt:  s.RevokedAt,
+	}
+}
+
+func (m *SessionModel) toEntity() *entity.Session {
+	return &entity.Session{
+		ID:         m.ID,
+		UserID:     m.UserID,
+		DeviceID:   m.DeviceID,
+		Platform:   m.Platform,
+		IP:         m.IP,
+		UserAgent:  m.UserAgent,
+		CreatedAt:  m.CreatedAt,
+		LastSeenAt: m.LastSeenAt,
+		ExpiresAt:  m.ExpiresAt,
+		RevokedAt:  m.RevokedAt,
+	}
+}
+
+type SessionRepoMysql struct {
+	db *gorm.DB
+}
+
+func NewSessionRepoMysql(db *gorm.DB) out.SessionRepository {
+	return &SessionRepoMysql{db: db}
+}
+
+func (r *SessionRepoMysql) Create(ctx context.Context, session *entity.Session) error {
+	return r.db.WithContext(ctx).Create(sessionModelFromEntity(session)).Error
+}
+
+func (r *SessionRepoMysql) Get(ctx context.Context, id string) (*entity.Session, error) {
+	var m SessionModel
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&m).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return m.toEntity(), nil
+}
+
+func (r *SessionRepoMysql) Update(ctx context.Context, session *entity.Session) error {
+	return r.db.WithContext(ctx).
+		Model(&SessionModel{}).
+		Where("id = ?", session.ID).
+		Updates(map[string]interface{}{
+			"ip":           session.IP,
+			"user_agent":   session.UserAgent,
+			"last_seen_at": session.LastSeenAt,
+			"expires_at":   session.ExpiresAt,
+			"revoked_at":   session.RevokedAt,
+		}).Error
+}
+
+func (r *SessionRepoMysql) ListActive(ctx context.Context, userID string, now time.Time) ([]*entity.Session, error) {
+	var models []SessionModel
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, now).
+		Order("last_seen_at DESC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	sessions := make([]*entity.Session, 0, len(models))
+	for i := range models {
+		sessions = append(sessions, models[i].toEntity())
+	}
+	return sessions, nil
+}
+
+func (r *SessionRepoMysql) ExistsByUser(ctx context.Context, userID string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&SessionModel{}).Where("user_id = ?", userID).Limit(1).Count(&count).Error
+	return count > 0, err
+}
+
+func (r *SessionRepoMysql) ExistsByDevice(ctx context.Context, userID, deviceID string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&SessionModel{}).
+		Where("user_id = ? AND device_id = ?", userID, deviceID).
+		Limit(1).
+		Count(&count).Error
+	return count > 0, err
+}
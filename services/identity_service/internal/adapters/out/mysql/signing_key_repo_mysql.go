@@ -0,0 +1,91 @@
+package mysql
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
+)
+
+type SigningKeyModel struct {
+	KID        string     `gorm:"column:kid;primaryKey;type:varchar(64)"`
+	Algorithm  string     `gorm:"column:algorithm;type:varchar(16);not null"`
+	PrivateKey string     `gorm:"column:private_key;type:text;not null"`
+	CreatedAt  time.Time  `gorm:"column:created_at;not null"`
+	RetiredAt  *time.Time `gorm:"column:retired_at"`
+	ExpiresAt  *time.Time `gorm:"column:expires_at;index"`
+}
+
+func (SigningKeyModel) TableName() string {
+	return "signing_keys"
+}
+
+func (m *SigningKeyModel) toEntity() *entity.SigningKey {
+	return &entity.SigningKey{
+		KID:        m.KID,
+		Algorithm:  m.Algorithm,
+		PrivateKey: m.PrivateKey,
+		CreatedAt:  m.CreatedAt,
+		RetiredAt:  m.RetiredAt,
+		ExpiresAt:  m.ExpiresAt,
+	}
+}
+
+type SigningKeyRepoMysql struct {
+	db *gorm.DB
+}
+
+func NewSigningKeyRepoMysql(db *gorm.DB) out.SigningKeyRepository {
+	return &SigningKeyRepoMysql{db: db}
+}
+
+func (r *SigningKeyRepoMysql) ListUsable(ctx context.Context, now time.Time) ([]*entity.SigningKey, error) {
+	var models []SigningKeyModel
+	err := r.db.WithContext(ctx).
+		Where("expires_at IS NULL OR expires_at > ?", now).
+		Order("created_at DESC, kid DESC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]*entity.SigningKey, 0, len(models))
+	for i := range models {
+		keys = append(keys, models[i].toEntity())
+	}
+	return keys, nil
+}
+
+func (r *SigningKeyRepoMysql) Create(ctx context.Context, key *entity.SigningKey) error {
+	return r.db.WithContext(ctx).Create(&SigningKeyModel{
+		KID:        key.KID,
+		Algorithm:  key.Algorithm,
+		PrivateKey: key.PrivateKey,
+		CreatedAt:  key.CreatedAt,
+	}).Error
+}
+
+func (r *SigningKeyRepoMysql) Retire(ctx context.Context, key *entity.SigningKey) error {
+	return r.db.WithContext(ctx).
+		Model(&SigningKeyModel{}).
+		Where("kid = ? AND retired_at IS NULL", key.KID).
+		Updates(map[string]interface{}{
+			"retired_at": key.RetiredAt,
+			"expires_at": key.ExpiresAt,
+		}).Error
+}
+
+func (r *SigningKeyRepoMysql) DeleteExpired(ctx context.Context, now time.Time) error {
+	return r.db.WithContext(ctx).
+		Where("expires_at IS NOT NULL AND expires_at <= ?", now).
+		Delete(&SigningKeyModel{}).Error
+}
+
+func (r *SigningKeyRepoMysql) UpdatePrivateKey(ctx context.Context, kid, privateKey string) error {
+	return r.db.WithContext(ctx).
+		Model(&SigningKeyModel{}).
+		Where("kid = ?", kid).
+		Update("private_key", privateKey).Error
+}
@@ -0,0 +1,80 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
+)
+
+type UserIdentityModel struct {
+	ID          uint64    `gorm:"column:id;primaryKey;autoIncrement"`
+	Provider    string    `gorm:"column:provider;type:varchar(64);not null;uniqueIndex:uk_provider_subject,priority:1"`
+	Subject     string    `gorm:"column:subject;type:varchar(255);not null;uniqueIndex:uk_provider_subject,priority:2"`
+	UserID      uint64    `gorm:"column:user_id;not null;index:idx_user"`
+	Email       string    `gorm:"column:email;type:varchar(255);not null;default:''"`
+	CreatedAt   time.Time `gorm:"column:created_at;not null"`
+	LastLoginAt time.Time `gorm:"column:last_login_at;not null"`
+}
+
+func (UserIdentityModel) TableName() string {
+	return "user_identities"
+}
+
+func (m *UserIdentityModel) toEntity() *entity.UserIdentity {
+	return &entity.UserIdentity{
+		ID:          m.ID,
+		Provider:    m.Provider,
+		Subject:     m.Subject,
+		UserID:      m.UserID,
+		Email:       m.Email,
+		CreatedAt:   m.CreatedAt,
+		LastLoginAt: m.LastLoginAt,
+	}
+}
+
+type UserIdentityRepoMysql struct {
+	db *gorm.DB
+}
+
+func NewUserIdentityRepoMysql(db *gorm.DB) out.UserIdentityRepository {
+	return &UserIdentityRepoMysql{db: db}
+}
+
+func (r *UserIdentityRepoMysql) Get(ctx context.Context, provider, subject string) (*entity.UserIdentity, error) {
+	var m UserIdentityModel
+	err := r.db.WithContext(ctx).Where("provider = ? AND subject = ?", provider, subject).First(&m).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return m.toEntity(), nil
+}
+
+func (r *UserIdentityRepoMysql) Create(ctx context.Context, identity *entity.UserIdentity) error {
+	m := &UserIdentityModel{
+		Provider:    identity.Provider,
+		Subject:     identity.Subject,
+		UserID:      identity.UserID,
+		Email:       identity.Email,
+		CreatedAt:   identity.CreatedAt,
+		LastLoginAt: identity.LastLoginAt,
+	}
+	if err := r.db.WithContext(ctx).Create(m).Error; err != nil {
+		return err
+	}
+	identity.ID = m.ID
+	return nil
+}
+
+func (r *UserIdentityRepoMysql) Touch(ctx context.Context, id uint64) error {
+	return r.db.WithContext(ctx).Model(&UserIdentityModel{}).
+		Where("id = ?", id).
+		Update("last_login_at", time.Now()).Error
+}
@@ -3,6 +3,7 @@ package mysql
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
@@ -161,3 +162,40 @@ func (r *UserRepositoryMySQL) ExistsByEmail(ctx context.Context, email string) (
 		Count(&count).Error
 	return count > 0, err
 }
+
+func (r *UserRepositoryMySQL) ListByIDs(ctx context.Context, ids []uint64) ([]*entity.User, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	var models []UserModel
+	if err := r.db.WithContext(ctx).
+		Where("id IN ? AND deleted_at IS NULL", ids).
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+	users := make([]*entity.User, len(models))
+	for i := range models {
+		users[i] = models[i].toEntity()
+	}
+	return users, nil
+}
+
+func (r *UserRepositoryMySQL) SearchIDsByName(ctx context.Context, keyword string, candidateIDs []uint64, limit int) ([]uint64, error) {
+	pattern := "%" + escapeLike(keyword) + "%"
+	query := r.db.WithContext(ctx).Model(&UserModel{}).
+		Where("(display_name LIKE ? OR username LIKE ?) AND deleted_at IS NULL", pattern, pattern)
+	if len(candidateIDs) > 0 {
+		query = query.Where("id IN ?", candidateIDs)
+	}
+	var ids []uint64
+	err := query.
+		Order("id ASC").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// escapeLike 转义 LIKE 通配符
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
 	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type UserStatusRepoMysql struct {
@@ -27,6 +28,26 @@ func (r *UserStatusRepoMysql) Get(ctx context.Context, userID string) (*entity.U
 	return &s, err
 }
 
+// Save 按 user_id 覆盖写入（实体没有主键标签，直接 Save 会重复插入）
 func (r *UserStatusRepoMysql) Save(ctx context.Context, s *entity.UserBlockStatus) error {
-	return r.db.WithContext(ctx).Save(s).Error
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		UpdateAll: true,
+	}).Create(s).Error
+}
+
+// BlockIfLonger 条件 upsert：原封禁生效且到期不早于新封禁时各列保持原值，不会缩短或替换管理员封禁。
+// MySQL 按顺序执行赋值，到期时间须在条件引用它之后最后改写；无变化时 RowsAffected 为 0
+func (r *UserStatusRepoMysql) BlockIfLonger(ctx context.Context, s *entity.UserBlockStatus) (bool, error) {
+	const keep = "is_blocked = 1 AND block_expire_at >= VALUES(block_expire_at)"
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.Set{
+			{Column: clause.Column{Name: "block_reason"}, Value: gorm.Expr("IF(" + keep + ", block_reason, VALUES(block_reason))")},
+			{Column: clause.Column{Name: "blocked_at"}, Value: gorm.Expr("IF(" + keep + ", blocked_at, VALUES(blocked_at))")},
+			{Column: clause.Column{Name: "block_expire_at"}, Value: gorm.Expr("IF(" + keep + ", block_expire_at, VALUES(block_expire_at))")},
+			{Column: clause.Column{Name: "is_blocked"}, Value: true},
+		},
+	}).Create(s)
+	return res.RowsAffected > 0, res.Error
 }
@@ -14,14 +14,20 @@ import (
 type AuthCodeRepoRedis struct {
 	client *redis.Client
 	ttl    time.Duration
+	prefix string
 }
 
 func NewAuthCodeRepoRedis(client *redis.Client, ttl time.Duration) out.AuthCodeRepository {
-	return &AuthCodeRepoRedis{client: client, ttl: ttl}
+	return NewAuthCodeRepoRedisWithPrefix(client, ttl, "sms_code")
+}
+
+// NewAuthCodeRepoRedisWithPrefix 按用途隔离验证码，例如找回密码的验证码不会覆盖登录验证码
+func NewAuthCodeRepoRedisWithPrefix(client *redis.Client, ttl time.Duration, prefix string) out.AuthCodeRepository {
+	return &AuthCodeRepoRedis{client: client, ttl: ttl, prefix: prefix}
 }
 
 func (r *AuthCodeRepoRedis) Save(ctx context.Context, code *entity.AuthCode) error {
-	key := fmt.Sprintf("sms_code:%s", code.Phone)
+	key := r.key(code.Phone)
 	b, err := json.Marshal(code)
 	if err != nil {
 		return fmt.Errorf("序列化验证码失败: %w", err)
@@ -30,7 +36,7 @@ func (r *AuthCodeRepoRedis) Save(ctx context.Context, code *entity.AuthCode) err
 }
 
 func (r *AuthCodeRepoRedis) Find(ctx context.Context, phone string) (*entity.AuthCode, error) {
-	key := fmt.Sprintf("sms_code:%s", phone)
+	key := r.key(phone)
 	data, err := r.client.Get(ctx, key).Bytes()
 	if err == redis.Nil {
 		return nil, nil
@@ -46,7 +52,7 @@ func (r *AuthCodeRepoRedis) Find(ctx context.Context, phone string) (*entity.Aut
 }
 
 func (r *AuthCodeRepoRedis) Delete(ctx context.Context, phone string) error {
-	key := fmt.Sprintf("sms_code:%s", phone)
+	key := r.key(phone)
 	return r.client.Del(ctx, key).Err()
 }
 
@@ -62,3 +68,7 @@ func (r *AuthCodeRepoRedis) IncrementAttempts(ctx context.Context, phone string)
 	// 保持原 TTL
 	return r.Save(ctx, ac)
 }
+
+func (r *AuthCodeRepoRedis) key(target string) string {
+	return fmt.Sprintf("%s:%s", r.prefix, target)
+}
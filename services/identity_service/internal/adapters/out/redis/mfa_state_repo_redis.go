@@ -0,0 +1,77 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
+	"github.com/go-redis/redis/v8"
+)
+
+type MFAStateRepoRedis struct {
+	client *redis.Client
+}
+
+func NewMFAStateRepoRedis(client *redis.Client) out.MFAStateRepository {
+	return &MFAStateRepoRedis{client: client}
+}
+
+func (r *MFAStateRepoRedis) SaveChallenge(ctx context.Context, challenge *entity.MFAChallenge) error {
+	ttl := time.Until(challenge.ExpiresAt)
+	if ttl <= 0 {
+		_, err := r.DeleteChallenge(ctx, challenge.Token)
+		return err
+	}
+	b, err := json.Marshal(challenge)
+	if err != nil {
+		return fmt.Errorf("序列化登录挑战失败: %w", err)
+	}
+	return r.client.Set(ctx, challengeKey(challenge.Token), b, ttl).Err()
+}
+
+func (r *MFAStateRepoRedis) GetChallenge(ctx context.Context, token string) (*entity.MFAChallenge, error) {
+	data, err := r.client.Get(ctx, challengeKey(token)).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("读取登录挑战失败: %w", err)
+	}
+
+	var ch entity.MFAChallenge
+	if err := json.Unmarshal(data, &ch); err != nil {
+		return nil, fmt.Errorf("反序列化登录挑战失败: %w", err)
+	}
+	ch.Token = token
+	return &ch, nil
+}
+
+func (r *MFAStateRepoRedis) DeleteChallenge(ctx context.Context, token string) (bool, error) {
+	n, err := r.client.Del(ctx, challengeKey(token)).Result()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+func (r *MFAStateRepoRedis) MarkStepUp(ctx context.Context, sessionKey string, ttl time.Duration) error {
+	return r.client.Set(ctx, stepUpKey(sessionKey), 1, ttl).Err()
+}
+
+func (r *MFAStateRepoRedis) HasStepUp(ctx context.Context, sessionKey string) (bool, error) {
+	n, err := r.client.Exists(ctx, stepUpKey(sessionKey)).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func challengeKey(token string) string {
+	return fmt.Sprintf("mfa_challenge:%s", token)
+}
+
+func stepUpKey(sessionKey string) string {
+	return fmt.Sprintf("mfa_step_up:%s", sessionKey)
+}
@@ -0,0 +1,53 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
+	"github.com/go-redis/redis/v8"
+)
+
+type OIDCStateRepoRedis struct {
+	client *redis.Client
+}
+
+func NewOIDCStateRepoRedis(client *redis.Client) out.OIDCStateRepository {
+	return &OIDCStateRepoRedis{client: client}
+}
+
+func (r *OIDCStateRepoRedis) Save(ctx context.Context, state *entity.OIDCState) error {
+	ttl := time.Until(state.ExpiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+	b, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("序列化 OIDC 状态失败: %w", err)
+	}
+	return r.client.Set(ctx, oidcStateKey(state.State), b, ttl).Err()
+}
+
+// Consume GETDEL 保证同一 state 只能回调一次
+func (r *OIDCStateRepoRedis) Consume(ctx context.Context, state string) (*entity.OIDCState, error) {
+	data, err := r.client.GetDel(ctx, oidcStateKey(state)).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("读取 OIDC 状态失败: %w", err)
+	}
+
+	var st entity.OIDCState
+	if err := json.Unmarshal(data, &st); err != nil {
+		return nil, fmt.Errorf("反序列化 OIDC 状态失败: %w", err)
+	}
+	st.State = state
+	return &st, nil
+}
+
+func oidcStateKey(state string) string {
+	return fmt.Sprintf("oidc_state:%s", state)
+}
@@ -0,0 +1,42 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
+	"github.com/go-redis/redis/v8"
+)
+
+type PasswordResetRepoRedis struct {
+	client *redis.Client
+}
+
+func NewPasswordResetRepoRedis(client *redis.Client) out.PasswordResetRepository {
+	return &PasswordResetRepoRedis{client: client}
+}
+
+func (r *PasswordResetRepoRedis) SaveToken(ctx context.Context, tokenHash string, userID uint64, ttl time.Duration) error {
+	return r.client.Set(ctx, resetTokenKey(tokenHash), userID, ttl).Err()
+}
+
+// ConsumeToken GETDEL 保证令牌只能被使用一次
+func (r *PasswordResetRepoRedis) ConsumeToken(ctx context.Context, tokenHash string) (uint64, error) {
+	val, err := r.client.GetDel(ctx, resetTokenKey(tokenHash)).Result()
+	if err == redis.Nil {
+		return 0, nil
+	} else if err != nil {
+		return 0, fmt.Errorf("读取重置令牌失败: %w", err)
+	}
+	userID, err := strconv.ParseUint(val, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("解析重置令牌失败: %w", err)
+	}
+	return userID, nil
+}
+
+func resetTokenKey(tokenHash string) string {
+	return fmt.Sprintf("password_reset:%s", tokenHash)
+}
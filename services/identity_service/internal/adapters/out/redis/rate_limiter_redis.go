@@ -0,0 +1,30 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
+	"github.com/go-redis/redis/v8"
+)
+
+type RateLimiterRedis struct {
+	client *redis.Client
+}
+
+func NewRateLimiterRedis(client *redis.Client) out.RateLimiter {
+	return &RateLimiterRedis{client: client}
+}
+
+// Allow 窗口内首次计数时设置过期时间，窗口结束后计数自动清零
+func (r *RateLimiterRedis) Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
+	key = fmt.Sprintf("rate_limit:%s", key)
+	pipe := r.client.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.ExpireNX(ctx, key, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, fmt.Errorf("限流计数失败: %w", err)
+	}
+	return incr.Val() <= int64(limit), nil
+}
@@ -0,0 +1,50 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
+	"github.com/go-redis/redis/v8"
+)
+
+type SlidingWindowRedis struct {
+	client *redis.Client
+}
+
+func NewSlidingWindowRedis(client *redis.Client) out.SlidingWindow {
+	return &SlidingWindowRedis{client: client}
+}
+
+func slidingWindowKey(key string) string {
+	return fmt.Sprintf("sliding_window:%s", key)
+}
+
+// Add 有序集合以时间为分值，先清理窗口外的成员再计数
+func (r *SlidingWindowRedis) Add(ctx context.Context, key, member string, window time.Duration) (int, error) {
+	key = slidingWindowKey(key)
+	now := time.Now()
+	pipe := r.client.TxPipeline()
+	pipe.ZRemRangeByScore(ctx, key, "-inf", "("+strconv.FormatInt(now.Add(-window).UnixMilli(), 10))
+	pipe.ZAdd(ctx, key, &redis.Z{Score: float64(now.UnixMilli()), Member: member})
+	card := pipe.ZCard(ctx, key)
+	pipe.Expire(ctx, key, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("滑动窗口计数失败: %w", err)
+	}
+	return int(card.Val()), nil
+}
+
+func (r *SlidingWindowRedis) Count(ctx context.Context, key string, window time.Duration) (int, error) {
+	n, err := r.client.ZCount(ctx, slidingWindowKey(key), strconv.FormatInt(time.Now().Add(-window).UnixMilli(), 10), "+inf").Result()
+	if err != nil {
+		return 0, fmt.Errorf("读取滑动窗口计数失败: %w", err)
+	}
+	return int(n), nil
+}
+
+func (r *SlidingWindowRedis) Reset(ctx context.Context, key string) error {
+	return r.client.Del(ctx, slidingWindowKey(key)).Err()
+}
@@ -0,0 +1,42 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
+	"github.com/go-redis/redis/v8"
+)
+
+type TemporaryLockRedis struct {
+	client *redis.Client
+}
+
+func NewTemporaryLockRedis(client *redis.Client) out.TemporaryLock {
+	return &TemporaryLockRedis{client: client}
+}
+
+func (r *TemporaryLockRedis) Lock(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	ok, err := r.client.SetNX(ctx, lockKey(key), 1, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("写入锁定标记失败: %w", err)
+	}
+	return ok, nil
+}
+
+// Remaining 键不存在时 PTTL 返回负值
+func (r *TemporaryLockRedis) Remaining(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := r.client.PTTL(ctx, lockKey(key)).Result()
+	if err != nil {
+		return 0, fmt.Errorf("读取锁定标记失败: %w", err)
+	}
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+func lockKey(key string) string {
+	return "temp_lock:" + key
+}
@@ -0,0 +1,52 @@
+package smtp
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
+)
+
+type SMTPEmailClient struct {
+	addr    string
+	auth    smtp.Auth
+	from    string
+	subject string
+}
+
+func NewSMTPEmailClient(host string, port int, username, password, from, subject string) out.EmailClient {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &SMTPEmailClient{
+		addr:    net.JoinHostPort(host, strconv.Itoa(port)),
+		auth:    auth,
+		from:    from,
+		subject: subject,
+	}
+}
+
+func (c *SMTPEmailClient) Send(ctx context.Context, email string, code string) error {
+	if strings.ContainsAny(email, "\r\n") {
+		return fmt.Errorf("邮箱地址非法: %q", email)
+	}
+	msg := strings.Join([]string{
+		"From: " + c.from,
+		"To: " + email,
+		"Subject: " + mime.QEncoding.Encode("UTF-8", c.subject),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		fmt.Sprintf("您的验证码是 %s，请勿泄露给他人。如非本人操作请忽略本邮件。", code),
+	}, "\r\n")
+	if err := smtp.SendMail(c.addr, c.auth, c.from, []string{email}, []byte(msg)); err != nil {
+		return fmt.Errorf("发送邮件失败: %w", err)
+	}
+	return nil
+}
@@ -2,11 +2,13 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"golang.org/x/crypto/bcrypt"
 
+	mfaApp "github.com/EthanQC/IM/services/identity_service/internal/application/mfa"
 	"github.com/EthanQC/IM/services/identity_service/internal/application/sms"
 	"github.com/EthanQC/IM/services/identity_service/internal/application/status"
 	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
@@ -24,6 +26,45 @@ type DefaultAuthUseCase struct {
 	statusUC  *status.CheckUserStatusUseCase
 	verifySMS *sms.VerifyCodeUseCase
 	userRepo  out.UserRepository
+
+	sessions SessionTracker
+	mfa      MFAGate
+	guard    LoginGuard
+}
+
+// SessionTracker 设备会话管理：登录时创建会话，刷新时续期，登出时结束
+type SessionTracker interface {
+	Start(ctx context.Context, userID string, device entity.DeviceInfo) (*entity.Session, error)
+	Touch(ctx context.Context, sessionID string, device entity.DeviceInfo) error
+	EndByToken(ctx context.Context, jti string) error
+}
+
+// MFAGate 登录二次验证：开启 TOTP 的账号校验密码后先拿到挑战令牌，验证通过再签发令牌
+type MFAGate interface {
+	Enabled(ctx context.Context, userID string) (bool, error)
+	Challenge(ctx context.Context, userID string, device entity.DeviceInfo) (*entity.MFAChallenge, error)
+	// PendingChallenge 读取未完成的挑战，不存在或已过期时返回 ErrChallengeNotFound
+	PendingChallenge(ctx context.Context, token string) (*entity.MFAChallenge, error)
+	VerifyChallenge(ctx context.Context, token, code string) (*entity.MFAChallenge, error)
+}
+
+// LoginGuard 登录防护：校验前检查限制，失败时计数并给出应答错误，成功时清空计数。
+// 密码登录使用 Check / Fail，短信验证码与二次验证使用 CheckFactor / FailFactor
+type LoginGuard interface {
+	Check(ctx context.Context, account, ip, captchaToken string) error
+	Fail(ctx context.Context, account, userID, ip string) error
+	CheckFactor(ctx context.Context, account, ip string) error
+	FailFactor(ctx context.Context, account, userID, ip string, cause error) error
+	Succeed(ctx context.Context, account string)
+}
+
+// MFARequiredError 第一步登录成功但需要二次验证，Challenge 携带挑战令牌
+type MFARequiredError struct {
+	Challenge *entity.MFAChallenge
+}
+
+func (e *MFARequiredError) Error() string {
+	return "two-factor authentication required"
 }
 
 var _ in.AuthUseCase = (*DefaultAuthUseCase)(nil)
@@ -46,17 +87,33 @@ func NewDefaultAuthUseCase(
 	}
 }
 
+// SetSessionTracker 设置会话管理（可选），未设置时令牌不关联会话
+func (uc *DefaultAuthUseCase) SetSessionTracker(sessions SessionTracker) {
+	uc.sessions = sessions
+}
+
+// SetMFAGate 设置登录二次验证（可选）
+func (uc *DefaultAuthUseCase) SetMFAGate(mfa MFAGate) {
+	uc.mfa = mfa
+}
+
+// SetLoginGuard 设置密码登录防护（可选）
+func (uc *DefaultAuthUseCase) SetLoginGuard(guard LoginGuard) {
+	uc.guard = guard
+}
+
 // LoginByPassword 目前将 identifier 视为 userID，真实校验应交给用户服务或统一账号中心。
-func (uc *DefaultAuthUseCase) LoginByPassword(ctx context.Context, identifier string, password string) (*entity.AuthToken, error) {
+func (uc *DefaultAuthUseCase) LoginByPassword(ctx context.Context, identifier string, password string, captchaToken string, device entity.DeviceInfo) (*entity.AuthToken, error) {
+	if uc.guard != nil {
+		if err := uc.guard.Check(ctx, identifier, device.IP, captchaToken); err != nil {
+			return nil, err
+		}
+	}
 	if uc.userRepo == nil {
 		if err := uc.statusUC.Execute(ctx, identifier); err != nil {
 			return nil, err
 		}
-		access, refresh, err := uc.generator.Execute(ctx, identifier)
-		if err != nil {
-			return nil, fmt.Errorf("generate token: %w", err)
-		}
-		return uc.buildToken(identifier, access, refresh), nil
+		return uc.issue(ctx, identifier, device)
 	}
 
 	user, err := uc.userRepo.GetByUsername(ctx, identifier)
@@ -64,53 +121,214 @@ func (uc *DefaultAuthUseCase) LoginByPassword(ctx context.Context, identifier st
 		return nil, fmt.Errorf("get user: %w", err)
 	}
 	if user == nil || !user.CanLogin() {
-		return nil, authErr.ErrInvalidPassword
+		return nil, uc.loginFailed(ctx, identifier, "", device)
 	}
+	userID := fmt.Sprintf("%d", user.ID)
 	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
-		return nil, authErr.ErrInvalidPassword
+		return nil, uc.loginFailed(ctx, identifier, userID, device)
+	}
+	if uc.guard != nil {
+		uc.guard.Succeed(ctx, identifier)
 	}
 
-	userID := fmt.Sprintf("%d", user.ID)
 	if err := uc.statusUC.Execute(ctx, userID); err != nil {
 		return nil, err
 	}
-	access, refresh, err := uc.generator.Execute(ctx, userID)
+	if err := uc.challengeMFA(ctx, userID, device); err != nil {
+		return nil, err
+	}
+	return uc.issue(ctx, userID, device)
+}
+
+// loginFailed 密码错误或账号不可用，统一返回密码错误，由防护策略决定是否附带人机验证或锁定
+func (uc *DefaultAuthUseCase) loginFailed(ctx context.Context, identifier, userID string, device entity.DeviceInfo) error {
+	if uc.guard == nil {
+		return authErr.ErrInvalidPassword
+	}
+	return uc.guard.Fail(ctx, identifier, userID, device.IP)
+}
+
+// CompleteMFALogin 两步登录的第二步：校验挑战的验证码后签发令牌。
+// 验证码错误按用户计入登录防护，IP 取密码校验通过时记录的设备 IP
+func (uc *DefaultAuthUseCase) CompleteMFALogin(ctx context.Context, challengeToken, code string) (*entity.AuthToken, error) {
+	if uc.mfa == nil {
+		return nil, authErr.ErrInvalidToken
+	}
+	pending, err := uc.mfa.PendingChallenge(ctx, challengeToken)
 	if err != nil {
-		return nil, fmt.Errorf("generate token: %w", err)
+		return nil, err
+	}
+	account := "mfa:" + pending.UserID
+	if uc.guard != nil {
+		if err := uc.guard.CheckFactor(ctx, account, pending.Device.IP); err != nil {
+			return nil, err
+		}
+	}
+	ch, err := uc.mfa.VerifyChallenge(ctx, challengeToken, code)
+	if err != nil {
+		if uc.guard != nil && errors.Is(err, mfaApp.ErrInvalidCode) {
+			return nil, uc.guard.FailFactor(ctx, account, pending.UserID, pending.Device.IP, err)
+		}
+		return nil, err
+	}
+	if uc.guard != nil {
+		uc.guard.Succeed(ctx, account)
+	}
+	// 挑战有效期内账号可能已被封禁
+	if err := uc.statusUC.Execute(ctx, ch.UserID); err != nil {
+		return nil, err
+	}
+	return uc.issue(ctx, ch.UserID, ch.Device)
+}
+
+// LoginVerified 注册或外部身份（OIDC）已完成认证后登录，同样检查账号状态与二次验证
+func (uc *DefaultAuthUseCase) LoginVerified(ctx context.Context, userID string, device entity.DeviceInfo) (*entity.AuthToken, error) {
+	if err := uc.statusUC.Execute(ctx, userID); err != nil {
+		return nil, err
+	}
+	if err := uc.challengeMFA(ctx, userID, device); err != nil {
+		return nil, err
 	}
-	return uc.buildToken(userID, access, refresh), nil
+	return uc.issue(ctx, userID, device)
 }
 
-func (uc *DefaultAuthUseCase) LoginBySMS(ctx context.Context, phone vo.Phone, code string) (*entity.AuthToken, error) {
+// challengeMFA 账号开启二次验证时返回 MFARequiredError
+func (uc *DefaultAuthUseCase) challengeMFA(ctx context.Context, userID string, device entity.DeviceInfo) error {
+	if uc.mfa == nil {
+		return nil
+	}
+	enabled, err := uc.mfa.Enabled(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("check mfa: %w", err)
+	}
+	if !enabled {
+		return nil
+	}
+	ch, err := uc.mfa.Challenge(ctx, userID, device)
+	if err != nil {
+		return fmt.Errorf("create mfa challenge: %w", err)
+	}
+	return &MFARequiredError{Challenge: ch}
+}
+
+// LoginBySMS 验证码错误按手机号计入登录防护，达到阈值后同样要求间隔重试或临时锁定
+func (uc *DefaultAuthUseCase) LoginBySMS(ctx context.Context, phone vo.Phone, code string, device entity.DeviceInfo) (*entity.AuthToken, error) {
+	account := "sms:" + phone.Number
+	if uc.guard != nil {
+		if err := uc.guard.CheckFactor(ctx, account, device.IP); err != nil {
+			return nil, err
+		}
+	}
 	if err := uc.verifySMS.Execute(ctx, phone, code); err != nil {
+		if uc.guard != nil && smsCodeRejected(err) {
+			return nil, uc.guard.FailFactor(ctx, account, "", device.IP, err)
+		}
+		return nil, err
+	}
+	if uc.guard != nil {
+		uc.guard.Succeed(ctx, account)
+	}
+	userID, err := uc.userIDByPhone(ctx, phone)
+	if err != nil {
 		return nil, err
 	}
-	if err := uc.statusUC.Execute(ctx, phone.Number); err != nil {
+	if err := uc.statusUC.Execute(ctx, userID); err != nil {
 		return nil, err
 	}
-	access, refresh, err := uc.generator.Execute(ctx, phone.Number)
+	if err := uc.challengeMFA(ctx, userID, device); err != nil {
+		return nil, err
+	}
+	return uc.issue(ctx, userID, device)
+}
+
+// userIDByPhone 短信验证通过后按手机号找到账号，会话、令牌与二次验证都以账号 ID 为准
+func (uc *DefaultAuthUseCase) userIDByPhone(ctx context.Context, phone vo.Phone) (string, error) {
+	if uc.userRepo == nil {
+		return "", fmt.Errorf("sms login requires a user repository")
+	}
+	user, err := uc.userRepo.GetByPhone(ctx, phone.Number)
 	if err != nil {
-		return nil, fmt.Errorf("generate token: %w", err)
+		return "", fmt.Errorf("get user: %w", err)
+	}
+	if user == nil {
+		return "", authErr.ErrPhoneNotRegistered
+	}
+	if !user.CanLogin() {
+		return "", authErr.ErrUserDisabled
 	}
-	return uc.buildToken(phone.Number, access, refresh), nil
+	return fmt.Sprintf("%d", user.ID), nil
 }
 
-func (uc *DefaultAuthUseCase) RefreshToken(ctx context.Context, refreshToken string) (*entity.AuthToken, error) {
-	at, err := uc.refresher.Execute(ctx, refreshToken)
+func (uc *DefaultAuthUseCase) RefreshToken(ctx context.Context, refreshToken string, device entity.DeviceInfo) (*entity.AuthToken, error) {
+	at, err := uc.refresher.Execute(ctx, refreshToken, device)
 	if err != nil {
 		return nil, fmt.Errorf("refresh token: %w", err)
 	}
 	return at, nil
 }
 
-func (uc *DefaultAuthUseCase) Logout(ctx context.Context, accessJTI string) error {
-	return uc.revoker.Execute(ctx, accessJTI, false)
+// Logout 撤销 access token 并广播撤销事件；refreshToken 非空时一并撤销
+func (uc *DefaultAuthUseCase) Logout(ctx context.Context, accessJTI, refreshToken string, accessExpiresAt time.Time) error {
+	if err := uc.revoker.RevokeAccess(ctx, accessJTI, accessExpiresAt); err != nil {
+		return err
+	}
+	if refreshToken != "" {
+		if err := uc.revoker.Execute(ctx, refreshToken, true); err != nil {
+			return err
+		}
+	}
+	if uc.sessions != nil {
+		if err := uc.sessions.EndByToken(ctx, accessJTI); err != nil {
+			return fmt.Errorf("end session: %w", err)
+		}
+	}
+	return nil
+}
+
+// CheckUserStatus 校验账号可用：已注销或被禁用返回 ErrUserDisabled，封禁中返回 ErrUserBlocked
+func (uc *DefaultAuthUseCase) CheckUserStatus(ctx context.Context, userID uint64) error {
+	if uc.userRepo != nil {
+		user, err := uc.userRepo.GetByID(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("get user: %w", err)
+		}
+		if user == nil || !user.IsActive() {
+			return fmt.Errorf("user %d: %w", userID, authErr.ErrUserDisabled)
+		}
+	}
+	return uc.statusUC.Execute(ctx, fmt.Sprintf("%d", userID))
+}
+
+func (uc *DefaultAuthUseCase) BlockUser(ctx context.Context, userID uint64, reason string, duration time.Duration) error {
+	return uc.statusUC.Block(ctx, fmt.Sprintf("%d", userID), reason, duration)
 }
 
-func (uc *DefaultAuthUseCase) buildToken(userID, access, refresh string) *entity.AuthToken {
+func (uc *DefaultAuthUseCase) UnblockUser(ctx context.Context, userID uint64) error {
+	return uc.statusUC.Unblock(ctx, fmt.Sprintf("%d", userID))
+}
+
+// issue 创建会话（已配置时）并签发令牌
+func (uc *DefaultAuthUseCase) issue(ctx context.Context, userID string, device entity.DeviceInfo) (*entity.AuthToken, error) {
+	sessionID := ""
+	if uc.sessions != nil {
+		s, err := uc.sessions.Start(ctx, userID, device)
+		if err != nil {
+			return nil, fmt.Errorf("start session: %w", err)
+		}
+		sessionID = s.ID
+	}
+	access, refresh, err := uc.generator.Execute(ctx, userID, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("generate token: %w", err)
+	}
+	return uc.buildToken(userID, sessionID, access, refresh), nil
+}
+
+func (uc *DefaultAuthUseCase) buildToken(userID, sessionID, access, refresh string) *entity.AuthToken {
 	now := time.Now()
 	return &entity.AuthToken{
 		UserID:           userID,
+		SessionID:        sessionID,
 		AccessToken:      access,
 		RefreshToken:     refresh,
 		CreatedAt:        now,
@@ -118,3 +336,9 @@ func (uc *DefaultAuthUseCase) buildToken(userID, access, refresh string) *entity
 		RefreshExpiresAt: now.Add(uc.generator.RefreshTTL),
 	}
 }
+
+// smsCodeRejected 验证码校验未通过（而非存储故障）
+func smsCodeRejected(err error) bool {
+	return errors.Is(err, authErr.ErrCodeInvalid) || errors.Is(err, authErr.ErrCodeNotFound) ||
+		errors.Is(err, authErr.ErrCodeExpired) || errors.Is(err, authErr.ErrTooManyAttempts)
+}
@@ -0,0 +1,155 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/application/sms"
+	"github.com/EthanQC/IM/services/identity_service/internal/application/status"
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/vo"
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
+	authErr "github.com/EthanQC/IM/services/identity_service/pkg/errors"
+	"github.com/EthanQC/IM/services/identity_service/pkg/jwt"
+)
+
+const (
+	testPhone  = "13800138000"
+	testUserID = uint64(42)
+	testCode   = "123456"
+)
+
+// memCodes 预置一条有效验证码
+type memCodes struct {
+	out.AuthCodeRepository
+	codes map[string]*entity.AuthCode
+}
+
+func (r *memCodes) Find(_ context.Context, phone string) (*entity.AuthCode, error) {
+	return r.codes[phone], nil
+}
+
+func (r *memCodes) Delete(_ context.Context, phone string) error {
+	delete(r.codes, phone)
+	return nil
+}
+
+func (r *memCodes) IncrementAttempts(_ context.Context, phone string) error {
+	if c, ok := r.codes[phone]; ok {
+		c.AttemptCnt++
+	}
+	return nil
+}
+
+type phoneUsers struct {
+	out.UserRepository
+	users map[string]*entity.User
+}
+
+func (r phoneUsers) GetByPhone(_ context.Context, phone string) (*entity.User, error) {
+	return r.users[phone], nil
+}
+
+// noStatus 没有封禁记录
+type noStatus struct {
+	out.UserStatusRepository
+}
+
+func (noStatus) Get(context.Context, string) (*entity.UserBlockStatus, error) { return nil, nil }
+
+type memRefresh struct {
+	out.RefreshTokenRepository
+}
+
+func (memRefresh) Save(context.Context, *entity.AuthToken) error { return nil }
+
+// fakeMFA 按账号 ID 记录是否开启二次验证
+type fakeMFA struct {
+	enabled map[string]bool
+	asked   []string
+}
+
+func (m *fakeMFA) Enabled(_ context.Context, userID string) (bool, error) {
+	m.asked = append(m.asked, userID)
+	return m.enabled[userID], nil
+}
+
+func (m *fakeMFA) Challenge(_ context.Context, userID string, device entity.DeviceInfo) (*entity.MFAChallenge, error) {
+	return &entity.MFAChallenge{Token: "challenge", UserID: userID, Device: device}, nil
+}
+
+func (m *fakeMFA) PendingChallenge(context.Context, string) (*entity.MFAChallenge, error) {
+	return nil, errors.New("not used")
+}
+
+func (m *fakeMFA) VerifyChallenge(context.Context, string, string) (*entity.MFAChallenge, error) {
+	return nil, errors.New("not used")
+}
+
+func newSMSLogin(t *testing.T, mfaEnabled bool) (*DefaultAuthUseCase, *fakeMFA) {
+	t.Helper()
+	codes := &memCodes{codes: map[string]*entity.AuthCode{
+		testPhone: {Phone: testPhone, Code: testCode, ExpireTime: time.Now().Add(time.Minute)},
+	}}
+	phone := testPhone
+	users := phoneUsers{users: map[string]*entity.User{
+		testPhone: {ID: testUserID, Phone: &phone, Status: entity.UserStatusNormal},
+	}}
+	generator := NewGenerateTokenUseCase(memRefresh{}, noStatus{}, jwt.NewManager("test-secret"), time.Minute, time.Hour)
+	uc := NewDefaultAuthUseCase(generator, nil, nil, status.NewCheckUserStatusUseCase(noStatus{}),
+		sms.NewVerifyCodeUseCase(codes, 5), users)
+	mfa := &fakeMFA{enabled: map[string]bool{"42": mfaEnabled}}
+	uc.SetMFAGate(mfa)
+	return uc, mfa
+}
+
+func testPhoneVO(t *testing.T) vo.Phone {
+	t.Helper()
+	p, err := vo.NewPhone(testPhone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return *p
+}
+
+func TestLoginBySMSRequiresMFAForEnabledAccount(t *testing.T) {
+	uc, mfa := newSMSLogin(t, true)
+
+	at, err := uc.LoginBySMS(context.Background(), testPhoneVO(t), testCode, entity.DeviceInfo{IP: "203.0.113.7"})
+	var required *MFARequiredError
+	if !errors.As(err, &required) {
+		t.Fatalf("LoginBySMS = %+v, %v; want MFARequiredError", at, err)
+	}
+	if required.Challenge.UserID != "42" {
+		t.Fatalf("challenge user = %q, want 42", required.Challenge.UserID)
+	}
+	if len(mfa.asked) != 1 || mfa.asked[0] != "42" {
+		t.Fatalf("MFA looked up for %v, want the account ID rather than the phone number", mfa.asked)
+	}
+}
+
+func TestLoginBySMSIssuesTokensForAccountID(t *testing.T) {
+	uc, _ := newSMSLogin(t, false)
+
+	at, err := uc.LoginBySMS(context.Background(), testPhoneVO(t), testCode, entity.DeviceInfo{})
+	if err != nil {
+		t.Fatalf("LoginBySMS: %v", err)
+	}
+	if at.UserID != "42" {
+		t.Fatalf("token user = %q, want 42", at.UserID)
+	}
+}
+
+func TestLoginBySMSRejectsUnregisteredPhone(t *testing.T) {
+	uc, mfa := newSMSLogin(t, false)
+	uc.userRepo = phoneUsers{}
+
+	if _, err := uc.LoginBySMS(context.Background(), testPhoneVO(t), testCode, entity.DeviceInfo{}); !errors.Is(err, authErr.ErrPhoneNotRegistered) {
+		t.Fatalf("unregistered phone: got %v, want ErrPhoneNotRegistered", err)
+	}
+	if len(mfa.asked) != 0 {
+		t.Fatalf("MFA looked up for %v before the account was resolved", mfa.asked)
+	}
+}
@@ -12,12 +12,19 @@ import (
 	"github.com/EthanQC/IM/services/identity_service/pkg/jwt"
 )
 
+// GrantResolver 查询用户的角色与权限，签发时写入访问令牌
+type GrantResolver interface {
+	ResolveGrants(ctx context.Context, userID string) (roles, permissions []string, err error)
+}
+
 type GenerateTokenUseCase struct {
 	RefreshRepo out.RefreshTokenRepository
 	StatusRepo  out.UserStatusRepository
 	JWTManager  jwt.Manager
 	AccessTTL   time.Duration
 	RefreshTTL  time.Duration
+
+	grants GrantResolver
 }
 
 func NewGenerateTokenUseCase(
@@ -36,8 +43,13 @@ func NewGenerateTokenUseCase(
 	}
 }
 
-// Execute 签发一对 Access/Refresh Token，并持久化 RefreshToken
-func (uc *GenerateTokenUseCase) Execute(ctx context.Context, userID string) (string, string, error) {
+// SetGrantResolver 设置角色权限查询（可选），未设置时访问令牌不携带权限声明
+func (uc *GenerateTokenUseCase) SetGrantResolver(grants GrantResolver) {
+	uc.grants = grants
+}
+
+// Execute 为会话签发一对 Access/Refresh Token，并持久化 RefreshToken
+func (uc *GenerateTokenUseCase) Execute(ctx context.Context, userID, sessionID string) (string, string, error) {
 	status, err := uc.StatusRepo.Get(ctx, userID)
 	if err != nil {
 		return "", "", fmt.Errorf("获取用户状态失败: %w", err)
@@ -48,16 +60,18 @@ func (uc *GenerateTokenUseCase) Execute(ctx context.Context, userID string) (str
 
 	at := entity.NewAuthToken(userID)
 	at.ID = uuid.New().String()
+	at.SessionID = sessionID
+	at.FamilyID = at.ID
 	at.RefreshExpiresAt = time.Now().Add(uc.RefreshTTL)
 
-	accessToken, err := uc.JWTManager.Generate(at.ID, userID, uc.AccessTTL)
+	accessToken, err := generateAccess(ctx, uc.JWTManager, uc.grants, at, uc.AccessTTL)
 	if err != nil {
 		return "", "", fmt.Errorf("生成 AccessToken 失败: %w", err)
 	}
 	at.AccessToken = accessToken
 	at.ExpiresAt = time.Now().Add(uc.AccessTTL)
 
-	refreshToken, err := uc.JWTManager.Generate(at.ID, userID, uc.RefreshTTL)
+	refreshToken, err := uc.JWTManager.GenerateRefresh(at.ID, userID, uc.RefreshTTL)
 	if err != nil {
 		return "", "", fmt.Errorf("生成 RefreshToken 失败: %w", err)
 	}
@@ -69,3 +83,17 @@ func (uc *GenerateTokenUseCase) Execute(ctx context.Context, userID string) (str
 
 	return accessToken, refreshToken, nil
 }
+
+// generateAccess 签发访问令牌，写入会话 ID；grants 非空时写入用户当前的角色与权限
+func generateAccess(ctx context.Context, mgr jwt.Manager, grants GrantResolver, at *entity.AuthToken, ttl time.Duration) (string, error) {
+	extra := jwt.AccessClaims{SessionID: at.SessionID}
+	if grants != nil {
+		roles, perms, err := grants.ResolveGrants(ctx, at.UserID)
+		if err != nil {
+			return "", fmt.Errorf("resolve grants: %w", err)
+		}
+		extra.Roles = roles
+		extra.Permissions = perms
+	}
+	return mgr.GenerateAccess(at.ID, at.UserID, ttl, extra)
+}
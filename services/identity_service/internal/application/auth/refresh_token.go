@@ -6,9 +6,11 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 
 	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
 	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
+	authErr "github.com/EthanQC/IM/services/identity_service/pkg/errors"
 	"github.com/EthanQC/IM/services/identity_service/pkg/jwt"
 )
 
@@ -18,6 +20,10 @@ type RefreshTokenUseCase struct {
 	JWTManager  jwt.Manager
 	AccessTTL   time.Duration
 	RefreshTTL  time.Duration
+
+	grants   GrantResolver
+	sessions SessionTracker
+	revoker  *RevokeTokenUseCase
 }
 
 func NewRefreshTokenUseCase(
@@ -35,14 +41,34 @@ func NewRefreshTokenUseCase(
 	}
 }
 
-// Execute 用旧 RefreshToken 颁发新一对 Access/Refresh Token，并返回新的 Token 记录
-func (uc *RefreshTokenUseCase) Execute(ctx context.Context, refreshToken string) (*entity.AuthToken, error) {
+// SetGrantResolver 设置角色权限查询（可选），刷新时按最新授权签发访问令牌
+func (uc *RefreshTokenUseCase) SetGrantResolver(grants GrantResolver) {
+	uc.grants = grants
+}
+
+// SetSessionTracker 设置会话管理（可选），刷新时续期会话，已撤销的会话不能再刷新
+func (uc *RefreshTokenUseCase) SetSessionTracker(sessions SessionTracker) {
+	uc.sessions = sessions
+}
+
+// SetRevoker 设置令牌撤销（可选），检测到重复使用时拉黑令牌族仍有效的 AccessToken 并发布安全事件
+func (uc *RefreshTokenUseCase) SetRevoker(revoker *RevokeTokenUseCase) {
+	uc.revoker = revoker
+}
+
+// Execute 轮换 RefreshToken：先生成新一对 Access/Refresh Token，再在同一事务中作废旧令牌并保存新令牌；
+// 已被轮换的令牌再次使用时作废整个令牌族，要求重新登录
+func (uc *RefreshTokenUseCase) Execute(ctx context.Context, refreshToken string, device entity.DeviceInfo) (*entity.AuthToken, error) {
 	claims, err := uc.JWTManager.Parse(refreshToken)
 	if err != nil {
 		return nil, fmt.Errorf("invalid refresh token: %w", err)
 	}
+	// 旧刷新令牌没有 typ 声明，仍靠下方与存储值的比对拒绝访问令牌
+	if claims.Type != jwt.TypeRefresh && claims.Type != "" {
+		return nil, fmt.Errorf("invalid refresh token: not a refresh token")
+	}
 
-	oldJTI := claims.Id
+	oldJTI := claims.ID
 	if oldJTI == "" {
 		return nil, fmt.Errorf("missing refresh token id")
 	}
@@ -51,12 +77,18 @@ func (uc *RefreshTokenUseCase) Execute(ctx context.Context, refreshToken string)
 	if err != nil {
 		return nil, fmt.Errorf("查询 RefreshToken 失败: %w", err)
 	}
-	if rec == nil || rec.IsRevoked {
+	if rec == nil {
 		return nil, fmt.Errorf("无效或已撤销的 RefreshToken")
 	}
 	if rec.RefreshToken == "" || rec.RefreshToken != refreshToken {
 		return nil, fmt.Errorf("RefreshToken 不匹配")
 	}
+	if rec.IsRotated() {
+		return nil, uc.handleReuse(ctx, rec)
+	}
+	if rec.IsRevoked {
+		return nil, fmt.Errorf("无效或已撤销的 RefreshToken")
+	}
 	if time.Now().After(rec.RefreshExpiresAt) {
 		return nil, fmt.Errorf("RefreshToken 已过期")
 	}
@@ -69,30 +101,91 @@ func (uc *RefreshTokenUseCase) Execute(ctx context.Context, refreshToken string)
 		return nil, fmt.Errorf("用户已被禁用: %s", rec.UserID)
 	}
 
-	if err := uc.RefreshRepo.Revoke(ctx, oldJTI); err != nil {
-		return nil, fmt.Errorf("撤销旧 RefreshToken 失败: %w", err)
+	if uc.sessions != nil {
+		if err := uc.sessions.Touch(ctx, rec.SessionID, device); err != nil {
+			return nil, fmt.Errorf("续期会话失败: %w", err)
+		}
 	}
 
 	at := entity.NewAuthToken(rec.UserID)
 	at.ID = uuid.New().String()
+	at.SessionID = rec.SessionID
+	at.FamilyID = rec.Family()
 	at.RefreshExpiresAt = time.Now().Add(uc.RefreshTTL)
 
-	accessToken, err := uc.JWTManager.Generate(at.ID, rec.UserID, uc.AccessTTL)
+	accessToken, err := generateAccess(ctx, uc.JWTManager, uc.grants, at, uc.AccessTTL)
 	if err != nil {
 		return nil, fmt.Errorf("生成新 AccessToken 失败: %w", err)
 	}
 	at.AccessToken = accessToken
 	at.ExpiresAt = time.Now().Add(uc.AccessTTL)
 
-	refreshToken, err = uc.JWTManager.Generate(at.ID, rec.UserID, uc.RefreshTTL)
+	refreshToken, err = uc.JWTManager.GenerateRefresh(at.ID, rec.UserID, uc.RefreshTTL)
 	if err != nil {
 		return nil, fmt.Errorf("生成新 RefreshToken 失败: %w", err)
 	}
 	at.RefreshToken = refreshToken
 
-	if err := uc.RefreshRepo.Save(ctx, at); err != nil {
-		return nil, fmt.Errorf("保存新 RefreshToken 失败: %w", err)
+	rotated, err := uc.RefreshRepo.Rotate(ctx, oldJTI, at)
+	if err != nil {
+		return nil, fmt.Errorf("轮换 RefreshToken 失败: %w", err)
+	}
+	if !rotated {
+		// 并发请求已先一步轮换或撤销该令牌，本次生成的令牌未入库，不可用于刷新
+		latest, err := uc.RefreshRepo.Find(ctx, oldJTI)
+		if err != nil {
+			return nil, fmt.Errorf("查询 RefreshToken 失败: %w", err)
+		}
+		if latest != nil && latest.IsRotated() {
+			return nil, uc.handleReuse(ctx, latest)
+		}
+		return nil, fmt.Errorf("无效或已撤销的 RefreshToken")
 	}
 
 	return at, nil
 }
+
+// handleReuse 已轮换的 RefreshToken 被再次使用，说明令牌可能已泄露：
+// 作废整个令牌族与所属会话，拉黑仍有效的 AccessToken，并发布安全事件
+func (uc *RefreshTokenUseCase) handleReuse(ctx context.Context, rec *entity.AuthToken) error {
+	family := rec.Family()
+	zap.L().Warn("refresh token reuse detected",
+		zap.String("user_id", rec.UserID),
+		zap.String("jti", rec.ID),
+		zap.String("family_id", family))
+
+	tokens, err := uc.RefreshRepo.ListByFamily(ctx, family)
+	if err != nil {
+		return fmt.Errorf("查询令牌族失败: %w", err)
+	}
+	if err := uc.RefreshRepo.RevokeFamily(ctx, family); err != nil {
+		return fmt.Errorf("作废令牌族失败: %w", err)
+	}
+
+	now := time.Now()
+	if uc.revoker != nil {
+		for _, t := range tokens {
+			// AccessToken 与 RefreshToken 记录共用 jti
+			expiresAt := t.CreatedAt.Add(uc.AccessTTL)
+			if !expiresAt.After(now) {
+				continue
+			}
+			if err := uc.revoker.RevokeAccess(ctx, t.ID, expiresAt); err != nil {
+				return fmt.Errorf("撤销 AccessToken 失败: %w", err)
+			}
+		}
+		uc.revoker.publish(ctx, rec.UserID, out.AuthEvent{
+			Type:       out.AuthEventRefreshTokenReused,
+			JTI:        rec.ID,
+			UserID:     rec.UserID,
+			SessionID:  rec.SessionID,
+			OccurredAt: now.Unix(),
+		})
+	}
+	if uc.sessions != nil {
+		if err := uc.sessions.EndByToken(ctx, rec.ID); err != nil {
+			return fmt.Errorf("结束会话失败: %w", err)
+		}
+	}
+	return authErr.ErrRefreshTokenReused
+}
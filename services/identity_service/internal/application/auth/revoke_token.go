@@ -2,7 +2,11 @@ package auth
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"time"
+
+	"go.uber.org/zap"
 
 	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
 )
@@ -10,6 +14,9 @@ import (
 type RevokeTokenUseCase struct {
 	AccessRepo  out.AccessTokenRepository
 	RefreshRepo out.RefreshTokenRepository
+
+	publisher  out.EventPublisher
+	eventTopic string
 }
 
 func NewRevokeTokenUseCase(
@@ -22,6 +29,12 @@ func NewRevokeTokenUseCase(
 	}
 }
 
+// SetEventPublisher 设置撤销事件发布者（可选），网关据此即时淘汰已撤销令牌
+func (uc *RevokeTokenUseCase) SetEventPublisher(publisher out.EventPublisher, topic string) {
+	uc.publisher = publisher
+	uc.eventTopic = topic
+}
+
 // Execute 撤销令牌；isRefresh=true 则撤销 RefreshToken，否则撤销 AccessToken
 func (uc *RevokeTokenUseCase) Execute(ctx context.Context, token string, isRefresh bool) error {
 	if isRefresh {
@@ -35,3 +48,34 @@ func (uc *RevokeTokenUseCase) Execute(ctx context.Context, token string, isRefre
 	}
 	return nil
 }
+
+// RevokeAccess 撤销 AccessToken 并广播撤销事件；expiresAt 为令牌过期时间，未知时传零值
+func (uc *RevokeTokenUseCase) RevokeAccess(ctx context.Context, jti string, expiresAt time.Time) error {
+	if err := uc.Execute(ctx, jti, false); err != nil {
+		return err
+	}
+	event := out.AuthEvent{
+		Type:       out.AuthEventTokenRevoked,
+		JTI:        jti,
+		OccurredAt: time.Now().Unix(),
+	}
+	if !expiresAt.IsZero() {
+		event.ExpiresAt = expiresAt.Unix()
+	}
+	uc.publish(ctx, jti, event)
+	return nil
+}
+
+// publish 发布失败只记日志：Redis 黑名单已写入，网关回源仍能拦截
+func (uc *RevokeTokenUseCase) publish(ctx context.Context, key string, event out.AuthEvent) {
+	if uc.publisher == nil || uc.eventTopic == "" {
+		return
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+	if err := uc.publisher.Publish(ctx, uc.eventTopic, key, data); err != nil {
+		zap.L().Warn("publish auth event failed", zap.String("type", event.Type), zap.Error(err))
+	}
+}
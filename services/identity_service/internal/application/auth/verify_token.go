@@ -6,7 +6,6 @@ import (
 
 	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
 	"github.com/EthanQC/IM/services/identity_service/pkg/jwt"
-	jwtgo "github.com/dgrijalva/jwt-go"
 )
 
 type VerifyTokenUseCase struct {
@@ -24,11 +23,14 @@ func NewVerifyTokenUseCase(
 }
 
 // Execute 验证 AccessToken：验签 → 黑名单 → 用户状态
-func (uc *VerifyTokenUseCase) Execute(ctx context.Context, tokenStr string) (*jwtgo.StandardClaims, error) {
+func (uc *VerifyTokenUseCase) Execute(ctx context.Context, tokenStr string) (*jwt.Claims, error) {
 	claims, err := uc.JWTManager.Parse(tokenStr)
 	if err != nil {
 		return nil, fmt.Errorf("解析 AccessToken 失败: %w", err)
 	}
+	if claims.Type != jwt.TypeAccess {
+		return nil, fmt.Errorf("不是 AccessToken")
+	}
 	rec, err := uc.AccessRepo.Find(ctx, tokenStr)
 	if err != nil {
 		return nil, fmt.Errorf("检查 Token 黑名单 失败: %w", err)
@@ -0,0 +1,266 @@
+package discovery
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/vo"
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/in"
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
+	authErr "github.com/EthanQC/IM/services/identity_service/pkg/errors"
+)
+
+var (
+	ErrUserNotFound     = errors.New("user not found")
+	ErrInvalidQuery     = errors.New("query must be a username, phone or email")
+	ErrInvalidShareCode = errors.New("invalid share code")
+)
+
+const (
+	// 名片码去掉易混淆的 0/O、1/I，8 位约 40 bit，配合限流无法枚举
+	shareCodeAlphabet = "23456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+	shareCodeLength   = 8
+	shareCodeAttempts = 3
+)
+
+// Config 查找用户的限流与分享链接
+type Config struct {
+	// LimitWindow 内每个用户最多 SearchLimit 次搜索或名片码查询
+	SearchLimit int
+	LimitWindow time.Duration
+	// ShareURL 分享链接前缀，名片码追加在末尾，客户端据此生成二维码
+	ShareURL string
+}
+
+// DiscoveryUseCase 只返回用户的公开资料；找不到、不允许搜索、已拉黑请求者时结果一致，避免泄露账号是否存在
+type DiscoveryUseCase struct {
+	userRepo      out.UserRepository
+	privacyRepo   out.UserPrivacyRepository
+	blacklistRepo out.BlacklistRepository
+	limiter       out.RateLimiter
+	cfg           Config
+}
+
+var _ in.DiscoveryUseCase = (*DiscoveryUseCase)(nil)
+
+func NewDiscoveryUseCase(
+	userRepo out.UserRepository,
+	privacyRepo out.UserPrivacyRepository,
+	blacklistRepo out.BlacklistRepository,
+	limiter out.RateLimiter,
+	cfg Config,
+) *DiscoveryUseCase {
+	return &DiscoveryUseCase{
+		userRepo:      userRepo,
+		privacyRepo:   privacyRepo,
+		blacklistRepo: blacklistRepo,
+		limiter:       limiter,
+		cfg:           cfg,
+	}
+}
+
+// SearchUser 含 @ 按邮箱、合法手机号按手机号、其余按用户名精确匹配
+func (uc *DiscoveryUseCase) SearchUser(ctx context.Context, requesterID uint64, query string) (*entity.User, error) {
+	query = strings.TrimSpace(query)
+	if query == "" || strings.ContainsAny(query, " \r\n") {
+		return nil, ErrInvalidQuery
+	}
+	if err := uc.allow(ctx, requesterID); err != nil {
+		return nil, err
+	}
+
+	var (
+		user    *entity.User
+		err     error
+		allowed func(*entity.UserPrivacy) bool
+	)
+	switch phone, isPhone := parsePhone(query); {
+	case strings.Contains(query, "@"):
+		user, err = uc.userRepo.GetByEmail(ctx, strings.ToLower(query))
+		allowed = func(p *entity.UserPrivacy) bool { return p.AllowFindByEmail }
+	case isPhone:
+		user, err = uc.userRepo.GetByPhone(ctx, phone)
+		allowed = func(p *entity.UserPrivacy) bool { return p.AllowFindByPhone }
+	default:
+		user, err = uc.userRepo.GetByUsername(ctx, query)
+		allowed = func(p *entity.UserPrivacy) bool { return p.AllowFindByUsername }
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	privacy, err := uc.privacy(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed(privacy) {
+		return nil, ErrUserNotFound
+	}
+	return uc.visible(ctx, requesterID, user)
+}
+
+func (uc *DiscoveryUseCase) ResolveShareCode(ctx context.Context, requesterID uint64, code string) (*entity.User, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if len(code) != shareCodeLength {
+		return nil, ErrInvalidShareCode
+	}
+	if err := uc.allow(ctx, requesterID); err != nil {
+		return nil, err
+	}
+	privacy, err := uc.privacyRepo.GetByShareCode(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("get share code: %w", err)
+	}
+	if privacy == nil {
+		return nil, ErrUserNotFound
+	}
+	user, err := uc.userRepo.GetByID(ctx, privacy.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	return uc.visible(ctx, requesterID, user)
+}
+
+func (uc *DiscoveryUseCase) GetShareCode(ctx context.Context, userID uint64) (string, string, error) {
+	privacy, err := uc.privacy(ctx, userID)
+	if err != nil {
+		return "", "", err
+	}
+	if privacy.ShareCode != "" {
+		return privacy.ShareCode, uc.shareURL(privacy.ShareCode), nil
+	}
+	return uc.issueShareCode(ctx, privacy)
+}
+
+func (uc *DiscoveryUseCase) ResetShareCode(ctx context.Context, userID uint64) (string, string, error) {
+	privacy, err := uc.privacy(ctx, userID)
+	if err != nil {
+		return "", "", err
+	}
+	return uc.issueShareCode(ctx, privacy)
+}
+
+func (uc *DiscoveryUseCase) GetPrivacy(ctx context.Context, userID uint64) (*entity.UserPrivacy, error) {
+	return uc.privacy(ctx, userID)
+}
+
+func (uc *DiscoveryUseCase) UpdatePrivacy(ctx context.Context, userID uint64, findByUsername, findByPhone, findByEmail bool) (*entity.UserPrivacy, error) {
+	privacy, err := uc.privacy(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	privacy.AllowFindByUsername = findByUsername
+	privacy.AllowFindByPhone = findByPhone
+	privacy.AllowFindByEmail = findByEmail
+	privacy.UpdatedAt = time.Now()
+	if err := uc.privacyRepo.Save(ctx, privacy); err != nil {
+		return nil, fmt.Errorf("save privacy: %w", err)
+	}
+	return privacy, nil
+}
+
+// issueShareCode 生成新码覆盖旧码；仅在与他人的码冲突时重试，其他写入错误直接返回
+func (uc *DiscoveryUseCase) issueShareCode(ctx context.Context, privacy *entity.UserPrivacy) (string, string, error) {
+	for i := 0; i < shareCodeAttempts; i++ {
+		code, err := randomShareCode()
+		if err != nil {
+			return "", "", err
+		}
+		privacy.ShareCode = code
+		privacy.UpdatedAt = time.Now()
+		err = uc.privacyRepo.Save(ctx, privacy)
+		if err == nil {
+			return code, uc.shareURL(code), nil
+		}
+		if !errors.Is(err, out.ErrShareCodeTaken) {
+			return "", "", fmt.Errorf("save share code: %w", err)
+		}
+	}
+	return "", "", fmt.Errorf("save share code: %w", out.ErrShareCodeTaken)
+}
+
+// privacy 未保存过设置时返回默认值
+func (uc *DiscoveryUseCase) privacy(ctx context.Context, userID uint64) (*entity.UserPrivacy, error) {
+	p, err := uc.privacyRepo.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get privacy: %w", err)
+	}
+	if p == nil {
+		p = entity.DefaultUserPrivacy(userID)
+	}
+	return p, nil
+}
+
+// visible 不可用账号与拉黑了请求者的用户对其不可见
+func (uc *DiscoveryUseCase) visible(ctx context.Context, requesterID uint64, user *entity.User) (*entity.User, error) {
+	if !user.IsActive() {
+		return nil, ErrUserNotFound
+	}
+	if uc.blacklistRepo != nil && user.ID != requesterID {
+		blocked, err := uc.blacklistRepo.IsBlocked(ctx, user.ID, requesterID)
+		if err != nil {
+			return nil, fmt.Errorf("check blacklist: %w", err)
+		}
+		if blocked {
+			return nil, ErrUserNotFound
+		}
+	}
+	return user, nil
+}
+
+// allow 按请求者计数，命中与未命中同样计入，防止枚举手机号 / 邮箱
+func (uc *DiscoveryUseCase) allow(ctx context.Context, requesterID uint64) error {
+	if uc.limiter == nil || uc.cfg.SearchLimit <= 0 {
+		return nil
+	}
+	ok, err := uc.limiter.Allow(ctx, "user_search:"+strconv.FormatUint(requesterID, 10), uc.cfg.SearchLimit, uc.cfg.LimitWindow)
+	if err != nil {
+		return fmt.Errorf("rate limit: %w", err)
+	}
+	if !ok {
+		return authErr.ErrTooManyRequests
+	}
+	return nil
+}
+
+func (uc *DiscoveryUseCase) shareURL(code string) string {
+	if uc.cfg.ShareURL == "" {
+		return ""
+	}
+	return uc.cfg.ShareURL + code
+}
+
+// parsePhone 允许带 +86 与短横线的大陆手机号
+func parsePhone(query string) (string, bool) {
+	phone := strings.TrimPrefix(strings.NewReplacer("-", "").Replace(query), "+86")
+	p, err := vo.NewPhone(phone)
+	if err != nil {
+		return "", false
+	}
+	return p.Number, true
+}
+
+func randomShareCode() (string, error) {
+	buf := make([]byte, shareCodeLength)
+	max := big.NewInt(int64(len(shareCodeAlphabet)))
+	for i := range buf {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("generate share code: %w", err)
+		}
+		buf[i] = shareCodeAlphabet[n.Int64()]
+	}
+	return string(buf), nil
+}
@@ -0,0 +1,329 @@
+package loginguard
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
+	authErr "github.com/EthanQC/IM/services/identity_service/pkg/errors"
+)
+
+const lockReason = "登录失败次数过多，临时锁定"
+
+// Config 各阈值为 0 时不启用对应策略
+type Config struct {
+	// Window 失败计数的滑动窗口
+	Window time.Duration
+	// DelayAfter 账号失败达到该次数后，下次尝试需间隔 BaseDelay，之后逐次翻倍直到 MaxDelay；
+	// 间隔内的尝试直接拒绝并给出 Retry-After，不占用服务端连接等待
+	DelayAfter int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+	// CaptchaAfter / IPCaptchaAfter 账号或 IP 失败达到该次数后要求人机验证
+	CaptchaAfter   int
+	IPCaptchaAfter int
+	// LockAfter 账号失败达到该次数后临时锁定 LockDuration：账号存在时写入带到期时间的封禁状态，
+	// 不覆盖或缩短管理员封禁等更长的封禁；TemporaryLock 中的锁定键供登录前快速判断
+	LockAfter    int
+	LockDuration time.Duration
+	// IPLimit 同一 IP 失败达到该次数后拒绝其密码登录，直到失败记录滑出窗口
+	IPLimit int
+	// IPAccountsAlert 同一 IP 在窗口内尝试失败的不同账号数达到该值时发出安全审计事件
+	IPAccountsAlert int
+}
+
+// AccountLocker 写入带到期时间的封禁状态，已有到期更晚的封禁时不改写，返回是否写入
+type AccountLocker interface {
+	LockTemporarily(ctx context.Context, userID, reason string, duration time.Duration) (bool, error)
+}
+
+// LoginGuard 登录防暴力破解：按账号与 IP 统计失败次数，渐进限制重试间隔、要求人机验证、临时锁定。
+// 密码登录使用 Check / Fail；短信验证码与二次验证等登录因素使用 CheckFactor / FailFactor，账号加前缀区分
+type LoginGuard struct {
+	window  out.SlidingWindow
+	limiter out.RateLimiter
+	locks   out.TemporaryLock
+	locker  AccountLocker
+	cfg     Config
+
+	captcha    out.CaptchaVerifier
+	publisher  out.EventPublisher
+	eventTopic string
+}
+
+func NewLoginGuard(window out.SlidingWindow, limiter out.RateLimiter, locks out.TemporaryLock, locker AccountLocker, cfg Config) *LoginGuard {
+	return &LoginGuard{
+		window:  window,
+		limiter: limiter,
+		locks:   locks,
+		locker:  locker,
+		cfg:     cfg,
+	}
+}
+
+// SetCaptchaVerifier 设置人机验证（可选），未设置时不要求人机验证
+func (g *LoginGuard) SetCaptchaVerifier(captcha out.CaptchaVerifier) {
+	g.captcha = captcha
+}
+
+// SetEventPublisher 设置安全审计事件发布者（可选）
+func (g *LoginGuard) SetEventPublisher(publisher out.EventPublisher, topic string) {
+	g.publisher = publisher
+	g.eventTopic = topic
+}
+
+// Check 校验密码前调用：IP 超限、账号已锁定或未到重试间隔时拒绝，需要时校验人机验证
+// 锁定与间隔只按账号标识记录，不区分账号是否存在，避免借此枚举账号
+func (g *LoginGuard) Check(ctx context.Context, account, ip, captchaToken string) error {
+	accountFails, ipFails, err := g.checkLimits(ctx, account, ip)
+	if err != nil {
+		return err
+	}
+	if g.captchaRequired(accountFails, ipFails) {
+		ok, err := g.captcha.Verify(ctx, captchaToken, ip)
+		if err != nil {
+			return fmt.Errorf("verify captcha: %w", err)
+		}
+		if !ok {
+			return authErr.ErrCaptchaRequired
+		}
+	}
+	return nil
+}
+
+// CheckFactor 校验短信验证码、二次验证码前调用，与 Check 相同的限制，但不要求人机验证
+func (g *LoginGuard) CheckFactor(ctx context.Context, account, ip string) error {
+	_, _, err := g.checkLimits(ctx, account, ip)
+	return err
+}
+
+// Fail 记录一次密码错误并返回应答给客户端的错误；userID 为空表示账号不存在或不可登录，只影响审计事件
+func (g *LoginGuard) Fail(ctx context.Context, account, userID, ip string) error {
+	accountFails, ipFails, err := g.record(ctx, account, userID, ip, authErr.ErrInvalidPassword)
+	if err != nil {
+		return err
+	}
+	if g.captchaRequired(accountFails, ipFails) {
+		return fmt.Errorf("%w: %w", authErr.ErrInvalidPassword, authErr.ErrCaptchaRequired)
+	}
+	return authErr.ErrInvalidPassword
+}
+
+// FailFactor 记录一次登录因素校验失败，触发锁定时返回锁定错误，否则原样返回 cause
+func (g *LoginGuard) FailFactor(ctx context.Context, account, userID, ip string, cause error) error {
+	if _, _, err := g.record(ctx, account, userID, ip, cause); err != nil {
+		return err
+	}
+	return cause
+}
+
+// checkLimits 返回当前的账号与 IP 失败次数；计数读取失败时拒绝登录
+func (g *LoginGuard) checkLimits(ctx context.Context, account, ip string) (int, int, error) {
+	ipFails := 0
+	if ip != "" {
+		n, err := g.window.Count(ctx, ipKey(ip), g.cfg.Window)
+		if err != nil {
+			return 0, 0, err
+		}
+		ipFails = n
+	}
+	if g.cfg.IPLimit > 0 && ipFails >= g.cfg.IPLimit {
+		// 最早的失败记录最迟在一个窗口后滑出
+		return 0, 0, &authErr.RetryAfterError{Err: authErr.ErrTooManyRequests, RetryAfter: g.cfg.Window}
+	}
+	if g.cfg.LockAfter > 0 {
+		left, err := g.locks.Remaining(ctx, lockKey(account))
+		if err != nil {
+			return 0, 0, err
+		}
+		if left > 0 {
+			return 0, 0, &authErr.RetryAfterError{Err: authErr.ErrAccountLocked, RetryAfter: left}
+		}
+	}
+	if g.cfg.DelayAfter > 0 {
+		left, err := g.locks.Remaining(ctx, throttleKey(account))
+		if err != nil {
+			return 0, 0, err
+		}
+		if left > 0 {
+			return 0, 0, &authErr.RetryAfterError{Err: authErr.ErrTooManyRequests, RetryAfter: left}
+		}
+	}
+	accountFails, err := g.window.Count(ctx, accountKey(account), g.cfg.Window)
+	if err != nil {
+		return 0, 0, err
+	}
+	return accountFails, ipFails, nil
+}
+
+// record 计入账号与 IP 的失败次数，达到阈值时锁定账号并返回锁定错误，否则设置下次尝试的间隔；
+// 计数写入失败时返回 cause，不因存储故障改变应答
+func (g *LoginGuard) record(ctx context.Context, account, userID, ip string, cause error) (int, int, error) {
+	attempt, err := attemptID()
+	if err != nil {
+		return 0, 0, cause
+	}
+	accountFails, err := g.window.Add(ctx, accountKey(account), attempt, g.cfg.Window)
+	if err != nil {
+		zap.L().Warn("record login failure failed", zap.String("account", account), zap.Error(err))
+		return 0, 0, cause
+	}
+	ipFails := 0
+	if ip != "" {
+		if ipFails, err = g.window.Add(ctx, ipKey(ip), attempt, g.cfg.Window); err != nil {
+			zap.L().Warn("record login failure failed", zap.String("ip", ip), zap.Error(err))
+		}
+		g.detectSpraying(ctx, account, ip)
+	}
+
+	if g.cfg.LockAfter > 0 && accountFails >= g.cfg.LockAfter {
+		g.lock(ctx, account, userID, ip, accountFails)
+		return accountFails, ipFails, &authErr.RetryAfterError{Err: authErr.ErrAccountLocked, RetryAfter: g.cfg.LockDuration}
+	}
+	if d := g.delay(accountFails); d > 0 {
+		if _, err := g.locks.Lock(ctx, throttleKey(account), d); err != nil {
+			zap.L().Warn("set login retry interval failed", zap.String("account", account), zap.Error(err))
+		}
+	}
+	return accountFails, ipFails, nil
+}
+
+// Succeed 登录成功后清空账号的失败计数；IP 计数保留，避免用自己的账号为撞库解锁
+func (g *LoginGuard) Succeed(ctx context.Context, account string) {
+	if err := g.window.Reset(ctx, accountKey(account)); err != nil {
+		zap.L().Warn("reset login failures failed", zap.String("account", account), zap.Error(err))
+	}
+}
+
+// lock 按账号标识锁定，已锁定时不延长；存在对应用户时写入封禁状态并发出审计事件
+func (g *LoginGuard) lock(ctx context.Context, account, userID, ip string, failures int) {
+	first, err := g.locks.Lock(ctx, lockKey(account), g.cfg.LockDuration)
+	if err != nil {
+		// 锁定键写入失败时仍写入封禁状态
+		zap.L().Error("set login lock failed", zap.String("account", account), zap.Error(err))
+	} else if !first {
+		return
+	}
+	if userID == "" {
+		return
+	}
+	written, err := g.locker.LockTemporarily(ctx, userID, lockReason, g.cfg.LockDuration)
+	if err != nil {
+		zap.L().Error("lock account failed", zap.String("user_id", userID), zap.Error(err))
+		return
+	}
+	if !written {
+		// 已有到期更晚的封禁
+		return
+	}
+	zap.L().Warn("account locked after failed logins",
+		zap.String("user_id", userID), zap.String("ip", ip), zap.Int("failures", failures))
+	g.publish(ctx, userID, out.AuthEvent{
+		Type:   out.AuthEventLoginLocked,
+		UserID: userID,
+		IP:     ip,
+		Count:  failures,
+		Reason: lockReason,
+	})
+}
+
+// detectSpraying 同一 IP 短时间内尝试大量不同账号（撞库 / 密码喷洒），每个窗口只告警一次
+func (g *LoginGuard) detectSpraying(ctx context.Context, account, ip string) {
+	if g.cfg.IPAccountsAlert <= 0 {
+		return
+	}
+	accounts, err := g.window.Add(ctx, ipAccountsKey(ip), normalize(account), g.cfg.Window)
+	if err != nil || accounts < g.cfg.IPAccountsAlert {
+		return
+	}
+	first, err := g.limiter.Allow(ctx, "login_alert:"+ip, 1, g.cfg.Window)
+	if err != nil || !first {
+		return
+	}
+	zap.L().Warn("suspicious login pattern: many accounts from one ip",
+		zap.String("ip", ip), zap.Int("accounts", accounts), zap.Duration("window", g.cfg.Window))
+	g.publish(ctx, ip, out.AuthEvent{
+		Type:   out.AuthEventSuspiciousLogin,
+		IP:     ip,
+		Count:  accounts,
+		Reason: "many_accounts_from_ip",
+	})
+}
+
+func (g *LoginGuard) publish(ctx context.Context, key string, event out.AuthEvent) {
+	if g.publisher == nil || g.eventTopic == "" {
+		return
+	}
+	event.OccurredAt = time.Now().Unix()
+	data, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+	if err := g.publisher.Publish(ctx, g.eventTopic, key, data); err != nil {
+		zap.L().Warn("publish auth event failed", zap.String("type", event.Type), zap.Error(err))
+	}
+}
+
+func (g *LoginGuard) captchaRequired(accountFails, ipFails int) bool {
+	if g.captcha == nil {
+		return false
+	}
+	return (g.cfg.CaptchaAfter > 0 && accountFails >= g.cfg.CaptchaAfter) ||
+		(g.cfg.IPCaptchaAfter > 0 && ipFails >= g.cfg.IPCaptchaAfter)
+}
+
+// delay 第 DelayAfter 次失败后从 BaseDelay 开始逐次翻倍
+func (g *LoginGuard) delay(failures int) time.Duration {
+	if g.cfg.DelayAfter <= 0 || failures < g.cfg.DelayAfter || g.cfg.BaseDelay <= 0 {
+		return 0
+	}
+	d := g.cfg.BaseDelay
+	for i := g.cfg.DelayAfter; i < failures && d < g.cfg.MaxDelay; i++ {
+		d *= 2
+	}
+	if g.cfg.MaxDelay > 0 && d > g.cfg.MaxDelay {
+		d = g.cfg.MaxDelay
+	}
+	return d
+}
+
+func normalize(account string) string {
+	return strings.ToLower(strings.TrimSpace(account))
+}
+
+func accountKey(account string) string {
+	return "login_fail:account:" + normalize(account)
+}
+
+func lockKey(account string) string {
+	return "login_lock:" + normalize(account)
+}
+
+func throttleKey(account string) string {
+	return "login_throttle:" + normalize(account)
+}
+
+func ipKey(ip string) string {
+	return "login_fail:ip:" + ip
+}
+
+func ipAccountsKey(ip string) string {
+	return "login_fail:ip_accounts:" + ip
+}
+
+// attemptID 每次失败一个成员，同一毫秒内的并发失败也分别计数
+func attemptID() (string, error) {
+	buf := make([]byte, 4)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(time.Now().UnixNano(), 36) + hex.EncodeToString(buf), nil
+}
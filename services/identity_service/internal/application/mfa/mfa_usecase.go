@@ -0,0 +1,399 @@
+package mfa
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/in"
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
+	"github.com/EthanQC/IM/services/identity_service/pkg/totp"
+)
+
+var (
+	ErrMFANotEnabled      = errors.New("two-factor authentication not enabled")
+	ErrMFAAlreadyEnabled  = errors.New("two-factor authentication already enabled")
+	ErrEnrollmentNotFound = errors.New("no pending enrollment")
+	ErrInvalidCode        = errors.New("invalid verification code")
+	ErrChallengeNotFound  = errors.New("login challenge not found or expired")
+	ErrStepUpRequired     = errors.New("step-up verification required")
+	ErrMFALocked          = errors.New("too many failed verification attempts, try again later")
+)
+
+const (
+	// 允许前后各一个步长（30 秒）的时钟偏差
+	totpSkew = 1
+	// 每个登录挑战最多尝试次数
+	maxChallengeAttempts = 5
+	recoveryCodeCount    = 10
+)
+
+// Config LockAfter 为 0 时不启用按账号的失败锁定
+type Config struct {
+	// Issuer 验证器应用中显示的服务名
+	Issuer       string
+	ChallengeTTL time.Duration
+	StepUpTTL    time.Duration
+	// LockAfter 账号在 LockWindow 内验证失败达到该次数后拒绝所有验证，直到失败记录滑出窗口；
+	// 登录挑战、再次验证、关闭与重新生成恢复码共用同一计数，换入口或换挑战都不会重置
+	LockAfter  int
+	LockWindow time.Duration
+}
+
+// MFAUseCase TOTP 二次验证：绑定与确认、恢复码、两步登录挑战，以及敏感操作前的再次验证
+type MFAUseCase struct {
+	repo     out.MFARepository
+	state    out.MFAStateRepository
+	failures out.SlidingWindow
+	cfg      Config
+}
+
+var _ in.MFAUseCase = (*MFAUseCase)(nil)
+
+func NewMFAUseCase(
+	repo out.MFARepository,
+	state out.MFAStateRepository,
+	failures out.SlidingWindow,
+	cfg Config,
+) *MFAUseCase {
+	return &MFAUseCase{
+		repo:     repo,
+		state:    state,
+		failures: failures,
+		cfg:      cfg,
+	}
+}
+
+func (uc *MFAUseCase) Status(ctx context.Context, userID uint64) (bool, int, error) {
+	m, err := uc.repo.Get(ctx, userID)
+	if err != nil {
+		return false, 0, fmt.Errorf("get mfa: %w", err)
+	}
+	if m == nil || !m.Enabled {
+		return false, 0, nil
+	}
+	return true, len(m.RecoveryCodes), nil
+}
+
+// Enroll 生成新密钥，确认前不生效；重复调用会替换尚未确认的密钥
+func (uc *MFAUseCase) Enroll(ctx context.Context, userID uint64, account string) (string, string, error) {
+	m, err := uc.repo.Get(ctx, userID)
+	if err != nil {
+		return "", "", fmt.Errorf("get mfa: %w", err)
+	}
+	if m != nil && m.Enabled {
+		return "", "", ErrMFAAlreadyEnabled
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return "", "", err
+	}
+	now := time.Now()
+	if err := uc.repo.Save(ctx, &entity.UserMFA{
+		UserID:    userID,
+		Secret:    secret,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}); err != nil {
+		return "", "", fmt.Errorf("save mfa: %w", err)
+	}
+	return secret, totp.URI(uc.cfg.Issuer, account, secret), nil
+}
+
+// Confirm 用验证器生成的验证码确认绑定，返回一次性恢复码（只展示这一次）
+func (uc *MFAUseCase) Confirm(ctx context.Context, userID uint64, code string) ([]string, error) {
+	m, err := uc.repo.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get mfa: %w", err)
+	}
+	if m == nil {
+		return nil, ErrEnrollmentNotFound
+	}
+	if m.Enabled {
+		return nil, ErrMFAAlreadyEnabled
+	}
+	if err := uc.check(ctx, m, code, false); err != nil {
+		return nil, err
+	}
+	codes, err := uc.resetRecoveryCodes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	m.Enabled = true
+	m.EnabledAt = &now
+	m.UpdatedAt = now
+	if err := uc.repo.Save(ctx, m); err != nil {
+		return nil, fmt.Errorf("save mfa: %w", err)
+	}
+	return codes, nil
+}
+
+// Disable 关闭二次验证，需要验证码或恢复码
+func (uc *MFAUseCase) Disable(ctx context.Context, userID uint64, code string) error {
+	if _, err := uc.verify(ctx, userID, code, true); err != nil {
+		return err
+	}
+	if err := uc.repo.Delete(ctx, userID); err != nil {
+		return fmt.Errorf("delete mfa: %w", err)
+	}
+	return nil
+}
+
+// RegenerateRecoveryCodes 重新生成恢复码，旧恢复码全部失效；只接受 TOTP 验证码
+func (uc *MFAUseCase) RegenerateRecoveryCodes(ctx context.Context, userID uint64, code string) ([]string, error) {
+	if _, err := uc.verify(ctx, userID, code, false); err != nil {
+		return nil, err
+	}
+	return uc.resetRecoveryCodes(ctx, userID)
+}
+
+// StepUp 敏感操作前再次验证，sessionKey 在 stepUpTTL 内视为已验证
+func (uc *MFAUseCase) StepUp(ctx context.Context, userID uint64, sessionKey, code string) (time.Duration, error) {
+	if _, err := uc.verify(ctx, userID, code, true); err != nil {
+		return 0, err
+	}
+	if err := uc.state.MarkStepUp(ctx, stepUpKey(userID, sessionKey), uc.cfg.StepUpTTL); err != nil {
+		return 0, fmt.Errorf("mark step-up: %w", err)
+	}
+	return uc.cfg.StepUpTTL, nil
+}
+
+// RequireStepUp 未开启二次验证的账号直接放行
+func (uc *MFAUseCase) RequireStepUp(ctx context.Context, userID uint64, sessionKey string) error {
+	enabled, _, err := uc.Status(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return nil
+	}
+	ok, err := uc.state.HasStepUp(ctx, stepUpKey(userID, sessionKey))
+	if err != nil {
+		return fmt.Errorf("check step-up: %w", err)
+	}
+	if !ok {
+		return ErrStepUpRequired
+	}
+	return nil
+}
+
+// Enabled 登录时判断是否需要二次验证
+func (uc *MFAUseCase) Enabled(ctx context.Context, userID string) (bool, error) {
+	id, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("invalid user id %q: %w", userID, err)
+	}
+	enabled, _, err := uc.Status(ctx, id)
+	return enabled, err
+}
+
+// Challenge 密码校验通过后创建登录挑战
+func (uc *MFAUseCase) Challenge(ctx context.Context, userID string, device entity.DeviceInfo) (*entity.MFAChallenge, error) {
+	token, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+	ch := &entity.MFAChallenge{
+		Token:     token,
+		UserID:    userID,
+		Device:    device,
+		ExpiresAt: time.Now().Add(uc.cfg.ChallengeTTL),
+	}
+	if err := uc.state.SaveChallenge(ctx, ch); err != nil {
+		return nil, fmt.Errorf("save challenge: %w", err)
+	}
+	return ch, nil
+}
+
+// PendingChallenge 读取未完成的挑战，供调用方在校验前按用户做登录防护
+func (uc *MFAUseCase) PendingChallenge(ctx context.Context, token string) (*entity.MFAChallenge, error) {
+	ch, err := uc.state.GetChallenge(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("get challenge: %w", err)
+	}
+	if ch == nil {
+		return nil, ErrChallengeNotFound
+	}
+	return ch, nil
+}
+
+// VerifyChallenge 校验挑战的验证码（或恢复码），通过后挑战作废；错误次数过多或账号已锁定同样作废
+func (uc *MFAUseCase) VerifyChallenge(ctx context.Context, token, code string) (*entity.MFAChallenge, error) {
+	ch, err := uc.state.GetChallenge(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("get challenge: %w", err)
+	}
+	if ch == nil {
+		return nil, ErrChallengeNotFound
+	}
+	userID, err := strconv.ParseUint(ch.UserID, 10, 64)
+	if err != nil {
+		return nil, ErrChallengeNotFound
+	}
+
+	if _, err := uc.verify(ctx, userID, code, true); err != nil {
+		switch {
+		case errors.Is(err, ErrMFALocked):
+			_, _ = uc.state.DeleteChallenge(ctx, token)
+		case errors.Is(err, ErrInvalidCode):
+			ch.Attempts++
+			if ch.Attempts >= maxChallengeAttempts {
+				_, _ = uc.state.DeleteChallenge(ctx, token)
+			} else {
+				_ = uc.state.SaveChallenge(ctx, ch)
+			}
+		}
+		return nil, err
+	}
+	// 同一挑战并发提交不同的有效验证码（如验证码与恢复码）时，只有删除成功的一方能完成登录
+	deleted, err := uc.state.DeleteChallenge(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("delete challenge: %w", err)
+	}
+	if !deleted {
+		return nil, ErrChallengeNotFound
+	}
+	return ch, nil
+}
+
+// verify 校验已开启账号的 TOTP 验证码，allowRecovery 时也接受恢复码（用后即焚）
+func (uc *MFAUseCase) verify(ctx context.Context, userID uint64, code string, allowRecovery bool) (*entity.UserMFA, error) {
+	m, err := uc.repo.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get mfa: %w", err)
+	}
+	if m == nil || !m.Enabled {
+		return nil, ErrMFANotEnabled
+	}
+	if err := uc.check(ctx, m, code, allowRecovery); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// check 账号未锁定时校验并消费验证码，失败计入账号的失败次数，成功后清零
+func (uc *MFAUseCase) check(ctx context.Context, m *entity.UserMFA, code string, allowRecovery bool) error {
+	if err := uc.checkLocked(ctx, m.UserID); err != nil {
+		return err
+	}
+	ok, err := uc.consume(ctx, m, code, allowRecovery)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return uc.recordFailure(ctx, m.UserID)
+	}
+	if uc.cfg.LockAfter > 0 {
+		if err := uc.failures.Reset(ctx, failureKey(m.UserID)); err != nil {
+			return fmt.Errorf("reset mfa failures: %w", err)
+		}
+	}
+	return nil
+}
+
+// consume 由仓储条件更新消费验证码：TOTP 步长只能前进，恢复码按摘要删除，
+// 并发提交同一验证码时只有一个请求成功
+func (uc *MFAUseCase) consume(ctx context.Context, m *entity.UserMFA, code string, allowRecovery bool) (bool, error) {
+	if step, ok := totp.Validate(m.Secret, code, time.Now(), totpSkew); ok {
+		consumed, err := uc.repo.ConsumeStep(ctx, m.UserID, step)
+		if err != nil {
+			return false, fmt.Errorf("consume totp step: %w", err)
+		}
+		return consumed, nil
+	}
+	if !allowRecovery {
+		return false, nil
+	}
+	consumed, err := uc.repo.ConsumeRecoveryCode(ctx, m.UserID, entity.HashRecoveryCode(code))
+	if err != nil {
+		return false, fmt.Errorf("consume recovery code: %w", err)
+	}
+	return consumed, nil
+}
+
+// checkLocked 计数读取失败时拒绝验证，与登录防护一致按失败关闭处理
+func (uc *MFAUseCase) checkLocked(ctx context.Context, userID uint64) error {
+	if uc.cfg.LockAfter <= 0 {
+		return nil
+	}
+	n, err := uc.failures.Count(ctx, failureKey(userID), uc.cfg.LockWindow)
+	if err != nil {
+		return fmt.Errorf("count mfa failures: %w", err)
+	}
+	if n >= uc.cfg.LockAfter {
+		return ErrMFALocked
+	}
+	return nil
+}
+
+// recordFailure 记录一次失败，达到阈值时直接返回 ErrMFALocked
+func (uc *MFAUseCase) recordFailure(ctx context.Context, userID uint64) error {
+	if uc.cfg.LockAfter <= 0 {
+		return ErrInvalidCode
+	}
+	attempt, err := attemptID()
+	if err != nil {
+		return fmt.Errorf("generate attempt id: %w", err)
+	}
+	n, err := uc.failures.Add(ctx, failureKey(userID), attempt, uc.cfg.LockWindow)
+	if err != nil {
+		return fmt.Errorf("record mfa failure: %w", err)
+	}
+	if n >= uc.cfg.LockAfter {
+		return ErrMFALocked
+	}
+	return ErrInvalidCode
+}
+
+// resetRecoveryCodes 生成新的恢复码并替换旧恢复码，仓储只保存摘要
+func (uc *MFAUseCase) resetRecoveryCodes(ctx context.Context, userID uint64) ([]string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		buf := make([]byte, 5)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, fmt.Errorf("generate recovery code: %w", err)
+		}
+		raw := base32.StdEncoding.EncodeToString(buf) // 8 个字符
+		code := strings.ToLower(raw[:4] + "-" + raw[4:])
+		codes = append(codes, code)
+		hashes = append(hashes, entity.HashRecoveryCode(code))
+	}
+	if err := uc.repo.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, fmt.Errorf("save recovery codes: %w", err)
+	}
+	return codes, nil
+}
+
+func failureKey(userID uint64) string {
+	return fmt.Sprintf("mfa_fail:%d", userID)
+}
+
+func stepUpKey(userID uint64, sessionKey string) string {
+	return fmt.Sprintf("%d:%s", userID, sessionKey)
+}
+
+func randomToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate challenge token: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// attemptID 每次失败一个成员，同一毫秒内的并发失败也分别计数
+func attemptID() (string, error) {
+	buf := make([]byte, 4)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(time.Now().UnixNano(), 36) + hex.EncodeToString(buf), nil
+}
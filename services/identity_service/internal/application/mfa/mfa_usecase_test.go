@@ -0,0 +1,382 @@
+package mfa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/identity_service/pkg/totp"
+)
+
+// memMFARepo 以互斥锁模拟 MySQL 条件更新的语义
+type memMFARepo struct {
+	mu    sync.Mutex
+	rows  map[uint64]entity.UserMFA
+	codes map[uint64]map[string]bool
+}
+
+func newMemMFARepo() *memMFARepo {
+	return &memMFARepo{rows: map[uint64]entity.UserMFA{}, codes: map[uint64]map[string]bool{}}
+}
+
+func (r *memMFARepo) Get(_ context.Context, userID uint64) (*entity.UserMFA, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	m, ok := r.rows[userID]
+	if !ok {
+		return nil, nil
+	}
+	for h := range r.codes[userID] {
+		m.RecoveryCodes = append(m.RecoveryCodes, h)
+	}
+	return &m, nil
+}
+
+func (r *memMFARepo) Save(_ context.Context, mfa *entity.UserMFA) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	m := *mfa
+	m.RecoveryCodes = nil
+	if old, ok := r.rows[mfa.UserID]; ok {
+		m.LastUsedStep = old.LastUsedStep
+	}
+	r.rows[mfa.UserID] = m
+	return nil
+}
+
+func (r *memMFARepo) Delete(_ context.Context, userID uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.rows, userID)
+	delete(r.codes, userID)
+	return nil
+}
+
+func (r *memMFARepo) ConsumeStep(_ context.Context, userID uint64, step int64) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	m, ok := r.rows[userID]
+	if !ok || m.LastUsedStep >= step {
+		return false, nil
+	}
+	m.LastUsedStep = step
+	r.rows[userID] = m
+	return true, nil
+}
+
+func (r *memMFARepo) ConsumeRecoveryCode(_ context.Context, userID uint64, codeHash string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.codes[userID][codeHash] {
+		return false, nil
+	}
+	delete(r.codes[userID], codeHash)
+	return true, nil
+}
+
+func (r *memMFARepo) ReplaceRecoveryCodes(_ context.Context, userID uint64, codeHashes []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	set := make(map[string]bool, len(codeHashes))
+	for _, h := range codeHashes {
+		set[h] = true
+	}
+	r.codes[userID] = set
+	return nil
+}
+
+type memMFAState struct {
+	mu         sync.Mutex
+	challenges map[string]entity.MFAChallenge
+	stepUps    map[string]bool
+}
+
+func newMemMFAState() *memMFAState {
+	return &memMFAState{challenges: map[string]entity.MFAChallenge{}, stepUps: map[string]bool{}}
+}
+
+func (s *memMFAState) SaveChallenge(_ context.Context, ch *entity.MFAChallenge) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.challenges[ch.Token] = *ch
+	return nil
+}
+
+func (s *memMFAState) GetChallenge(_ context.Context, token string) (*entity.MFAChallenge, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ch, ok := s.challenges[token]
+	if !ok {
+		return nil, nil
+	}
+	return &ch, nil
+}
+
+func (s *memMFAState) DeleteChallenge(_ context.Context, token string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.challenges[token]
+	delete(s.challenges, token)
+	return ok, nil
+}
+
+func (s *memMFAState) MarkStepUp(_ context.Context, sessionKey string, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stepUps[sessionKey] = true
+	return nil
+}
+
+func (s *memMFAState) HasStepUp(_ context.Context, sessionKey string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stepUps[sessionKey], nil
+}
+
+// memWindow 测试内不会跨越窗口，只按成员计数
+type memWindow struct {
+	mu      sync.Mutex
+	members map[string]map[string]bool
+}
+
+func newMemWindow() *memWindow {
+	return &memWindow{members: map[string]map[string]bool{}}
+}
+
+func (w *memWindow) Add(_ context.Context, key, member string, _ time.Duration) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.members[key] == nil {
+		w.members[key] = map[string]bool{}
+	}
+	w.members[key][member] = true
+	return len(w.members[key]), nil
+}
+
+func (w *memWindow) Count(_ context.Context, key string, _ time.Duration) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.members[key]), nil
+}
+
+func (w *memWindow) Reset(_ context.Context, key string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.members, key)
+	return nil
+}
+
+const testUserID uint64 = 42
+
+type fixture struct {
+	uc       *MFAUseCase
+	secret   string
+	recovery []string
+}
+
+// newFixture 完成绑定确认，返回已开启二次验证的账号；确认时已消费当前步长
+func newFixture(t *testing.T, lockAfter int) *fixture {
+	t.Helper()
+	uc := NewMFAUseCase(newMemMFARepo(), newMemMFAState(), newMemWindow(), Config{
+		Issuer:       "IM",
+		ChallengeTTL: time.Minute,
+		StepUpTTL:    time.Minute,
+		LockAfter:    lockAfter,
+		LockWindow:   time.Minute,
+	})
+	ctx := context.Background()
+	secret, _, err := uc.Enroll(ctx, testUserID, "alice")
+	if err != nil {
+		t.Fatalf("Enroll: %v", err)
+	}
+	recovery, err := uc.Confirm(ctx, testUserID, codeAt(t, secret, -1))
+	if err != nil {
+		t.Fatalf("Confirm: %v", err)
+	}
+	return &fixture{uc: uc, secret: secret, recovery: recovery}
+}
+
+// codeAt 当前步长偏移 offset 的验证码，偏移在 totpSkew 内均可通过校验
+func codeAt(t *testing.T, secret string, offset int64) string {
+	t.Helper()
+	code, err := totp.Code(secret, totp.Step(time.Now())+offset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code
+}
+
+// badCode 不在允许偏差内的验证码，避免固定值恰好命中
+func badCode(t *testing.T, secret string) string {
+	t.Helper()
+	valid := map[string]bool{}
+	for i := int64(-totpSkew); i <= totpSkew; i++ {
+		valid[codeAt(t, secret, i)] = true
+	}
+	for i := 0; ; i++ {
+		if code := fmt.Sprintf("%06d", i); !valid[code] {
+			return code
+		}
+	}
+}
+
+func (f *fixture) challenge(t *testing.T) string {
+	t.Helper()
+	ch, err := f.uc.Challenge(context.Background(), strconv.FormatUint(testUserID, 10), entity.DeviceInfo{})
+	if err != nil {
+		t.Fatalf("Challenge: %v", err)
+	}
+	return ch.Token
+}
+
+func TestVerifyChallengeRejectsReplayedTOTP(t *testing.T) {
+	f := newFixture(t, 0)
+	ctx := context.Background()
+	code := codeAt(t, f.secret, 0)
+
+	if _, err := f.uc.VerifyChallenge(ctx, f.challenge(t), code); err != nil {
+		t.Fatalf("first use: %v", err)
+	}
+	if _, err := f.uc.VerifyChallenge(ctx, f.challenge(t), code); !errors.Is(err, ErrInvalidCode) {
+		t.Fatalf("replayed code on new challenge: got %v, want ErrInvalidCode", err)
+	}
+	// 已用步长之前的验证码同样不能再用
+	if _, err := f.uc.VerifyChallenge(ctx, f.challenge(t), codeAt(t, f.secret, -1)); !errors.Is(err, ErrInvalidCode) {
+		t.Fatalf("older code: got %v, want ErrInvalidCode", err)
+	}
+}
+
+func TestVerifyChallengeIsSingleUse(t *testing.T) {
+	f := newFixture(t, 0)
+	ctx := context.Background()
+	token := f.challenge(t)
+
+	if _, err := f.uc.VerifyChallenge(ctx, token, codeAt(t, f.secret, 0)); err != nil {
+		t.Fatalf("first use: %v", err)
+	}
+	if _, err := f.uc.VerifyChallenge(ctx, token, codeAt(t, f.secret, 1)); !errors.Is(err, ErrChallengeNotFound) {
+		t.Fatalf("reused challenge: got %v, want ErrChallengeNotFound", err)
+	}
+}
+
+func TestVerifyChallengeConcurrentCodesCompleteOnce(t *testing.T) {
+	f := newFixture(t, 0)
+	ctx := context.Background()
+	token := f.challenge(t)
+
+	// 同一挑战同时提交验证码与恢复码，只能有一次登录成功
+	codes := []string{codeAt(t, f.secret, 0), f.recovery[0], f.recovery[1]}
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		successes int
+	)
+	for _, code := range codes {
+		wg.Add(1)
+		go func(code string) {
+			defer wg.Done()
+			if _, err := f.uc.VerifyChallenge(ctx, token, code); err == nil {
+				mu.Lock()
+				successes++
+				mu.Unlock()
+			}
+		}(code)
+	}
+	wg.Wait()
+	if successes != 1 {
+		t.Fatalf("successes = %d, want 1", successes)
+	}
+}
+
+func TestRecoveryCodeCannotBeReused(t *testing.T) {
+	f := newFixture(t, 0)
+	ctx := context.Background()
+	code := f.recovery[0]
+
+	if _, err := f.uc.VerifyChallenge(ctx, f.challenge(t), code); err != nil {
+		t.Fatalf("first use: %v", err)
+	}
+	if _, err := f.uc.StepUp(ctx, testUserID, "s1", code); !errors.Is(err, ErrInvalidCode) {
+		t.Fatalf("reused recovery code: got %v, want ErrInvalidCode", err)
+	}
+	_, left, err := f.uc.Status(ctx, testUserID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if left != recoveryCodeCount-1 {
+		t.Fatalf("recovery codes left = %d, want %d", left, recoveryCodeCount-1)
+	}
+}
+
+func TestConcurrentStepUpWithSameCodeSucceedsOnce(t *testing.T) {
+	f := newFixture(t, 0)
+	ctx := context.Background()
+	code := codeAt(t, f.secret, 0)
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		successes int
+	)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if _, err := f.uc.StepUp(ctx, testUserID, strconv.Itoa(i), code); err == nil {
+				mu.Lock()
+				successes++
+				mu.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+	if successes != 1 {
+		t.Fatalf("successes = %d, want 1", successes)
+	}
+}
+
+func TestLockoutIsSharedAcrossEntryPoints(t *testing.T) {
+	f := newFixture(t, 3)
+	ctx := context.Background()
+	token := f.challenge(t)
+
+	if _, err := f.uc.StepUp(ctx, testUserID, "s1", badCode(t, f.secret)); !errors.Is(err, ErrInvalidCode) {
+		t.Fatalf("step-up failure: got %v", err)
+	}
+	if err := f.uc.Disable(ctx, testUserID, "bad-code"); !errors.Is(err, ErrInvalidCode) {
+		t.Fatalf("disable failure: got %v", err)
+	}
+	if _, err := f.uc.VerifyChallenge(ctx, token, badCode(t, f.secret)); !errors.Is(err, ErrMFALocked) {
+		t.Fatalf("third failure: got %v, want ErrMFALocked", err)
+	}
+	// 锁定后挑战作废，正确的验证码也不再接受
+	if _, err := f.uc.VerifyChallenge(ctx, token, codeAt(t, f.secret, 0)); !errors.Is(err, ErrChallengeNotFound) {
+		t.Fatalf("locked challenge: got %v, want ErrChallengeNotFound", err)
+	}
+	if _, err := f.uc.VerifyChallenge(ctx, f.challenge(t), codeAt(t, f.secret, 0)); !errors.Is(err, ErrMFALocked) {
+		t.Fatalf("new challenge while locked: got %v, want ErrMFALocked", err)
+	}
+	if _, err := f.uc.RegenerateRecoveryCodes(ctx, testUserID, codeAt(t, f.secret, 0)); !errors.Is(err, ErrMFALocked) {
+		t.Fatalf("regenerate while locked: got %v, want ErrMFALocked", err)
+	}
+}
+
+func TestSuccessfulVerificationResetsFailures(t *testing.T) {
+	f := newFixture(t, 2)
+	ctx := context.Background()
+
+	if _, err := f.uc.StepUp(ctx, testUserID, "s1", badCode(t, f.secret)); !errors.Is(err, ErrInvalidCode) {
+		t.Fatalf("failure: got %v", err)
+	}
+	if _, err := f.uc.StepUp(ctx, testUserID, "s1", codeAt(t, f.secret, 0)); err != nil {
+		t.Fatalf("success: %v", err)
+	}
+	if _, err := f.uc.StepUp(ctx, testUserID, "s1", badCode(t, f.secret)); !errors.Is(err, ErrInvalidCode) {
+		t.Fatalf("failure after reset: got %v, want ErrInvalidCode", err)
+	}
+}
@@ -0,0 +1,379 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/vo"
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/in"
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
+)
+
+var (
+	ErrProviderNotFound = errors.New("oidc provider not found")
+	ErrInvalidState     = errors.New("oidc state invalid, expired or already used")
+	ErrInvalidIDToken   = errors.New("oidc id_token invalid")
+	ErrAccountNotLinked = errors.New("no account linked to this identity")
+	ErrDomainNotAllowed = errors.New("email domain not allowed for this provider")
+)
+
+const (
+	// 用户名长度上限与 users.username 一致，预留冲突时追加的后缀
+	maxUsernameBase   = 24
+	provisionAttempts = 5
+)
+
+var usernameDisallowed = regexp.MustCompile(`[^a-zA-Z0-9_.-]+`)
+
+// Policy 提供方的账号绑定与开户策略
+type Policy struct {
+	// LinkByEmail / LinkByPhone 首次登录时按提供方已验证的邮箱 / 手机号绑定已有账号
+	LinkByEmail bool
+	LinkByPhone bool
+	// AutoProvision 找不到可绑定的账号时自动创建
+	AutoProvision bool
+	// AllowedDomains 非空时只接受这些域名下已验证邮箱的身份（企业 SSO）
+	AllowedDomains []string
+}
+
+// TokenIssuer 外部身份认证通过后为本地用户签发令牌
+type TokenIssuer interface {
+	LoginVerified(ctx context.Context, userID string, device entity.DeviceInfo) (*entity.AuthToken, error)
+}
+
+type provider struct {
+	out.OIDCProvider
+	policy Policy
+}
+
+// OIDCUseCase 授权码 + PKCE 登录：发起时保存 state/nonce/code_verifier，回调时校验并绑定或创建本地账号
+type OIDCUseCase struct {
+	providers    map[string]provider
+	order        []string
+	stateRepo    out.OIDCStateRepository
+	identityRepo out.UserIdentityRepository
+	userRepo     out.UserRepository
+	issuer       TokenIssuer
+	stateTTL     time.Duration
+}
+
+var _ in.OIDCUseCase = (*OIDCUseCase)(nil)
+
+func NewOIDCUseCase(
+	stateRepo out.OIDCStateRepository,
+	identityRepo out.UserIdentityRepository,
+	userRepo out.UserRepository,
+	issuer TokenIssuer,
+	stateTTL time.Duration,
+) *OIDCUseCase {
+	return &OIDCUseCase{
+		providers:    make(map[string]provider),
+		stateRepo:    stateRepo,
+		identityRepo: identityRepo,
+		userRepo:     userRepo,
+		issuer:       issuer,
+		stateTTL:     stateTTL,
+	}
+}
+
+// Register 注册提供方，同名覆盖
+func (uc *OIDCUseCase) Register(p out.OIDCProvider, policy Policy) {
+	if _, ok := uc.providers[p.Name()]; !ok {
+		uc.order = append(uc.order, p.Name())
+	}
+	uc.providers[p.Name()] = provider{OIDCProvider: p, policy: policy}
+}
+
+// Providers 按配置顺序返回可用的提供方
+func (uc *OIDCUseCase) Providers() []in.OIDCProviderInfo {
+	infos := make([]in.OIDCProviderInfo, 0, len(uc.order))
+	for _, name := range uc.order {
+		infos = append(infos, in.OIDCProviderInfo{Name: name, DisplayName: uc.providers[name].DisplayName()})
+	}
+	return infos
+}
+
+// Begin 生成 state、nonce、PKCE 参数与浏览器绑定值，返回提供方授权地址
+func (uc *OIDCUseCase) Begin(ctx context.Context, providerName string, device entity.DeviceInfo) (string, string, string, error) {
+	p, ok := uc.providers[providerName]
+	if !ok {
+		return "", "", "", ErrProviderNotFound
+	}
+	state, err := randomString(32)
+	if err != nil {
+		return "", "", "", err
+	}
+	nonce, err := randomString(32)
+	if err != nil {
+		return "", "", "", err
+	}
+	verifier, err := randomString(48)
+	if err != nil {
+		return "", "", "", err
+	}
+	binding, err := randomString(32)
+	if err != nil {
+		return "", "", "", err
+	}
+	authURL, err := p.AuthCodeURL(ctx, state, nonce, codeChallenge(verifier))
+	if err != nil {
+		return "", "", "", fmt.Errorf("build authorization url: %w", err)
+	}
+	if err := uc.stateRepo.Save(ctx, &entity.OIDCState{
+		State:        state,
+		Provider:     providerName,
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		BindingHash:  hashBinding(binding),
+		Device:       device,
+		ExpiresAt:    time.Now().Add(uc.stateTTL),
+	}); err != nil {
+		return "", "", "", fmt.Errorf("save oidc state: %w", err)
+	}
+	return authURL, state, binding, nil
+}
+
+// Complete 回调：校验 state 与浏览器绑定值，换取并校验 ID Token，解析出本地用户后签发令牌。
+// state 先被消费，绑定值不符时同样作废，被诱导打开他人回调地址的浏览器无法完成登录
+func (uc *OIDCUseCase) Complete(ctx context.Context, providerName, code, state, binding string) (*entity.AuthToken, error) {
+	p, ok := uc.providers[providerName]
+	if !ok {
+		return nil, ErrProviderNotFound
+	}
+	st, err := uc.stateRepo.Consume(ctx, state)
+	if err != nil {
+		return nil, fmt.Errorf("consume oidc state: %w", err)
+	}
+	if st == nil || st.Provider != providerName {
+		return nil, ErrInvalidState
+	}
+	if st.BindingHash == "" || subtle.ConstantTimeCompare([]byte(hashBinding(binding)), []byte(st.BindingHash)) != 1 {
+		return nil, ErrInvalidState
+	}
+
+	claims, err := p.Exchange(ctx, code, st.CodeVerifier)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+	if subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(st.Nonce)) != 1 {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+
+	userID, err := uc.resolveUser(ctx, providerName, p.policy, claims)
+	if err != nil {
+		return nil, err
+	}
+	return uc.issuer.LoginVerified(ctx, strconv.FormatUint(userID, 10), st.Device)
+}
+
+// resolveUser 已绑定的身份直接登录；否则按已验证的邮箱、手机号绑定已有账号，仍未找到时按策略开户
+func (uc *OIDCUseCase) resolveUser(ctx context.Context, providerName string, policy Policy, claims *entity.OIDCClaims) (uint64, error) {
+	ident, err := uc.identityRepo.Get(ctx, providerName, claims.Subject)
+	if err != nil {
+		return 0, fmt.Errorf("get identity: %w", err)
+	}
+	if ident != nil {
+		if err := uc.identityRepo.Touch(ctx, ident.ID); err != nil {
+			return 0, fmt.Errorf("touch identity: %w", err)
+		}
+		return ident.UserID, nil
+	}
+
+	email := ""
+	if claims.EmailVerified {
+		email = strings.TrimSpace(claims.Email)
+	}
+	phone := ""
+	if claims.PhoneVerified {
+		phone = normalizePhone(claims.Phone)
+	}
+	if len(policy.AllowedDomains) > 0 && !domainAllowed(email, policy.AllowedDomains) {
+		return 0, ErrDomainNotAllowed
+	}
+
+	var user *entity.User
+	if policy.LinkByEmail && email != "" {
+		if user, err = uc.userRepo.GetByEmail(ctx, email); err != nil {
+			return 0, fmt.Errorf("get user by email: %w", err)
+		}
+	}
+	if user == nil && policy.LinkByPhone && phone != "" {
+		if user, err = uc.userRepo.GetByPhone(ctx, phone); err != nil {
+			return 0, fmt.Errorf("get user by phone: %w", err)
+		}
+	}
+	if user == nil {
+		if !policy.AutoProvision {
+			return 0, ErrAccountNotLinked
+		}
+		if user, err = uc.provision(ctx, claims, email, phone); err != nil {
+			return 0, err
+		}
+	}
+
+	now := time.Now()
+	if err := uc.identityRepo.Create(ctx, &entity.UserIdentity{
+		Provider:    providerName,
+		Subject:     claims.Subject,
+		UserID:      user.ID,
+		Email:       email,
+		CreatedAt:   now,
+		LastLoginAt: now,
+	}); err != nil {
+		// 并发回调时以先写入的绑定为准
+		if existing, getErr := uc.identityRepo.Get(ctx, providerName, claims.Subject); getErr == nil && existing != nil {
+			return existing.UserID, nil
+		}
+		return 0, fmt.Errorf("create identity: %w", err)
+	}
+	return user.ID, nil
+}
+
+// provision 即时开户：不设可用密码，用户可通过找回密码设置；已被占用的邮箱、手机号不写入
+func (uc *OIDCUseCase) provision(ctx context.Context, claims *entity.OIDCClaims, email, phone string) (*entity.User, error) {
+	secret, err := randomString(32)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(secret), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("hash password: %w", err)
+	}
+
+	user := &entity.User{
+		PasswordHash: string(hash),
+		DisplayName:  displayName(claims),
+		Status:       entity.UserStatusNormal,
+	}
+	if email != "" {
+		taken, err := uc.userRepo.ExistsByEmail(ctx, email)
+		if err != nil {
+			return nil, fmt.Errorf("check email exists: %w", err)
+		}
+		if !taken {
+			user.Email = &email
+		}
+	}
+	if phone != "" {
+		taken, err := uc.userRepo.ExistsByPhone(ctx, phone)
+		if err != nil {
+			return nil, fmt.Errorf("check phone exists: %w", err)
+		}
+		if !taken {
+			user.Phone = &phone
+		}
+	}
+
+	base := usernameBase(claims)
+	for i := 0; i < provisionAttempts; i++ {
+		username := base
+		if i > 0 {
+			suffix, err := randomString(3)
+			if err != nil {
+				return nil, err
+			}
+			username = base + "_" + suffix
+		}
+		taken, err := uc.userRepo.ExistsByUsername(ctx, username)
+		if err != nil {
+			return nil, fmt.Errorf("check username exists: %w", err)
+		}
+		if taken {
+			continue
+		}
+		user.Username = username
+		if err := uc.userRepo.Create(ctx, user); err != nil {
+			return nil, fmt.Errorf("create user: %w", err)
+		}
+		return user, nil
+	}
+	return nil, fmt.Errorf("no available username for %q", base)
+}
+
+// usernameBase 取 preferred_username 或邮箱前缀，去掉不允许的字符
+func usernameBase(claims *entity.OIDCClaims) string {
+	candidate := claims.PreferredUsername
+	if at := strings.IndexByte(candidate, '@'); at >= 0 {
+		candidate = candidate[:at]
+	}
+	if candidate == "" {
+		if at := strings.IndexByte(claims.Email, '@'); at > 0 {
+			candidate = claims.Email[:at]
+		}
+	}
+	candidate = usernameDisallowed.ReplaceAllString(candidate, "")
+	if len(candidate) > maxUsernameBase {
+		candidate = candidate[:maxUsernameBase]
+	}
+	if candidate == "" {
+		candidate = "user"
+	}
+	return candidate
+}
+
+func displayName(claims *entity.OIDCClaims) string {
+	switch {
+	case claims.Name != "":
+		return claims.Name
+	case claims.PreferredUsername != "":
+		return claims.PreferredUsername
+	}
+	return usernameBase(claims)
+}
+
+// normalizePhone OIDC 手机号为 E.164 格式，本地账号存储不带 +86 的大陆手机号
+func normalizePhone(phone string) string {
+	phone = strings.NewReplacer(" ", "", "-", "").Replace(phone)
+	phone = strings.TrimPrefix(phone, "+86")
+	if _, err := vo.NewPhone(phone); err != nil {
+		return ""
+	}
+	return phone
+}
+
+func domainAllowed(email string, domains []string) bool {
+	at := strings.LastIndexByte(email, '@')
+	if at < 0 {
+		return false
+	}
+	domain := strings.ToLower(email[at+1:])
+	for _, d := range domains {
+		if strings.ToLower(d) == domain {
+			return true
+		}
+	}
+	return false
+}
+
+// codeChallenge PKCE S256
+func codeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func hashBinding(binding string) string {
+	sum := sha256.Sum256([]byte(binding))
+	return hex.EncodeToString(sum[:])
+}
+
+// randomString n 字节随机数的十六进制编码
+func randomString(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate random: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,373 @@
+package password
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/vo"
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/in"
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
+	authErr "github.com/EthanQC/IM/services/identity_service/pkg/errors"
+)
+
+var (
+	ErrUserNotFound       = errors.New("user not found")
+	ErrWrongPassword      = errors.New("current password is incorrect")
+	ErrSamePassword       = errors.New("new password must differ from the current one")
+	ErrUnsupportedChannel = errors.New("unsupported reset channel")
+	ErrInvalidTarget      = errors.New("invalid phone or email")
+	ErrInvalidResetToken  = errors.New("reset token invalid, expired or already used")
+)
+
+const (
+	ChannelSMS   = "sms"
+	ChannelEmail = "email"
+
+	// resendInterval 同一手机号或邮箱两次发送验证码的最小间隔
+	resendInterval = time.Minute
+)
+
+// Config 找回密码的有效期与限流阈值
+type Config struct {
+	CodeTTL     time.Duration
+	TokenTTL    time.Duration
+	MaxAttempts int
+	// LimitWindow 内每个账号最多 AccountLimit 次、每个 IP 最多 IPLimit 次请求
+	AccountLimit int
+	IPLimit      int
+	LimitWindow  time.Duration
+}
+
+// SessionRevoker 修改或重置密码后下线设备；currentSessionID 为空时下线全部设备
+type SessionRevoker interface {
+	RevokeOthers(ctx context.Context, userID uint64, currentSessionID string) (int, error)
+}
+
+// PasswordUseCase 修改密码与找回密码：验证码 → 一次性重置令牌 → 设置新密码
+type PasswordUseCase struct {
+	userRepo  out.UserRepository
+	codeRepo  out.AuthCodeRepository
+	tokenRepo out.PasswordResetRepository
+	limiter   out.RateLimiter
+	sessions  SessionRevoker
+	cfg       Config
+
+	sms   out.SMSClient
+	email out.EmailClient
+}
+
+var _ in.PasswordUseCase = (*PasswordUseCase)(nil)
+
+func NewPasswordUseCase(
+	userRepo out.UserRepository,
+	codeRepo out.AuthCodeRepository,
+	tokenRepo out.PasswordResetRepository,
+	limiter out.RateLimiter,
+	sessions SessionRevoker,
+	cfg Config,
+) *PasswordUseCase {
+	return &PasswordUseCase{
+		userRepo:  userRepo,
+		codeRepo:  codeRepo,
+		tokenRepo: tokenRepo,
+		limiter:   limiter,
+		sessions:  sessions,
+		cfg:       cfg,
+	}
+}
+
+// SetSMSClient 设置短信通道（可选），未设置时不支持短信找回
+func (uc *PasswordUseCase) SetSMSClient(client out.SMSClient) {
+	uc.sms = client
+}
+
+// SetEmailClient 设置邮件通道（可选），未设置时不支持邮箱找回
+func (uc *PasswordUseCase) SetEmailClient(client out.EmailClient) {
+	uc.email = client
+}
+
+// Change 校验旧密码后修改密码，并下线当前会话以外的全部设备，返回下线数量
+func (uc *PasswordUseCase) Change(ctx context.Context, userID uint64, currentSessionID, oldPassword, newPassword string) (int, error) {
+	if err := uc.allow(ctx, "pwd_change:"+strconv.FormatUint(userID, 10), uc.cfg.AccountLimit); err != nil {
+		return 0, err
+	}
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("get user: %w", err)
+	}
+	if user == nil {
+		return 0, ErrUserNotFound
+	}
+	current := &vo.Password{HashedValue: user.PasswordHash}
+	if !current.Matches(oldPassword) {
+		return 0, ErrWrongPassword
+	}
+	if oldPassword == newPassword {
+		return 0, ErrSamePassword
+	}
+	if err := uc.setPassword(ctx, user, newPassword); err != nil {
+		return 0, err
+	}
+	revoked, err := uc.sessions.RevokeOthers(ctx, userID, currentSessionID)
+	if err != nil {
+		return revoked, fmt.Errorf("revoke sessions: %w", err)
+	}
+	return revoked, nil
+}
+
+// RequestReset 向手机号或邮箱发送找回密码验证码，返回验证码有效期。
+// 账号不存在或不可用时同样返回成功；限流与重发间隔在查询账号之前按 target 判断，
+// 账号是否存在都得到相同的结果，避免被用来探测账号。
+func (uc *PasswordUseCase) RequestReset(ctx context.Context, channel, target, ip string) (time.Duration, error) {
+	target, err := normalizeTarget(channel, target)
+	if err != nil {
+		return 0, err
+	}
+	send, err := uc.sender(channel)
+	if err != nil {
+		return 0, err
+	}
+	if err := uc.allow(ctx, "pwd_reset_ip:"+ip, uc.cfg.IPLimit); err != nil {
+		return 0, err
+	}
+	if err := uc.allow(ctx, "pwd_reset:"+target, uc.cfg.AccountLimit); err != nil {
+		return 0, err
+	}
+	if err := uc.allowWithin(ctx, "pwd_reset_resend:"+target, 1, resendInterval); err != nil {
+		return 0, err
+	}
+
+	user, err := uc.lookup(ctx, channel, target)
+	if err != nil {
+		return 0, err
+	}
+	if user == nil || !user.IsActive() {
+		return uc.cfg.CodeTTL, nil
+	}
+
+	code, err := randomDigits(6)
+	if err != nil {
+		return 0, err
+	}
+	authCode := entity.NewAuthCode(target, ip)
+	authCode.Code = code
+	authCode.ExpireTime = time.Now().Add(uc.cfg.CodeTTL)
+	if err := uc.codeRepo.Save(ctx, authCode); err != nil {
+		return 0, fmt.Errorf("save code: %w", err)
+	}
+	if err := send(ctx, target, code); err != nil {
+		return 0, err
+	}
+	return uc.cfg.CodeTTL, nil
+}
+
+// VerifyReset 校验验证码，通过后签发一次性重置令牌。
+// 验证码不存在、过期、错误次数超限或账号不可用时一律返回 ErrCodeInvalid，避免被用来探测账号。
+func (uc *PasswordUseCase) VerifyReset(ctx context.Context, channel, target, code, ip string) (string, time.Duration, error) {
+	target, err := normalizeTarget(channel, target)
+	if err != nil {
+		return "", 0, err
+	}
+	if err := uc.allow(ctx, "pwd_reset_verify_ip:"+ip, uc.cfg.IPLimit); err != nil {
+		return "", 0, err
+	}
+	if err := uc.checkCode(ctx, target, code); err != nil {
+		return "", 0, err
+	}
+
+	user, err := uc.lookup(ctx, channel, target)
+	if err != nil {
+		return "", 0, err
+	}
+	if user == nil || !user.IsActive() {
+		return "", 0, authErr.ErrCodeInvalid
+	}
+
+	token, err := randomToken()
+	if err != nil {
+		return "", 0, err
+	}
+	if err := uc.tokenRepo.SaveToken(ctx, hashToken(token), user.ID, uc.cfg.TokenTTL); err != nil {
+		return "", 0, fmt.Errorf("save reset token: %w", err)
+	}
+	return token, uc.cfg.TokenTTL, nil
+}
+
+// Reset 使用重置令牌设置新密码，令牌随即作废，并下线全部设备
+func (uc *PasswordUseCase) Reset(ctx context.Context, resetToken, newPassword string) error {
+	// 先校验新密码格式，避免格式错误时白白消耗令牌
+	if _, err := newHashedPassword(newPassword); err != nil {
+		return err
+	}
+	userID, err := uc.tokenRepo.ConsumeToken(ctx, hashToken(resetToken))
+	if err != nil {
+		return fmt.Errorf("consume reset token: %w", err)
+	}
+	if userID == 0 {
+		return ErrInvalidResetToken
+	}
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("get user: %w", err)
+	}
+	if user == nil {
+		return ErrInvalidResetToken
+	}
+	if err := uc.setPassword(ctx, user, newPassword); err != nil {
+		return err
+	}
+	if _, err := uc.sessions.RevokeOthers(ctx, userID, ""); err != nil {
+		return fmt.Errorf("revoke sessions: %w", err)
+	}
+	return nil
+}
+
+// checkCode 校验验证码：存在→未过期→未超限→匹配→删除；各类失败统一返回 ErrCodeInvalid，
+// 不存在账号的 target 从未发过验证码，区分"不存在"与"错误"会暴露账号是否存在
+func (uc *PasswordUseCase) checkCode(ctx context.Context, target, code string) error {
+	stored, err := uc.codeRepo.Find(ctx, target)
+	if err != nil {
+		return fmt.Errorf("find code: %w", err)
+	}
+	if stored == nil {
+		return authErr.ErrCodeInvalid
+	}
+	if stored.IsExpired() {
+		_ = uc.codeRepo.Delete(ctx, target)
+		return authErr.ErrCodeInvalid
+	}
+	if stored.AttemptCnt >= uc.cfg.MaxAttempts {
+		return authErr.ErrCodeInvalid
+	}
+	if subtle.ConstantTimeCompare([]byte(stored.Code), []byte(code)) != 1 {
+		_ = uc.codeRepo.IncrementAttempts(ctx, target)
+		return authErr.ErrCodeInvalid
+	}
+	if err := uc.codeRepo.Delete(ctx, target); err != nil {
+		return fmt.Errorf("delete code: %w", err)
+	}
+	return nil
+}
+
+func (uc *PasswordUseCase) setPassword(ctx context.Context, user *entity.User, plaintext string) error {
+	hash, err := newHashedPassword(plaintext)
+	if err != nil {
+		return err
+	}
+	user.SetPassword(hash)
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return fmt.Errorf("update user: %w", err)
+	}
+	return nil
+}
+
+func (uc *PasswordUseCase) lookup(ctx context.Context, channel, target string) (*entity.User, error) {
+	var (
+		user *entity.User
+		err  error
+	)
+	if channel == ChannelSMS {
+		user, err = uc.userRepo.GetByPhone(ctx, target)
+	} else {
+		user, err = uc.userRepo.GetByEmail(ctx, target)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+	return user, nil
+}
+
+func (uc *PasswordUseCase) sender(channel string) (func(ctx context.Context, target, code string) error, error) {
+	switch {
+	case channel == ChannelSMS && uc.sms != nil:
+		return uc.sms.Send, nil
+	case channel == ChannelEmail && uc.email != nil:
+		return uc.email.Send, nil
+	}
+	return nil, ErrUnsupportedChannel
+}
+
+// allow limit<=0 表示不限流
+func (uc *PasswordUseCase) allow(ctx context.Context, key string, limit int) error {
+	return uc.allowWithin(ctx, key, limit, uc.cfg.LimitWindow)
+}
+
+func (uc *PasswordUseCase) allowWithin(ctx context.Context, key string, limit int, window time.Duration) error {
+	if uc.limiter == nil || limit <= 0 {
+		return nil
+	}
+	ok, err := uc.limiter.Allow(ctx, key, limit, window)
+	if err != nil {
+		return fmt.Errorf("rate limit: %w", err)
+	}
+	if !ok {
+		return authErr.ErrTooManyRequests
+	}
+	return nil
+}
+
+// normalizeTarget 校验手机号或邮箱格式，邮箱统一小写
+func normalizeTarget(channel, target string) (string, error) {
+	target = strings.TrimSpace(target)
+	switch channel {
+	case ChannelSMS:
+		phone, err := vo.NewPhone(target)
+		if err != nil {
+			return "", ErrInvalidTarget
+		}
+		return phone.Number, nil
+	case ChannelEmail:
+		if !strings.Contains(target, "@") || strings.ContainsAny(target, " \r\n") {
+			return "", ErrInvalidTarget
+		}
+		return strings.ToLower(target), nil
+	}
+	return "", ErrUnsupportedChannel
+}
+
+// newHashedPassword 按密码规则校验并生成 bcrypt 哈希
+func newHashedPassword(plaintext string) (string, error) {
+	pw, err := vo.NewPassword(plaintext)
+	if err != nil {
+		if errors.Is(err, authErr.ErrInvalidPassword) {
+			return "", fmt.Errorf("password must be 6-20 characters with letters and digits: %w", err)
+		}
+		return "", fmt.Errorf("hash password: %w", err)
+	}
+	return pw.HashedValue, nil
+}
+
+func randomDigits(n int) (string, error) {
+	max := big.NewInt(1)
+	for i := 0; i < n; i++ {
+		max.Mul(max, big.NewInt(10))
+	}
+	v, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", fmt.Errorf("generate code: %w", err)
+	}
+	return fmt.Sprintf("%0*d", n, v), nil
+}
+
+func randomToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate reset token: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
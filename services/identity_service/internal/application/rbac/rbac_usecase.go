@@ -0,0 +1,258 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/vo"
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/in"
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
+)
+
+var (
+	ErrRoleNotFound      = errors.New("role not found")
+	ErrRoleExists        = errors.New("role already exists")
+	ErrSystemRole        = errors.New("system role cannot be modified")
+	ErrInvalidRoleName   = errors.New("invalid role name")
+	ErrInvalidPermission = errors.New("invalid permission")
+	ErrUserNotFound      = errors.New("user not found")
+)
+
+// 角色名：小写字母开头，2-32 位小写字母、数字、下划线或连字符
+var roleNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{1,31}$`)
+
+// RBACUseCase 角色与用户授权管理
+// 授权变更不会影响已签发的访问令牌，用户在下次刷新令牌时获得新的权限
+type RBACUseCase struct {
+	roleRepo out.RoleRepository
+	userRepo out.UserRepository
+}
+
+var _ in.RBACUseCase = (*RBACUseCase)(nil)
+
+func NewRBACUseCase(roleRepo out.RoleRepository, userRepo out.UserRepository) *RBACUseCase {
+	return &RBACUseCase{roleRepo: roleRepo, userRepo: userRepo}
+}
+
+// EnsureSystemRoles 写入系统内置角色，启动时调用
+func (uc *RBACUseCase) EnsureSystemRoles(ctx context.Context) error {
+	now := time.Now()
+	for _, role := range entity.SystemRoles() {
+		role.CreatedAt = now
+		role.UpdatedAt = now
+		if err := uc.roleRepo.EnsureSystemRole(ctx, role); err != nil {
+			return fmt.Errorf("ensure role %s: %w", role.Name, err)
+		}
+	}
+	return nil
+}
+
+// BootstrapAdmins 为配置中的账号分配 admin 角色，用于初始化第一个管理员
+func (uc *RBACUseCase) BootstrapAdmins(ctx context.Context, userIDs []uint64) error {
+	for _, userID := range userIDs {
+		if err := uc.roleRepo.Assign(ctx, userID, entity.RoleAdmin, 0); err != nil {
+			return fmt.Errorf("assign admin to %d: %w", userID, err)
+		}
+	}
+	return nil
+}
+
+func (uc *RBACUseCase) ListRoles(ctx context.Context) ([]*entity.Role, error) {
+	roles, err := uc.roleRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list roles: %w", err)
+	}
+	return roles, nil
+}
+
+func (uc *RBACUseCase) CreateRole(ctx context.Context, name, description string, permissions []string) (*entity.Role, error) {
+	if !roleNamePattern.MatchString(name) {
+		return nil, ErrInvalidRoleName
+	}
+	perms, err := normalizePermissions(permissions)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := uc.roleRepo.Get(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("get role: %w", err)
+	}
+	if existing != nil {
+		return nil, ErrRoleExists
+	}
+
+	now := time.Now()
+	role := &entity.Role{
+		Name:        name,
+		Description: description,
+		Permissions: perms,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := uc.roleRepo.Create(ctx, role); err != nil {
+		return nil, fmt.Errorf("create role: %w", err)
+	}
+	return role, nil
+}
+
+func (uc *RBACUseCase) UpdateRole(ctx context.Context, name, description string, permissions []string) (*entity.Role, error) {
+	role, err := uc.getMutableRole(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	perms, err := normalizePermissions(permissions)
+	if err != nil {
+		return nil, err
+	}
+	role.Description = description
+	role.Permissions = perms
+	role.UpdatedAt = time.Now()
+	if err := uc.roleRepo.Update(ctx, role); err != nil {
+		return nil, fmt.Errorf("update role: %w", err)
+	}
+	return role, nil
+}
+
+func (uc *RBACUseCase) DeleteRole(ctx context.Context, name string) error {
+	if _, err := uc.getMutableRole(ctx, name); err != nil {
+		return err
+	}
+	if err := uc.roleRepo.Delete(ctx, name); err != nil {
+		return fmt.Errorf("delete role: %w", err)
+	}
+	return nil
+}
+
+// ListUserRoles 用户的全部角色，默认角色排在首位
+func (uc *RBACUseCase) ListUserRoles(ctx context.Context, userID uint64) ([]*entity.Role, error) {
+	assigned, err := uc.roleRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list user roles: %w", err)
+	}
+	defaultRole, err := uc.roleRepo.Get(ctx, entity.RoleUser)
+	if err != nil {
+		return nil, fmt.Errorf("get default role: %w", err)
+	}
+	if defaultRole == nil {
+		return assigned, nil
+	}
+	roles := []*entity.Role{defaultRole}
+	for _, r := range assigned {
+		if r.Name != entity.RoleUser {
+			roles = append(roles, r)
+		}
+	}
+	return roles, nil
+}
+
+func (uc *RBACUseCase) AssignRole(ctx context.Context, operatorID, userID uint64, roleName string) error {
+	if roleName == entity.RoleUser {
+		return nil
+	}
+	role, err := uc.roleRepo.Get(ctx, roleName)
+	if err != nil {
+		return fmt.Errorf("get role: %w", err)
+	}
+	if role == nil {
+		return ErrRoleNotFound
+	}
+	if uc.userRepo != nil {
+		user, err := uc.userRepo.GetByID(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("get user: %w", err)
+		}
+		if user == nil {
+			return ErrUserNotFound
+		}
+	}
+	if err := uc.roleRepo.Assign(ctx, userID, roleName, operatorID); err != nil {
+		return fmt.Errorf("assign role: %w", err)
+	}
+	return nil
+}
+
+func (uc *RBACUseCase) RevokeRole(ctx context.Context, userID uint64, roleName string) error {
+	if roleName == entity.RoleUser {
+		return ErrSystemRole
+	}
+	if err := uc.roleRepo.Unassign(ctx, userID, roleName); err != nil {
+		return fmt.Errorf("revoke role: %w", err)
+	}
+	return nil
+}
+
+// ResolveGrants 汇总默认角色与已分配角色的权限；非数字 userID（如短信登录的手机号）只有默认角色
+func (uc *RBACUseCase) ResolveGrants(ctx context.Context, userID string) ([]string, []string, error) {
+	var (
+		roles []*entity.Role
+		err   error
+	)
+	if id, perr := strconv.ParseUint(userID, 10, 64); perr == nil {
+		roles, err = uc.ListUserRoles(ctx, id)
+	} else {
+		var defaultRole *entity.Role
+		defaultRole, err = uc.roleRepo.Get(ctx, entity.RoleUser)
+		if defaultRole != nil {
+			roles = []*entity.Role{defaultRole}
+		}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	names := make([]string, 0, len(roles))
+	seen := make(map[string]struct{})
+	var perms []string
+	for _, r := range roles {
+		names = append(names, r.Name)
+		for _, p := range r.Permissions {
+			if _, ok := seen[p]; ok {
+				continue
+			}
+			seen[p] = struct{}{}
+			perms = append(perms, p)
+		}
+	}
+	sort.Strings(perms)
+	return names, perms, nil
+}
+
+func (uc *RBACUseCase) getMutableRole(ctx context.Context, name string) (*entity.Role, error) {
+	role, err := uc.roleRepo.Get(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("get role: %w", err)
+	}
+	if role == nil {
+		return nil, ErrRoleNotFound
+	}
+	if role.IsSystem {
+		return nil, ErrSystemRole
+	}
+	return role, nil
+}
+
+// normalizePermissions 校验并去重排序
+func normalizePermissions(permissions []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(permissions))
+	perms := make([]string, 0, len(permissions))
+	for _, s := range permissions {
+		p, ok := vo.ParsePermission(s)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidPermission, s)
+		}
+		key := p.String()
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		perms = append(perms, key)
+	}
+	sort.Strings(perms)
+	return perms, nil
+}
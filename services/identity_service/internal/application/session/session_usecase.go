@@ -0,0 +1,231 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/in"
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
+)
+
+var (
+	ErrSessionNotFound = errors.New("session not found")
+	ErrSessionRevoked  = errors.New("session revoked")
+)
+
+// SessionUseCase 设备会话管理：登录创建会话，刷新令牌续期，撤销会话时作废其全部令牌
+type SessionUseCase struct {
+	sessionRepo out.SessionRepository
+	refreshRepo out.RefreshTokenRepository
+	accessRepo  out.AccessTokenRepository
+	accessTTL   time.Duration
+	refreshTTL  time.Duration
+
+	publisher  out.EventPublisher
+	eventTopic string
+}
+
+var _ in.SessionUseCase = (*SessionUseCase)(nil)
+
+func NewSessionUseCase(
+	sessionRepo out.SessionRepository,
+	refreshRepo out.RefreshTokenRepository,
+	accessRepo out.AccessTokenRepository,
+	accessTTL, refreshTTL time.Duration,
+) *SessionUseCase {
+	return &SessionUseCase{
+		sessionRepo: sessionRepo,
+		refreshRepo: refreshRepo,
+		accessRepo:  accessRepo,
+		accessTTL:   accessTTL,
+		refreshTTL:  refreshTTL,
+	}
+}
+
+// SetEventPublisher 设置会话事件发布者（可选），投递服务据此断开连接、推送新设备提醒
+func (uc *SessionUseCase) SetEventPublisher(publisher out.EventPublisher, topic string) {
+	uc.publisher = publisher
+	uc.eventTopic = topic
+}
+
+// Start 登录时创建会话；用户已有会话记录而该设备从未登录过时发布新设备登录事件
+func (uc *SessionUseCase) Start(ctx context.Context, userID string, device entity.DeviceInfo) (*entity.Session, error) {
+	s := entity.NewSession(uuid.New().String(), userID, device, time.Now().Add(uc.refreshTTL))
+
+	known, err := uc.sessionRepo.ExistsByDevice(ctx, userID, s.DeviceID)
+	if err != nil {
+		return nil, fmt.Errorf("check device: %w", err)
+	}
+	newDevice := false
+	if !known {
+		newDevice, err = uc.sessionRepo.ExistsByUser(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("check sessions: %w", err)
+		}
+	}
+
+	if err := uc.sessionRepo.Create(ctx, s); err != nil {
+		return nil, fmt.Errorf("create session: %w", err)
+	}
+	if newDevice {
+		uc.publish(ctx, userID, out.AuthEvent{
+			Type:       out.AuthEventNewDeviceLogin,
+			UserID:     userID,
+			SessionID:  s.ID,
+			DeviceID:   s.DeviceID,
+			Platform:   s.Platform,
+			IP:         s.IP,
+			OccurredAt: time.Now().Unix(),
+		})
+	}
+	return s, nil
+}
+
+// Touch 刷新令牌时续期会话；sessionID 为空（会话上线前签发的令牌）时跳过
+func (uc *SessionUseCase) Touch(ctx context.Context, sessionID string, device entity.DeviceInfo) error {
+	if sessionID == "" {
+		return nil
+	}
+	s, err := uc.sessionRepo.Get(ctx, sessionID)
+	if err != nil {
+		return fmt.Errorf("get session: %w", err)
+	}
+	if s == nil {
+		return ErrSessionNotFound
+	}
+	if s.RevokedAt != nil {
+		return ErrSessionRevoked
+	}
+	s.Touch(device, time.Now().Add(uc.refreshTTL))
+	if err := uc.sessionRepo.Update(ctx, s); err != nil {
+		return fmt.Errorf("update session: %w", err)
+	}
+	return nil
+}
+
+func (uc *SessionUseCase) List(ctx context.Context, userID uint64) ([]*entity.Session, error) {
+	sessions, err := uc.sessionRepo.ListActive(ctx, strconv.FormatUint(userID, 10), time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("list sessions: %w", err)
+	}
+	return sessions, nil
+}
+
+// Revoke 撤销用户自己的一个会话
+func (uc *SessionUseCase) Revoke(ctx context.Context, userID uint64, sessionID string) error {
+	s, err := uc.sessionRepo.Get(ctx, sessionID)
+	if err != nil {
+		return fmt.Errorf("get session: %w", err)
+	}
+	if s == nil || s.UserID != strconv.FormatUint(userID, 10) {
+		return ErrSessionNotFound
+	}
+	if s.RevokedAt != nil {
+		return nil
+	}
+	return uc.end(ctx, s)
+}
+
+// RevokeOthers 撤销当前会话以外的全部会话，返回撤销数量
+func (uc *SessionUseCase) RevokeOthers(ctx context.Context, userID uint64, currentSessionID string) (int, error) {
+	sessions, err := uc.List(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	revoked := 0
+	for _, s := range sessions {
+		if s.ID == currentSessionID {
+			continue
+		}
+		if err := uc.end(ctx, s); err != nil {
+			return revoked, err
+		}
+		revoked++
+	}
+	return revoked, nil
+}
+
+// EndByToken 登出时结束令牌所属的会话
+func (uc *SessionUseCase) EndByToken(ctx context.Context, jti string) error {
+	rec, err := uc.refreshRepo.Find(ctx, jti)
+	if err != nil {
+		return fmt.Errorf("find token: %w", err)
+	}
+	if rec == nil || rec.SessionID == "" {
+		return nil
+	}
+	s, err := uc.sessionRepo.Get(ctx, rec.SessionID)
+	if err != nil {
+		return fmt.Errorf("get session: %w", err)
+	}
+	if s == nil || s.RevokedAt != nil {
+		return nil
+	}
+	return uc.end(ctx, s)
+}
+
+// end 标记会话撤销，作废其 RefreshToken 并拉黑仍有效的 AccessToken
+// 已被轮换的刷新记录对应的 AccessToken 在 accessTTL 内同样有效，按签发时间而非撤销状态筛选
+func (uc *SessionUseCase) end(ctx context.Context, s *entity.Session) error {
+	s.Revoke()
+	if err := uc.sessionRepo.Update(ctx, s); err != nil {
+		return fmt.Errorf("revoke session: %w", err)
+	}
+
+	now := time.Now()
+	tokens, err := uc.refreshRepo.ListBySessionSince(ctx, s.ID, now.Add(-uc.accessTTL))
+	if err != nil {
+		return fmt.Errorf("list session tokens: %w", err)
+	}
+	if err := uc.refreshRepo.RevokeBySession(ctx, s.ID); err != nil {
+		return fmt.Errorf("revoke session tokens: %w", err)
+	}
+	for _, t := range tokens {
+		// AccessToken 与 RefreshToken 记录共用 jti
+		expiresAt := t.CreatedAt.Add(uc.accessTTL)
+		if !expiresAt.After(now) {
+			continue
+		}
+		if err := uc.accessRepo.Revoke(ctx, t.ID); err != nil {
+			return fmt.Errorf("revoke access token: %w", err)
+		}
+		uc.publish(ctx, t.ID, out.AuthEvent{
+			Type:       out.AuthEventTokenRevoked,
+			JTI:        t.ID,
+			UserID:     s.UserID,
+			ExpiresAt:  expiresAt.Unix(),
+			OccurredAt: now.Unix(),
+		})
+	}
+
+	uc.publish(ctx, s.UserID, out.AuthEvent{
+		Type:       out.AuthEventSessionRevoked,
+		UserID:     s.UserID,
+		SessionID:  s.ID,
+		DeviceID:   s.DeviceID,
+		OccurredAt: now.Unix(),
+	})
+	return nil
+}
+
+// publish 发布失败只记日志，不影响会话操作
+func (uc *SessionUseCase) publish(ctx context.Context, key string, event out.AuthEvent) {
+	if uc.publisher == nil || uc.eventTopic == "" {
+		return
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+	if err := uc.publisher.Publish(ctx, uc.eventTopic, key, data); err != nil {
+		zap.L().Warn("publish session event failed", zap.String("type", event.Type), zap.Error(err))
+	}
+}
@@ -0,0 +1,155 @@
+package signingkey
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/in"
+	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
+	"github.com/EthanQC/IM/services/identity_service/pkg/jwt"
+)
+
+// Config 密钥轮换参数
+type Config struct {
+	Algorithm        string        // 新密钥算法：RS256 / EdDSA
+	RotationInterval time.Duration // 最新密钥超过该时长即生成下一把
+	ActivationDelay  time.Duration // 新密钥发布后延迟启用，应大于验签方 JWKS 缓存时长
+	RetireGrace      time.Duration // 退役密钥保留验签的时长，应不小于 RefreshToken 有效期
+}
+
+// KeyRotationUseCase 从存储同步签名密钥到 KeyRing，并按计划生成、启用、退役密钥
+// 多实例并发执行时各自以存储为准收敛：最新且已激活的密钥签发，更早的活跃密钥全部退役
+// 私钥经 cipher 加密后入库，早期的明文记录在同步时改写为密文
+type KeyRotationUseCase struct {
+	repo   out.SigningKeyRepository
+	cipher out.PrivateKeyCipher
+	ring   *jwt.KeyRing
+	cfg    Config
+}
+
+var _ in.SigningKeyUseCase = (*KeyRotationUseCase)(nil)
+
+func NewKeyRotationUseCase(repo out.SigningKeyRepository, cipher out.PrivateKeyCipher, ring *jwt.KeyRing, cfg Config) *KeyRotationUseCase {
+	return &KeyRotationUseCase{repo: repo, cipher: cipher, ring: ring, cfg: cfg}
+}
+
+// Sync 执行一次轮换检查并刷新 KeyRing
+func (uc *KeyRotationUseCase) Sync(ctx context.Context) error {
+	now := time.Now()
+	keys, err := uc.repo.ListUsable(ctx, now)
+	if err != nil {
+		return fmt.Errorf("list signing keys: %w", err)
+	}
+
+	// keys 按创建时间倒序
+	var active []*entity.SigningKey
+	for _, k := range keys {
+		if k.IsActive() {
+			active = append(active, k)
+		}
+	}
+	if len(active) == 0 || now.Sub(active[0].CreatedAt) >= uc.cfg.RotationInterval {
+		next, err := uc.createKey(ctx, now)
+		if err != nil {
+			return err
+		}
+		keys = append([]*entity.SigningKey{next}, keys...)
+		active = append([]*entity.SigningKey{next}, active...)
+	}
+
+	// 最新的已激活密钥用于签发；都未激活（首次启动）时沿用最早的一把
+	current := active[len(active)-1]
+	for _, k := range active {
+		if k.IsActivated(now, uc.cfg.ActivationDelay) {
+			current = k
+			break
+		}
+	}
+	for _, k := range active {
+		if k == current || !k.CreatedAt.Before(current.CreatedAt) {
+			continue
+		}
+		k.Retire(now, uc.cfg.RetireGrace)
+		if err := uc.repo.Retire(ctx, k); err != nil {
+			return fmt.Errorf("retire signing key %s: %w", k.KID, err)
+		}
+		zap.L().Info("signing key retired", zap.String("kid", k.KID))
+	}
+	if err := uc.repo.DeleteExpired(ctx, now); err != nil {
+		zap.L().Warn("delete expired signing keys failed", zap.Error(err))
+	}
+
+	parsed := make([]*jwt.Key, 0, len(keys))
+	var currentKey *jwt.Key
+	for _, k := range keys {
+		privatePEM, err := uc.privateKeyPEM(ctx, k)
+		if err != nil {
+			zap.L().Warn("skip undecryptable signing key", zap.String("kid", k.KID), zap.Error(err))
+			continue
+		}
+		key, err := jwt.ParseKey(k.KID, k.Algorithm, privatePEM)
+		if err != nil {
+			zap.L().Warn("skip invalid signing key", zap.String("kid", k.KID), zap.Error(err))
+			continue
+		}
+		if k == current {
+			currentKey = key
+		}
+		parsed = append(parsed, key)
+	}
+	if currentKey == nil {
+		return fmt.Errorf("signing key %s is invalid", current.KID)
+	}
+	uc.ring.Replace(currentKey, parsed)
+	return nil
+}
+
+// JWKS 导出可验签公钥
+func (uc *KeyRotationUseCase) JWKS() jwt.JWKS {
+	return uc.ring.JWKS()
+}
+
+func (uc *KeyRotationUseCase) createKey(ctx context.Context, now time.Time) (*entity.SigningKey, error) {
+	kid, privatePEM, err := jwt.GenerateKey(uc.cfg.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := uc.cipher.Encrypt(ctx, kid, privatePEM)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt signing key: %w", err)
+	}
+	key := &entity.SigningKey{
+		KID:        kid,
+		Algorithm:  uc.cfg.Algorithm,
+		PrivateKey: encrypted,
+		CreatedAt:  now,
+	}
+	if err := uc.repo.Create(ctx, key); err != nil {
+		return nil, fmt.Errorf("create signing key: %w", err)
+	}
+	zap.L().Info("signing key created", zap.String("kid", kid), zap.String("alg", uc.cfg.Algorithm))
+	return key, nil
+}
+
+// privateKeyPEM 解密存储的私钥；明文记录加密后写回，写回失败不影响本次使用
+func (uc *KeyRotationUseCase) privateKeyPEM(ctx context.Context, k *entity.SigningKey) (string, error) {
+	if uc.cipher.IsEncrypted(k.PrivateKey) {
+		return uc.cipher.Decrypt(ctx, k.KID, k.PrivateKey)
+	}
+	encrypted, err := uc.cipher.Encrypt(ctx, k.KID, k.PrivateKey)
+	if err != nil {
+		return "", fmt.Errorf("encrypt plaintext signing key: %w", err)
+	}
+	if err := uc.repo.UpdatePrivateKey(ctx, k.KID, encrypted); err != nil {
+		zap.L().Warn("encrypt plaintext signing key failed", zap.String("kid", k.KID), zap.Error(err))
+	} else {
+		zap.L().Info("plaintext signing key encrypted", zap.String("kid", k.KID))
+	}
+	plaintext := k.PrivateKey
+	k.PrivateKey = encrypted
+	return plaintext, nil
+}
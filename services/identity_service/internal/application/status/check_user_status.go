@@ -2,19 +2,37 @@ package status
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"time"
 
+	"go.uber.org/zap"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
 	"github.com/EthanQC/IM/services/identity_service/internal/ports/out"
+	authErr "github.com/EthanQC/IM/services/identity_service/pkg/errors"
 )
 
+// permanentBlock 长期封禁的时长
+const permanentBlock = 100 * 365 * 24 * time.Hour
+
 type CheckUserStatusUseCase struct {
 	StatusRepo out.UserStatusRepository
+
+	publisher  out.EventPublisher
+	eventTopic string
 }
 
 func NewCheckUserStatusUseCase(statusRepo out.UserStatusRepository) *CheckUserStatusUseCase {
 	return &CheckUserStatusUseCase{StatusRepo: statusRepo}
 }
 
+// SetEventPublisher 设置状态变更事件发布者（可选）
+func (uc *CheckUserStatusUseCase) SetEventPublisher(publisher out.EventPublisher, topic string) {
+	uc.publisher = publisher
+	uc.eventTopic = topic
+}
+
 // Execute 检查用户封禁状态；若过期自动解封
 func (uc *CheckUserStatusUseCase) Execute(ctx context.Context, userID string) error {
 	us, err := uc.StatusRepo.Get(ctx, userID)
@@ -23,14 +41,78 @@ func (uc *CheckUserStatusUseCase) Execute(ctx context.Context, userID string) er
 	}
 	if us != nil {
 		if !us.IsActive() {
-			return fmt.Errorf("user %s is blocked: %s", userID, us.BlockReason)
+			return fmt.Errorf("user %s: %w: %s", userID, authErr.ErrUserBlocked, us.BlockReason)
 		}
 		if us.IsBlocked {
 			us.Unblock()
 			if err := uc.StatusRepo.Save(ctx, us); err != nil {
 				return fmt.Errorf("unblock user: %w", err)
 			}
+			uc.publishStatusChanged(ctx, userID)
 		}
 	}
 	return nil
 }
+
+// Block 封禁用户，duration<=0 表示长期封禁；网关收到状态变更事件后立即拒绝其令牌
+func (uc *CheckUserStatusUseCase) Block(ctx context.Context, userID, reason string, duration time.Duration) error {
+	if duration <= 0 {
+		duration = permanentBlock
+	}
+	us := entity.NewUserBlockStatus(userID)
+	us.Block(reason, duration)
+	if err := uc.StatusRepo.Save(ctx, us); err != nil {
+		return fmt.Errorf("block user: %w", err)
+	}
+	uc.publishStatusChanged(ctx, userID)
+	return nil
+}
+
+// LockTemporarily 登录失败过多时临时封禁；已有到期更晚的封禁（如管理员封禁）时不改写，返回是否写入
+func (uc *CheckUserStatusUseCase) LockTemporarily(ctx context.Context, userID, reason string, duration time.Duration) (bool, error) {
+	us := entity.NewUserBlockStatus(userID)
+	us.Block(reason, duration)
+	written, err := uc.StatusRepo.BlockIfLonger(ctx, us)
+	if err != nil {
+		return false, fmt.Errorf("lock user: %w", err)
+	}
+	if written {
+		uc.publishStatusChanged(ctx, userID)
+	}
+	return written, nil
+}
+
+// Unblock 解除封禁
+func (uc *CheckUserStatusUseCase) Unblock(ctx context.Context, userID string) error {
+	us, err := uc.StatusRepo.Get(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("get user status: %w", err)
+	}
+	if us == nil || !us.IsBlocked {
+		return nil
+	}
+	us.Unblock()
+	if err := uc.StatusRepo.Save(ctx, us); err != nil {
+		return fmt.Errorf("unblock user: %w", err)
+	}
+	uc.publishStatusChanged(ctx, userID)
+	return nil
+}
+
+// publishStatusChanged 通知网关丢弃该用户的状态缓存
+func (uc *CheckUserStatusUseCase) publishStatusChanged(ctx context.Context, userID string) {
+	if uc.publisher == nil || uc.eventTopic == "" {
+		return
+	}
+	data, err := json.Marshal(out.AuthEvent{
+		Type:       out.AuthEventUserStatusChanged,
+		UserID:     userID,
+		OccurredAt: time.Now().Unix(),
+	})
+	if err != nil {
+		return
+	}
+	if err := uc.publisher.Publish(ctx, uc.eventTopic, userID, data); err != nil {
+		zap.L().Warn("publish auth event failed", zap.String("user_id", userID), zap.Error(err))
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -23,6 +24,14 @@ var (
 	ErrInvalidCredentials = errors.New("invalid credentials")
 	ErrUserNotFound       = errors.New("user not found")
 	ErrUserInactive       = errors.New("user is inactive")
+	ErrTooManyUsers       = errors.New("too many users in one request")
+)
+
+const (
+	// maxBatchProfiles 批量获取用户资料的单次上限
+	maxBatchProfiles = 500
+	// maxMatchUsers 按名称匹配用户的返回上限
+	maxMatchUsers = 500
 )
 
 type UserUseCaseImpl struct {
@@ -45,6 +54,11 @@ func NewUserUseCaseImpl(
 	}
 }
 
+// SetEventPublisher 设置用户事件发布器，注册和资料变更事件发布到 user-events
+func (uc *UserUseCaseImpl) SetEventPublisher(eventPub out.EventPublisher) {
+	uc.eventPub = eventPub
+}
+
 func (uc *UserUseCaseImpl) Register(ctx context.Context, username, password, displayName string, phone, email *string) (*entity.User, error) {
 	// 检查用户名是否已存在
 	exists, err := uc.userRepo.ExistsByUsername(ctx, username)
@@ -100,10 +114,11 @@ func (uc *UserUseCaseImpl) Register(ctx context.Context, username, password, dis
 	// 发布用户注册事件
 	if uc.eventPub != nil {
 		event := map[string]interface{}{
-			"type":      "user.registered",
-			"user_id":   user.ID,
-			"username":  user.Username,
-			"timestamp": user.CreatedAt,
+			"type":         "user.registered",
+			"user_id":      user.ID,
+			"username":     user.Username,
+			"display_name": user.DisplayName,
+			"timestamp":    user.CreatedAt,
 		}
 		data, _ := json.Marshal(event)
 		_ = uc.eventPub.Publish(ctx, "user-events", fmt.Sprintf("%d", user.ID), data)
@@ -194,3 +209,37 @@ func (uc *UserUseCaseImpl) UpdateProfile(ctx context.Context, userID uint64, dis
 
 	return user, nil
 }
+
+// BatchGetProfiles 批量获取用户资料，单次最多 maxBatchProfiles 个
+func (uc *UserUseCaseImpl) BatchGetProfiles(ctx context.Context, userIDs []uint64) ([]*entity.User, error) {
+	if len(userIDs) > maxBatchProfiles {
+		return nil, ErrTooManyUsers
+	}
+	users, err := uc.userRepo.ListByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("list users: %w", err)
+	}
+	return users, nil
+}
+
+// MatchUsersByName 按展示名或用户名关键字匹配用户ID
+// 指定候选用户时在候选集合内完整匹配，不受 maxMatchUsers 截断
+func (uc *UserUseCaseImpl) MatchUsersByName(ctx context.Context, keyword string, candidateIDs []uint64, limit int) ([]uint64, error) {
+	keyword = strings.TrimSpace(keyword)
+	if keyword == "" {
+		return nil, nil
+	}
+	if len(candidateIDs) > maxBatchProfiles {
+		return nil, ErrTooManyUsers
+	}
+	if len(candidateIDs) > 0 {
+		limit = len(candidateIDs)
+	} else if limit <= 0 || limit > maxMatchUsers {
+		limit = maxMatchUsers
+	}
+	ids, err := uc.userRepo.SearchIDsByName(ctx, keyword, candidateIDs, limit)
+	if err != nil {
+		return nil, fmt.Errorf("search users: %w", err)
+	}
+	return ids, nil
+}
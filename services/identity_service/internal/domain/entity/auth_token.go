@@ -10,6 +10,9 @@ import (
 type AuthToken struct {
 	ID               string    // Token 唯一标识
 	UserID           string    // 关联的用户 ID
+	SessionID        string    // 所属设备会话
+	FamilyID         string    // 令牌族：同一次登录经轮换产生的 RefreshToken 共享，取首个令牌的 ID
+	ReplacedBy       string    // 轮换后替代它的令牌 ID，非空表示已被轮换
 	AccessToken      string    // 访问令牌
 	RefreshToken     string    // 刷新令牌
 	ExpiresAt        time.Time // 过期时间
@@ -55,6 +58,19 @@ func (at *AuthToken) Refresh() error {
 	return nil
 }
 
+// Family 所属令牌族，旧记录没有族 ID 时自成一族
+func (at *AuthToken) Family() string {
+	if at.FamilyID != "" {
+		return at.FamilyID
+	}
+	return at.ID
+}
+
+// IsRotated 是否已被轮换；已轮换的令牌再次出现说明可能被盗用
+func (at *AuthToken) IsRotated() bool {
+	return at.ReplacedBy != ""
+}
+
 func (at *AuthToken) UpdateRoles(roles []vo.Role) {
 	at.Roles = roles
 }
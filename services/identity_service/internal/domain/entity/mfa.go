@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+// UserMFA 账号的 TOTP 二次验证配置；Enabled=false 表示已发起绑定但尚未确认
+type UserMFA struct {
+	UserID        uint64
+	Secret        string
+	Enabled       bool
+	RecoveryCodes []string // 剩余恢复码的 SHA-256 摘要，使用时由仓储按摘要删除
+	LastUsedStep  int64    // 最近一次通过校验的 TOTP 步长，防止验证码重放
+	CreatedAt     time.Time
+	EnabledAt     *time.Time
+	UpdatedAt     time.Time
+}
+
+// HashRecoveryCode 恢复码摘要：忽略大小写与分隔符
+func HashRecoveryCode(code string) string {
+	normalized := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+// MFAChallenge 密码校验通过、等待二次验证的登录
+type MFAChallenge struct {
+	Token     string     `json:"-"`
+	UserID    string     `json:"user_id"`
+	Device    DeviceInfo `json:"device"`
+	Attempts  int        `json:"attempts"`
+	ExpiresAt time.Time  `json:"expires_at"`
+}
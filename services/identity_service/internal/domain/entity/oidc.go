@@ -0,0 +1,38 @@
+package entity
+
+import "time"
+
+// UserIdentity 外部身份（OIDC 提供方 + sub）与本地用户的绑定
+type UserIdentity struct {
+	ID          uint64
+	Provider    string
+	Subject     string
+	UserID      uint64
+	Email       string // 绑定时提供方给出的邮箱，仅用于展示与排查
+	CreatedAt   time.Time
+	LastLoginAt time.Time
+}
+
+// OIDCState 授权码流程发起时保存的一次性状态，回调时按 state 取回
+type OIDCState struct {
+	State        string `json:"-"`
+	Provider     string
+	Nonce        string
+	CodeVerifier string // PKCE
+	// BindingHash 浏览器绑定值的 SHA-256 摘要，回调时比对，防止把 state 交给其他浏览器完成登录
+	BindingHash string
+	Device      DeviceInfo
+	ExpiresAt   time.Time
+}
+
+// OIDCClaims 校验通过的 ID Token 中用于账号绑定与开户的声明
+type OIDCClaims struct {
+	Subject           string
+	Nonce             string
+	Email             string
+	EmailVerified     bool
+	Phone             string
+	PhoneVerified     bool
+	Name              string
+	PreferredUsername string
+}
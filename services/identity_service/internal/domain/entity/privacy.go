@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+// UserPrivacy 用户的可发现性设置与名片码；未保存过设置的用户使用 DefaultUserPrivacy
+type UserPrivacy struct {
+	UserID uint64
+	// AllowFindBy* 是否允许他人按用户名 / 手机号 / 邮箱精确搜索到自己
+	AllowFindByUsername bool
+	AllowFindByPhone    bool
+	AllowFindByEmail    bool
+	// ShareCode 可分享的名片码（用于二维码），重置后旧码失效；为空表示尚未生成
+	ShareCode string
+	UpdatedAt time.Time
+}
+
+// DefaultUserPrivacy 默认允许按用户名与手机号搜索，邮箱不可搜索
+func DefaultUserPrivacy(userID uint64) *UserPrivacy {
+	return &UserPrivacy{
+		UserID:              userID,
+		AllowFindByUsername: true,
+		AllowFindByPhone:    true,
+		AllowFindByEmail:    false,
+	}
+}
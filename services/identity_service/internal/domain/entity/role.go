@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/vo"
+)
+
+// 系统角色：admin 拥有全部权限用于运营管理；user 为所有账号的默认角色，无需分配
+const (
+	RoleAdmin = "admin"
+	RoleUser  = "user"
+)
+
+// Role 持久化的角色定义
+type Role struct {
+	Name        string
+	Description string
+	Permissions []string // 形如 "Group:Manage"
+	IsSystem    bool     // 系统角色不可修改或删除
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// SystemRoles 系统内置角色
+func SystemRoles() []*Role {
+	return []*Role{
+		{
+			Name:        RoleAdmin,
+			Description: "系统管理员",
+			Permissions: vo.AllPermissions(),
+			IsSystem:    true,
+		},
+		{
+			Name:        RoleUser,
+			Description: "普通用户",
+			Permissions: []string{
+				vo.ResourceUser + ":" + vo.ActionRead,
+				vo.ResourceUser + ":" + vo.ActionWrite,
+				vo.ResourceGroup + ":" + vo.ActionRead,
+				vo.ResourceGroup + ":" + vo.ActionWrite,
+				vo.ResourceMessage + ":" + vo.ActionRead,
+				vo.ResourceMessage + ":" + vo.ActionSend,
+			},
+			IsSystem: true,
+		},
+	}
+}
+
+// ToVO 转换为令牌使用的角色值对象
+func (r *Role) ToVO() vo.Role {
+	return *vo.NewRole(r.Name, r.Permissions)
+}
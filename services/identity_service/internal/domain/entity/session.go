@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// DeviceInfo 登录设备信息，IP / UserAgent 由网关填写
+type DeviceInfo struct {
+	DeviceID  string
+	Platform  string
+	IP        string
+	UserAgent string
+}
+
+// Fingerprint 设备标识；客户端未上报 device_id 时按平台 + UA 生成，用于识别新设备
+func (d DeviceInfo) Fingerprint() string {
+	if d.DeviceID != "" {
+		return d.DeviceID
+	}
+	sum := sha256.Sum256([]byte(d.Platform + "|" + d.UserAgent))
+	return "ua-" + hex.EncodeToString(sum[:8])
+}
+
+// Session 一次登录产生的设备会话，同一会话内刷新令牌共享 SessionID
+type Session struct {
+	ID         string
+	UserID     string
+	DeviceID   string
+	Platform   string
+	IP         string
+	UserAgent  string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	ExpiresAt  time.Time  // 最近一次签发的 RefreshToken 过期时间
+	RevokedAt  *time.Time // 非空表示已撤销
+}
+
+func NewSession(id, userID string, device DeviceInfo, expiresAt time.Time) *Session {
+	now := time.Now()
+	return &Session{
+		ID:         id,
+		UserID:     userID,
+		DeviceID:   device.Fingerprint(),
+		Platform:   device.Platform,
+		IP:         device.IP,
+		UserAgent:  device.UserAgent,
+		CreatedAt:  now,
+		LastSeenAt: now,
+		ExpiresAt:  expiresAt,
+	}
+}
+
+// IsActive 未撤销且 RefreshToken 未过期
+func (s *Session) IsActive(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
+
+// Touch 刷新令牌时更新最近活跃时间与网络信息
+func (s *Session) Touch(device DeviceInfo, expiresAt time.Time) {
+	s.LastSeenAt = time.Now()
+	s.ExpiresAt = expiresAt
+	if device.IP != "" {
+		s.IP = device.IP
+	}
+	if device.UserAgent != "" {
+		s.UserAgent = device.UserAgent
+	}
+}
+
+func (s *Session) Revoke() {
+	now := time.Now()
+	s.RevokedAt = &now
+}
@@ -0,0 +1,31 @@
+package entity
+
+import "time"
+
+// SigningKey 令牌签名密钥
+// 新密钥先发布到 JWKS，经过激活延迟后才用于签发；退役后仅用于验签，过期后删除
+type SigningKey struct {
+	KID        string
+	Algorithm  string
+	PrivateKey string // 存储形式：KEK 加密后的私钥，早期记录为明文 PEM
+	CreatedAt  time.Time
+	RetiredAt  *time.Time // 退役时间，nil 表示仍可签发
+	ExpiresAt  *time.Time // 退役密钥的删除时间
+}
+
+// IsActive 是否仍可用于签发
+func (k *SigningKey) IsActive() bool {
+	return k.RetiredAt == nil
+}
+
+// IsActivated 发布满 delay 后才可用于签发，给各验签方刷新 JWKS 的时间
+func (k *SigningKey) IsActivated(now time.Time, delay time.Duration) bool {
+	return !now.Before(k.CreatedAt.Add(delay))
+}
+
+// Retire 退役密钥，grace 内仍可验签
+func (k *SigningKey) Retire(now time.Time, grace time.Duration) {
+	expiresAt := now.Add(grace)
+	k.RetiredAt = &now
+	k.ExpiresAt = &expiresAt
+}
@@ -49,6 +49,12 @@ func (u *User) Update(displayName string, avatarURL *string) {
 	u.UpdatedAt = time.Now()
 }
 
+// SetPassword 更新密码哈希
+func (u *User) SetPassword(hash string) {
+	u.PasswordHash = hash
+	u.UpdatedAt = time.Now()
+}
+
 // Disable 禁用用户
 func (u *User) Disable() {
 	u.Status = UserStatusDisabled
@@ -2,34 +2,11 @@ package service
 
 import (
 	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
-	"github.com/EthanQC/IM/services/identity_service/internal/domain/vo"
 	"github.com/EthanQC/IM/services/identity_service/pkg/errors"
 )
 
 type AuthDomainService struct{}
 
-// 验证访问权限
-func (s *AuthDomainService) ValidateAccess(rule *vo.AccessRule, token *entity.AuthToken) error {
-	// 检查是否公开访问
-	if rule.IsPublic {
-		return nil
-	}
-
-	// 检查令牌状态
-	if token.IsExpired() || token.IsRevoked {
-		return errors.ErrInvalidToken
-	}
-
-	// 检查用户角色权限
-	for _, role := range token.Roles {
-		if role.HasPermission(rule.Path) {
-			return nil
-		}
-	}
-
-	return errors.ErrPermissionDenied
-}
-
 // ValidateToken 验证令牌状态
 func (s *AuthDomainService) ValidateToken(token *entity.AuthToken, userStatus *entity.UserBlockStatus) error {
 	// 1. 检查令牌是否过期
@@ -1,5 +1,7 @@
 package vo
 
+import "strings"
+
 type Permission struct {
 	Resource string // 资源类型，User/Group/Message
 	Action   string // 操作类型，Read/Write/Manage/Send
@@ -53,3 +55,34 @@ func (p *Permission) IsValid() bool {
 
 	return resourceValid && actionValid
 }
+
+// String 权限的字符串形式 "<Resource>:<Action>"，写入令牌与角色表
+func (p *Permission) String() string {
+	return p.Resource + ":" + p.Action
+}
+
+// ParsePermission 解析 "<Resource>:<Action>"，格式或取值非法时返回 false
+func ParsePermission(s string) (*Permission, bool) {
+	resource, action, ok := strings.Cut(s, ":")
+	if !ok {
+		return nil, false
+	}
+	p := NewPermission(resource, action)
+	if !p.IsValid() {
+		return nil, false
+	}
+	return p, true
+}
+
+// AllPermissions 全部资源与操作的组合，系统 admin 角色拥有全部权限
+func AllPermissions() []string {
+	resources := []string{ResourceUser, ResourceGroup, ResourceMessage}
+	actions := []string{ActionRead, ActionWrite, ActionManage, ActionSend}
+	perms := make([]string, 0, len(resources)*len(actions))
+	for _, r := range resources {
+		for _, a := range actions {
+			perms = append(perms, r+":"+a)
+		}
+	}
+	return perms
+}
@@ -2,6 +2,7 @@ package in
 
 import (
 	"context"
+	"time"
 
 	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
 	"github.com/EthanQC/IM/services/identity_service/internal/domain/vo"
@@ -9,10 +10,22 @@ import (
 
 type AuthUseCase interface {
 	// 刷新 token
-	RefreshToken(ctx context.Context, refreshJTI string) (*entity.AuthToken, error)
+	RefreshToken(ctx context.Context, refreshJTI string, device entity.DeviceInfo) (*entity.AuthToken, error)
 
-	// 登录相关
-	LoginByPassword(ctx context.Context, identifier string, password string) (*entity.AuthToken, error)
-	LoginBySMS(ctx context.Context, phone vo.Phone, code string) (*entity.AuthToken, error)
-	Logout(ctx context.Context, accessJTI string) error
+	// 登录相关，每次登录创建一个设备会话
+	// captchaToken 为客户端完成人机验证后的凭证，连续失败后才要求提供
+	LoginByPassword(ctx context.Context, identifier string, password string, captchaToken string, device entity.DeviceInfo) (*entity.AuthToken, error)
+	LoginBySMS(ctx context.Context, phone vo.Phone, code string, device entity.DeviceInfo) (*entity.AuthToken, error)
+	// LoginVerified 身份已由注册或第三方登录确认，检查账号状态与二次验证后签发令牌
+	LoginVerified(ctx context.Context, userID string, device entity.DeviceInfo) (*entity.AuthToken, error)
+	// CompleteMFALogin 开启二次验证的账号在密码登录后用挑战令牌与验证码换取令牌
+	CompleteMFALogin(ctx context.Context, challengeToken, code string) (*entity.AuthToken, error)
+	Logout(ctx context.Context, accessJTI, refreshToken string, accessExpiresAt time.Time) error
+
+	// CheckUserStatus 校验账号是否可用（未注销、未禁用、未封禁）
+	CheckUserStatus(ctx context.Context, userID uint64) error
+
+	// 管理员封禁/解封账号，duration<=0 表示长期封禁
+	BlockUser(ctx context.Context, userID uint64, reason string, duration time.Duration) error
+	UnblockUser(ctx context.Context, userID uint64) error
 }
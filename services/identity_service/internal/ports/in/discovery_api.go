@@ -0,0 +1,21 @@
+package in
+
+import (
+	"context"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+)
+
+// DiscoveryUseCase 查找用户：按用户名 / 手机号 / 邮箱精确搜索或通过名片码添加联系人
+type DiscoveryUseCase interface {
+	// SearchUser 按隐私设置精确匹配，找不到与不允许搜索返回同一错误
+	SearchUser(ctx context.Context, requesterID uint64, query string) (*entity.User, error)
+	ResolveShareCode(ctx context.Context, requesterID uint64, code string) (*entity.User, error)
+
+	// GetShareCode 返回名片码与分享链接，首次调用时生成；ResetShareCode 重新生成，旧码失效
+	GetShareCode(ctx context.Context, userID uint64) (code, shareURL string, err error)
+	ResetShareCode(ctx context.Context, userID uint64) (code, shareURL string, err error)
+
+	GetPrivacy(ctx context.Context, userID uint64) (*entity.UserPrivacy, error)
+	UpdatePrivacy(ctx context.Context, userID uint64, findByUsername, findByPhone, findByEmail bool) (*entity.UserPrivacy, error)
+}
@@ -0,0 +1,21 @@
+package in
+
+import (
+	"context"
+	"time"
+)
+
+type MFAUseCase interface {
+	// Status 是否已开启 TOTP 及剩余恢复码数量
+	Status(ctx context.Context, userID uint64) (enabled bool, recoveryCodesLeft int, err error)
+
+	// 绑定：Enroll 返回密钥与 otpauth URI，Confirm 校验首个验证码后开启并返回恢复码
+	Enroll(ctx context.Context, userID uint64, account string) (secret, uri string, err error)
+	Confirm(ctx context.Context, userID uint64, code string) (recoveryCodes []string, err error)
+	Disable(ctx context.Context, userID uint64, code string) error
+	RegenerateRecoveryCodes(ctx context.Context, userID uint64, code string) ([]string, error)
+
+	// 敏感操作前的再次验证，sessionKey 为当前登录会话
+	StepUp(ctx context.Context, userID uint64, sessionKey, code string) (time.Duration, error)
+	RequireStepUp(ctx context.Context, userID uint64, sessionKey string) error
+}
@@ -0,0 +1,21 @@
+package in
+
+import (
+	"context"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+)
+
+type OIDCProviderInfo struct {
+	Name        string
+	DisplayName string
+}
+
+type OIDCUseCase interface {
+	// Providers 已配置的 OIDC 提供方
+	Providers() []OIDCProviderInfo
+	// Begin 返回提供方授权地址、本次流程的 state 与浏览器绑定值；绑定值由调用方保存在发起方浏览器
+	Begin(ctx context.Context, provider string, device entity.DeviceInfo) (authURL, state, binding string, err error)
+	// Complete 用回调中的 code、state 与浏览器带回的绑定值完成登录；账号开启两步验证时返回 MFARequiredError
+	Complete(ctx context.Context, provider, code, state, binding string) (*entity.AuthToken, error)
+}
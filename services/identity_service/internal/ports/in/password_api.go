@@ -0,0 +1,17 @@
+package in
+
+import (
+	"context"
+	"time"
+)
+
+type PasswordUseCase interface {
+	// Change 校验旧密码后修改密码，下线当前会话以外的设备
+	Change(ctx context.Context, userID uint64, currentSessionID, oldPassword, newPassword string) (revoked int, err error)
+
+	// 找回密码：RequestReset 发送验证码，VerifyReset 换取一次性重置令牌，Reset 设置新密码
+	// channel 为 sms 或 email，target 为对应的手机号或邮箱
+	RequestReset(ctx context.Context, channel, target, ip string) (codeTTL time.Duration, err error)
+	VerifyReset(ctx context.Context, channel, target, code, ip string) (resetToken string, ttl time.Duration, err error)
+	Reset(ctx context.Context, resetToken, newPassword string) error
+}
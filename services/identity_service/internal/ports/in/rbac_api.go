@@ -0,0 +1,23 @@
+package in
+
+import (
+	"context"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+)
+
+type RBACUseCase interface {
+	// 角色管理
+	ListRoles(ctx context.Context) ([]*entity.Role, error)
+	CreateRole(ctx context.Context, name, description string, permissions []string) (*entity.Role, error)
+	UpdateRole(ctx context.Context, name, description string, permissions []string) (*entity.Role, error)
+	DeleteRole(ctx context.Context, name string) error
+
+	// 用户授权
+	ListUserRoles(ctx context.Context, userID uint64) ([]*entity.Role, error)
+	AssignRole(ctx context.Context, operatorID, userID uint64, roleName string) error
+	RevokeRole(ctx context.Context, userID uint64, roleName string) error
+
+	// ResolveGrants 汇总用户的角色与权限，写入访问令牌
+	ResolveGrants(ctx context.Context, userID string) (roles, permissions []string, err error)
+}
@@ -0,0 +1,16 @@
+package in
+
+import (
+	"context"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+)
+
+type SessionUseCase interface {
+	// List 用户的活跃会话
+	List(ctx context.Context, userID uint64) ([]*entity.Session, error)
+	// Revoke 撤销指定会话，会话不属于该用户时返回未找到
+	Revoke(ctx context.Context, userID uint64, sessionID string) error
+	// RevokeOthers 撤销除当前会话外的全部会话
+	RevokeOthers(ctx context.Context, userID uint64, currentSessionID string) (int, error)
+}
@@ -0,0 +1,14 @@
+package in
+
+import (
+	"context"
+
+	"github.com/EthanQC/IM/services/identity_service/pkg/jwt"
+)
+
+type SigningKeyUseCase interface {
+	// Sync 同步签名密钥并按计划轮换
+	Sync(ctx context.Context) error
+	// JWKS 导出可验签公钥，供网关等验签方拉取
+	JWKS() jwt.JWKS
+}
@@ -19,11 +19,19 @@ type UserUseCase interface {
 	
 	// UpdateProfile 更新用户资料
 	UpdateProfile(ctx context.Context, userID uint64, displayName string, avatarURL *string) (*entity.User, error)
+	
+	// BatchGetProfiles 批量获取用户资料
+	BatchGetProfiles(ctx context.Context, userIDs []uint64) ([]*entity.User, error)
+	
+	// MatchUsersByName 按展示名或用户名关键字匹配用户ID
+	// candidateIDs 非空时只在这些用户中匹配，单次最多 maxBatchProfiles 个
+	MatchUsersByName(ctx context.Context, keyword string, candidateIDs []uint64, limit int) ([]uint64, error)
 }
 
 // ContactUseCase 联系人用例接口
 type ContactUseCase interface {
-	// ApplyContact 申请添加联系人
+	// ApplyContact 申请添加联系人；按用户 ID 申请不受"允许被搜索到"设置约束，
+	// 隐私设置只管搜索与名片码这类发现途径，ID 来自已获得的名片、共同群聊等，拉黑仍会拒绝申请
 	ApplyContact(ctx context.Context, fromUserID, toUserID uint64, message *string) error
 	
 	// RespondContact 响应联系人申请
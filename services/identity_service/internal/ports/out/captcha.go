@@ -0,0 +1,8 @@
+package out
+
+import "context"
+
+// CaptchaVerifier 校验客户端提交的人机验证凭证
+type CaptchaVerifier interface {
+	Verify(ctx context.Context, token, ip string) (bool, error)
+}
@@ -0,0 +1,7 @@
+package out
+
+import "context"
+
+type EmailClient interface {
+	Send(ctx context.Context, email string, code string) error
+}
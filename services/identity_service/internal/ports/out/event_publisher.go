@@ -7,3 +7,34 @@ type EventPublisher interface {
 	// Publish 向指定 topic 发布 key + value 消息
 	Publish(ctx context.Context, topic string, key string, value []byte) error
 }
+
+// 鉴权事件类型，网关与投递服务据此刷新本地撤销缓存
+const (
+	AuthEventTokenRevoked      = "token_revoked"
+	AuthEventUserStatusChanged = "user_status_changed"
+	// 会话被撤销：投递服务断开该会话的 WebSocket 连接
+	AuthEventSessionRevoked = "session_revoked"
+	// 未知设备登录：投递服务向用户在线设备推送提醒
+	AuthEventNewDeviceLogin = "new_device_login"
+	// 已轮换的 RefreshToken 被重复使用：令牌族已整体作废
+	AuthEventRefreshTokenReused = "refresh_token_reused"
+	// 安全审计：连续登录失败导致账号临时锁定
+	AuthEventLoginLocked = "login_locked"
+	// 安全审计：可疑登录行为，如同一 IP 短时间内尝试大量账号
+	AuthEventSuspiciousLogin = "suspicious_login"
+)
+
+// AuthEvent 令牌撤销 / 账号状态变更 / 会话事件
+type AuthEvent struct {
+	Type       string `json:"type"`
+	JTI        string `json:"jti,omitempty"`
+	UserID     string `json:"user_id,omitempty"`
+	ExpiresAt  int64  `json:"expires_at,omitempty"` // 被撤销令牌的过期时间（秒），缓存保留到此刻
+	SessionID  string `json:"session_id,omitempty"`
+	DeviceID   string `json:"device_id,omitempty"`
+	Platform   string `json:"platform,omitempty"`
+	IP         string `json:"ip,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+	Count      int    `json:"count,omitempty"` // 审计事件的失败次数 / 账号数
+	OccurredAt int64  `json:"occurred_at"`
+}
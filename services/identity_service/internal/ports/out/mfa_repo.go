@@ -0,0 +1,37 @@
+package out
+
+import (
+	"context"
+	"time"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+)
+
+type MFARepository interface {
+	// Get 未配置二次验证时返回 nil, nil
+	Get(ctx context.Context, userID uint64) (*entity.UserMFA, error)
+	// Save 按 user_id 写入密钥与启用状态；不改写 LastUsedStep 与恢复码
+	Save(ctx context.Context, mfa *entity.UserMFA) error
+	// Delete 同时删除恢复码
+	Delete(ctx context.Context, userID uint64) error
+	// ConsumeStep 仅当 step 大于已用步长时推进，返回是否推进成功；并发提交同一验证码只有一个成功
+	ConsumeStep(ctx context.Context, userID uint64, step int64) (bool, error)
+	// ConsumeRecoveryCode 删除该摘要的恢复码，返回是否存在并被本次删除
+	ConsumeRecoveryCode(ctx context.Context, userID uint64, codeHash string) (bool, error)
+	// ReplaceRecoveryCodes 原子地替换全部恢复码
+	ReplaceRecoveryCodes(ctx context.Context, userID uint64, codeHashes []string) error
+}
+
+// MFAStateRepository 二次验证的短期状态：登录挑战与敏感操作前的再次验证
+type MFAStateRepository interface {
+	// SaveChallenge 写入挑战，过期时间取 challenge.ExpiresAt
+	SaveChallenge(ctx context.Context, challenge *entity.MFAChallenge) error
+	// GetChallenge 挑战不存在或已过期时返回 nil, nil
+	GetChallenge(ctx context.Context, token string) (*entity.MFAChallenge, error)
+	// DeleteChallenge 返回挑战删除前是否存在，用于保证挑战只能被使用一次
+	DeleteChallenge(ctx context.Context, token string) (bool, error)
+
+	// MarkStepUp 记录会话在 ttl 内已完成再次验证
+	MarkStepUp(ctx context.Context, sessionKey string, ttl time.Duration) error
+	HasStepUp(ctx context.Context, sessionKey string) (bool, error)
+}
@@ -0,0 +1,32 @@
+package out
+
+import (
+	"context"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+)
+
+// OIDCProvider 一个 OIDC 身份提供方
+type OIDCProvider interface {
+	Name() string
+	DisplayName() string
+	// AuthCodeURL 授权地址，codeChallenge 为 PKCE S256 摘要
+	AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error)
+	// Exchange 用授权码换取 ID Token，并按提供方 JWKS 校验签名、iss、aud 与有效期；nonce 由调用方比对
+	Exchange(ctx context.Context, code, codeVerifier string) (*entity.OIDCClaims, error)
+}
+
+type UserIdentityRepository interface {
+	// Get 未绑定时返回 nil, nil
+	Get(ctx context.Context, provider, subject string) (*entity.UserIdentity, error)
+	// Create (provider, subject) 已存在时返回错误
+	Create(ctx context.Context, identity *entity.UserIdentity) error
+	Touch(ctx context.Context, id uint64) error
+}
+
+type OIDCStateRepository interface {
+	// Save 过期时间取 state.ExpiresAt
+	Save(ctx context.Context, state *entity.OIDCState) error
+	// Consume 取出并删除，state 不存在、已过期或已使用时返回 nil, nil
+	Consume(ctx context.Context, state string) (*entity.OIDCState, error)
+}
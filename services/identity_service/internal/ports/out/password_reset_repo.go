@@ -0,0 +1,13 @@
+package out
+
+import (
+	"context"
+	"time"
+)
+
+// PasswordResetRepository 找回密码的一次性重置令牌，只保存令牌摘要
+type PasswordResetRepository interface {
+	SaveToken(ctx context.Context, tokenHash string, userID uint64, ttl time.Duration) error
+	// ConsumeToken 取出并删除令牌，令牌不存在、已过期或已使用时返回 0, nil
+	ConsumeToken(ctx context.Context, tokenHash string) (uint64, error)
+}
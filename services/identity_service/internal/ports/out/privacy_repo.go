@@ -0,0 +1,20 @@
+package out
+
+import (
+	"context"
+	"errors"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+)
+
+// ErrShareCodeTaken 名片码已被其他用户占用
+var ErrShareCodeTaken = errors.New("share code taken")
+
+type UserPrivacyRepository interface {
+	// Get 未保存过设置时返回 nil, nil
+	Get(ctx context.Context, userID uint64) (*entity.UserPrivacy, error)
+	// GetByShareCode 名片码不存在时返回 nil, nil
+	GetByShareCode(ctx context.Context, code string) (*entity.UserPrivacy, error)
+	// Save 按 user_id 覆盖写入；名片码与他人冲突时返回 ErrShareCodeTaken
+	Save(ctx context.Context, privacy *entity.UserPrivacy) error
+}
@@ -0,0 +1,12 @@
+package out
+
+import (
+	"context"
+	"time"
+)
+
+// RateLimiter 固定窗口计数限流，多实例共享计数
+type RateLimiter interface {
+	// Allow 记一次请求，窗口内累计超过 limit 次时返回 false
+	Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error)
+}
@@ -0,0 +1,25 @@
+package out
+
+import (
+	"context"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+)
+
+type RoleRepository interface {
+	List(ctx context.Context) ([]*entity.Role, error)
+	// Get 角色不存在时返回 nil, nil
+	Get(ctx context.Context, name string) (*entity.Role, error)
+	Create(ctx context.Context, role *entity.Role) error
+	Update(ctx context.Context, role *entity.Role) error
+	// Delete 删除角色及其全部分配
+	Delete(ctx context.Context, name string) error
+	// EnsureSystemRole 写入系统角色，已存在时覆盖其权限
+	EnsureSystemRole(ctx context.Context, role *entity.Role) error
+
+	// ListByUser 用户已分配的角色（不含默认角色）
+	ListByUser(ctx context.Context, userID uint64) ([]*entity.Role, error)
+	// Assign 分配角色，已分配时不做修改
+	Assign(ctx context.Context, userID uint64, roleName string, grantedBy uint64) error
+	Unassign(ctx context.Context, userID uint64, roleName string) error
+}
@@ -0,0 +1,20 @@
+package out
+
+import (
+	"context"
+	"time"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+)
+
+type SessionRepository interface {
+	Create(ctx context.Context, session *entity.Session) error
+	// Get 会话不存在时返回 nil, nil
+	Get(ctx context.Context, id string) (*entity.Session, error)
+	Update(ctx context.Context, session *entity.Session) error
+	// ListActive 未撤销且未过期的会话，按最近活跃时间倒序
+	ListActive(ctx context.Context, userID string, now time.Time) ([]*entity.Session, error)
+	// ExistsByUser / ExistsByDevice 用于判断是否为首次登录或新设备登录
+	ExistsByUser(ctx context.Context, userID string) (bool, error)
+	ExistsByDevice(ctx context.Context, userID, deviceID string) (bool, error)
+}
@@ -0,0 +1,28 @@
+package out
+
+import (
+	"context"
+	"time"
+
+	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
+)
+
+type SigningKeyRepository interface {
+	// ListUsable 返回未过期的全部密钥（含已退役但仍可验签的）
+	ListUsable(ctx context.Context, now time.Time) ([]*entity.SigningKey, error)
+	Create(ctx context.Context, key *entity.SigningKey) error
+	// Retire 退役仍在签发的密钥，已被其他实例退役时不做修改
+	Retire(ctx context.Context, key *entity.SigningKey) error
+	DeleteExpired(ctx context.Context, now time.Time) error
+	// UpdatePrivateKey 替换存储的私钥（明文记录迁移为密文）
+	UpdatePrivateKey(ctx context.Context, kid, privateKey string) error
+}
+
+// PrivateKeyCipher 签名私钥加解密（配置中的 KEK 或 KMS），数据库只保存密文
+// kid 作为附加数据参与加密，密文不能挪用到其他密钥记录
+type PrivateKeyCipher interface {
+	Encrypt(ctx context.Context, kid, plaintext string) (string, error)
+	Decrypt(ctx context.Context, kid, ciphertext string) (string, error)
+	// IsEncrypted 存储值是否为本接口生成的密文
+	IsEncrypted(stored string) bool
+}
@@ -0,0 +1,15 @@
+package out
+
+import (
+	"context"
+	"time"
+)
+
+// SlidingWindow 滑动窗口计数，多实例共享；同一成员在窗口内只计一次
+type SlidingWindow interface {
+	// Add 记录成员并返回窗口内的成员数
+	Add(ctx context.Context, key, member string, window time.Duration) (int, error)
+	// Count 返回窗口内的成员数
+	Count(ctx context.Context, key string, window time.Duration) (int, error)
+	Reset(ctx context.Context, key string) error
+}
@@ -0,0 +1,14 @@
+package out
+
+import (
+	"context"
+	"time"
+)
+
+// TemporaryLock 带过期时间的锁定标记，多实例共享；只表示临时限制，不改写账号的封禁状态
+type TemporaryLock interface {
+	// Lock 未锁定时锁定 ttl 并返回 true；已锁定时不延长也不缩短，返回 false
+	Lock(ctx context.Context, key string, ttl time.Duration) (bool, error)
+	// Remaining 剩余锁定时间，未锁定时返回 0
+	Remaining(ctx context.Context, key string) (time.Duration, error)
+}
@@ -2,6 +2,7 @@ package out
 
 import (
 	"context"
+	"time"
 
 	"github.com/EthanQC/IM/services/identity_service/internal/domain/entity"
 )
@@ -11,6 +12,16 @@ type RefreshTokenRepository interface {
 	Find(ctx context.Context, token string) (*entity.AuthToken, error)
 	UpdateExpiry(ctx context.Context, token string, newExp int64) error
 	Revoke(ctx context.Context, token string) error
+	// ListBySessionSince 会话下 since 之后创建的令牌记录（含已轮换、已撤销的），
+	// 用于找出对应 AccessToken 仍可能有效的 jti
+	ListBySessionSince(ctx context.Context, sessionID string, since time.Time) ([]*entity.AuthToken, error)
+	RevokeBySession(ctx context.Context, sessionID string) error
+	// Rotate 在同一事务中将未撤销的令牌标记为已被 next 轮换并保存 next；
+	// 令牌已撤销或已轮换时不保存 next 并返回 false
+	Rotate(ctx context.Context, token string, next *entity.AuthToken) (bool, error)
+	// ListByFamily / RevokeFamily 令牌族的全部记录，用于重复使用时整族作废
+	ListByFamily(ctx context.Context, familyID string) ([]*entity.AuthToken, error)
+	RevokeFamily(ctx context.Context, familyID string) error
 }
 
 // AccessToken 不持久化，只签名后发给客户端
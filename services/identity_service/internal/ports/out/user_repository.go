@@ -34,4 +34,11 @@ type UserRepository interface {
 	
 	// ExistsByEmail 检查邮箱是否存在
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
+	
+	// ListByIDs 批量获取用户，不存在的用户不在结果中
+	ListByIDs(ctx context.Context, ids []uint64) ([]*entity.User, error)
+	
+	// SearchIDsByName 获取展示名或用户名包含关键字的用户ID，最多返回 limit 个
+	// candidateIDs 非空时只在这些用户中匹配
+	SearchIDsByName(ctx context.Context, keyword string, candidateIDs []uint64, limit int) ([]uint64, error)
 }
@@ -9,4 +9,6 @@ import (
 type UserStatusRepository interface {
 	Get(ctx context.Context, userID string) (*entity.UserBlockStatus, error)
 	Save(ctx context.Context, s *entity.UserBlockStatus) error
+	// BlockIfLonger 写入封禁，已有到期更晚的生效封禁时保持不变，返回是否写入
+	BlockIfLonger(ctx context.Context, s *entity.UserBlockStatus) (bool, error)
 }
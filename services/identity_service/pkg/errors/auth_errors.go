@@ -16,11 +16,19 @@ var (
 	ErrPermissionDenied = errors.New("没有访问权限")
 
 	// 用户状态相关
-	ErrUserBlocked = errors.New("用户已被封禁")
+	ErrUserBlocked  = errors.New("用户已被封禁")
+	ErrUserDisabled = errors.New("用户已被禁用")
+	// ErrPhoneNotRegistered 短信验证码正确但手机号未绑定可登录的账号
+	ErrPhoneNotRegistered = errors.New("手机号未注册")
 
 	// 令牌刷新相关
 	ErrRefreshTokenExpired = errors.New("刷新令牌已过期")
+	ErrRefreshTokenReused  = errors.New("刷新令牌被重复使用，请重新登录")
 
-	// 访问规则相关
-	ErrInvalidAccessRule = errors.New("无效的访问规则")
+	// 限流相关
+	ErrTooManyRequests = errors.New("请求过于频繁，请稍后再试")
+
+	// 登录防护相关
+	ErrCaptchaRequired = errors.New("需要完成人机验证")
+	ErrAccountLocked   = errors.New("登录失败次数过多，账号已临时锁定")
 )
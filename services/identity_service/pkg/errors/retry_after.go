@@ -0,0 +1,29 @@
+package errors
+
+import (
+	"errors"
+	"time"
+)
+
+// RetryAfterError 限流、锁定类错误附带建议的重试时间，接入层据此返回 Retry-After
+type RetryAfterError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+func (e *RetryAfterError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RetryAfterError) Unwrap() error {
+	return e.Err
+}
+
+// RetryAfter 取出错误链中的建议重试时间
+func RetryAfter(err error) (time.Duration, bool) {
+	var ra *RetryAfterError
+	if errors.As(err, &ra) && ra.RetryAfter > 0 {
+		return ra.RetryAfter, true
+	}
+	return 0, false
+}
@@ -0,0 +1,188 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// 支持的非对称签名算法
+const (
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+)
+
+const rsaKeyBits = 2048
+
+// Key 一把签名密钥，KID 写入令牌 header 用于验签时定位公钥
+type Key struct {
+	KID       string
+	Algorithm string
+	Private   crypto.Signer
+	Public    crypto.PublicKey
+}
+
+func (k *Key) method() jwt.SigningMethod {
+	if k.Algorithm == AlgEdDSA {
+		return jwt.SigningMethodEdDSA
+	}
+	return jwt.SigningMethodRS256
+}
+
+// IsSupportedAlgorithm 判断是否为支持的非对称算法
+func IsSupportedAlgorithm(alg string) bool {
+	return alg == AlgRS256 || alg == AlgEdDSA
+}
+
+// GenerateKey 生成新密钥，返回 kid 与 PKCS#8 PEM 编码的私钥
+func GenerateKey(alg string) (string, string, error) {
+	var priv interface{}
+	switch alg {
+	case AlgRS256:
+		k, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		if err != nil {
+			return "", "", fmt.Errorf("generate rsa key: %w", err)
+		}
+		priv = k
+	case AlgEdDSA:
+		_, k, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return "", "", fmt.Errorf("generate ed25519 key: %w", err)
+		}
+		priv = k
+	default:
+		return "", "", fmt.Errorf("unsupported algorithm %q", alg)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		return "", "", fmt.Errorf("marshal private key: %w", err)
+	}
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", "", fmt.Errorf("generate kid: %w", err)
+	}
+	kid := fmt.Sprintf("%s-%s", time.Now().UTC().Format("20060102T150405"), hex.EncodeToString(suffix))
+	return kid, string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})), nil
+}
+
+// ParseKey 解析 PEM 私钥并校验与算法匹配
+func ParseKey(kid, alg, privatePEM string) (*Key, error) {
+	block, _ := pem.Decode([]byte(privatePEM))
+	if block == nil {
+		return nil, fmt.Errorf("key %s: invalid pem", kid)
+	}
+	priv, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("key %s: parse private key: %w", kid, err)
+	}
+	key := &Key{KID: kid, Algorithm: alg}
+	switch k := priv.(type) {
+	case *rsa.PrivateKey:
+		if alg != AlgRS256 {
+			return nil, fmt.Errorf("key %s: rsa key with algorithm %s", kid, alg)
+		}
+		key.Private, key.Public = k, &k.PublicKey
+	case ed25519.PrivateKey:
+		if alg != AlgEdDSA {
+			return nil, fmt.Errorf("key %s: ed25519 key with algorithm %s", kid, alg)
+		}
+		key.Private, key.Public = k, k.Public()
+	default:
+		return nil, fmt.Errorf("key %s: unsupported key type %T", kid, priv)
+	}
+	return key, nil
+}
+
+// KeyRing 当前签名密钥与所有可验签密钥，由密钥轮换任务整体替换
+type KeyRing struct {
+	mu      sync.RWMutex
+	current *Key
+	keys    map[string]*Key
+}
+
+func NewKeyRing() *KeyRing {
+	return &KeyRing{keys: make(map[string]*Key)}
+}
+
+// Replace 替换密钥集合，current 必须包含在 keys 中
+func (r *KeyRing) Replace(current *Key, keys []*Key) {
+	m := make(map[string]*Key, len(keys))
+	for _, k := range keys {
+		m[k.KID] = k
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.current = current
+	r.keys = m
+}
+
+// Current 返回当前签名密钥
+func (r *KeyRing) Current() *Key {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.current
+}
+
+// Lookup 按 kid 查找验签密钥
+func (r *KeyRing) Lookup(kid string) (*Key, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	k, ok := r.keys[kid]
+	return k, ok
+}
+
+// JWK RFC 7517 公钥表示，RSA 使用 n/e，Ed25519 使用 crv/x
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKS 公钥集合
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS 导出全部可验签公钥
+func (r *KeyRing) JWKS() JWKS {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	set := JWKS{Keys: make([]JWK, 0, len(r.keys))}
+	for _, k := range r.keys {
+		set.Keys = append(set.Keys, k.jwk())
+	}
+	return set
+}
+
+func (k *Key) jwk() JWK {
+	enc := base64.RawURLEncoding
+	j := JWK{Kid: k.KID, Use: "sig", Alg: k.Algorithm}
+	switch pub := k.Public.(type) {
+	case *rsa.PublicKey:
+		j.Kty = "RSA"
+		j.N = enc.EncodeToString(pub.N.Bytes())
+		j.E = enc.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case ed25519.PublicKey:
+		j.Kty = "OKP"
+		j.Crv = "Ed25519"
+		j.X = enc.EncodeToString(pub)
+	}
+	return j
+}
@@ -1,51 +1,160 @@
 package jwt
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
-	"github.com/dgrijalva/jwt-go"
+	"github.com/golang-jwt/jwt/v5"
 )
 
+// 令牌类型，写入 typ 声明；校验方只接受访问令牌，刷新令牌只能用于换取新令牌
+const (
+	TypeAccess  = "access"
+	TypeRefresh = "refresh"
+)
+
+// Claims 令牌声明：标准声明（jti / sub / iat / exp）与类型外，访问令牌携带会话 ID 与签发时的角色与权限
+type Claims struct {
+	jwt.RegisteredClaims
+	Type        string   `json:"typ,omitempty"`
+	SessionID   string   `json:"sid,omitempty"`
+	Roles       []string `json:"roles,omitempty"`
+	Permissions []string `json:"perms,omitempty"`
+}
+
+// AccessClaims 访问令牌的附加声明
+type AccessClaims struct {
+	SessionID   string
+	Roles       []string
+	Permissions []string
+}
+
 // Manager 负责 JWT 的签发与解析
 type Manager interface {
+	// Generate 签发不带附加声明的访问令牌
 	Generate(jti, subject string, ttl time.Duration) (string, error)
-	Parse(tokenStr string) (*jwt.StandardClaims, error)
+	// GenerateAccess 签发携带会话、角色与权限声明的访问令牌
+	GenerateAccess(jti, subject string, ttl time.Duration, extra AccessClaims) (string, error)
+	// GenerateRefresh 签发刷新令牌
+	GenerateRefresh(jti, subject string, ttl time.Duration) (string, error)
+	Parse(tokenStr string) (*Claims, error)
 }
 
 type manager struct {
 	secret []byte
 }
 
-// NewManager 用给定的 secret 构造 Manager
+// NewManager 用给定的 secret 构造 HS256 Manager（旧版签名方式）
 func NewManager(secret string) Manager {
 	return &manager{secret: []byte(secret)}
 }
 
-// Generate 生成一个带 jti 和 subject 的 JWT，ttl 控制过期时间
+// Generate 生成一个带 jti 和 subject 的访问令牌，ttl 控制过期时间
 func (m *manager) Generate(jti, subject string, ttl time.Duration) (string, error) {
-	now := time.Now()
-	claims := jwt.StandardClaims{
-		Id:        jti,
-		Subject:   subject,
-		IssuedAt:  now.Unix(),
-		ExpiresAt: now.Add(ttl).Unix(),
-	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(m.secret)
+	return m.GenerateAccess(jti, subject, ttl, AccessClaims{})
+}
+
+func (m *manager) GenerateAccess(jti, subject string, ttl time.Duration, extra AccessClaims) (string, error) {
+	return m.sign(newClaims(TypeAccess, jti, subject, ttl, extra))
 }
 
-// Parse 验签并解析 JWT，返回 StandardClaims
-func (m *manager) Parse(tokenStr string) (*jwt.StandardClaims, error) {
-	tok, err := jwt.ParseWithClaims(tokenStr, &jwt.StandardClaims{}, func(_ *jwt.Token) (interface{}, error) {
+func (m *manager) GenerateRefresh(jti, subject string, ttl time.Duration) (string, error) {
+	return m.sign(newClaims(TypeRefresh, jti, subject, ttl, AccessClaims{}))
+}
+
+func (m *manager) sign(claims *Claims) (string, error) {
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(m.secret)
+}
+
+// Parse 验签并解析 JWT
+func (m *manager) Parse(tokenStr string) (*Claims, error) {
+	return parse(tokenStr, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
 		return m.secret, nil
 	})
+}
+
+type keyRingManager struct {
+	ring         *KeyRing
+	legacySecret []byte
+}
+
+// NewKeyRingManager 用 KeyRing 的当前密钥签发 RS256/EdDSA 令牌（header 带 kid），
+// 按 kid 查找验签公钥；legacySecret 非空时在过渡期内仍接受旧的 HS256 令牌
+func NewKeyRingManager(ring *KeyRing, legacySecret string) Manager {
+	m := &keyRingManager{ring: ring}
+	if legacySecret != "" {
+		m.legacySecret = []byte(legacySecret)
+	}
+	return m
+}
+
+func (m *keyRingManager) Generate(jti, subject string, ttl time.Duration) (string, error) {
+	return m.GenerateAccess(jti, subject, ttl, AccessClaims{})
+}
+
+func (m *keyRingManager) GenerateAccess(jti, subject string, ttl time.Duration, extra AccessClaims) (string, error) {
+	return m.sign(newClaims(TypeAccess, jti, subject, ttl, extra))
+}
+
+func (m *keyRingManager) GenerateRefresh(jti, subject string, ttl time.Duration) (string, error) {
+	return m.sign(newClaims(TypeRefresh, jti, subject, ttl, AccessClaims{}))
+}
+
+func (m *keyRingManager) sign(claims *Claims) (string, error) {
+	key := m.ring.Current()
+	if key == nil {
+		return "", errors.New("no active signing key")
+	}
+	token := jwt.NewWithClaims(key.method(), claims)
+	token.Header["kid"] = key.KID
+	return token.SignedString(key.Private)
+}
+
+func (m *keyRingManager) Parse(tokenStr string) (*Claims, error) {
+	return parse(tokenStr, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); ok {
+			if m.legacySecret == nil {
+				return nil, errors.New("legacy HS256 token no longer accepted")
+			}
+			return m.legacySecret, nil
+		}
+		kid, _ := t.Header["kid"].(string)
+		key, ok := m.ring.Lookup(kid)
+		if !ok {
+			return nil, fmt.Errorf("unknown signing key %q", kid)
+		}
+		if t.Method.Alg() != key.Algorithm {
+			return nil, fmt.Errorf("signing method %s does not match key %s", t.Method.Alg(), key.Algorithm)
+		}
+		return key.Public, nil
+	})
+}
+
+func newClaims(typ, jti, subject string, ttl time.Duration, extra AccessClaims) *Claims {
+	now := time.Now()
+	return &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        jti,
+			Subject:   subject,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+		Type:        typ,
+		SessionID:   extra.SessionID,
+		Roles:       extra.Roles,
+		Permissions: extra.Permissions,
+	}
+}
+
+func parse(tokenStr string, keyFunc jwt.Keyfunc) (*Claims, error) {
+	claims := &Claims{}
+	tok, err := jwt.ParseWithClaims(tokenStr, claims, keyFunc)
 	if err != nil || !tok.Valid {
 		return nil, fmt.Errorf("invalid token: %w", err)
 	}
-	claims, ok := tok.Claims.(*jwt.StandardClaims)
-	if !ok {
-		return nil, fmt.Errorf("invalid token claims")
-	}
 	return claims, nil
 }
@@ -26,6 +26,8 @@ import (
 	"github.com/EthanQC/IM/pkg/zlog"
 	"github.com/EthanQC/IM/services/message_service/internal/adapters/in/grpc/server"
 	httpAdapter "github.com/EthanQC/IM/services/message_service/internal/adapters/in/http"
+	mqIn "github.com/EthanQC/IM/services/message_service/internal/adapters/in/mq"
+	"github.com/EthanQC/IM/services/message_service/internal/adapters/in/reaper"
 	"github.com/EthanQC/IM/services/message_service/internal/adapters/in/ws"
 	"github.com/EthanQC/IM/services/message_service/internal/adapters/out/db"
 	grpcOut "github.com/EthanQC/IM/services/message_service/internal/adapters/out/grpc"
@@ -111,7 +113,16 @@ func main() {
 		logger.Fatal("Failed to connect conversation service", zap.Error(err))
 	}
 	defer convConn.Close()
-	memberRepo = grpcOut.NewConversationClient(imv1.NewConversationServiceClient(convConn), convTimeout)
+	// 会话状态本地短时缓存，会话事件到达时主动失效
+	memberCacheTTL := viper.GetDuration("grpc.member_cache_ttl")
+	if memberCacheTTL == 0 {
+		memberCacheTTL = 2 * time.Second
+	}
+	cachedConvClient := grpcOut.NewCachedConversationClient(
+		grpcOut.NewConversationClient(imv1.NewConversationServiceClient(convConn), convTimeout),
+		memberCacheTTL,
+	)
+	memberRepo = cachedConvClient
 
 	// 初始化应用层（增强版）
 	messageUseCase := application.NewEnhancedMessageUseCase(
@@ -123,6 +134,63 @@ func main() {
 		eventPublisher,
 	)
 
+	// 发送者展示名只读Redis缓存，身份服务仅用于缓存未命中时异步回填
+	var profileReader out.UserProfileReader
+	if identityAddr := viper.GetString("grpc.identity_addr"); identityAddr != "" {
+		identityConn, err := grpc.Dial(identityAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			logger.Fatal("Failed to connect identity service", zap.Error(err))
+		}
+		defer identityConn.Close()
+		profileReader = grpcOut.NewIdentityClient(imv1.NewIdentityServiceClient(identityConn), convTimeout)
+	}
+	messageUseCase.SetUserNameResolver(redisRepo.NewUserNameCacheRedis(redisClient), profileReader)
+
+	// 文件服务用于删除过期消息引用的文件，未配置时只删除消息
+	if fileAddr := viper.GetString("grpc.file_addr"); fileAddr != "" {
+		fileConn, err := grpc.Dial(fileAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			logger.Fatal("Failed to connect file service", zap.Error(err))
+		}
+		defer fileConn.Close()
+		messageUseCase.SetFileCleaner(grpcOut.NewFileClient(imv1.NewFileServiceClient(fileConn), convTimeout))
+	}
+
+	// 定期清理开启自动删除的会话中已过期的消息
+	reaperConfig := reaper.DefaultConfig()
+	if interval := viper.GetDuration("expiry.interval"); interval > 0 {
+		reaperConfig.Interval = interval
+	}
+	if batchSize := viper.GetInt("expiry.batch_size"); batchSize > 0 {
+		reaperConfig.BatchSize = batchSize
+	}
+	expiryReaper := reaper.NewExpiryReaper(messageUseCase, reaperConfig)
+	if err := expiryReaper.Start(); err != nil {
+		logger.Fatal("Failed to start expiry reaper", zap.Error(err))
+	}
+	defer expiryReaper.Stop()
+
+	// 消费会话事件，生成入群等系统消息并同步收件箱设置
+	groupID := viper.GetString("kafka.group_id")
+	if groupID == "" {
+		groupID = "message-service-group"
+	}
+	convEventConsumer, err := mqIn.NewConversationEventConsumer(kafkaBrokers, groupID, messageUseCase, messageUseCase, cachedConvClient)
+	if err != nil {
+		logger.Fatal("Failed to init conversation event consumer", zap.Error(err))
+	}
+	convEventConsumer.SetDeadLetterPublisher(eventPublisher)
+	convEventConsumer.Start(context.Background())
+	defer convEventConsumer.Stop()
+
+	// 消费用户事件，用户修改展示名时更新缓存
+	userEventConsumer, err := mqIn.NewUserEventConsumer(kafkaBrokers, groupID, messageUseCase)
+	if err != nil {
+		logger.Fatal("Failed to init user event consumer", zap.Error(err))
+	}
+	userEventConsumer.Start(context.Background())
+	defer userEventConsumer.Stop()
+
 	// 初始化WebSocket Hub
 	hub := ws.NewHub(messageUseCase)
 	go hub.Run()
@@ -177,7 +245,7 @@ func main() {
 	}
 
 	grpcServer := grpc.NewServer()
-	messageGrpcServer := server.NewMessageServer(messageUseCase)
+	messageGrpcServer := server.NewMessageServer(messageUseCase, messageUseCase)
 	server.RegisterMessageServiceServer(grpcServer, messageGrpcServer)
 
 	go func() {
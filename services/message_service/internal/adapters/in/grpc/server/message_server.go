@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"strconv"
 
 	"google.golang.org/grpc"
@@ -12,6 +13,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/EthanQC/IM/api/gen/im/v1"
+	"github.com/EthanQC/IM/services/message_service/internal/application"
 	"github.com/EthanQC/IM/services/message_service/internal/domain/entity"
 	"github.com/EthanQC/IM/services/message_service/internal/ports/in"
 )
@@ -20,11 +22,18 @@ import (
 type MessageServer struct {
 	pb.UnimplementedMessageServiceServer
 	messageUseCase in.MessageUseCase
+	summaryUseCase in.ConversationSummaryUseCase
 }
 
+// maxSummaryBatch 单次批量获取会话摘要的上限
+const maxSummaryBatch = 100
+
+// maxUnreadBatch 单次批量获取未读数的上限
+const maxUnreadBatch = 1000
+
 // NewMessageServer 创建消息服务
-func NewMessageServer(messageUseCase in.MessageUseCase) *MessageServer {
-	return &MessageServer{messageUseCase: messageUseCase}
+func NewMessageServer(messageUseCase in.MessageUseCase, summaryUseCase in.ConversationSummaryUseCase) *MessageServer {
+	return &MessageServer{messageUseCase: messageUseCase, summaryUseCase: summaryUseCase}
 }
 
 // RegisterMessageServiceServer 注册服务
@@ -51,6 +60,21 @@ func getUserIDFromMetadata(ctx context.Context) (uint64, error) {
 	return userID, nil
 }
 
+// sendErrorCode 发送失败错误到 gRPC 状态码的映射
+func sendErrorCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, entity.ErrSenderNotMember),
+		errors.Is(err, entity.ErrSenderMuted),
+		errors.Is(err, entity.ErrConversationMuted),
+		errors.Is(err, entity.ErrChannelReadOnly):
+		return codes.PermissionDenied
+	case errors.Is(err, entity.ErrConversationDissolved):
+		return codes.FailedPrecondition
+	default:
+		return codes.Internal
+	}
+}
+
 // SendMessage 发送消息
 func (s *MessageServer) SendMessage(ctx context.Context, req *pb.SendMessageRequest) (*pb.SendMessageResponse, error) {
 	// 从 metadata 获取 userID
@@ -74,7 +98,7 @@ func (s *MessageServer) SendMessage(ctx context.Context, req *pb.SendMessageRequ
 		Content:        content,
 	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(sendErrorCode(err), err.Error())
 	}
 
 	return &pb.SendMessageResponse{
@@ -108,6 +132,28 @@ func (s *MessageServer) GetHistory(ctx context.Context, req *pb.GetHistoryReques
 	}, nil
 }
 
+// GetMessage 获取调用者可见的单条消息
+// 调用者需是消息所在会话的成员，已撤回、删除或过期的消息视为不存在
+func (s *MessageServer) GetMessage(ctx context.Context, req *pb.GetMessageRequest) (*pb.MessageItem, error) {
+	userID, err := getUserIDFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.MessageId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "message_id is required")
+	}
+
+	msg, err := s.messageUseCase.GetMessage(ctx, userID, uint64(req.MessageId))
+	if err != nil {
+		if errors.Is(err, application.ErrMessageNotFound) {
+			return nil, status.Error(codes.NotFound, "message not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return s.entityToMessageItem(msg), nil
+}
+
 // UpdateRead 更新已读状态
 func (s *MessageServer) UpdateRead(ctx context.Context, req *pb.UpdateReadRequest) (*emptypb.Empty, error) {
 	userID, err := getUserIDFromMetadata(ctx)
@@ -127,6 +173,82 @@ func (s *MessageServer) UpdateRead(ctx context.Context, req *pb.UpdateReadReques
 	return &emptypb.Empty{}, nil
 }
 
+// BatchGetConversationSummaries 批量获取会话摘要
+func (s *MessageServer) BatchGetConversationSummaries(ctx context.Context, req *pb.BatchGetConversationSummariesRequest) (*pb.BatchGetConversationSummariesResponse, error) {
+	userID, err := getUserIDFromMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.ConversationIds) > maxSummaryBatch {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d conversations per request", maxSummaryBatch)
+	}
+
+	convIDs := make([]uint64, len(req.ConversationIds))
+	for i, id := range req.ConversationIds {
+		convIDs[i] = uint64(id)
+	}
+	channelIDs := make([]uint64, len(req.ChannelIds))
+	for i, id := range req.ChannelIds {
+		channelIDs[i] = uint64(id)
+	}
+
+	summaries, err := s.summaryUseCase.GetConversationSummaries(ctx, userID, convIDs, channelIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	items := make([]*pb.ConversationSummary, len(summaries))
+	for i, summary := range summaries {
+		item := &pb.ConversationSummary{
+			ConversationId: int64(summary.ConversationID),
+			UnreadCount:    int32(summary.UnreadCount),
+			HasMention:     summary.HasMention,
+		}
+		if summary.LastMessage != nil {
+			item.LastMessage = s.entityToMessageItem(summary.LastMessage)
+			item.Preview = summary.LastMessage.Preview()
+		}
+		items[i] = item
+	}
+
+	return &pb.BatchGetConversationSummariesResponse{Items: items}, nil
+}
+
+// BatchGetUnreadCounts 批量获取未读数（内部接口）
+func (s *MessageServer) BatchGetUnreadCounts(ctx context.Context, req *pb.BatchGetUnreadCountsRequest) (*pb.BatchGetUnreadCountsResponse, error) {
+	if req.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if len(req.ConversationIds) > maxUnreadBatch {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d conversations per request", maxUnreadBatch)
+	}
+
+	convIDs := make([]uint64, len(req.ConversationIds))
+	for i, id := range req.ConversationIds {
+		convIDs[i] = uint64(id)
+	}
+	channelIDs := make([]uint64, len(req.ChannelIds))
+	for i, id := range req.ChannelIds {
+		channelIDs[i] = uint64(id)
+	}
+
+	counts, err := s.summaryUseCase.GetUnreadCounts(ctx, uint64(req.UserId), convIDs, channelIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	items := make([]*pb.UnreadCount, len(counts))
+	for i, c := range counts {
+		items[i] = &pb.UnreadCount{
+			ConversationId: int64(c.ConversationID),
+			UnreadCount:    int32(c.UnreadCount),
+			HasMention:     c.HasMention,
+		}
+	}
+	return &pb.BatchGetUnreadCountsResponse{Items: items}, nil
+}
+
 // bodyToContent 将 proto MessageBody 转换为 domain MessageContent
 func (s *MessageServer) bodyToContent(body *pb.MessageBody) entity.MessageContent {
 	content := entity.MessageContent{}
@@ -138,7 +260,11 @@ func (s *MessageServer) bodyToContent(body *pb.MessageBody) entity.MessageConten
 	case *pb.MessageBody_Text:
 		if b.Text != nil {
 			content.Text = &entity.TextContent{
-				Text: b.Text.Text,
+				Text:       b.Text.Text,
+				MentionAll: b.Text.MentionAll,
+			}
+			for _, id := range b.Text.MentionUserIds {
+				content.Text.MentionUserIDs = append(content.Text.MentionUserIDs, uint64(id))
 			}
 		}
 	case *pb.MessageBody_Image:
@@ -199,6 +325,12 @@ func (s *MessageServer) entityToMessageItem(msg *entity.Message) *pb.MessageItem
 		ContentType:    pb.MessageContentType(msg.ContentType),
 		CreateTime:     timestamppb.New(msg.CreatedAt),
 	}
+	if msg.ContentType == entity.MessageContentTypeSystem {
+		item.ContentType = pb.MessageContentType_MESSAGE_CONTENT_TYPE_SYSTEM
+	}
+	if msg.ExpiresAt != nil {
+		item.ExpireTime = timestamppb.New(*msg.ExpiresAt)
+	}
 
 	// 构建 MessageBody
 	item.Body = s.contentToBody(msg.Content)
@@ -211,11 +343,14 @@ func (s *MessageServer) contentToBody(content entity.MessageContent) *pb.Message
 	body := &pb.MessageBody{}
 
 	if content.Text != nil {
-		body.Body = &pb.MessageBody_Text{
-			Text: &pb.TextBody{
-				Text: content.Text.Text,
-			},
+		text := &pb.TextBody{
+			Text:       content.Text.Text,
+			MentionAll: content.Text.MentionAll,
 		}
+		for _, id := range content.Text.MentionUserIDs {
+			text.MentionUserIds = append(text.MentionUserIds, int64(id))
+		}
+		body.Body = &pb.MessageBody_Text{Text: text}
 	} else if content.Image != nil {
 		body.Body = &pb.MessageBody_Image{
 			Image: &pb.MediaRef{
@@ -246,6 +381,15 @@ func (s *MessageServer) contentToBody(content entity.MessageContent) *pb.Message
 				ThumbnailKey: content.Video.ThumbnailKey,
 			},
 		}
+	} else if content.System != nil {
+		body.Body = &pb.MessageBody_System{
+			System: &pb.SystemBody{
+				Type:       content.System.Type,
+				Text:       content.System.Text,
+				Payload:    string(content.System.Payload),
+				MentionAll: content.System.MentionAll,
+			},
+		}
 	} else if content.File != nil {
 		body.Body = &pb.MessageBody_File{
 			File: &pb.MediaRef{
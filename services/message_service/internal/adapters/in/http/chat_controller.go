@@ -1,6 +1,7 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -72,7 +73,7 @@ func (c *ChatController) SendMessage(ctx *gin.Context) {
 		ReplyToMsgID:   req.ReplyToMsgID,
 	})
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		ctx.JSON(sendErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
@@ -82,6 +83,7 @@ func (c *ChatController) SendMessage(ctx *gin.Context) {
 			"message_id": msg.ID,
 			"seq":        msg.Seq,
 			"created_at": msg.CreatedAt,
+			"expires_at": msg.ExpiresAt,
 		},
 	})
 }
@@ -280,3 +282,17 @@ func (c *ChatController) GetUnreadCount(ctx *gin.Context) {
 		},
 	})
 }
+
+// sendErrorStatus 发送失败错误到 HTTP 状态码的映射
+func sendErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, entity.ErrSenderNotMember),
+		errors.Is(err, entity.ErrSenderMuted),
+		errors.Is(err, entity.ErrConversationMuted):
+		return http.StatusForbidden
+	case errors.Is(err, entity.ErrConversationDissolved):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,266 @@
+package mq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+
+	"github.com/EthanQC/IM/services/message_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/message_service/internal/ports/in"
+	"github.com/EthanQC/IM/services/message_service/internal/ports/out"
+)
+
+// TopicConversationEvents 会话事件Topic（由 conversation_service 发布）
+const TopicConversationEvents = "im.conversation.events"
+
+const (
+	// eventSettingUpdated 用户会话设置变更事件，同步到收件箱
+	eventSettingUpdated = "conversation.setting_updated"
+	// eventChannelSubscribed 订阅频道事件，初始化订阅者的已读位置
+	eventChannelSubscribed = "conversation.channel_subscribed"
+)
+
+const (
+	// maxHandleAttempts 单条事件原地重试次数，重试期间阻塞所在分区以保持同一会话内的事件顺序
+	maxHandleAttempts = 3
+	// retryBaseInterval 重试间隔基数（指数退避）
+	retryBaseInterval = 500 * time.Millisecond
+)
+
+// conversationEvent 会话事件公共字段
+type conversationEvent struct {
+	EventID        string `json:"event_id"`
+	Type           string `json:"type"`
+	ConversationID uint64 `json:"conversation_id"`
+	OperatorID     uint64 `json:"operator_id"`
+}
+
+// settingUpdatedEvent 会话设置变更事件
+type settingUpdatedEvent struct {
+	UserID    uint64 `json:"user_id"`
+	Pinned    bool   `json:"pinned"`
+	Muted     bool   `json:"muted"`
+	MuteUntil int64  `json:"mute_until"`
+}
+
+// ConversationEventConsumer 消费会话事件并生成系统消息
+// 会话事件按会话ID分区，同一会话的系统消息按事件顺序写入时间线
+type ConversationEventConsumer struct {
+	consumerGroup sarama.ConsumerGroup
+	sysMsgUseCase in.SystemMessageUseCase
+	inboxUseCase  in.InboxSettingUseCase
+	invalidator   out.ConversationStateInvalidator
+	deadLetter    out.EventPublisher
+	cancel        context.CancelFunc
+}
+
+// NewConversationEventConsumer 创建会话事件消费者
+// invalidator 可为空，非空时每个事件都会使对应会话的状态缓存失效
+func NewConversationEventConsumer(brokers []string, groupID string, sysMsgUseCase in.SystemMessageUseCase, inboxUseCase in.InboxSettingUseCase, invalidator out.ConversationStateInvalidator) (*ConversationEventConsumer, error) {
+	config := sarama.NewConfig()
+	config.Version = sarama.V2_8_0_0
+	config.Consumer.Group.Rebalance.Strategy = sarama.NewBalanceStrategyRoundRobin()
+	config.Consumer.Offsets.Initial = sarama.OffsetNewest
+	config.Consumer.Return.Errors = true
+
+	consumerGroup, err := sarama.NewConsumerGroup(brokers, groupID, config)
+	if err != nil {
+		return nil, fmt.Errorf("create consumer group failed: %w", err)
+	}
+
+	return &ConversationEventConsumer{
+		consumerGroup: consumerGroup,
+		sysMsgUseCase: sysMsgUseCase,
+		inboxUseCase:  inboxUseCase,
+		invalidator:   invalidator,
+	}, nil
+}
+
+// SetDeadLetterPublisher 设置死信发布器，重试耗尽的事件转入死信队列后再提交位点
+// 未设置时重试耗尽的事件不提交位点，重新加入消费组后再次投递
+func (c *ConversationEventConsumer) SetDeadLetterPublisher(publisher out.EventPublisher) {
+	c.deadLetter = publisher
+}
+
+// Start 启动消费
+func (c *ConversationEventConsumer) Start(ctx context.Context) {
+	ctx, c.cancel = context.WithCancel(ctx)
+	handler := &conversationEventHandler{
+		sysMsgUseCase: c.sysMsgUseCase,
+		inboxUseCase:  c.inboxUseCase,
+		invalidator:   c.invalidator,
+		deadLetter:    c.deadLetter,
+	}
+
+	go func() {
+		for {
+			if err := c.consumerGroup.Consume(ctx, []string{TopicConversationEvents}, handler); err != nil {
+				zap.L().Warn("Error from conversation event consumer", zap.Error(err))
+			}
+			if ctx.Err() != nil {
+				return
+			}
+		}
+	}()
+}
+
+// Stop 停止消费
+func (c *ConversationEventConsumer) Stop() error {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	return c.consumerGroup.Close()
+}
+
+type conversationEventHandler struct {
+	sysMsgUseCase in.SystemMessageUseCase
+	inboxUseCase  in.InboxSettingUseCase
+	invalidator   out.ConversationStateInvalidator
+	deadLetter    out.EventPublisher
+}
+
+func (h *conversationEventHandler) Setup(sarama.ConsumerGroupSession) error   { return nil }
+func (h *conversationEventHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+func (h *conversationEventHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for message := range claim.Messages() {
+		attempts, err := h.handleWithRetry(session.Context(), message.Value)
+		if err != nil {
+			zap.L().Warn("Handle conversation event failed",
+				zap.String("key", string(message.Key)),
+				zap.Int("attempts", attempts),
+				zap.Error(err))
+			if !h.sendToDeadLetter(session.Context(), message, attempts, err) {
+				// 未能转入死信队列时不提交位点，结束本次会话后从该事件重新消费
+				return fmt.Errorf("conversation event at offset %d not handled: %w", message.Offset, err)
+			}
+		}
+		session.MarkMessage(message, "")
+	}
+	return nil
+}
+
+// handleWithRetry 处理事件，失败时按指数退避原地重试
+func (h *conversationEventHandler) handleWithRetry(ctx context.Context, data []byte) (int, error) {
+	var err error
+	for attempt := 1; attempt <= maxHandleAttempts; attempt++ {
+		if err = h.handle(ctx, data); err == nil {
+			return attempt, nil
+		}
+		if attempt == maxHandleAttempts {
+			return attempt, err
+		}
+		select {
+		case <-ctx.Done():
+			return attempt, err
+		case <-time.After(retryBaseInterval << (attempt - 1)):
+		}
+	}
+	return maxHandleAttempts, err
+}
+
+// sendToDeadLetter 将重试耗尽的事件转入死信队列，返回是否成功
+func (h *conversationEventHandler) sendToDeadLetter(ctx context.Context, message *sarama.ConsumerMessage, attempts int, cause error) bool {
+	if h.deadLetter == nil || ctx.Err() != nil {
+		return false
+	}
+	payload := json.RawMessage(message.Value)
+	if !json.Valid(payload) {
+		// 无法解析的原始消息按字符串保存，避免死信本身序列化失败
+		payload, _ = json.Marshal(string(message.Value))
+	}
+	err := h.deadLetter.PublishDeadLetter(ctx, &out.DeadLetterEvent{
+		OriginalTopic: message.Topic,
+		OriginalKey:   string(message.Key),
+		Payload:       payload,
+		ErrorMsg:      cause.Error(),
+		RetryCount:    attempts,
+		CreatedAt:     time.Now().Unix(),
+	})
+	if err != nil {
+		zap.L().Error("Publish conversation event to dead letter failed", zap.Error(err))
+		return false
+	}
+	return true
+}
+
+func (h *conversationEventHandler) handle(ctx context.Context, data []byte) error {
+	var event conversationEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		return fmt.Errorf("unmarshal conversation event failed: %w", err)
+	}
+
+	// 成员、角色、禁言等变化都会影响发言权限，先失效缓存再生成系统消息
+	if h.invalidator != nil && event.ConversationID != 0 {
+		h.invalidator.Invalidate(event.ConversationID)
+	}
+
+	switch event.Type {
+	case eventSettingUpdated:
+		return h.syncSetting(ctx, event.ConversationID, data)
+	case eventChannelSubscribed:
+		return h.initChannelInbox(ctx, event.ConversationID, data)
+	}
+
+	sysMsg, ok := systemMessages[event.Type]
+	if !ok || event.EventID == "" || event.OperatorID == 0 {
+		return nil
+	}
+	var payload systemEventPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("unmarshal system event failed: %w", err)
+	}
+
+	// 以事件ID作为客户端消息ID，事件重复投递时依赖发送幂等去重
+	_, err := h.sysMsgUseCase.SendSystemMessage(ctx, &in.SendMessageRequest{
+		ConversationID: event.ConversationID,
+		SenderID:       event.OperatorID,
+		ClientMsgID:    "sys:" + event.EventID,
+		Content: entity.MessageContent{
+			System: &entity.SystemContent{
+				Type:       sysMsg.sysType,
+				Text:       sysMsg.render(&payload),
+				Payload:    json.RawMessage(data),
+				MentionAll: mentionAllEvents[event.Type],
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("send system message: %w", err)
+	}
+	return nil
+}
+
+func (h *conversationEventHandler) initChannelInbox(ctx context.Context, conversationID uint64, data []byte) error {
+	var event struct {
+		UserID uint64 `json:"user_id"`
+	}
+	if err := json.Unmarshal(data, &event); err != nil {
+		return fmt.Errorf("unmarshal channel event failed: %w", err)
+	}
+	if event.UserID == 0 {
+		return nil
+	}
+	if err := h.inboxUseCase.InitChannelInbox(ctx, event.UserID, conversationID); err != nil {
+		return fmt.Errorf("init channel inbox: %w", err)
+	}
+	return nil
+}
+
+func (h *conversationEventHandler) syncSetting(ctx context.Context, conversationID uint64, data []byte) error {
+	var event settingUpdatedEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		return fmt.Errorf("unmarshal setting event failed: %w", err)
+	}
+	if event.UserID == 0 {
+		return nil
+	}
+	if err := h.inboxUseCase.SyncInboxSetting(ctx, event.UserID, conversationID, event.Muted, event.MuteUntil, event.Pinned); err != nil {
+		return fmt.Errorf("sync inbox setting: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package mq
+
+import (
+	"fmt"
+	"strings"
+)
+
+// systemEventPayload 生成系统消息所需的会话事件字段
+type systemEventPayload struct {
+	OperatorID     uint64   `json:"operator_id"`
+	UserID         uint64   `json:"user_id"`
+	AddedUserIDs   []uint64 `json:"added_user_ids"`
+	RemovedUserIDs []uint64 `json:"removed_user_ids"`
+	DurationSecs   int64    `json:"duration_secs"`
+	MuteAll        bool     `json:"mute_all"`
+	Title          string   `json:"title"`
+	Content        string   `json:"content"`
+	NewOwnerID     uint64   `json:"new_owner_id"`
+	Auto           bool     `json:"auto"`
+	MessageTTL     int64    `json:"message_ttl"`
+}
+
+// systemMessage 会话事件对应的系统消息类型及文案
+type systemMessage struct {
+	sysType string
+	render  func(e *systemEventPayload) string
+}
+
+// systemMessages 会话事件类型到系统消息的映射，未列出的事件不生成系统消息
+// 文案中的用户以 {{uid:N}} 占位，由客户端替换为展示名
+var systemMessages = map[string]systemMessage{
+	"conversation.member_joined": {"member_join", func(e *systemEventPayload) string {
+		if e.OperatorID == e.UserID {
+			return uidText(e.UserID) + " 加入了群聊"
+		}
+		return uidText(e.OperatorID) + " 同意 " + uidText(e.UserID) + " 加入了群聊"
+	}},
+	"conversation.members_added": {"member_add", func(e *systemEventPayload) string {
+		return uidText(e.OperatorID) + " 邀请 " + uidsText(e.AddedUserIDs) + " 加入了群聊"
+	}},
+	"conversation.members_removed": {"member_remove", func(e *systemEventPayload) string {
+		return uidText(e.OperatorID) + " 将 " + uidsText(e.RemovedUserIDs) + " 移出了群聊"
+	}},
+	"conversation.member_left": {"member_leave", func(e *systemEventPayload) string {
+		return uidText(e.UserID) + " 退出了群聊"
+	}},
+	"conversation.member_muted": {"member_mute", func(e *systemEventPayload) string {
+		if e.DurationSecs <= 0 {
+			return uidText(e.UserID) + " 被 " + uidText(e.OperatorID) + " 永久禁言"
+		}
+		return uidText(e.UserID) + " 被 " + uidText(e.OperatorID) + " 禁言 " + durationText(e.DurationSecs)
+	}},
+	"conversation.member_unmuted": {"member_unmute", func(e *systemEventPayload) string {
+		return uidText(e.OperatorID) + " 解除了 " + uidText(e.UserID) + " 的禁言"
+	}},
+	"conversation.mute_all_updated": {"mute_all", func(e *systemEventPayload) string {
+		if e.MuteAll {
+			return uidText(e.OperatorID) + " 开启了全员禁言"
+		}
+		return uidText(e.OperatorID) + " 关闭了全员禁言"
+	}},
+	"conversation.title_changed": {"title_change", func(e *systemEventPayload) string {
+		return uidText(e.OperatorID) + " 将群名修改为「" + e.Title + "」"
+	}},
+	"conversation.message_ttl_changed": {"message_ttl", func(e *systemEventPayload) string {
+		if e.MessageTTL <= 0 {
+			return uidText(e.OperatorID) + " 关闭了消息自动删除"
+		}
+		return uidText(e.OperatorID) + " 将消息自动删除设为 " + durationText(e.MessageTTL)
+	}},
+	"conversation.owner_transferred": {"owner_transfer", func(e *systemEventPayload) string {
+		// 群主退出后自动继任
+		if e.Auto {
+			return uidText(e.NewOwnerID) + " 成为新群主"
+		}
+		return uidText(e.OperatorID) + " 将群主转让给 " + uidText(e.NewOwnerID)
+	}},
+	"conversation.dissolved": {"dissolve", func(e *systemEventPayload) string {
+		return uidText(e.OperatorID) + " 解散了群聊"
+	}},
+	"conversation.announcement_updated": {"announcement", func(e *systemEventPayload) string {
+		return uidText(e.OperatorID) + " 发布了群公告：" + e.Content
+	}},
+	"conversation.announcement_deleted": {"announcement_delete", func(e *systemEventPayload) string {
+		return uidText(e.OperatorID) + " 删除了群公告"
+	}},
+	"conversation.message_pinned": {"message_pin", func(e *systemEventPayload) string {
+		return uidText(e.OperatorID) + " 置顶了一条消息"
+	}},
+	"conversation.message_unpinned": {"message_unpin", func(e *systemEventPayload) string {
+		return uidText(e.OperatorID) + " 取消置顶了一条消息"
+	}},
+}
+
+// mentionAllEvents 需要以@所有人方式提醒成员的会话事件
+var mentionAllEvents = map[string]bool{
+	"conversation.announcement_updated": true,
+}
+
+func uidText(userID uint64) string {
+	return fmt.Sprintf("{{uid:%d}}", userID)
+}
+
+func uidsText(userIDs []uint64) string {
+	parts := make([]string, len(userIDs))
+	for i, id := range userIDs {
+		parts[i] = uidText(id)
+	}
+	return strings.Join(parts, "、")
+}
+
+// durationText 时长文案（禁言、消息自动删除），取最大的整单位
+func durationText(secs int64) string {
+	switch {
+	case secs >= 86400 && secs%86400 == 0:
+		return fmt.Sprintf("%d 天", secs/86400)
+	case secs >= 3600 && secs%3600 == 0:
+		return fmt.Sprintf("%d 小时", secs/3600)
+	case secs >= 60 && secs%60 == 0:
+		return fmt.Sprintf("%d 分钟", secs/60)
+	default:
+		return fmt.Sprintf("%d 秒", secs)
+	}
+}
@@ -0,0 +1,114 @@
+package mq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+
+	"github.com/EthanQC/IM/services/message_service/internal/ports/in"
+)
+
+// TopicUserEvents 用户事件Topic（由 identity_service 发布）
+const TopicUserEvents = "user-events"
+
+const (
+	// eventUserRegistered 用户注册事件
+	eventUserRegistered = "user.registered"
+	// eventUserUpdated 用户资料变更事件
+	eventUserUpdated = "user.updated"
+)
+
+// userEvent 用户事件中展示名同步所需的字段
+type userEvent struct {
+	Type        string  `json:"type"`
+	UserID      uint64  `json:"user_id"`
+	DisplayName *string `json:"display_name"`
+}
+
+// UserEventConsumer 消费用户事件，维护发送消息时使用的展示名缓存
+type UserEventConsumer struct {
+	consumerGroup sarama.ConsumerGroup
+	profileUC     in.UserProfileSyncUseCase
+	cancel        context.CancelFunc
+}
+
+// NewUserEventConsumer 创建用户事件消费者
+func NewUserEventConsumer(brokers []string, groupID string, profileUC in.UserProfileSyncUseCase) (*UserEventConsumer, error) {
+	config := sarama.NewConfig()
+	config.Version = sarama.V2_8_0_0
+	config.Consumer.Group.Rebalance.Strategy = sarama.NewBalanceStrategyRoundRobin()
+	config.Consumer.Offsets.Initial = sarama.OffsetNewest
+	config.Consumer.Return.Errors = true
+
+	consumerGroup, err := sarama.NewConsumerGroup(brokers, groupID, config)
+	if err != nil {
+		return nil, fmt.Errorf("create consumer group failed: %w", err)
+	}
+
+	return &UserEventConsumer{
+		consumerGroup: consumerGroup,
+		profileUC:     profileUC,
+	}, nil
+}
+
+// Start 启动消费
+func (c *UserEventConsumer) Start(ctx context.Context) {
+	ctx, c.cancel = context.WithCancel(ctx)
+	handler := &userEventHandler{profileUC: c.profileUC}
+
+	go func() {
+		for {
+			if err := c.consumerGroup.Consume(ctx, []string{TopicUserEvents}, handler); err != nil {
+				zap.L().Warn("Error from user event consumer", zap.Error(err))
+			}
+			if ctx.Err() != nil {
+				return
+			}
+		}
+	}()
+}
+
+// Stop 停止消费
+func (c *UserEventConsumer) Stop() error {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	return c.consumerGroup.Close()
+}
+
+type userEventHandler struct {
+	profileUC in.UserProfileSyncUseCase
+}
+
+func (h *userEventHandler) Setup(sarama.ConsumerGroupSession) error   { return nil }
+func (h *userEventHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+func (h *userEventHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for message := range claim.Messages() {
+		if err := h.handle(session.Context(), message.Value); err != nil {
+			// 展示名缓存带过期时间，单次同步失败只会让旧名称保留到缓存过期
+			zap.L().Warn("Handle user event failed",
+				zap.String("key", string(message.Key)),
+				zap.Error(err))
+		}
+		session.MarkMessage(message, "")
+	}
+	return nil
+}
+
+func (h *userEventHandler) handle(ctx context.Context, data []byte) error {
+	var event userEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		return fmt.Errorf("unmarshal user event failed: %w", err)
+	}
+	if event.Type != eventUserRegistered && event.Type != eventUserUpdated {
+		return nil
+	}
+	if event.UserID == 0 || event.DisplayName == nil {
+		return nil
+	}
+	return h.profileUC.SyncDisplayName(ctx, event.UserID, *event.DisplayName)
+}
@@ -0,0 +1,115 @@
+package reaper
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/EthanQC/IM/services/message_service/internal/ports/in"
+)
+
+// Config 过期消息清理配置
+type Config struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+// DefaultConfig 默认配置
+func DefaultConfig() Config {
+	return Config{
+		Interval:  30 * time.Second,
+		BatchSize: 200,
+	}
+}
+
+// ExpiryReaper 定期清理已过期的消息
+type ExpiryReaper struct {
+	config  Config
+	expiry  in.MessageExpiryUseCase
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	running bool
+}
+
+// NewExpiryReaper 创建过期消息清理器
+func NewExpiryReaper(expiry in.MessageExpiryUseCase, config Config) *ExpiryReaper {
+	return &ExpiryReaper{
+		config: config,
+		expiry: expiry,
+	}
+}
+
+// Start 启动清理
+func (r *ExpiryReaper) Start() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.running {
+		return fmt.Errorf("expiry reaper already running")
+	}
+	r.running = true
+	r.ctx, r.cancel = context.WithCancel(context.Background())
+
+	r.wg.Add(1)
+	go r.loop()
+
+	zap.L().Info("Expiry reaper started", zap.Duration("interval", r.config.Interval))
+	return nil
+}
+
+// Stop 停止清理，等待当前批次结束
+func (r *ExpiryReaper) Stop() {
+	r.mu.Lock()
+	if !r.running {
+		r.mu.Unlock()
+		return
+	}
+	r.running = false
+	r.mu.Unlock()
+
+	r.cancel()
+	r.wg.Wait()
+	zap.L().Info("Expiry reaper stopped")
+}
+
+func (r *ExpiryReaper) loop() {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+			r.reap()
+		}
+	}
+}
+
+// reap 连续清理直到某一批不满，避免积压时每个周期只处理一批
+func (r *ExpiryReaper) reap() {
+	total := 0
+	for r.ctx.Err() == nil {
+		ctx, cancel := context.WithTimeout(r.ctx, time.Minute)
+		n, err := r.expiry.ReapExpiredMessages(ctx, r.config.BatchSize)
+		cancel()
+		if err != nil {
+			zap.L().Warn("Reap expired messages failed", zap.Error(err))
+			break
+		}
+		total += n
+		if n < r.config.BatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		zap.L().Info("Reaped expired messages", zap.Int("count", total))
+	}
+}
@@ -320,6 +320,7 @@ func (c *Client) handleSendMessage(msg WSMessage) {
 		"message_id":    result.ID,
 		"seq":           result.Seq,
 		"created_at":    result.CreatedAt,
+		"expires_at":    result.ExpiresAt,
 	})
 	ack.Data = ackData
 
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/EthanQC/IM/services/message_service/internal/domain/entity"
 	"github.com/EthanQC/IM/services/message_service/internal/ports/out"
@@ -24,6 +25,7 @@ type MessageModel struct {
 	Content        string        `gorm:"column:content;type:json;not null"`
 	Status         int8          `gorm:"column:status;default:1"`
 	ReplyToMsgID   sql.NullInt64 `gorm:"column:reply_to_msg_id"`
+	ExpiresAt      *time.Time    `gorm:"column:expires_at"`
 	CreatedAt      time.Time     `gorm:"column:created_at;autoCreateTime"`
 	UpdatedAt      time.Time     `gorm:"column:updated_at;autoUpdateTime"`
 }
@@ -52,6 +54,7 @@ func (m *MessageModel) toEntity() *entity.Message {
 		Content:        content,
 		Status:         entity.MessageStatus(m.Status),
 		ReplyToMsgID:   replyToMsgID,
+		ExpiresAt:      m.ExpiresAt,
 		CreatedAt:      m.CreatedAt,
 		UpdatedAt:      m.UpdatedAt,
 	}
@@ -75,6 +78,7 @@ func messageModelFromEntity(e *entity.Message) *MessageModel {
 		Content:        string(contentBytes),
 		Status:         int8(e.Status),
 		ReplyToMsgID:   replyToMsgID,
+		ExpiresAt:      e.ExpiresAt,
 		CreatedAt:      e.CreatedAt,
 		UpdatedAt:      e.UpdatedAt,
 	}
@@ -91,7 +95,17 @@ func NewMessageRepositoryMySQL(db *gorm.DB) out.MessageRepository {
 
 func (r *MessageRepositoryMySQL) Create(ctx context.Context, msg *entity.Message) error {
 	model := messageModelFromEntity(msg)
-	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+		refs := mediaRefModels(model.ID, msg)
+		if len(refs) == 0 {
+			return nil
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&refs).Error
+	})
+	if err != nil {
 		return err
 	}
 	msg.ID = model.ID
@@ -130,6 +144,7 @@ func (r *MessageRepositoryMySQL) GetHistoryAfter(ctx context.Context, conversati
 	var models []MessageModel
 	err := r.db.WithContext(ctx).
 		Where("conversation_id = ? AND seq > ? AND status = ?", conversationID, afterSeq, entity.MessageStatusNormal).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now()).
 		Order("seq ASC").
 		Limit(limit).
 		Find(&models).Error
@@ -148,6 +163,7 @@ func (r *MessageRepositoryMySQL) GetHistoryBefore(ctx context.Context, conversat
 	var models []MessageModel
 	err := r.db.WithContext(ctx).
 		Where("conversation_id = ? AND seq < ? AND status = ?", conversationID, beforeSeq, entity.MessageStatusNormal).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now()).
 		Order("seq DESC").
 		Limit(limit).
 		Find(&models).Error
@@ -168,6 +184,77 @@ func (r *MessageRepositoryMySQL) Update(ctx context.Context, msg *entity.Message
 	return r.db.WithContext(ctx).Save(model).Error
 }
 
+func (r *MessageRepositoryMySQL) ListExpired(ctx context.Context, before time.Time, limit int) ([]*entity.Message, error) {
+	var models []MessageModel
+	err := r.db.WithContext(ctx).
+		Where("expires_at IS NOT NULL AND expires_at <= ?", before).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*entity.Message, len(models))
+	for i, m := range models {
+		messages[i] = m.toEntity()
+	}
+	return messages, nil
+}
+
+func (r *MessageRepositoryMySQL) DeleteByIDs(ctx context.Context, ids []uint64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("message_id IN ?", ids).Delete(&MediaRefModel{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id IN ?", ids).Delete(&MessageModel{}).Error
+	})
+}
+
+func (r *MessageRepositoryMySQL) ListMediaRefs(ctx context.Context, messageIDs []uint64) ([]*out.MediaRef, error) {
+	if len(messageIDs) == 0 {
+		return nil, nil
+	}
+	var models []MediaRefModel
+	if err := r.db.WithContext(ctx).Where("message_id IN ?", messageIDs).Find(&models).Error; err != nil {
+		return nil, err
+	}
+	refs := make([]*out.MediaRef, len(models))
+	for i, m := range models {
+		refs[i] = &out.MediaRef{
+			MessageID:      m.MessageID,
+			ConversationID: m.ConversationID,
+			OwnerID:        m.OwnerID,
+			ObjectKey:      m.ObjectKey,
+		}
+	}
+	return refs, nil
+}
+
+func (r *MessageRepositoryMySQL) ListReferencedObjectKeys(ctx context.Context, objectKeys []string, excludeMessageIDs []uint64) (map[string]bool, error) {
+	referenced := make(map[string]bool)
+	if len(objectKeys) == 0 {
+		return referenced, nil
+	}
+	query := r.db.WithContext(ctx).Model(&MediaRefModel{}).
+		Distinct("object_key").
+		Where("object_key IN ?", objectKeys)
+	if len(excludeMessageIDs) > 0 {
+		query = query.Where("message_id NOT IN ?", excludeMessageIDs)
+	}
+	var keys []string
+	if err := query.Pluck("object_key", &keys).Error; err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		referenced[key] = true
+	}
+	return referenced, nil
+}
+
 func (r *MessageRepositoryMySQL) GetLatestSeq(ctx context.Context, conversationID uint64) (uint64, error) {
 	var seq uint64
 	err := r.db.WithContext(ctx).
@@ -178,6 +265,39 @@ func (r *MessageRepositoryMySQL) GetLatestSeq(ctx context.Context, conversationI
 	return seq, err
 }
 
+// MediaRefModel 消息文件引用模型
+type MediaRefModel struct {
+	MessageID      uint64    `gorm:"column:message_id;primaryKey"`
+	ObjectKey      string    `gorm:"column:object_key;primaryKey;type:varchar(255)"`
+	ConversationID uint64    `gorm:"column:conversation_id;not null"`
+	OwnerID        uint64    `gorm:"column:owner_id;not null"`
+	CreatedAt      time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (MediaRefModel) TableName() string {
+	return "message_media_refs"
+}
+
+// mediaRefModels 消息引用的文件对象，同一对象只记录一次
+func mediaRefModels(messageID uint64, msg *entity.Message) []MediaRefModel {
+	keys := msg.MediaObjectKeys()
+	refs := make([]MediaRefModel, 0, len(keys))
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		refs = append(refs, MediaRefModel{
+			MessageID:      messageID,
+			ObjectKey:      key,
+			ConversationID: msg.ConversationID,
+			OwnerID:        msg.SenderID,
+		})
+	}
+	return refs
+}
+
 // SequenceModel 序列号模型
 type SequenceModel struct {
 	ConversationID uint64    `gorm:"column:conversation_id;primaryKey"`
@@ -254,16 +374,38 @@ func (r *SequenceRepositoryMySQL) GetCurrentSeq(ctx context.Context, conversatio
 	return model.NextSeq - 1, nil
 }
 
+func (r *SequenceRepositoryMySQL) BatchGetCurrentSeqs(ctx context.Context, conversationIDs []uint64) (map[uint64]uint64, error) {
+	seqMap := make(map[uint64]uint64, len(conversationIDs))
+	if len(conversationIDs) == 0 {
+		return seqMap, nil
+	}
+	var models []SequenceModel
+	if err := r.db.WithContext(ctx).Where("conversation_id IN ?", conversationIDs).Find(&models).Error; err != nil {
+		return nil, err
+	}
+	for _, m := range models {
+		seqMap[m.ConversationID] = m.NextSeq - 1
+	}
+	return seqMap, nil
+}
+
 // InboxModel 收件箱模型
 type InboxModel struct {
-	UserID           uint64    `gorm:"column:user_id;primaryKey"`
-	ConversationID   uint64    `gorm:"column:conversation_id;primaryKey"`
-	LastReadSeq      uint64    `gorm:"column:last_read_seq;default:0"`
-	LastDeliveredSeq uint64    `gorm:"column:last_delivered_seq;default:0"`
-	UnreadCount      int       `gorm:"column:unread_count;default:0"`
-	IsMuted          int8      `gorm:"column:is_muted;default:0"`
-	IsPinned         int8      `gorm:"column:is_pinned;default:0"`
-	UpdatedAt        time.Time `gorm:"column:updated_at;autoUpdateTime"`
+	UserID           uint64     `gorm:"column:user_id;primaryKey"`
+	ConversationID   uint64     `gorm:"column:conversation_id;primaryKey"`
+	LastReadSeq      uint64     `gorm:"column:last_read_seq;default:0"`
+	LastDeliveredSeq uint64     `gorm:"column:last_delivered_seq;default:0"`
+	UnreadCount      int        `gorm:"column:unread_count;default:0"`
+	IsMuted          int8       `gorm:"column:is_muted;default:0"`
+	MuteUntil        *time.Time `gorm:"column:mute_until"`
+	IsPinned         int8       `gorm:"column:is_pinned;default:0"`
+	LastMentionSeq   uint64     `gorm:"column:last_mention_seq;default:0"`
+	UpdatedAt        time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// isMutedAt 指定时间是否处于免打扰
+func (m *InboxModel) isMutedAt(now time.Time) bool {
+	return m.IsMuted == 1 && (m.MuteUntil == nil || now.Before(*m.MuteUntil))
 }
 
 func (InboxModel) TableName() string {
@@ -277,8 +419,9 @@ func (m *InboxModel) toDTO() *out.Inbox {
 		LastReadSeq:      m.LastReadSeq,
 		LastDeliveredSeq: m.LastDeliveredSeq,
 		UnreadCount:      m.UnreadCount,
-		IsMuted:          m.IsMuted == 1,
+		IsMuted:          m.isMutedAt(time.Now()),
 		IsPinned:         m.IsPinned == 1,
+		LastMentionSeq:   m.LastMentionSeq,
 	}
 }
 
@@ -367,7 +510,7 @@ func (r *InboxRepositoryMySQL) GetTotalUnread(ctx context.Context, userID uint64
 	var total int
 	err := r.db.WithContext(ctx).
 		Model(&InboxModel{}).
-		Where("user_id = ? AND is_muted = 0", userID).
+		Where("user_id = ? AND NOT (is_muted = 1 AND (mute_until IS NULL OR mute_until > ?))", userID, time.Now()).
 		Select("COALESCE(SUM(unread_count), 0)").
 		Scan(&total).Error
 	return total, err
@@ -384,6 +527,7 @@ func (r *InboxRepositoryMySQL) GetUserInboxes(ctx context.Context, userID uint64
 		return nil, err
 	}
 
+	now := time.Now()
 	inboxes := make([]*entity.Inbox, len(models))
 	for i, m := range models {
 		inboxes[i] = &entity.Inbox{
@@ -392,10 +536,56 @@ func (r *InboxRepositoryMySQL) GetUserInboxes(ctx context.Context, userID uint64
 			LastReadSeq:      m.LastReadSeq,
 			LastDeliveredSeq: m.LastDeliveredSeq,
 			UnreadCount:      int(m.UnreadCount),
-			IsMuted:          m.IsMuted == 1,
+			IsMuted:          m.isMutedAt(now),
 			IsPinned:         m.IsPinned == 1,
 		}
 	}
 
 	return inboxes, nil
 }
+
+// UpdateSetting 同步免打扰、置顶状态
+func (r *InboxRepositoryMySQL) UpdateSetting(ctx context.Context, userID, conversationID uint64, muted bool, muteUntil int64, pinned bool) error {
+	model := InboxModel{UserID: userID, ConversationID: conversationID}
+	if muted {
+		model.IsMuted = 1
+		if muteUntil > 0 {
+			until := time.Unix(muteUntil, 0)
+			model.MuteUntil = &until
+		}
+	}
+	if pinned {
+		model.IsPinned = 1
+	}
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.AssignmentColumns([]string{"is_muted", "mute_until", "is_pinned", "updated_at"}),
+	}).Create(&model).Error
+}
+
+// MarkMention 记录被@的消息序号，只前进不回退
+func (r *InboxRepositoryMySQL) MarkMention(ctx context.Context, userID, conversationID, seq uint64) error {
+	return r.db.WithContext(ctx).
+		Model(&InboxModel{}).
+		Where("user_id = ? AND conversation_id = ? AND last_mention_seq < ?", userID, conversationID, seq).
+		Update("last_mention_seq", seq).Error
+}
+
+// BatchGetInboxes 批量获取收件箱
+func (r *InboxRepositoryMySQL) BatchGetInboxes(ctx context.Context, userID uint64, conversationIDs []uint64) (map[uint64]*out.Inbox, error) {
+	inboxes := make(map[uint64]*out.Inbox, len(conversationIDs))
+	if len(conversationIDs) == 0 {
+		return inboxes, nil
+	}
+
+	var models []InboxModel
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND conversation_id IN ?", userID, conversationIDs).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	for i := range models {
+		inboxes[models[i].ConversationID] = models[i].toDTO()
+	}
+	return inboxes, nil
+}
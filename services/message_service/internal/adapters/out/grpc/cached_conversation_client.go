@@ -0,0 +1,83 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/EthanQC/IM/services/message_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/message_service/internal/ports/out"
+)
+
+type cachedState struct {
+	state     *entity.ConversationState
+	expiresAt time.Time
+}
+
+// CachedConversationClient 带本地短时缓存的会话服务适配器
+// 发送热路径不必每条消息都请求 conversation_service，会话事件到达时主动失效
+type CachedConversationClient struct {
+	next out.ConversationMemberRepository
+	ttl  time.Duration
+
+	mu      sync.RWMutex
+	entries map[uint64]cachedState
+}
+
+func NewCachedConversationClient(next out.ConversationMemberRepository, ttl time.Duration) *CachedConversationClient {
+	return &CachedConversationClient{
+		next:    next,
+		ttl:     ttl,
+		entries: make(map[uint64]cachedState),
+	}
+}
+
+func (c *CachedConversationClient) ListMemberIDs(ctx context.Context, conversationID uint64) ([]uint64, error) {
+	state, err := c.GetConversationState(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	return state.MemberIDs(), nil
+}
+
+func (c *CachedConversationClient) GetConversationState(ctx context.Context, conversationID uint64) (*entity.ConversationState, error) {
+	now := time.Now()
+
+	c.mu.RLock()
+	entry, ok := c.entries[conversationID]
+	c.mu.RUnlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.state, nil
+	}
+
+	state, err := c.next.GetConversationState(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.entries[conversationID] = cachedState{state: state, expiresAt: now.Add(c.ttl)}
+	// 顺带清理过期条目，避免冷门会话长期占用内存
+	if len(c.entries) > 10000 {
+		for id, e := range c.entries {
+			if now.After(e.expiresAt) {
+				delete(c.entries, id)
+			}
+		}
+	}
+	c.mu.Unlock()
+
+	return state, nil
+}
+
+// IsMember 直接查询会话服务，频道订阅者不在缓存的会话状态中
+func (c *CachedConversationClient) IsMember(ctx context.Context, conversationID, userID uint64) (bool, error) {
+	return c.next.IsMember(ctx, conversationID, userID)
+}
+
+// Invalidate 使会话状态缓存失效
+func (c *CachedConversationClient) Invalidate(conversationID uint64) {
+	c.mu.Lock()
+	delete(c.entries, conversationID)
+	c.mu.Unlock()
+}
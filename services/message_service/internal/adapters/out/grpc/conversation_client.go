@@ -5,6 +5,7 @@ import (
 	"time"
 
 	imv1 "github.com/EthanQC/IM/api/gen/im/v1"
+	"github.com/EthanQC/IM/services/message_service/internal/domain/entity"
 	"github.com/EthanQC/IM/services/message_service/internal/ports/out"
 )
 
@@ -36,3 +37,55 @@ func (c *ConversationClient) ListMemberIDs(ctx context.Context, conversationID u
 	}
 	return memberIDs, nil
 }
+
+func (c *ConversationClient) IsMember(ctx context.Context, conversationID, userID uint64) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	resp, err := c.client.IsMember(ctx, &imv1.IsMemberRequest{
+		ConversationId: int64(conversationID),
+		UserId:         int64(userID),
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.IsMember, nil
+}
+
+func (c *ConversationClient) GetConversationState(ctx context.Context, conversationID uint64) (*entity.ConversationState, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	resp, err := c.client.GetConversationState(ctx, &imv1.GetConversationStateRequest{ConversationId: int64(conversationID)})
+	if err != nil {
+		return nil, err
+	}
+
+	state := &entity.ConversationState{
+		ConversationID: conversationID,
+		Dissolved:      resp.Dissolved,
+		MuteAll:        resp.MuteAll,
+		Channel:        resp.Type == imv1.ConversationType_CONVERSATION_TYPE_CHANNEL,
+		MessageTTL:     time.Duration(resp.MessageTtlSeconds) * time.Second,
+		Members:        make(map[uint64]*entity.MemberState, len(resp.Members)),
+	}
+	for _, m := range resp.Members {
+		if m == nil || m.UserId <= 0 {
+			continue
+		}
+		member := &entity.MemberState{
+			UserID:    uint64(m.UserId),
+			IsManager: m.Role == imv1.MemberRole_MEMBER_ROLE_ADMIN || m.Role == imv1.MemberRole_MEMBER_ROLE_OWNER,
+			Muted:     m.Muted,
+			DND:       m.Dnd,
+
+			DisplayName: m.DisplayName,
+		}
+		if m.MutedUntil != nil {
+			until := m.MutedUntil.AsTime()
+			member.MutedUntil = &until
+		}
+		state.Members[member.UserID] = member
+	}
+	return state, nil
+}
@@ -0,0 +1,43 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	imv1 "github.com/EthanQC/IM/api/gen/im/v1"
+	"github.com/EthanQC/IM/services/message_service/internal/ports/out"
+)
+
+// deleteObjectsBatchSize 单次请求删除的对象数，与文件服务的上限一致
+const deleteObjectsBatchSize = 500
+
+// FileClient gRPC文件服务适配器
+type FileClient struct {
+	client  imv1.FileServiceClient
+	timeout time.Duration
+}
+
+func NewFileClient(client imv1.FileServiceClient, timeout time.Duration) out.FileCleaner {
+	return &FileClient{client: client, timeout: timeout}
+}
+
+func (c *FileClient) DeleteObjects(ctx context.Context, ownerID uint64, objectKeys []string) error {
+	for start := 0; start < len(objectKeys); start += deleteObjectsBatchSize {
+		end := start + deleteObjectsBatchSize
+		if end > len(objectKeys) {
+			end = len(objectKeys)
+		}
+		if err := c.deleteObjects(ctx, ownerID, objectKeys[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *FileClient) deleteObjects(ctx context.Context, ownerID uint64, objectKeys []string) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	_, err := c.client.DeleteObjects(ctx, &imv1.DeleteObjectsRequest{ObjectKeys: objectKeys, OwnerId: int64(ownerID)})
+	return err
+}
@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	imv1 "github.com/EthanQC/IM/api/gen/im/v1"
+	"github.com/EthanQC/IM/services/message_service/internal/ports/out"
+)
+
+// IdentityClient gRPC身份服务适配器
+type IdentityClient struct {
+	client  imv1.IdentityServiceClient
+	timeout time.Duration
+}
+
+func NewIdentityClient(client imv1.IdentityServiceClient, timeout time.Duration) out.UserProfileReader {
+	return &IdentityClient{client: client, timeout: timeout}
+}
+
+func (c *IdentityClient) GetDisplayNames(ctx context.Context, userIDs []uint64) (map[uint64]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req := &imv1.BatchGetProfilesRequest{UserIds: make([]int64, len(userIDs))}
+	for i, id := range userIDs {
+		req.UserIds[i] = int64(id)
+	}
+	resp, err := c.client.BatchGetProfiles(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[uint64]string, len(resp.Users))
+	for _, u := range resp.Users {
+		names[uint64(u.Id)] = u.DisplayName
+	}
+	return names, nil
+}
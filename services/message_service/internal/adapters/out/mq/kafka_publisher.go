@@ -16,6 +16,9 @@ const (
 	TopicMessageNew     = "im.message.new"
 	TopicMessageRead    = "im.message.read"
 	TopicMessageRevoked = "im.message.revoked"
+	TopicMessageExpired = "im.message.expired"
+	// TopicDeadLetter 消费失败且重试耗尽的事件
+	TopicDeadLetter = "im.message_service.dead_letter"
 )
 
 // KafkaEventPublisher Kafka事件发布器
@@ -113,6 +116,55 @@ func (p *KafkaEventPublisher) PublishMessageRevoked(ctx context.Context, event *
 	return nil
 }
 
+func (p *KafkaEventPublisher) PublishMessagesExpired(ctx context.Context, event *out.MessagesExpiredEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal messages expired event failed: %w", err)
+	}
+
+	msg := &sarama.ProducerMessage{
+		Topic: TopicMessageExpired,
+		Key:   sarama.StringEncoder(fmt.Sprintf("%d", event.ConversationID)),
+		Value: sarama.ByteEncoder(data),
+		Headers: []sarama.RecordHeader{
+			{Key: []byte("event_type"), Value: []byte("messages_expired")},
+			{Key: []byte("timestamp"), Value: []byte(time.Now().UTC().Format(time.RFC3339))},
+		},
+	}
+
+	_, _, err = p.producer.SendMessage(msg)
+	if err != nil {
+		return fmt.Errorf("publish messages expired event failed: %w", err)
+	}
+
+	return nil
+}
+
+func (p *KafkaEventPublisher) PublishDeadLetter(ctx context.Context, event *out.DeadLetterEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal dead letter event failed: %w", err)
+	}
+
+	msg := &sarama.ProducerMessage{
+		Topic: TopicDeadLetter,
+		Key:   sarama.StringEncoder(event.OriginalKey),
+		Value: sarama.ByteEncoder(data),
+		Headers: []sarama.RecordHeader{
+			{Key: []byte("event_type"), Value: []byte("dead_letter")},
+			{Key: []byte("original_topic"), Value: []byte(event.OriginalTopic)},
+			{Key: []byte("timestamp"), Value: []byte(time.Now().UTC().Format(time.RFC3339))},
+		},
+	}
+
+	_, _, err = p.producer.SendMessage(msg)
+	if err != nil {
+		return fmt.Errorf("publish dead letter event failed: %w", err)
+	}
+
+	return nil
+}
+
 func (p *KafkaEventPublisher) Close() error {
 	return p.producer.Close()
 }
@@ -29,9 +29,16 @@ type InboxCacheItem struct {
 	LastDeliveredSeq uint64 `json:"last_delivered_seq"`
 	UnreadCount      int    `json:"unread_count"`
 	IsMuted          bool   `json:"is_muted"`
+	MuteUntil        int64  `json:"mute_until,omitempty"` // 免打扰截止时间，0表示永久
 	IsPinned         bool   `json:"is_pinned"`
 	LastMsgSeq       uint64 `json:"last_msg_seq"`
 	LastMsgTime      int64  `json:"last_msg_time"`
+	LastMentionSeq   uint64 `json:"last_mention_seq,omitempty"` // 最近一次被@的消息序号
+}
+
+// isMutedAt 指定时间是否处于免打扰
+func (item *InboxCacheItem) isMutedAt(now int64) bool {
+	return item.IsMuted && (item.MuteUntil == 0 || now < item.MuteUntil)
 }
 
 // Lua脚本：原子性更新已读位置并计算未读数
@@ -152,6 +159,53 @@ redis.call('HSET', inbox_key, conv_id, cjson.encode(inbox))
 return 0
 `)
 
+// Lua脚本：原子性更新免打扰、置顶状态，收件箱不存在时创建
+var updateSettingScript = redis.NewScript(`
+local inbox_key = KEYS[1]
+local conv_id = ARGV[1]
+
+local data = redis.call('HGET', inbox_key, conv_id)
+local inbox
+if not data then
+    inbox = {
+        conversation_id = tonumber(conv_id),
+        last_read_seq = 0,
+        last_delivered_seq = 0,
+        unread_count = 0,
+        last_msg_seq = 0,
+        last_msg_time = 0
+    }
+else
+    inbox = cjson.decode(data)
+end
+
+inbox.is_muted = ARGV[2] == '1'
+inbox.mute_until = tonumber(ARGV[3])
+inbox.is_pinned = ARGV[4] == '1'
+
+redis.call('HSET', inbox_key, conv_id, cjson.encode(inbox))
+return 1
+`)
+
+// Lua脚本：原子性记录被@的消息序号，只前进不回退
+var markMentionScript = redis.NewScript(`
+local inbox_key = KEYS[1]
+local conv_id = ARGV[1]
+local seq = tonumber(ARGV[2])
+
+local data = redis.call('HGET', inbox_key, conv_id)
+if not data then
+    return 0
+end
+
+local inbox = cjson.decode(data)
+if seq > (inbox.last_mention_seq or 0) then
+    inbox.last_mention_seq = seq
+    redis.call('HSET', inbox_key, conv_id, cjson.encode(inbox))
+end
+return 1
+`)
+
 // InboxRepositoryRedis Redis收件箱仓储实现
 type InboxRepositoryRedis struct {
 	client *redis.Client
@@ -229,8 +283,9 @@ func (r *InboxRepositoryRedis) GetOrCreate(ctx context.Context, userID, conversa
 		LastReadSeq:      item.LastReadSeq,
 		LastDeliveredSeq: item.LastDeliveredSeq,
 		UnreadCount:      item.UnreadCount,
-		IsMuted:          item.IsMuted,
+		IsMuted:          item.isMutedAt(time.Now().Unix()),
 		IsPinned:         item.IsPinned,
+		LastMentionSeq:   item.LastMentionSeq,
 	}, nil
 }
 
@@ -367,6 +422,7 @@ func (r *InboxRepositoryRedis) GetAllInboxes(ctx context.Context, userID uint64)
 		return nil, fmt.Errorf("get all inboxes failed: %w", err)
 	}
 
+	now := time.Now().Unix()
 	inboxes := make([]*out.Inbox, 0, len(data))
 	for _, d := range data {
 		var item InboxCacheItem
@@ -380,7 +436,7 @@ func (r *InboxRepositoryRedis) GetAllInboxes(ctx context.Context, userID uint64)
 			LastReadSeq:      item.LastReadSeq,
 			LastDeliveredSeq: item.LastDeliveredSeq,
 			UnreadCount:      item.UnreadCount,
-			IsMuted:          item.IsMuted,
+			IsMuted:          item.isMutedAt(now),
 			IsPinned:         item.IsPinned,
 		})
 	}
@@ -423,6 +479,7 @@ func (r *InboxRepositoryRedis) BatchGetInboxes(ctx context.Context, userID uint6
 		return nil, fmt.Errorf("batch get inboxes failed: %w", err)
 	}
 
+	now := time.Now().Unix()
 	inboxMap := make(map[uint64]*out.Inbox, len(conversationIDs))
 	for i, result := range results {
 		if result == nil {
@@ -445,8 +502,9 @@ func (r *InboxRepositoryRedis) BatchGetInboxes(ctx context.Context, userID uint6
 			LastReadSeq:      item.LastReadSeq,
 			LastDeliveredSeq: item.LastDeliveredSeq,
 			UnreadCount:      item.UnreadCount,
-			IsMuted:          item.IsMuted,
+			IsMuted:          item.isMutedAt(now),
 			IsPinned:         item.IsPinned,
+			LastMentionSeq:   item.LastMentionSeq,
 		}
 	}
 
@@ -480,3 +538,35 @@ func (r *InboxRepositoryRedis) GetUserInboxes(ctx context.Context, userID uint64
 
 	return result, nil
 }
+
+// UpdateSetting 同步免打扰、置顶状态（实现 InboxRepository 接口）
+func (r *InboxRepositoryRedis) UpdateSetting(ctx context.Context, userID, conversationID uint64, muted bool, muteUntil int64, pinned bool) error {
+	key := r.getInboxKey(userID)
+	convIDStr := strconv.FormatUint(conversationID, 10)
+
+	mutedFlag, pinnedFlag := 0, 0
+	if muted {
+		mutedFlag = 1
+	}
+	if pinned {
+		pinnedFlag = 1
+	}
+
+	_, err := updateSettingScript.Run(ctx, r.client, []string{key}, convIDStr, mutedFlag, muteUntil, pinnedFlag).Result()
+	if err != nil && err != redis.Nil {
+		return fmt.Errorf("update inbox setting failed: %w", err)
+	}
+	return nil
+}
+
+// MarkMention 记录被@的消息序号（实现 InboxRepository 接口）
+func (r *InboxRepositoryRedis) MarkMention(ctx context.Context, userID, conversationID, seq uint64) error {
+	key := r.getInboxKey(userID)
+	convIDStr := strconv.FormatUint(conversationID, 10)
+
+	_, err := markMentionScript.Run(ctx, r.client, []string{key}, convIDStr, seq).Result()
+	if err != nil && err != redis.Nil {
+		return fmt.Errorf("mark mention failed: %w", err)
+	}
+	return nil
+}
@@ -109,6 +109,38 @@ func (r *SequenceRepositoryRedis) GetCurrentSeq(ctx context.Context, conversatio
 	return seq, nil
 }
 
+// BatchGetCurrentSeqs 批量获取当前序号（MGET一次往返）
+func (r *SequenceRepositoryRedis) BatchGetCurrentSeqs(ctx context.Context, conversationIDs []uint64) (map[uint64]uint64, error) {
+	seqMap := make(map[uint64]uint64, len(conversationIDs))
+	if len(conversationIDs) == 0 {
+		return seqMap, nil
+	}
+
+	keys := make([]string, len(conversationIDs))
+	for i, convID := range conversationIDs {
+		keys[i] = r.getKey(convID)
+	}
+
+	values, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("batch get current seqs failed: %w", err)
+	}
+
+	for i, v := range values {
+		str, ok := v.(string)
+		if !ok {
+			continue
+		}
+		seq, err := strconv.ParseUint(str, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse seq failed: %w", err)
+		}
+		seqMap[conversationIDs[i]] = seq
+	}
+
+	return seqMap, nil
+}
+
 // BatchGetNextSeqs 批量获取下一个序号
 func (r *SequenceRepositoryRedis) BatchGetNextSeqs(ctx context.Context, conversationIDs []uint64) (map[uint64]uint64, error) {
 	if len(conversationIDs) == 0 {
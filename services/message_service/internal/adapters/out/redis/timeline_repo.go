@@ -32,6 +32,7 @@ type MessageCacheItem struct {
 	Content        entity.MessageContent `json:"content"`
 	Status         int8                  `json:"status"`
 	ReplyToMsgID   *uint64               `json:"reply_to_msg_id,omitempty"`
+	ExpiresAt      int64                 `json:"expires_at,omitempty"`
 	CreatedAt      int64                 `json:"created_at"`
 }
 
@@ -69,6 +70,9 @@ func (r *TimelineRepositoryRedis) AddMessage(ctx context.Context, conversationID
 		ReplyToMsgID:   msg.ReplyToMsgID,
 		CreatedAt:      msg.CreatedAt.Unix(),
 	}
+	if msg.ExpiresAt != nil {
+		item.ExpiresAt = msg.ExpiresAt.Unix()
+	}
 
 	data, err := json.Marshal(item)
 	if err != nil {
@@ -241,6 +245,10 @@ func (r *TimelineRepositoryRedis) parseMessages(results []string) ([]*entity.Mes
 			ReplyToMsgID:   item.ReplyToMsgID,
 			CreatedAt:      time.Unix(item.CreatedAt, 0),
 		}
+		if item.ExpiresAt > 0 {
+			expiresAt := time.Unix(item.ExpiresAt, 0)
+			msg.ExpiresAt = &expiresAt
+		}
 
 		messages = append(messages, msg)
 	}
@@ -270,6 +278,9 @@ func (r *TimelineRepositoryRedis) BatchAddMessages(ctx context.Context, conversa
 			ReplyToMsgID:   msg.ReplyToMsgID,
 			CreatedAt:      msg.CreatedAt.Unix(),
 		}
+		if msg.ExpiresAt != nil {
+			item.ExpiresAt = msg.ExpiresAt.Unix()
+		}
 
 		data, err := json.Marshal(item)
 		if err != nil {
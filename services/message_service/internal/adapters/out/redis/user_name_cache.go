@@ -0,0 +1,68 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/EthanQC/IM/services/message_service/internal/ports/out"
+)
+
+const (
+	// 用户展示名Key前缀 (String: value=displayName)
+	userNameKeyPrefix = "im:user:name:"
+	// 展示名缓存过期时间，资料变更事件到达时主动覆盖
+	userNameTTL = 7 * 24 * time.Hour
+)
+
+// UserNameCacheRedis Redis用户展示名缓存实现
+type UserNameCacheRedis struct {
+	client *redis.Client
+}
+
+// 确保实现接口
+var _ out.UserNameCache = (*UserNameCacheRedis)(nil)
+
+func NewUserNameCacheRedis(client *redis.Client) *UserNameCacheRedis {
+	return &UserNameCacheRedis{client: client}
+}
+
+func userNameKey(userID uint64) string {
+	return userNameKeyPrefix + strconv.FormatUint(userID, 10)
+}
+
+func (c *UserNameCacheRedis) GetDisplayNames(ctx context.Context, userIDs []uint64) (map[uint64]string, error) {
+	result := make(map[uint64]string, len(userIDs))
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+
+	keys := make([]string, len(userIDs))
+	for i, id := range userIDs {
+		keys[i] = userNameKey(id)
+	}
+	values, err := c.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	for i, v := range values {
+		if name, ok := v.(string); ok {
+			result[userIDs[i]] = name
+		}
+	}
+	return result, nil
+}
+
+func (c *UserNameCacheRedis) SetDisplayNames(ctx context.Context, names map[uint64]string) error {
+	if len(names) == 0 {
+		return nil
+	}
+	pipe := c.client.Pipeline()
+	for id, name := range names {
+		pipe.Set(ctx, userNameKey(id), name, userNameTTL)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
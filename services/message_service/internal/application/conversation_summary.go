@@ -0,0 +1,150 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/EthanQC/IM/services/message_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/message_service/internal/ports/in"
+)
+
+var _ in.ConversationSummaryUseCase = (*EnhancedMessageUseCaseImpl)(nil)
+
+// GetConversationSummaries 批量获取会话摘要
+// 未读数与@提醒来自收件箱，最后一条消息优先从Timeline读取，缺失时回源MySQL
+// 频道不写订阅者收件箱，未读数为最后一条消息序号与已读位置之差
+func (uc *EnhancedMessageUseCaseImpl) GetConversationSummaries(ctx context.Context, userID uint64, conversationIDs, channelIDs []uint64) ([]*in.ConversationSummary, error) {
+	inboxes, err := uc.inboxRepo.BatchGetInboxes(ctx, userID, conversationIDs)
+	if err != nil {
+		return nil, fmt.Errorf("batch get inboxes: %w", err)
+	}
+	channels := make(map[uint64]bool, len(channelIDs))
+	for _, id := range channelIDs {
+		channels[id] = true
+	}
+
+	summaries := make([]*in.ConversationSummary, len(conversationIDs))
+	var (
+		wg      sync.WaitGroup
+		errChan = make(chan error, len(conversationIDs))
+		sem     = make(chan struct{}, inboxConcurrencyLimit)
+	)
+	for i, convID := range conversationIDs {
+		summary := &in.ConversationSummary{ConversationID: convID}
+		if inbox, ok := inboxes[convID]; ok {
+			summary.UnreadCount = inbox.UnreadCount
+			summary.HasMention = inbox.HasUnreadMention()
+		}
+		summaries[i] = summary
+
+		wg.Add(1)
+		go func(s *in.ConversationSummary) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			msg, err := uc.getLatestMessage(ctx, s.ConversationID)
+			if err != nil {
+				errChan <- fmt.Errorf("get latest message of conversation %d: %w", s.ConversationID, err)
+				return
+			}
+			s.LastMessage = msg
+			if channels[s.ConversationID] && msg != nil {
+				var readSeq uint64
+				if inbox, ok := inboxes[s.ConversationID]; ok {
+					readSeq = inbox.LastReadSeq
+				}
+				s.UnreadCount, s.HasMention = 0, false
+				if msg.Seq > readSeq {
+					s.UnreadCount = int(msg.Seq - readSeq)
+				}
+			}
+		}(summary)
+	}
+
+	wg.Wait()
+	close(errChan)
+
+	for err := range errChan {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return summaries, nil
+}
+
+// GetUnreadCounts 批量获取未读数
+// 普通会话直接读收件箱；频道按当前序号与已读位置计算，不读取消息内容
+func (uc *EnhancedMessageUseCaseImpl) GetUnreadCounts(ctx context.Context, userID uint64, conversationIDs, channelIDs []uint64) ([]*in.UnreadCount, error) {
+	inboxes, err := uc.inboxRepo.BatchGetInboxes(ctx, userID, conversationIDs)
+	if err != nil {
+		return nil, fmt.Errorf("batch get inboxes: %w", err)
+	}
+	channels := make(map[uint64]bool, len(channelIDs))
+	for _, id := range channelIDs {
+		channels[id] = true
+	}
+	var channelSeqs map[uint64]uint64
+	if len(channelIDs) > 0 {
+		channelSeqs, err = uc.seqRepo.BatchGetCurrentSeqs(ctx, channelIDs)
+		if err != nil {
+			return nil, fmt.Errorf("batch get current seqs: %w", err)
+		}
+	}
+
+	var counts []*in.UnreadCount
+	for _, convID := range conversationIDs {
+		inbox, ok := inboxes[convID]
+		if channels[convID] {
+			seq := channelSeqs[convID]
+			var readSeq uint64
+			if ok {
+				readSeq = inbox.LastReadSeq
+			}
+			if seq > readSeq {
+				counts = append(counts, &in.UnreadCount{ConversationID: convID, UnreadCount: int(seq - readSeq)})
+			}
+			continue
+		}
+		if ok && inbox.UnreadCount > 0 {
+			counts = append(counts, &in.UnreadCount{
+				ConversationID: convID,
+				UnreadCount:    inbox.UnreadCount,
+				HasMention:     inbox.HasUnreadMention(),
+			})
+		}
+	}
+	return counts, nil
+}
+
+// getLatestMessage 获取会话最后一条消息，会话暂无消息时返回 nil
+func (uc *EnhancedMessageUseCaseImpl) getLatestMessage(ctx context.Context, conversationID uint64) (*entity.Message, error) {
+	if uc.timelineRepo != nil {
+		messages, err := uc.timelineRepo.GetLatestMessages(ctx, conversationID, 1)
+		// 最后一条已过期但尚未清理时回源MySQL，跳过过期消息
+		if err == nil && len(messages) > 0 && !messages[len(messages)-1].IsExpiredAt(time.Now()) {
+			return messages[len(messages)-1], nil
+		}
+	}
+
+	// Timeline缓存未命中，从MySQL读取
+	latestSeq, err := uc.msgRepo.GetLatestSeq(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	if latestSeq == 0 {
+		return nil, nil
+	}
+	messages, err := uc.msgRepo.GetHistoryBefore(ctx, conversationID, latestSeq+1, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(messages) == 0 {
+		return nil, nil
+	}
+	return messages[len(messages)-1], nil
+}
@@ -27,9 +27,17 @@ type EnhancedMessageUseCaseImpl struct {
 	timelineRepo out.TimelineRepository
 	memberRepo   out.ConversationMemberRepository
 	eventPub     out.EventPublisher
+	fileCleaner  out.FileCleaner
+
+	nameCache     out.UserNameCache
+	profileReader out.UserProfileReader
+	warming       sync.Map // 正在回填展示名的用户ID
 }
 
-var _ in.MessageUseCase = (*EnhancedMessageUseCaseImpl)(nil)
+var (
+	_ in.MessageUseCase       = (*EnhancedMessageUseCaseImpl)(nil)
+	_ in.SystemMessageUseCase = (*EnhancedMessageUseCaseImpl)(nil)
+)
 
 func NewEnhancedMessageUseCase(
 	msgRepo out.MessageRepository,
@@ -49,6 +57,11 @@ func NewEnhancedMessageUseCase(
 	}
 }
 
+// SetFileCleaner 设置文件清理器，过期消息引用的文件随消息一起删除
+func (uc *EnhancedMessageUseCaseImpl) SetFileCleaner(fileCleaner out.FileCleaner) {
+	uc.fileCleaner = fileCleaner
+}
+
 // SendMessage 发送消息
 // 1. 幂等检查（基于clientMsgID）
 // 2. 使用Redis Lua脚本原子生成序号
@@ -57,6 +70,39 @@ func NewEnhancedMessageUseCase(
 // 5. 更新收件箱
 // 6. 发布Kafka事件
 func (uc *EnhancedMessageUseCaseImpl) SendMessage(ctx context.Context, req *in.SendMessageRequest) (*entity.Message, error) {
+	return uc.sendMessage(ctx, req, true)
+}
+
+// SendSystemMessage 发送系统消息
+// 发送者为触发事件的操作人，其可能已不在会话中（如退群），因此跳过成员校验
+func (uc *EnhancedMessageUseCaseImpl) SendSystemMessage(ctx context.Context, req *in.SendMessageRequest) (*entity.Message, error) {
+	req.ContentType = entity.MessageContentTypeSystem
+	return uc.sendMessage(ctx, req, false)
+}
+
+// SyncInboxSetting 同步会话免打扰、置顶状态到收件箱
+// 免打扰会话不计入总未读数
+func (uc *EnhancedMessageUseCaseImpl) SyncInboxSetting(ctx context.Context, userID, conversationID uint64, muted bool, muteUntil int64, pinned bool) error {
+	return uc.inboxRepo.UpdateSetting(ctx, userID, conversationID, muted, muteUntil, pinned)
+}
+
+// InitChannelInbox 初始化频道收件箱
+// 频道消息不写订阅者收件箱，已读位置即为订阅者的读取进度
+func (uc *EnhancedMessageUseCaseImpl) InitChannelInbox(ctx context.Context, userID, conversationID uint64) error {
+	seq, err := uc.seqRepo.GetCurrentSeq(ctx, conversationID)
+	if err != nil {
+		return fmt.Errorf("get current seq: %w", err)
+	}
+	if _, err := uc.inboxRepo.GetOrCreate(ctx, userID, conversationID); err != nil {
+		return fmt.Errorf("ensure inbox: %w", err)
+	}
+	if err := uc.inboxRepo.UpdateLastDelivered(ctx, userID, conversationID, seq); err != nil {
+		return fmt.Errorf("update delivered seq: %w", err)
+	}
+	return uc.inboxRepo.UpdateLastRead(ctx, userID, conversationID, seq)
+}
+
+func (uc *EnhancedMessageUseCaseImpl) sendMessage(ctx context.Context, req *in.SendMessageRequest, checkMember bool) (*entity.Message, error) {
 	if uc.memberRepo == nil {
 		return nil, fmt.Errorf("member repository not configured")
 	}
@@ -70,22 +116,17 @@ func (uc *EnhancedMessageUseCaseImpl) SendMessage(ctx context.Context, req *in.S
 		return existingMsg, nil // 返回已存在的消息，实现幂等
 	}
 
-	// 获取会话成员并校验身份
-	memberIDs, err := uc.memberRepo.ListMemberIDs(ctx, req.ConversationID)
+	// 获取会话状态并校验发言权限（成员身份、禁言、全员禁言、解散）
+	state, err := uc.memberRepo.GetConversationState(ctx, req.ConversationID)
 	if err != nil {
-		return nil, fmt.Errorf("get conversation members: %w", err)
+		return nil, fmt.Errorf("get conversation state: %w", err)
 	}
-
-	isMember := false
-	for _, memberID := range memberIDs {
-		if memberID == req.SenderID {
-			isMember = true
-			break
+	if checkMember {
+		if err := state.CheckSend(req.SenderID, time.Now()); err != nil {
+			return nil, err
 		}
 	}
-	if !isMember {
-		return nil, fmt.Errorf("sender not in conversation")
-	}
+	memberIDs := state.MemberIDs()
 
 	// 使用Redis Lua脚本原子生成序号
 	seq, err := uc.seqRepo.GetNextSeq(ctx, req.ConversationID)
@@ -104,6 +145,7 @@ func (uc *EnhancedMessageUseCaseImpl) SendMessage(ctx context.Context, req *in.S
 		Content:        req.Content,
 		Status:         entity.MessageStatusNormal,
 		ReplyToMsgID:   req.ReplyToMsgID,
+		ExpiresAt:      state.MessageExpiresAt(now),
 		CreatedAt:      now,
 		UpdatedAt:      now,
 	}
@@ -123,7 +165,12 @@ func (uc *EnhancedMessageUseCaseImpl) SendMessage(ctx context.Context, req *in.S
 
 	// 更新收件箱（写扩散模型）
 	// 使用信号量控制并发，避免大群场景下瞬时压垮 Redis
-	if err := uc.updateInboxesConcurrently(ctx, memberIDs, req.SenderID, req.ConversationID, seq); err != nil {
+	// 频道为读扩散，只更新发送者收件箱，订阅者通过 Timeline 拉取
+	inboxUserIDs := memberIDs
+	if state.Channel {
+		inboxUserIDs = []uint64{req.SenderID}
+	}
+	if err := uc.updateInboxesConcurrently(ctx, inboxUserIDs, msg); err != nil {
 		return nil, err
 	}
 
@@ -139,6 +186,13 @@ func (uc *EnhancedMessageUseCaseImpl) SendMessage(ctx context.Context, req *in.S
 			ContentType:    int8(msg.ContentType),
 			Content:        string(contentBytes),
 			CreatedAt:      msg.CreatedAt.Unix(),
+
+			MutedReceiverIDs: state.DNDMemberIDs(),
+			Channel:          state.Channel,
+			SenderName:       uc.senderName(ctx, state, msg.SenderID),
+		}
+		if msg.ExpiresAt != nil {
+			event.ExpiresAt = msg.ExpiresAt.Unix()
 		}
 		if err := uc.eventPub.PublishMessageSent(ctx, event); err != nil {
 			fmt.Printf("publish message sent event failed: %v\n", err)
@@ -148,18 +202,51 @@ func (uc *EnhancedMessageUseCaseImpl) SendMessage(ctx context.Context, req *in.S
 	return msg, nil
 }
 
-// GetMessage 获取单条消息
-func (uc *EnhancedMessageUseCaseImpl) GetMessage(ctx context.Context, messageID uint64) (*entity.Message, error) {
+// GetMessage 获取用户可见的单条消息
+// 与历史消息一致，已撤回、删除或过期的消息视为不存在；非成员同样返回不存在，避免泄露消息ID
+func (uc *EnhancedMessageUseCaseImpl) GetMessage(ctx context.Context, userID, messageID uint64) (*entity.Message, error) {
 	msg, err := uc.msgRepo.GetByID(ctx, messageID)
 	if err != nil {
 		return nil, fmt.Errorf("get message: %w", err)
 	}
-	if msg == nil {
+	if msg == nil || !msg.IsNormal() || msg.IsExpiredAt(time.Now()) {
+		return nil, ErrMessageNotFound
+	}
+
+	isMember, err := uc.isMember(ctx, msg.ConversationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
 		return nil, ErrMessageNotFound
 	}
 	return msg, nil
 }
 
+// isMember 用户是否为会话成员
+// 频道的会话状态只包含群主和管理员，订阅者单独查询成员关系
+func (uc *EnhancedMessageUseCaseImpl) isMember(ctx context.Context, conversationID, userID uint64) (bool, error) {
+	if uc.memberRepo == nil {
+		return false, fmt.Errorf("member repository not configured")
+	}
+	state, err := uc.memberRepo.GetConversationState(ctx, conversationID)
+	if err != nil {
+		return false, fmt.Errorf("get conversation state: %w", err)
+	}
+	if _, ok := state.Members[userID]; ok {
+		return true, nil
+	}
+	if !state.Channel {
+		return false, nil
+	}
+
+	isMember, err := uc.memberRepo.IsMember(ctx, conversationID, userID)
+	if err != nil {
+		return false, fmt.Errorf("check member: %w", err)
+	}
+	return isMember, nil
+}
+
 // GetHistory 获取消息历史（优先从Timeline缓存读取）
 // 实现推拉结合：优先从Redis Timeline读取热数据，缺失时回源MySQL
 func (uc *EnhancedMessageUseCaseImpl) GetHistory(ctx context.Context, conversationID uint64, afterSeq uint64, limit int) ([]*entity.Message, error) {
@@ -170,8 +257,11 @@ func (uc *EnhancedMessageUseCaseImpl) GetHistory(ctx context.Context, conversati
 	// 优先从Redis Timeline读取
 	if uc.timelineRepo != nil {
 		messages, err := uc.timelineRepo.GetMessagesAfterSeq(ctx, conversationID, afterSeq, limit)
+		// 过期消息在清理前可能仍在缓存中，过滤后为空时回源MySQL
 		if err == nil && len(messages) > 0 {
-			return messages, nil
+			if messages = withoutExpired(messages, time.Now()); len(messages) > 0 {
+				return messages, nil
+			}
 		}
 	}
 
@@ -188,8 +278,11 @@ func (uc *EnhancedMessageUseCaseImpl) GetHistoryBefore(ctx context.Context, conv
 	// 优先从Redis Timeline读取
 	if uc.timelineRepo != nil {
 		messages, err := uc.timelineRepo.GetMessagesBeforeSeq(ctx, conversationID, beforeSeq, limit)
+		// 过期消息在清理前可能仍在缓存中，过滤后为空时回源MySQL
 		if err == nil && len(messages) > 0 {
-			return messages, nil
+			if messages = withoutExpired(messages, time.Now()); len(messages) > 0 {
+				return messages, nil
+			}
 		}
 	}
 
@@ -208,13 +301,16 @@ func (uc *EnhancedMessageUseCaseImpl) UpdateRead(ctx context.Context, userID, co
 		return fmt.Errorf("clear unread: %w", err)
 	}
 
-	// 发布已读事件
+	// 发布已读事件，频道不发送已读回执
 	if uc.eventPub != nil {
 		receiverIDs := []uint64{}
 		if uc.memberRepo != nil {
-			members, err := uc.memberRepo.ListMemberIDs(ctx, conversationID)
+			state, err := uc.memberRepo.GetConversationState(ctx, conversationID)
 			if err == nil {
-				for _, memberID := range members {
+				if state.Channel {
+					return nil
+				}
+				for _, memberID := range state.MemberIDs() {
 					if memberID == userID {
 						continue
 					}
@@ -307,13 +403,17 @@ func (uc *EnhancedMessageUseCaseImpl) GetUnreadCount(ctx context.Context, userID
 // updateInboxesConcurrently 并发更新收件箱（写扩散模型的核心实现）
 // 使用 semaphore 控制并发数，避免大群场景下瞬时压垮 Redis
 // 对发送者和接收者使用不同的更新逻辑，通过 Lua 脚本保证原子性
+// 被@的接收者额外记录@提醒位置
 func (uc *EnhancedMessageUseCaseImpl) updateInboxesConcurrently(
 	ctx context.Context,
 	memberIDs []uint64,
-	senderID uint64,
-	conversationID uint64,
-	seq uint64,
+	msg *entity.Message,
 ) error {
+	var (
+		senderID       = msg.SenderID
+		conversationID = msg.ConversationID
+		seq            = msg.Seq
+	)
 	var (
 		wg      sync.WaitGroup
 		errChan = make(chan error, len(memberIDs))
@@ -356,6 +456,12 @@ func (uc *EnhancedMessageUseCaseImpl) updateInboxesConcurrently(
 					errChan <- fmt.Errorf("update delivered seq for receiver %d: %w", mid, err)
 					return
 				}
+				if msg.Mentions(mid) {
+					if err := uc.inboxRepo.MarkMention(ctx, mid, conversationID, seq); err != nil {
+						errChan <- fmt.Errorf("mark mention for receiver %d: %w", mid, err)
+						return
+					}
+				}
 			}
 		}(memberID)
 	}
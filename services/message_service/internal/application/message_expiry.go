@@ -0,0 +1,144 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/EthanQC/IM/services/message_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/message_service/internal/ports/in"
+	"github.com/EthanQC/IM/services/message_service/internal/ports/out"
+)
+
+var _ in.MessageExpiryUseCase = (*EnhancedMessageUseCaseImpl)(nil)
+
+// ReapExpiredMessages 清理一批已过期的消息
+// 先删除引用的文件，失败时整批保留到下一轮重试；消息删除后再移除Timeline缓存并通知成员清理本地副本
+// 只删除发送时记录的、由发送者本人上传且不再被其他消息引用的对象（上传者由文件服务校验）
+// 过期消息在清理前已被历史查询过滤，清理延迟不影响可见性
+func (uc *EnhancedMessageUseCaseImpl) ReapExpiredMessages(ctx context.Context, limit int) (int, error) {
+	now := time.Now()
+	messages, err := uc.msgRepo.ListExpired(ctx, now, limit)
+	if err != nil {
+		return 0, fmt.Errorf("list expired messages: %w", err)
+	}
+	if len(messages) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]uint64, 0, len(messages))
+	byConversation := make(map[uint64][]*entity.Message)
+	for _, msg := range messages {
+		ids = append(ids, msg.ID)
+		byConversation[msg.ConversationID] = append(byConversation[msg.ConversationID], msg)
+	}
+
+	if uc.fileCleaner != nil {
+		if err := uc.deleteUnreferencedFiles(ctx, ids); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := uc.msgRepo.DeleteByIDs(ctx, ids); err != nil {
+		return 0, fmt.Errorf("delete expired messages: %w", err)
+	}
+
+	for convID, msgs := range byConversation {
+		if uc.timelineRepo != nil {
+			for _, msg := range msgs {
+				if err := uc.timelineRepo.RemoveMessage(ctx, convID, msg.Seq); err != nil {
+					zap.L().Warn("Remove expired message from timeline failed",
+						zap.Uint64("conversation_id", convID),
+						zap.Uint64("seq", msg.Seq),
+						zap.Error(err))
+				}
+			}
+		}
+		uc.publishMessagesExpired(ctx, convID, msgs, now)
+	}
+
+	return len(messages), nil
+}
+
+// deleteUnreferencedFiles 删除即将清理的消息引用、且没有其他消息再引用的文件，按发送者分组校验上传者
+func (uc *EnhancedMessageUseCaseImpl) deleteUnreferencedFiles(ctx context.Context, messageIDs []uint64) error {
+	refs, err := uc.msgRepo.ListMediaRefs(ctx, messageIDs)
+	if err != nil {
+		return fmt.Errorf("list media refs: %w", err)
+	}
+	if len(refs) == 0 {
+		return nil
+	}
+
+	objectKeys := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		objectKeys = append(objectKeys, ref.ObjectKey)
+	}
+	referenced, err := uc.msgRepo.ListReferencedObjectKeys(ctx, objectKeys, messageIDs)
+	if err != nil {
+		return fmt.Errorf("list referenced objects: %w", err)
+	}
+
+	byOwner := make(map[uint64][]string)
+	seen := make(map[string]bool, len(refs))
+	for _, ref := range refs {
+		// 同一对象在本批中被多人引用时，只由上传者的那条引用删除成功，其余被文件服务跳过
+		dedupKey := fmt.Sprintf("%d/%s", ref.OwnerID, ref.ObjectKey)
+		if referenced[ref.ObjectKey] || seen[dedupKey] {
+			continue
+		}
+		seen[dedupKey] = true
+		byOwner[ref.OwnerID] = append(byOwner[ref.OwnerID], ref.ObjectKey)
+	}
+
+	for ownerID, keys := range byOwner {
+		if err := uc.fileCleaner.DeleteObjects(ctx, ownerID, keys); err != nil {
+			return fmt.Errorf("delete expired files of user %d: %w", ownerID, err)
+		}
+	}
+	return nil
+}
+
+// publishMessagesExpired 通知会话成员消息已过期
+// 频道只通知群主和管理员，订阅者按消息的过期时间自行清理
+func (uc *EnhancedMessageUseCaseImpl) publishMessagesExpired(ctx context.Context, conversationID uint64, msgs []*entity.Message, expiredAt time.Time) {
+	if uc.eventPub == nil || uc.memberRepo == nil {
+		return
+	}
+	memberIDs, err := uc.memberRepo.ListMemberIDs(ctx, conversationID)
+	if err != nil {
+		zap.L().Warn("List members for expired messages failed",
+			zap.Uint64("conversation_id", conversationID),
+			zap.Error(err))
+		return
+	}
+
+	messageIDs := make([]uint64, len(msgs))
+	for i, msg := range msgs {
+		messageIDs[i] = msg.ID
+	}
+	event := &out.MessagesExpiredEvent{
+		ConversationID: conversationID,
+		MessageIDs:     messageIDs,
+		ReceiverIDs:    memberIDs,
+		ExpiredAt:      expiredAt.Unix(),
+	}
+	if err := uc.eventPub.PublishMessagesExpired(ctx, event); err != nil {
+		zap.L().Warn("Publish messages expired event failed",
+			zap.Uint64("conversation_id", conversationID),
+			zap.Error(err))
+	}
+}
+
+// withoutExpired 过滤已过期但尚未清理的消息
+func withoutExpired(messages []*entity.Message, now time.Time) []*entity.Message {
+	result := messages[:0]
+	for _, msg := range messages {
+		if !msg.IsExpiredAt(now) {
+			result = append(result, msg)
+		}
+	}
+	return result
+}
@@ -0,0 +1,75 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/EthanQC/IM/services/message_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/message_service/internal/ports/out"
+)
+
+const (
+	testChannelID  = uint64(7)
+	testMessageID  = uint64(100)
+	testOwnerID    = uint64(1)
+	testSubscriber = uint64(2)
+	testOutsider   = uint64(3)
+)
+
+type memMessages struct {
+	out.MessageRepository
+	messages map[uint64]*entity.Message
+}
+
+func (r memMessages) GetByID(_ context.Context, id uint64) (*entity.Message, error) {
+	return r.messages[id], nil
+}
+
+// channelMembers 模拟会话服务：会话状态只含群主，订阅者只能通过 IsMember 查到
+type channelMembers struct {
+	out.ConversationMemberRepository
+	subscribers map[uint64]bool
+}
+
+func (r channelMembers) GetConversationState(_ context.Context, conversationID uint64) (*entity.ConversationState, error) {
+	return &entity.ConversationState{
+		ConversationID: conversationID,
+		Channel:        true,
+		Members: map[uint64]*entity.MemberState{
+			testOwnerID: {UserID: testOwnerID, IsManager: true},
+		},
+	}, nil
+}
+
+func (r channelMembers) IsMember(_ context.Context, _ uint64, userID uint64) (bool, error) {
+	return userID == testOwnerID || r.subscribers[userID], nil
+}
+
+func newChannelMessageUseCase() *EnhancedMessageUseCaseImpl {
+	msgs := memMessages{messages: map[uint64]*entity.Message{
+		testMessageID: {ID: testMessageID, ConversationID: testChannelID, Status: entity.MessageStatusNormal},
+	}}
+	members := channelMembers{subscribers: map[uint64]bool{testSubscriber: true}}
+	return NewEnhancedMessageUseCase(msgs, nil, nil, nil, members, nil)
+}
+
+func TestGetMessageAllowsChannelSubscriber(t *testing.T) {
+	uc := newChannelMessageUseCase()
+
+	msg, err := uc.GetMessage(context.Background(), testSubscriber, testMessageID)
+	if err != nil {
+		t.Fatalf("GetMessage for subscriber: %v", err)
+	}
+	if msg.ID != testMessageID {
+		t.Fatalf("got message %d, want %d", msg.ID, testMessageID)
+	}
+}
+
+func TestGetMessageHidesChannelMessageFromNonMember(t *testing.T) {
+	uc := newChannelMessageUseCase()
+
+	if _, err := uc.GetMessage(context.Background(), testOutsider, testMessageID); !errors.Is(err, ErrMessageNotFound) {
+		t.Fatalf("GetMessage for non-member: got %v, want ErrMessageNotFound", err)
+	}
+}
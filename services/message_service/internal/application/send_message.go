@@ -60,22 +60,15 @@ func (uc *MessageUseCaseImpl) SendMessage(ctx context.Context, req *in.SendMessa
 		return existingMsg, nil // 返回已存在的消息，实现幂等
 	}
 
-	// 获取会话成员并校验身份
-	memberIDs, err := uc.memberRepo.ListMemberIDs(ctx, req.ConversationID)
+	// 获取会话状态并校验发言权限
+	state, err := uc.memberRepo.GetConversationState(ctx, req.ConversationID)
 	if err != nil {
-		return nil, fmt.Errorf("get conversation members: %w", err)
+		return nil, fmt.Errorf("get conversation state: %w", err)
 	}
-
-	isMember := false
-	for _, memberID := range memberIDs {
-		if memberID == req.SenderID {
-			isMember = true
-			break
-		}
-	}
-	if !isMember {
-		return nil, fmt.Errorf("sender not in conversation")
+	if err := state.CheckSend(req.SenderID, time.Now()); err != nil {
+		return nil, err
 	}
+	memberIDs := state.MemberIDs()
 
 	// 获取下一个序号
 	seq, err := uc.seqRepo.GetNextSeq(ctx, req.ConversationID)
@@ -94,6 +87,7 @@ func (uc *MessageUseCaseImpl) SendMessage(ctx context.Context, req *in.SendMessa
 		Content:        req.Content,
 		Status:         entity.MessageStatusNormal,
 		ReplyToMsgID:   req.ReplyToMsgID,
+		ExpiresAt:      state.MessageExpiresAt(now),
 		CreatedAt:      now,
 		UpdatedAt:      now,
 	}
@@ -102,8 +96,12 @@ func (uc *MessageUseCaseImpl) SendMessage(ctx context.Context, req *in.SendMessa
 		return nil, fmt.Errorf("create message: %w", err)
 	}
 
-	// 更新收件箱
-	for _, memberID := range memberIDs {
+	// 更新收件箱，频道为读扩散，只更新发送者收件箱
+	inboxUserIDs := memberIDs
+	if state.Channel {
+		inboxUserIDs = []uint64{req.SenderID}
+	}
+	for _, memberID := range inboxUserIDs {
 		if _, err := uc.inboxRepo.GetOrCreate(ctx, memberID, req.ConversationID); err != nil {
 			return nil, fmt.Errorf("ensure inbox: %w", err)
 		}
@@ -122,6 +120,11 @@ func (uc *MessageUseCaseImpl) SendMessage(ctx context.Context, req *in.SendMessa
 		if err := uc.inboxRepo.IncrUnread(ctx, memberID, req.ConversationID, 1); err != nil {
 			return nil, fmt.Errorf("incr unread: %w", err)
 		}
+		if msg.Mentions(memberID) {
+			if err := uc.inboxRepo.MarkMention(ctx, memberID, req.ConversationID, seq); err != nil {
+				return nil, fmt.Errorf("mark mention: %w", err)
+			}
+		}
 	}
 
 	// 发布消息发送事件
@@ -136,6 +139,13 @@ func (uc *MessageUseCaseImpl) SendMessage(ctx context.Context, req *in.SendMessa
 			ContentType:    int8(msg.ContentType),
 			Content:        string(contentBytes),
 			CreatedAt:      msg.CreatedAt.Unix(),
+
+			MutedReceiverIDs: state.DNDMemberIDs(),
+			Channel:          state.Channel,
+			SenderName:       state.DisplayName(msg.SenderID),
+		}
+		if msg.ExpiresAt != nil {
+			event.ExpiresAt = msg.ExpiresAt.Unix()
 		}
 		if err := uc.eventPub.PublishMessageSent(ctx, event); err != nil {
 			// 记录日志但不阻塞
@@ -146,15 +156,28 @@ func (uc *MessageUseCaseImpl) SendMessage(ctx context.Context, req *in.SendMessa
 	return msg, nil
 }
 
-func (uc *MessageUseCaseImpl) GetMessage(ctx context.Context, messageID uint64) (*entity.Message, error) {
+func (uc *MessageUseCaseImpl) GetMessage(ctx context.Context, userID, messageID uint64) (*entity.Message, error) {
 	msg, err := uc.msgRepo.GetByID(ctx, messageID)
 	if err != nil {
 		return nil, fmt.Errorf("get message: %w", err)
 	}
-	if msg == nil {
+	if msg == nil || !msg.IsNormal() || msg.IsExpiredAt(time.Now()) {
 		return nil, ErrMessageNotFound
 	}
-	return msg, nil
+
+	if uc.memberRepo == nil {
+		return nil, fmt.Errorf("member repository not configured")
+	}
+	memberIDs, err := uc.memberRepo.ListMemberIDs(ctx, msg.ConversationID)
+	if err != nil {
+		return nil, fmt.Errorf("list members: %w", err)
+	}
+	for _, id := range memberIDs {
+		if id == userID {
+			return msg, nil
+		}
+	}
+	return nil, ErrMessageNotFound
 }
 
 func (uc *MessageUseCaseImpl) GetHistory(ctx context.Context, conversationID uint64, afterSeq uint64, limit int) ([]*entity.Message, error) {
@@ -0,0 +1,70 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/EthanQC/IM/services/message_service/internal/domain/entity"
+	"github.com/EthanQC/IM/services/message_service/internal/ports/in"
+	"github.com/EthanQC/IM/services/message_service/internal/ports/out"
+)
+
+var _ in.UserProfileSyncUseCase = (*EnhancedMessageUseCaseImpl)(nil)
+
+// SetUserNameResolver 设置用户展示名缓存及回填来源
+// 发送路径只读缓存，未命中时异步从身份服务回填，reader 为空时只依赖资料变更事件
+func (uc *EnhancedMessageUseCaseImpl) SetUserNameResolver(cache out.UserNameCache, reader out.UserProfileReader) {
+	uc.nameCache = cache
+	uc.profileReader = reader
+}
+
+// SyncDisplayName 用户资料变更时更新展示名缓存
+func (uc *EnhancedMessageUseCaseImpl) SyncDisplayName(ctx context.Context, userID uint64, displayName string) error {
+	if uc.nameCache == nil {
+		return nil
+	}
+	return uc.nameCache.SetDisplayNames(ctx, map[uint64]string{userID: displayName})
+}
+
+// senderName 发送者展示名，群昵称优先，其次为缓存中的用户展示名
+// 缓存未命中时本条消息不带展示名，由客户端按用户资料展示
+func (uc *EnhancedMessageUseCaseImpl) senderName(ctx context.Context, state *entity.ConversationState, senderID uint64) string {
+	if nickname := state.DisplayName(senderID); nickname != "" || uc.nameCache == nil {
+		return nickname
+	}
+
+	names, err := uc.nameCache.GetDisplayNames(ctx, []uint64{senderID})
+	if err != nil {
+		fmt.Printf("get cached display name failed: %v\n", err)
+		return ""
+	}
+	if name, ok := names[senderID]; ok {
+		return name
+	}
+	uc.warmDisplayName(senderID)
+	return ""
+}
+
+// warmDisplayName 异步回填展示名缓存，同一用户同时只回填一次
+func (uc *EnhancedMessageUseCaseImpl) warmDisplayName(userID uint64) {
+	if uc.profileReader == nil {
+		return
+	}
+	if _, loaded := uc.warming.LoadOrStore(userID, struct{}{}); loaded {
+		return
+	}
+
+	go func() {
+		defer uc.warming.Delete(userID)
+
+		ctx := context.Background()
+		names, err := uc.profileReader.GetDisplayNames(ctx, []uint64{userID})
+		if err != nil {
+			fmt.Printf("load display name failed: %v\n", err)
+			return
+		}
+		if err := uc.nameCache.SetDisplayNames(ctx, names); err != nil {
+			fmt.Printf("cache display name failed: %v\n", err)
+		}
+	}()
+}
@@ -0,0 +1,103 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrSenderNotMember       = errors.New("sender not in conversation")
+	ErrConversationDissolved = errors.New("conversation dissolved")
+	ErrSenderMuted           = errors.New("sender is muted")
+	ErrConversationMuted     = errors.New("conversation is muted")
+	ErrChannelReadOnly       = errors.New("channel is read-only for subscribers")
+)
+
+// MemberState 会话成员的发送相关状态
+type MemberState struct {
+	UserID     uint64
+	IsManager  bool // 群主或管理员
+	Muted      bool
+	MutedUntil *time.Time // 为空且 Muted 为 true 表示永久禁言
+	DND        bool       // 成员对该会话开启了免打扰
+	// DisplayName 群昵称，未设置时为空
+	DisplayName string
+}
+
+// IsMutedAt 指定时间是否处于禁言
+func (m *MemberState) IsMutedAt(now time.Time) bool {
+	if !m.Muted {
+		return false
+	}
+	return m.MutedUntil == nil || now.Before(*m.MutedUntil)
+}
+
+// ConversationState 会话状态快照（来自 conversation_service）
+// 频道的 Members 只包含群主和管理员，订阅者通过 Timeline 拉取消息
+type ConversationState struct {
+	ConversationID uint64
+	Dissolved      bool
+	MuteAll        bool
+	Channel        bool
+	MessageTTL     time.Duration // 消息自动删除时长，0表示关闭
+	Members        map[uint64]*MemberState
+}
+
+// MemberIDs 成员ID列表
+func (s *ConversationState) MemberIDs() []uint64 {
+	ids := make([]uint64, 0, len(s.Members))
+	for id := range s.Members {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// DisplayName 成员的群昵称，不是成员或未设置时为空
+func (s *ConversationState) DisplayName(userID uint64) string {
+	if m, ok := s.Members[userID]; ok {
+		return m.DisplayName
+	}
+	return ""
+}
+
+// DNDMemberIDs 开启免打扰的成员ID列表
+func (s *ConversationState) DNDMemberIDs() []uint64 {
+	var ids []uint64
+	for id, m := range s.Members {
+		if m.DND {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// MessageExpiresAt 按会话的自动删除设置计算消息过期时间，未开启时为空
+func (s *ConversationState) MessageExpiresAt(sentAt time.Time) *time.Time {
+	if s.MessageTTL <= 0 {
+		return nil
+	}
+	expiresAt := sentAt.Add(s.MessageTTL)
+	return &expiresAt
+}
+
+// CheckSend 校验用户能否发言
+// 全员禁言时群主和管理员仍可发言，个人禁言对所有角色生效，频道仅群主和管理员可发言
+func (s *ConversationState) CheckSend(userID uint64, now time.Time) error {
+	if s.Dissolved {
+		return ErrConversationDissolved
+	}
+	member, ok := s.Members[userID]
+	if s.Channel && (!ok || !member.IsManager) {
+		return ErrChannelReadOnly
+	}
+	if !ok {
+		return ErrSenderNotMember
+	}
+	if member.IsMutedAt(now) {
+		return ErrSenderMuted
+	}
+	if s.MuteAll && !member.IsManager {
+		return ErrConversationMuted
+	}
+	return nil
+}
@@ -16,6 +16,7 @@ type Message struct {
 	Content        MessageContent
 	Status         MessageStatus
 	ReplyToMsgID   *uint64
+	ExpiresAt      *time.Time // 自动删除时间，为空表示不会过期
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
 }
@@ -55,7 +56,9 @@ type MessageContent struct {
 
 // TextContent 文本内容
 type TextContent struct {
-	Text string `json:"text"`
+	Text           string   `json:"text"`
+	MentionUserIDs []uint64 `json:"mention_user_ids,omitempty"` // 被@的成员
+	MentionAll     bool     `json:"mention_all,omitempty"`      // @所有人
 }
 
 // MediaContent 媒体内容
@@ -79,9 +82,12 @@ type LocationContent struct {
 }
 
 // SystemContent 系统消息内容
+// Text 中的 {{uid:N}} 为用户占位符，由客户端替换为展示名
 type SystemContent struct {
-	Type    string          `json:"type"` // member_join, member_leave, etc.
-	Payload json.RawMessage `json:"payload"`
+	Type       string          `json:"type"` // member_join, member_leave, etc.
+	Text       string          `json:"text,omitempty"`
+	Payload    json.RawMessage `json:"payload"`
+	MentionAll bool            `json:"mention_all,omitempty"` // 提醒所有成员，如发布群公告
 }
 
 // IsRevoked 是否已撤回
@@ -94,6 +100,79 @@ func (m *Message) IsNormal() bool {
 	return m.Status == MessageStatusNormal
 }
 
+// IsExpiredAt 指定时间消息是否已过期
+func (m *Message) IsExpiredAt(now time.Time) bool {
+	return m.ExpiresAt != nil && !now.Before(*m.ExpiresAt)
+}
+
+// MediaObjectKeys 消息引用的文件对象（含缩略图）
+func (m *Message) MediaObjectKeys() []string {
+	var keys []string
+	for _, media := range []*MediaContent{m.Content.Image, m.Content.Audio, m.Content.Video, m.Content.File} {
+		if media == nil {
+			continue
+		}
+		if media.ObjectKey != "" {
+			keys = append(keys, media.ObjectKey)
+		}
+		if media.ThumbnailKey != "" {
+			keys = append(keys, media.ThumbnailKey)
+		}
+	}
+	return keys
+}
+
+// Mentions 消息是否@了指定用户，发送者不会被自己@到
+func (m *Message) Mentions(userID uint64) bool {
+	if userID == m.SenderID {
+		return false
+	}
+	if m.Content.System != nil {
+		return m.Content.System.MentionAll
+	}
+	if m.Content.Text == nil {
+		return false
+	}
+	if m.Content.Text.MentionAll {
+		return true
+	}
+	for _, id := range m.Content.Text.MentionUserIDs {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// Preview 会话列表中展示的消息预览
+func (m *Message) Preview() string {
+	if m.IsRevoked() {
+		return "[消息已撤回]"
+	}
+	c := m.Content
+	switch {
+	case c.Text != nil:
+		return c.Text.Text
+	case c.Image != nil:
+		return "[图片]"
+	case c.Audio != nil:
+		return "[语音]"
+	case c.Video != nil:
+		return "[视频]"
+	case c.File != nil:
+		return "[文件] " + c.File.Filename
+	case c.Location != nil:
+		return "[位置] " + c.Location.Name
+	case c.System != nil:
+		if c.System.Text != "" {
+			return c.System.Text
+		}
+		return "[系统消息]"
+	default:
+		return ""
+	}
+}
+
 // Revoke 撤回消息
 func (m *Message) Revoke() {
 	m.Status = MessageStatusRevoked
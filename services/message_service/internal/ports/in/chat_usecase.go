@@ -21,8 +21,9 @@ type MessageUseCase interface {
 	// SendMessage 发送消息
 	SendMessage(ctx context.Context, req *SendMessageRequest) (*entity.Message, error)
 
-	// GetMessage 获取消息
-	GetMessage(ctx context.Context, messageID uint64) (*entity.Message, error)
+	// GetMessage 获取用户可见的单条消息
+	// 用户不是会话成员，或消息已撤回、删除、过期时返回 ErrMessageNotFound
+	GetMessage(ctx context.Context, userID, messageID uint64) (*entity.Message, error)
 
 	// GetHistory 获取历史消息
 	GetHistory(ctx context.Context, conversationID uint64, afterSeq uint64, limit int) ([]*entity.Message, error)
@@ -42,3 +43,56 @@ type MessageUseCase interface {
 	// GetUnreadCount 获取未读数
 	GetUnreadCount(ctx context.Context, userID, conversationID uint64) (int, error)
 }
+
+// SystemMessageUseCase 系统消息用例接口
+type SystemMessageUseCase interface {
+	// SendSystemMessage 发送系统消息（由会话事件触发，不校验发送者成员身份）
+	SendSystemMessage(ctx context.Context, req *SendMessageRequest) (*entity.Message, error)
+}
+
+// InboxSettingUseCase 收件箱设置同步用例接口
+type InboxSettingUseCase interface {
+	// SyncInboxSetting 同步会话免打扰、置顶状态到收件箱（由会话设置事件触发），muteUntil 为0表示永久免打扰
+	SyncInboxSetting(ctx context.Context, userID, conversationID uint64, muted bool, muteUntil int64, pinned bool) error
+
+	// InitChannelInbox 订阅频道时以当前最新序号初始化已读位置，订阅前的历史消息不计入未读
+	InitChannelInbox(ctx context.Context, userID, conversationID uint64) error
+}
+
+// UserProfileSyncUseCase 用户资料同步用例接口
+type UserProfileSyncUseCase interface {
+	// SyncDisplayName 同步用户展示名（由身份服务的资料变更事件触发）
+	SyncDisplayName(ctx context.Context, userID uint64, displayName string) error
+}
+
+// ConversationSummary 会话摘要
+type ConversationSummary struct {
+	ConversationID uint64
+	LastMessage    *entity.Message // 会话暂无消息时为 nil
+	UnreadCount    int
+	HasMention     bool // 未读消息中是否有@我
+}
+
+// ConversationSummaryUseCase 会话摘要用例接口
+type ConversationSummaryUseCase interface {
+	// GetConversationSummaries 批量获取用户的会话摘要，结果与 conversationIDs 顺序一致
+	// channelIDs 为其中的频道，频道未读数按最新序号与已读位置计算
+	GetConversationSummaries(ctx context.Context, userID uint64, conversationIDs, channelIDs []uint64) ([]*ConversationSummary, error)
+
+	// GetUnreadCounts 批量获取用户的未读数，只返回有未读的会话
+	// channelIDs 含义同 GetConversationSummaries
+	GetUnreadCounts(ctx context.Context, userID uint64, conversationIDs, channelIDs []uint64) ([]*UnreadCount, error)
+}
+
+// UnreadCount 会话未读数
+type UnreadCount struct {
+	ConversationID uint64
+	UnreadCount    int
+	HasMention     bool
+}
+
+// MessageExpiryUseCase 消息过期清理用例接口
+type MessageExpiryUseCase interface {
+	// ReapExpiredMessages 删除一批已过期的消息，返回删除数量
+	ReapExpiredMessages(ctx context.Context, limit int) (int, error)
+}
@@ -1,8 +1,23 @@
 package out
 
-import "context"
+import (
+	"context"
+
+	"github.com/EthanQC/IM/services/message_service/internal/domain/entity"
+)
 
 // ConversationMemberRepository 提供会话成员读取能力
 type ConversationMemberRepository interface {
 	ListMemberIDs(ctx context.Context, conversationID uint64) ([]uint64, error)
+
+	// GetConversationState 获取会话状态及成员禁言、角色信息
+	GetConversationState(ctx context.Context, conversationID uint64) (*entity.ConversationState, error)
+
+	// IsMember 用户是否为会话成员，含频道订阅者
+	IsMember(ctx context.Context, conversationID, userID uint64) (bool, error)
+}
+
+// ConversationStateInvalidator 会话状态缓存失效接口
+type ConversationStateInvalidator interface {
+	Invalidate(conversationID uint64)
 }
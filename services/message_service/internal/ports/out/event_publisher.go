@@ -1,6 +1,9 @@
 package out
 
-import "context"
+import (
+	"context"
+	"encoding/json"
+)
 
 // EventPublisher 事件发布器接口
 type EventPublisher interface {
@@ -12,6 +15,12 @@ type EventPublisher interface {
 
 	// PublishMessageRead 发布消息已读事件
 	PublishMessageRead(ctx context.Context, event *MessageReadEvent) error
+
+	// PublishMessagesExpired 发布消息过期删除事件
+	PublishMessagesExpired(ctx context.Context, event *MessagesExpiredEvent) error
+
+	// PublishDeadLetter 发布处理失败且重试耗尽的事件到死信队列
+	PublishDeadLetter(ctx context.Context, event *DeadLetterEvent) error
 }
 
 // MessageSentEvent 消息发送事件
@@ -24,6 +33,15 @@ type MessageSentEvent struct {
 	ContentType    int8   `json:"content_type"`
 	Content        string `json:"content"`
 	CreatedAt      int64  `json:"created_at"`
+	// MutedReceiverIDs 开启免打扰的接收者，投递时仍实时推送但不发离线通知
+	MutedReceiverIDs []uint64 `json:"muted_receiver_ids,omitempty"`
+	// Channel 频道消息（读扩散），ReceiverIDs 只包含群主和管理员，订阅者通过 Timeline 拉取
+	Channel bool `json:"channel,omitempty"`
+	// SenderName 发送者在会话中的展示名（群昵称优先，其次为用户展示名），随消息下发给客户端
+	// 展示名尚未缓存时为空
+	SenderName string `json:"sender_name,omitempty"`
+	// ExpiresAt 消息自动删除时间（Unix秒），0表示不会过期
+	ExpiresAt int64 `json:"expires_at,omitempty"`
 }
 
 // MessageRevokedEvent 消息撤回事件
@@ -43,3 +61,21 @@ type MessageReadEvent struct {
 	ReadSeq        uint64 `json:"read_seq"`
 	ReadAt         int64  `json:"read_at"`
 }
+
+// MessagesExpiredEvent 消息过期删除事件，客户端收到后清理本地副本
+type MessagesExpiredEvent struct {
+	ConversationID uint64   `json:"conversation_id"`
+	MessageIDs     []uint64 `json:"message_ids"`
+	ReceiverIDs    []uint64 `json:"receiver_ids"`
+	ExpiredAt      int64    `json:"expired_at"`
+}
+
+// DeadLetterEvent 死信事件，保留原始消息以便人工排查或重放
+type DeadLetterEvent struct {
+	OriginalTopic string          `json:"original_topic"`
+	OriginalKey   string          `json:"original_key"`
+	Payload       json.RawMessage `json:"payload"`
+	ErrorMsg      string          `json:"error_msg"`
+	RetryCount    int             `json:"retry_count"`
+	CreatedAt     int64           `json:"created_at"`
+}
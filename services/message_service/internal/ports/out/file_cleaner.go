@@ -0,0 +1,9 @@
+package out
+
+import "context"
+
+// FileCleaner 文件清理接口（file_service），用于删除过期消息引用的文件
+type FileCleaner interface {
+	// DeleteObjects 按ObjectKey删除 ownerID 上传的文件，不存在或属于他人的对象忽略
+	DeleteObjects(ctx context.Context, ownerID uint64, objectKeys []string) error
+}
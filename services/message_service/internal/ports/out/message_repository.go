@@ -2,13 +2,14 @@ package out
 
 import (
 	"context"
+	"time"
 
 	"github.com/EthanQC/IM/services/message_service/internal/domain/entity"
 )
 
 // MessageRepository 消息仓储接口
 type MessageRepository interface {
-	// Create 创建消息
+	// Create 创建消息，同一事务内记录消息引用的文件对象
 	Create(ctx context.Context, msg *entity.Message) error
 
 	// GetByID 根据ID获取消息
@@ -28,6 +29,26 @@ type MessageRepository interface {
 
 	// GetLatestSeq 获取会话最新消息序号
 	GetLatestSeq(ctx context.Context, conversationID uint64) (uint64, error)
+
+	// ListExpired 获取在 before 之前过期的消息，按过期时间升序
+	ListExpired(ctx context.Context, before time.Time, limit int) ([]*entity.Message, error)
+
+	// DeleteByIDs 物理删除消息及其文件引用（已读回执随外键级联删除）
+	DeleteByIDs(ctx context.Context, ids []uint64) error
+
+	// ListMediaRefs 获取消息引用的文件对象
+	ListMediaRefs(ctx context.Context, messageIDs []uint64) ([]*MediaRef, error)
+
+	// ListReferencedObjectKeys 返回 objectKeys 中仍被 excludeMessageIDs 以外的消息引用的对象
+	ListReferencedObjectKeys(ctx context.Context, objectKeys []string, excludeMessageIDs []uint64) (map[string]bool, error)
+}
+
+// MediaRef 消息引用的文件对象，OwnerID 为消息发送者
+type MediaRef struct {
+	MessageID      uint64
+	ConversationID uint64
+	OwnerID        uint64
+	ObjectKey      string
 }
 
 // SequenceRepository 序列号仓储接口
@@ -37,6 +58,9 @@ type SequenceRepository interface {
 
 	// GetCurrentSeq 获取当前序号
 	GetCurrentSeq(ctx context.Context, conversationID uint64) (uint64, error)
+
+	// BatchGetCurrentSeqs 批量获取当前序号，无序号的会话不出现在结果中
+	BatchGetCurrentSeqs(ctx context.Context, conversationIDs []uint64) (map[uint64]uint64, error)
 }
 
 // InboxRepository 收件箱仓储接口
@@ -67,6 +91,15 @@ type InboxRepository interface {
 
 	// GetUserInboxes 获取用户的所有收件箱
 	GetUserInboxes(ctx context.Context, userID uint64) ([]*entity.Inbox, error)
+
+	// UpdateSetting 同步免打扰、置顶状态，muteUntil 为0表示永久免打扰
+	UpdateSetting(ctx context.Context, userID, conversationID uint64, muted bool, muteUntil int64, pinned bool) error
+
+	// MarkMention 记录用户在会话中最近一次被@的消息序号
+	MarkMention(ctx context.Context, userID, conversationID, seq uint64) error
+
+	// BatchGetInboxes 批量获取用户在多个会话的收件箱，不存在的会话不返回
+	BatchGetInboxes(ctx context.Context, userID uint64, conversationIDs []uint64) (map[uint64]*Inbox, error)
 }
 
 // TimelineRepository 消息时间线仓储接口（Redis热数据缓存）
@@ -83,6 +116,9 @@ type TimelineRepository interface {
 	// GetLatestSeq 获取最新序号
 	GetLatestSeq(ctx context.Context, conversationID uint64) (uint64, error)
 
+	// GetLatestMessages 获取最新的N条消息（按seq升序）
+	GetLatestMessages(ctx context.Context, conversationID uint64, limit int) ([]*entity.Message, error)
+
 	// RemoveMessage 移除消息
 	RemoveMessage(ctx context.Context, conversationID uint64, seq uint64) error
 }
@@ -96,4 +132,10 @@ type Inbox struct {
 	UnreadCount      int
 	IsMuted          bool
 	IsPinned         bool
+	LastMentionSeq   uint64 // 最近一次被@的消息序号
+}
+
+// HasUnreadMention 是否有未读的@提醒
+func (i *Inbox) HasUnreadMention() bool {
+	return i.LastMentionSeq > i.LastReadSeq
 }
@@ -0,0 +1,18 @@
+package out
+
+import "context"
+
+// UserNameCache 用户展示名缓存，由身份服务的用户资料变更事件维护
+type UserNameCache interface {
+	// GetDisplayNames 批量获取缓存的展示名，未缓存的用户不在结果中
+	GetDisplayNames(ctx context.Context, userIDs []uint64) (map[uint64]string, error)
+
+	// SetDisplayNames 写入或覆盖展示名
+	SetDisplayNames(ctx context.Context, names map[uint64]string) error
+}
+
+// UserProfileReader 从身份服务读取用户展示名，只用于异步回填缓存
+type UserProfileReader interface {
+	// GetDisplayNames 批量获取用户展示名，不存在的用户不在结果中
+	GetDisplayNames(ctx context.Context, userIDs []uint64) (map[uint64]string, error)
+}
@@ -41,6 +41,10 @@ export async function apiLogin(input: { username: string; password: string }): P
   return ensureAuthPayload(response.data);
 }
 
+export async function apiLogout(input: { refresh_token?: string }): Promise<void> {
+  await http.post("/auth/logout", input);
+}
+
 export async function apiGetProfile(): Promise<UserProfile> {
   const response = await http.get("/users/me");
   return unwrapData<UserProfile>(response.data);
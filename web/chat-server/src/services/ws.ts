@@ -1,5 +1,6 @@
 import { nanoid } from "nanoid";
 import type { WsEnvelope } from "../types/im";
+import { getAccessToken } from "./token";
 
 interface ClientOptions {
   userId: number;
@@ -32,9 +33,10 @@ function normalizeWsBaseURL(raw: string): string {
   return `${protocol}//${window.location.host}${path}`;
 }
 
-function buildSocketURL(baseURL: string, userId: number, deviceId: string): string {
+// 浏览器 WebSocket 无法设置 Authorization 头，令牌通过 token 参数传递
+function buildSocketURL(baseURL: string, token: string, deviceId: string): string {
   const url = new URL(normalizeWsBaseURL(baseURL));
-  url.searchParams.set("user_id", String(userId));
+  url.searchParams.set("token", token);
   url.searchParams.set("device_id", deviceId);
   url.searchParams.set("platform", "web");
   return url.toString();
@@ -64,7 +66,7 @@ export class IMWebSocketClient {
     }
 
     const wsBaseURL = import.meta.env.VITE_WS_BASE_URL || "/ws";
-    const socketURL = buildSocketURL(wsBaseURL, this.options.userId, this.options.deviceId);
+    const socketURL = buildSocketURL(wsBaseURL, getAccessToken(), this.options.deviceId);
 
     this.ws = new WebSocket(socketURL);
 
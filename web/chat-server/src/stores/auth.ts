@@ -1,6 +1,6 @@
 import { computed, ref } from "vue";
 import { defineStore } from "pinia";
-import { apiGetProfile, apiLogin, apiRegister, apiUpdateProfile } from "../services/api";
+import { apiGetProfile, apiLogin, apiLogout, apiRegister, apiUpdateProfile } from "../services/api";
 import { clearProfile, clearTokens, getAccessToken, getRefreshToken, loadProfile, saveProfile, setTokens } from "../services/token";
 import { toErrorMessage } from "../services/response";
 import type { UserProfile } from "../types/im";
@@ -83,7 +83,15 @@ export const useAuthStore = defineStore("auth", () => {
     return nextProfile;
   }
 
-  function logout(): void {
+  async function logout(): Promise<void> {
+    // 服务端撤销令牌失败不影响本地登出
+    if (accessToken.value) {
+      try {
+        await apiLogout({ refresh_token: refreshToken.value || undefined });
+      } catch {
+        // ignore
+      }
+    }
     clearTokens();
     clearProfile();
     accessToken.value = "";